@@ -34,6 +34,13 @@ func (ald AuthzLimiterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	return next(ctx, tx, simulate)
 }
 
+// CheckDisabledMsgs returns an error if the msgs grant or execute any of the disabled
+// msg types. It applies the restrictions of the decorator to msgs that are not
+// included in a transaction, like the ones dispatched by the authz precompile.
+func (ald AuthzLimiterDecorator) CheckDisabledMsgs(msgs []sdk.Msg) error {
+	return ald.checkDisabledMsgs(msgs, false, 1)
+}
+
 // checkDisabledMsgs iterates through the msgs and returns an error if it finds any unauthorized msgs.
 //
 // When searchOnlyInAuthzMsgs is enabled, only authz MsgGrant and MsgExec are blocked, if they contain unauthorized msg types.
@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -100,11 +101,49 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 	return authInfo.Fee, nil
 }
 
+// GetFeeDenom returns the alternative fee denom selected by the senders of
+// the EVM messages of the tx through a signed MsgSetFeeDenom. It returns nil if
+// the fees are paid in the EVM denom, and an error if the senders do not pay
+// the fees in the same denom.
+func GetFeeDenom(
+	ctx sdktypes.Context,
+	msgs []sdktypes.Msg,
+	feeMarketKeeper anteinterfaces.FeeMarketKeeper,
+) (*feemarkettypes.FeeDenom, error) {
+	var feeDenom *feemarkettypes.FeeDenom
+	for i, msg := range msgs {
+		ethMsg, _, err := evmtypes.UnpackEthMsg(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		var msgFeeDenom *feemarkettypes.FeeDenom
+		if accFeeDenom, found := feeMarketKeeper.GetAccountFeeDenom(ctx, ethMsg.GetFrom()); found {
+			msgFeeDenom = &accFeeDenom
+		}
+
+		if i > 0 && !equalFeeDenoms(feeDenom, msgFeeDenom) {
+			return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "the fees of all the messages of the tx must be paid in the same denom")
+		}
+		feeDenom = msgFeeDenom
+	}
+
+	return feeDenom, nil
+}
+
+func equalFeeDenoms(a, b *feemarkettypes.FeeDenom) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Denom == b.Denom
+}
+
 // CheckTxFee checks if the Amount and GasLimit fields of the txFeeInfo input
 // are equal to the txFee coins and the txGasLimit value.
 // The function expects txFeeInfo to contains coins in the original decimal
-// representation.
-func CheckTxFee(txFeeInfo *tx.Fee, txFee *big.Int, txGasLimit uint64) error {
+// representation. If the fees are paid in an alternative fee denom, the
+// Amount must contain the txFee converted into the fee denom, rounded up.
+func CheckTxFee(txFeeInfo *tx.Fee, txFee *big.Int, txGasLimit uint64, feeDenom *feemarkettypes.FeeDenom) error {
 	if txFeeInfo == nil {
 		return nil
 	}
@@ -113,9 +152,12 @@ func CheckTxFee(txFeeInfo *tx.Fee, txFee *big.Int, txGasLimit uint64) error {
 	// to MsgEthereumTx, which is a sdk tx. Here, the denom will be a uatom, not aatom.
 	// BuildTx then converts uatom to aatom meaning that logic that interacts with the user
 	// will use uatom and internal processing such as the ante handler will operate based on aatom.
-	evmExtendedDenom := evmtypes.GetEVMCoinExtendedDenom()
-	if !txFeeInfo.Amount.AmountOf(evmExtendedDenom).Equal(sdkmath.NewIntFromBigInt(txFee)) {
-		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid AuthInfo Fee Amount (%s != %s)", txFeeInfo.Amount, txFee)
+	expFee := sdktypes.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), sdkmath.NewIntFromBigInt(txFee))
+	if feeDenom != nil {
+		expFee = evmtypes.ConvertAmountFrom18DecimalsToFeeDenom(txFee, *feeDenom, true)
+	}
+	if !txFeeInfo.Amount.AmountOf(expFee.Denom).Equal(expFee.Amount) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid AuthInfo Fee Amount (%s != %s)", txFeeInfo.Amount, expFee)
 	}
 
 	if txFeeInfo.GasLimit != txGasLimit {
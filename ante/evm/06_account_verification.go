@@ -26,6 +26,31 @@ func VerifyAccountBalance(
 	account *statedb.Account,
 	from common.Address,
 	txData evmtypes.TxData,
+) error {
+	return verifyAccountBalance(ctx, accountKeeper, account, from, txData, keeper.CheckSenderBalance)
+}
+
+// VerifyAccountValueBalance checks that the account balance is greater than the
+// transaction value. It is used instead of VerifyAccountBalance when the fees
+// are paid in an alternative fee denom, whose balance is checked when the fees
+// are deducted.
+func VerifyAccountValueBalance(
+	ctx sdk.Context,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+	txData evmtypes.TxData,
+) error {
+	return verifyAccountBalance(ctx, accountKeeper, account, from, txData, keeper.CheckSenderValueBalance)
+}
+
+func verifyAccountBalance(
+	ctx sdk.Context,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+	txData evmtypes.TxData,
+	checkSenderBalance func(sdkmath.Int, evmtypes.TxData) error,
 ) error {
 	// Only EOA are allowed to send transactions.
 	if account != nil && account.IsContract() {
@@ -41,7 +66,7 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
-	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), txData); err != nil {
+	if err := checkSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), txData); err != nil {
 		return errorsmod.Wrap(err, "failed to check sender balance")
 	}
 
@@ -35,3 +35,19 @@ func IncrementNonce(
 	accountKeeper.SetAccount(ctx, account)
 	return nil
 }
+
+// CheckNonceGap checks that the tx nonce is not lower than the sequence of the
+// account, without incrementing it. Nonces ahead of the account sequence are
+// accepted so that the app-side mempool can queue the tx until the gap is
+// filled.
+func CheckNonceGap(account sdk.AccountI, txNonce uint64) error {
+	nonce := account.GetSequence()
+	if txNonce < nonce {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"invalid nonce; got %d, expected >= %d", txNonce, nonce,
+		)
+	}
+
+	return nil
+}
@@ -147,13 +147,13 @@ func checkTxFeeWithValidatorMinGasPrices(ctx sdk.Context, tx sdk.FeeTx) (sdk.Coi
 		}
 	}
 
-	priority := getTxPriority(feeCoins, gas)
+	priority := GetTxPriority(feeCoins, gas)
 	return feeCoins, priority, nil
 }
 
-// getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
+// GetTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
 // provided in a transaction.
-func getTxPriority(fees sdk.Coins, gas int64) int64 {
+func GetTxPriority(fees sdk.Coins, gas int64) int64 {
 	var priority int64
 
 	for _, fee := range fees {
@@ -47,6 +47,10 @@ func (m MockFeemarketKeeper) GetParams(_ sdk.Context) (params feemarkettypes.Par
 	return feemarkettypes.DefaultParams()
 }
 
+func (m MockFeemarketKeeper) GetAccountFeeDenom(_ sdk.Context, _ sdk.AccAddress) (feemarkettypes.FeeDenom, bool) {
+	return feemarkettypes.FeeDenom{}, false
+}
+
 func TestSDKTxFeeChecker(t *testing.T) {
 	// testCases:
 	//   fallback
@@ -7,6 +7,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -25,6 +26,12 @@ type MonoDecorator struct {
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper
 	evmKeeper       anteinterfaces.EVMKeeper
 	maxGasWanted    uint64
+	// mempoolNonceGaps defines if nonce gaps and replacements are accepted
+	// during CheckTx, leaving the ordering of the sender txs to the mempool.
+	mempoolNonceGaps bool
+	// isImpersonated checks if the signature verification of the sender txs
+	// is skipped by a local dev node.
+	isImpersonated func(common.Address) bool
 }
 
 // NewEVMMonoDecorator creates the 'mono' decorator, that is used to run the ante handle logic
@@ -47,6 +54,30 @@ func NewEVMMonoDecorator(
 	}
 }
 
+// WithMempoolNonceGaps returns a copy of the decorator that, during CheckTx,
+// accepts transactions with a nonce ahead of the sender sequence or equal to
+// the nonce of a transaction already in the mempool. The sequence is not
+// incremented during CheckTx, so that the app-side mempool can queue the
+// transactions until the nonce gap is filled and replace them on resubmission.
+//
+// NOTE: it must only be enabled together with an app-side mempool that orders
+// the transactions of each sender by nonce (see the mempool package). The
+// nonce is always checked strictly when the transactions are executed.
+func (md MonoDecorator) WithMempoolNonceGaps() MonoDecorator {
+	md.mempoolNonceGaps = true
+	return md
+}
+
+// WithImpersonation returns a copy of the decorator that skips the signature
+// verification of the txs whose sender is impersonated.
+//
+// NOTE: it must only be used by local dev nodes, together with
+// the x/vm keeper SetImpersonationChecker.
+func (md MonoDecorator) WithImpersonation(isImpersonated func(common.Address) bool) MonoDecorator {
+	md.isImpersonated = isImpersonated
+	return md
+}
+
 // AnteHandle handles the entire decorator chain using a mono decorator.
 func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	// 0. Basic validation of the transaction
@@ -75,19 +106,73 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
-	// NOTE: the protocol does not support multiple EVM messages currently so
-	// this loop will complete after the first message.
+	// NOTE: a Cosmos transaction can contain multiple EVM messages that are
+	// applied sequentially. Every message is checked on its own, so nonces,
+	// balances and fees account for the messages that precede it in the tx.
 	msgs := tx.GetMsgs()
-	if len(msgs) != 1 {
-		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "expected 1 message, got %d", len(msgs))
+	if len(msgs) == 0 {
+		return ctx, errorsmod.Wrap(errortypes.ErrUnknownRequest, "invalid transaction. Transaction without messages")
+	}
+
+	// Track the number of messages so that the execution of the batch can be
+	// reverted as a whole if any of its messages fails.
+	md.evmKeeper.SetTxMsgCountTransient(ctx, uint64(len(msgs))) //nolint:gosec // G115 // won't exceed uint64
+
+	// NOTE: the fee denom is selected by the senders through a signed Cosmos
+	// msg, since the Cosmos tx wrapping the EVM messages is not signed. It is
+	// tracked so that the leftover gas is refunded in the same denom.
+	feeDenom, err := GetFeeDenom(ctx, msgs, md.feeMarketKeeper)
+	if err != nil {
+		return ctx, err
+	}
+	if feeDenom != nil {
+		md.evmKeeper.SetTxFeeDenomTransient(ctx, feeDenom.Denom)
+	} else {
+		md.evmKeeper.SetTxFeeDenomTransient(ctx, "")
+	}
+
+	for msgIndex := range msgs {
+		if err := md.anteHandleMsg(ctx, msgs[msgIndex], msgIndex, simulate, evmDenom, feeDenom, decUtils); err != nil {
+			return ctx, err
+		}
+	}
+
+	// 10. gas wanted
+	if err := CheckGasWanted(ctx, md.feeMarketKeeper, tx, decUtils.Rules.IsLondon); err != nil {
+		return ctx, err
 	}
-	msgIndex := 0
 
-	ethMsg, txData, err := evmtypes.UnpackEthMsg(msgs[msgIndex])
+	if err := CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit, feeDenom); err != nil {
+		return ctx, err
+	}
+
+	ctx, err = CheckBlockGasLimit(ctx, decUtils.GasWanted, decUtils.MinPriority)
 	if err != nil {
 		return ctx, err
 	}
 
+	return next(ctx, tx, simulate)
+}
+
+// anteHandleMsg runs the checks of the mono decorator for a single EVM message
+// contained in the transaction. The decorator utils are updated with the
+// cumulative values (fee, gas limit, gas wanted and priority) of the message.
+// The fees are paid in the fee denom if it is not nil, and in the EVM denom
+// otherwise.
+func (md MonoDecorator) anteHandleMsg(
+	ctx sdk.Context,
+	msg sdk.Msg,
+	msgIndex int,
+	simulate bool,
+	evmDenom string,
+	feeDenom *feemarkettypes.FeeDenom,
+	decUtils *DecoratorUtils,
+) error {
+	ethMsg, txData, err := evmtypes.UnpackEthMsg(msg)
+	if err != nil {
+		return err
+	}
+
 	feeAmt := txData.Fee()
 	gas := txData.GetGas()
 	fee := sdkmath.LegacyNewDecFromBigInt(feeAmt)
@@ -100,7 +185,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	if ctx.IsCheckTx() && !simulate {
 		// FIX: Mempool dec should be converted
 		if err := CheckMempoolFee(fee, decUtils.MempoolMinGasPrice, gasLimit, decUtils.Rules.IsLondon); err != nil {
-			return ctx, err
+			return err
 		}
 	}
 
@@ -116,7 +201,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 
 	// 3. min gas price (global min fee)
 	if err := CheckGlobalFee(fee, decUtils.GlobalMinGasPrice, gasLimit); err != nil {
-		return ctx, err
+		return err
 	}
 
 	// 4. validate msg contents
@@ -125,16 +210,19 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		txData,
 		ethMsg.GetFrom(),
 	); err != nil {
-		return ctx, err
+		return err
 	}
 
 	// 5. signature verification
-	if err := SignatureVerification(
-		ethMsg,
-		decUtils.Signer,
-		decUtils.EvmParams.AllowUnprotectedTxs,
-	); err != nil {
-		return ctx, err
+	impersonated := md.isImpersonated != nil && md.isImpersonated(ethMsg.GetSender())
+	if !impersonated {
+		if err := SignatureVerification(
+			ethMsg,
+			decUtils.Signer,
+			decUtils.EvmParams.AllowUnprotectedTxs,
+		); err != nil {
+			return err
+		}
 	}
 
 	from := ethMsg.GetFrom()
@@ -145,20 +233,24 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	// using a wrapper of the bank keeper as a dependency to scale all
 	// balances to 18 decimals.
 	account := md.evmKeeper.GetAccount(ctx, fromAddr)
-	if err := VerifyAccountBalance(
+	verifyBalance := VerifyAccountBalance
+	if feeDenom != nil {
+		verifyBalance = VerifyAccountValueBalance
+	}
+	if err := verifyBalance(
 		ctx,
 		md.accountKeeper,
 		account,
 		fromAddr,
 		txData,
 	); err != nil {
-		return ctx, err
+		return err
 	}
 
 	// 7. can transfer
 	coreMsg, err := ethMsg.AsMessage(decUtils.BaseFee)
 	if err != nil {
-		return ctx, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			err,
 			"failed to create an ethereum core.Message from signer %T", decUtils.Signer,
 		)
@@ -172,7 +264,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		decUtils.EvmParams,
 		decUtils.Rules.IsLondon,
 	); err != nil {
-		return ctx, err
+		return err
 	}
 
 	// 8. gas consumption
@@ -186,7 +278,13 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		ctx.IsCheckTx(),
 	)
 	if err != nil {
-		return ctx, err
+		return err
+	}
+
+	// The fees paid in an alternative fee denom are converted from the EVM
+	// denom, rounding up, so that the converted base fee is enforced.
+	if feeDenom != nil && !msgFees.IsZero() {
+		msgFees = sdk.Coins{evmtypes.ConvertAmountFrom18DecimalsToFeeDenom(msgFees.AmountOf(evmDenom).BigInt(), *feeDenom, true)}
 	}
 
 	err = ConsumeFeesAndEmitEvent(
@@ -196,7 +294,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		from,
 	)
 	if err != nil {
-		return ctx, err
+		return err
 	}
 
 	gasWanted := UpdateCumulativeGasWanted(
@@ -226,34 +324,24 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	acc := md.accountKeeper.GetAccount(ctx, from)
 	if acc == nil {
 		// safety check: shouldn't happen
-		return ctx, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			errortypes.ErrUnknownAddress,
 			"account %s does not exist",
 			from,
 		)
 	}
 
-	if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
-		return ctx, err
-	}
-
-	// 10. gas wanted
-	if err := CheckGasWanted(ctx, md.feeMarketKeeper, tx, decUtils.Rules.IsLondon); err != nil {
-		return ctx, err
+	if md.mempoolNonceGaps && ctx.IsCheckTx() {
+		if err := CheckNonceGap(acc, txData.GetNonce()); err != nil {
+			return err
+		}
+	} else if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
+		return err
 	}
 
 	// 11. emit events
 	txIdx := uint64(msgIndex) //nolint:gosec // G115
 	EmitTxHashEvent(ctx, ethMsg, decUtils.BlockTxIndex, txIdx)
 
-	if err := CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
-		return ctx, err
-	}
-
-	ctx, err = CheckBlockGasLimit(ctx, decUtils.GasWanted, decUtils.MinPriority)
-	if err != nil {
-		return ctx, err
-	}
-
-	return next(ctx, tx, simulate)
+	return nil
 }
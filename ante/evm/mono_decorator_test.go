@@ -65,9 +65,11 @@ func (k *ExtendedEVMKeeper) ResetTransientGasUsed(_ sdk.Context) {}
 func (k *ExtendedEVMKeeper) GetParams(_ sdk.Context) evmsdktypes.Params {
 	return evmsdktypes.DefaultParams()
 }
-func (k *ExtendedEVMKeeper) GetBaseFee(_ sdk.Context) *big.Int           { return big.NewInt(0) }
-func (k *ExtendedEVMKeeper) GetMinGasPrice(_ sdk.Context) math.LegacyDec { return math.LegacyZeroDec() }
-func (k *ExtendedEVMKeeper) GetTxIndexTransient(_ sdk.Context) uint64    { return 0 }
+func (k *ExtendedEVMKeeper) GetBaseFee(_ sdk.Context) *big.Int              { return big.NewInt(0) }
+func (k *ExtendedEVMKeeper) GetMinGasPrice(_ sdk.Context) math.LegacyDec    { return math.LegacyZeroDec() }
+func (k *ExtendedEVMKeeper) GetTxIndexTransient(_ sdk.Context) uint64       { return 0 }
+func (k *ExtendedEVMKeeper) SetTxMsgCountTransient(_ sdk.Context, _ uint64) {}
+func (k *ExtendedEVMKeeper) SetTxFeeDenomTransient(_ sdk.Context, _ string) {}
 
 // only methods called by EVMMonoDecorator
 type MockFeeMarketKeeper struct{}
@@ -81,15 +83,26 @@ func (m MockFeeMarketKeeper) AddTransientGasWanted(_ sdk.Context, _ uint64) (uin
 }
 func (m MockFeeMarketKeeper) GetBaseFeeEnabled(_ sdk.Context) bool    { return true }
 func (m MockFeeMarketKeeper) GetBaseFee(_ sdk.Context) math.LegacyDec { return math.LegacyZeroDec() }
+func (m MockFeeMarketKeeper) GetAccountFeeDenom(_ sdk.Context, _ sdk.AccAddress) (feemarkettypes.FeeDenom, bool) {
+	return feemarkettypes.FeeDenom{}, false
+}
 
 // matches the actual signatures
 type MockAccountKeeper struct {
 	FundedAddr sdk.AccAddress
+	FundedAcc  *authtypes.BaseAccount
+}
+
+func NewMockAccountKeeper(fundedAddr sdk.AccAddress) MockAccountKeeper {
+	return MockAccountKeeper{
+		FundedAddr: fundedAddr,
+		FundedAcc:  &authtypes.BaseAccount{Address: fundedAddr.String()},
+	}
 }
 
 func (m MockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
 	if m.FundedAddr != nil && addr.Equals(m.FundedAddr) {
-		return &authtypes.BaseAccount{Address: addr.String()}
+		return m.FundedAcc
 	}
 	return nil
 }
@@ -168,7 +181,7 @@ func TestMonoDecorator(t *testing.T) {
 			"",
 		},
 		{
-			"failure with two evm txs",
+			"success with two evm txs",
 			true,
 			func(privKey *ethsecp256k1.PrivKey) []*evmsdktypes.MsgEthereumTx {
 				args1 := &evmsdktypes.EvmTxArgs{
@@ -188,7 +201,30 @@ func TestMonoDecorator(t *testing.T) {
 					signMsgEthereumTx(t, privKey, args2),
 				}
 			},
-			"expected 1 message, got 2",
+			"",
+		},
+		{
+			"failure with two evm txs with invalid nonce order",
+			true,
+			func(privKey *ethsecp256k1.PrivKey) []*evmsdktypes.MsgEthereumTx {
+				args1 := &evmsdktypes.EvmTxArgs{
+					Nonce:    1,
+					GasLimit: 100000,
+					GasPrice: big.NewInt(1),
+					Input:    []byte("test"),
+				}
+				args2 := &evmsdktypes.EvmTxArgs{
+					Nonce:    0,
+					GasLimit: 100000,
+					GasPrice: big.NewInt(1),
+					Input:    []byte("test2"),
+				}
+				return []*evmsdktypes.MsgEthereumTx{
+					signMsgEthereumTx(t, privKey, args1),
+					signMsgEthereumTx(t, privKey, args2),
+				}
+			},
+			"invalid nonce; got 1, expected 0",
 		},
 	}
 
@@ -196,7 +232,7 @@ func TestMonoDecorator(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			privKey, _ := ethsecp256k1.GenerateKey()
 			keeper, cosmosAddr := setupFundedKeeper(t, privKey)
-			accountKeeper := MockAccountKeeper{FundedAddr: cosmosAddr}
+			accountKeeper := NewMockAccountKeeper(cosmosAddr)
 
 			monoDec := evm.NewEVMMonoDecorator(accountKeeper, MockFeeMarketKeeper{}, keeper, 0)
 			ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
@@ -216,3 +252,49 @@ func TestMonoDecorator(t *testing.T) {
 		})
 	}
 }
+
+func TestMonoDecoratorMempoolNonceGaps(t *testing.T) {
+	chainID := uint64(config.EighteenDecimalsChainID)
+	require.NoError(t, config.EvmAppOptions(chainID))
+	cfg := encoding.MakeConfig(chainID)
+
+	testCases := []struct {
+		name    string
+		nonce   uint64
+		checkTx bool
+		expErr  string
+	}{
+		{"success with nonce gap in CheckTx", 2, true, ""},
+		{"failure with nonce gap in DeliverTx", 2, false, "invalid nonce; got 2, expected 0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			privKey, _ := ethsecp256k1.GenerateKey()
+			keeper, cosmosAddr := setupFundedKeeper(t, privKey)
+			accountKeeper := NewMockAccountKeeper(cosmosAddr)
+
+			monoDec := evm.NewEVMMonoDecorator(accountKeeper, MockFeeMarketKeeper{}, keeper, 0).WithMempoolNonceGaps()
+			ctx := sdk.NewContext(nil, tmproto.Header{}, tc.checkTx, log.NewNopLogger())
+			ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(1e19))
+
+			msg := signMsgEthereumTx(t, privKey, &evmsdktypes.EvmTxArgs{
+				Nonce:    tc.nonce,
+				GasLimit: 100000,
+				GasPrice: big.NewInt(1),
+				Input:    []byte("test"),
+			})
+			tx, err := utiltx.PrepareEthTx(cfg.TxConfig, nil, msg)
+			require.NoError(t, err)
+
+			_, err = monoDec.AnteHandle(ctx, tx, true, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				// the account sequence is not incremented by CheckTx
+				require.Equal(t, uint64(0), accountKeeper.FundedAcc.GetSequence())
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -29,6 +29,8 @@ type EVMKeeper interface {
 	GetBalance(ctx sdk.Context, addr common.Address) *uint256.Int
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
+	SetTxMsgCountTransient(ctx sdk.Context, count uint64)
+	SetTxFeeDenomTransient(ctx sdk.Context, feeDenom string)
 	GetParams(ctx sdk.Context) evmtypes.Params
 	// GetBaseFee returns the BaseFee param from the fee market module
 	// adapted according to the evm denom decimals
@@ -44,6 +46,7 @@ type FeeMarketKeeper interface {
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
 	GetBaseFeeEnabled(ctx sdk.Context) bool
 	GetBaseFee(ctx sdk.Context) math.LegacyDec
+	GetAccountFeeDenom(ctx sdk.Context, addr sdk.AccAddress) (feemarkettypes.FeeDenom, bool)
 }
 
 type ProtoTxProvider interface {
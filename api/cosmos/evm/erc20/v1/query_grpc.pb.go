@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_TokenPairs_FullMethodName = "/cosmos.evm.erc20.v1.Query/TokenPairs"
-	Query_TokenPair_FullMethodName  = "/cosmos.evm.erc20.v1.Query/TokenPair"
-	Query_Params_FullMethodName     = "/cosmos.evm.erc20.v1.Query/Params"
+	Query_TokenPairs_FullMethodName      = "/cosmos.evm.erc20.v1.Query/TokenPairs"
+	Query_TokenPair_FullMethodName       = "/cosmos.evm.erc20.v1.Query/TokenPair"
+	Query_TokenPairSupply_FullMethodName = "/cosmos.evm.erc20.v1.Query/TokenPairSupply"
+	Query_Params_FullMethodName          = "/cosmos.evm.erc20.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -32,6 +33,9 @@ type QueryClient interface {
 	TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
+	// TokenPairSupply retrieves the amounts held on both sides of a registered
+	// token pair
+	TokenPairSupply(ctx context.Context, in *QueryTokenPairSupplyRequest, opts ...grpc.CallOption) (*QueryTokenPairSupplyResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -62,6 +66,15 @@ func (c *queryClient) TokenPair(ctx context.Context, in *QueryTokenPairRequest,
 	return out, nil
 }
 
+func (c *queryClient) TokenPairSupply(ctx context.Context, in *QueryTokenPairSupplyRequest, opts ...grpc.CallOption) (*QueryTokenPairSupplyResponse, error) {
+	out := new(QueryTokenPairSupplyResponse)
+	err := c.cc.Invoke(ctx, Query_TokenPairSupply_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
@@ -79,6 +92,9 @@ type QueryServer interface {
 	TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
+	// TokenPairSupply retrieves the amounts held on both sides of a registered
+	// token pair
+	TokenPairSupply(context.Context, *QueryTokenPairSupplyRequest) (*QueryTokenPairSupplyResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -94,6 +110,9 @@ func (UnimplementedQueryServer) TokenPairs(context.Context, *QueryTokenPairsRequ
 func (UnimplementedQueryServer) TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenPair not implemented")
 }
+func (UnimplementedQueryServer) TokenPairSupply(context.Context, *QueryTokenPairSupplyRequest) (*QueryTokenPairSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPairSupply not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -146,6 +165,24 @@ func _Query_TokenPair_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenPairSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPairSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenPairSupply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPairSupply(ctx, req.(*QueryTokenPairSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -179,6 +216,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TokenPair",
 			Handler:    _Query_TokenPair_Handler,
 		},
+		{
+			MethodName: "TokenPairSupply",
+			Handler:    _Query_TokenPairSupply_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -19,11 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_ConvertERC20_FullMethodName     = "/cosmos.evm.erc20.v1.Msg/ConvertERC20"
-	Msg_ConvertCoin_FullMethodName      = "/cosmos.evm.erc20.v1.Msg/ConvertCoin"
-	Msg_UpdateParams_FullMethodName     = "/cosmos.evm.erc20.v1.Msg/UpdateParams"
-	Msg_RegisterERC20_FullMethodName    = "/cosmos.evm.erc20.v1.Msg/RegisterERC20"
-	Msg_ToggleConversion_FullMethodName = "/cosmos.evm.erc20.v1.Msg/ToggleConversion"
+	Msg_ConvertERC20_FullMethodName        = "/cosmos.evm.erc20.v1.Msg/ConvertERC20"
+	Msg_ConvertCoin_FullMethodName         = "/cosmos.evm.erc20.v1.Msg/ConvertCoin"
+	Msg_UpdateParams_FullMethodName        = "/cosmos.evm.erc20.v1.Msg/UpdateParams"
+	Msg_RegisterERC20_FullMethodName       = "/cosmos.evm.erc20.v1.Msg/RegisterERC20"
+	Msg_ToggleConversion_FullMethodName    = "/cosmos.evm.erc20.v1.Msg/ToggleConversion"
+	Msg_UpdateCoinMetadata_FullMethodName  = "/cosmos.evm.erc20.v1.Msg/UpdateCoinMetadata"
+	Msg_MigrateTokenPair_FullMethodName    = "/cosmos.evm.erc20.v1.Msg/MigrateTokenPair"
+	Msg_DeregisterTokenPair_FullMethodName = "/cosmos.evm.erc20.v1.Msg/DeregisterTokenPair"
 )
 
 // MsgClient is the client API for Msg service.
@@ -48,6 +51,19 @@ type MsgClient interface {
 	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error)
+	// UpdateCoinMetadata defines a governance operation for updating the bank
+	// metadata of the Cosmos coin of a token pair. The authority is hard-coded to
+	// the Cosmos SDK x/gov module account
+	UpdateCoinMetadata(ctx context.Context, in *MsgUpdateCoinMetadata, opts ...grpc.CallOption) (*MsgUpdateCoinMetadataResponse, error)
+	// MigrateTokenPair defines a governance operation for migrating a native
+	// Cosmos coin token pair to a new ERC20 precompile address. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	MigrateTokenPair(ctx context.Context, in *MsgMigrateTokenPair, opts ...grpc.CallOption) (*MsgMigrateTokenPairResponse, error)
+	// DeregisterTokenPair defines a governance operation for removing the token
+	// pair of an external ERC20 contract after returning the escrowed ERC20
+	// tokens to the holders of the Cosmos coins. The authority is hard-coded to
+	// the Cosmos SDK x/gov module account
+	DeregisterTokenPair(ctx context.Context, in *MsgDeregisterTokenPair, opts ...grpc.CallOption) (*MsgDeregisterTokenPairResponse, error)
 }
 
 type msgClient struct {
@@ -103,6 +119,33 @@ func (c *msgClient) ToggleConversion(ctx context.Context, in *MsgToggleConversio
 	return out, nil
 }
 
+func (c *msgClient) UpdateCoinMetadata(ctx context.Context, in *MsgUpdateCoinMetadata, opts ...grpc.CallOption) (*MsgUpdateCoinMetadataResponse, error) {
+	out := new(MsgUpdateCoinMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateCoinMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateTokenPair(ctx context.Context, in *MsgMigrateTokenPair, opts ...grpc.CallOption) (*MsgMigrateTokenPairResponse, error) {
+	out := new(MsgMigrateTokenPairResponse)
+	err := c.cc.Invoke(ctx, Msg_MigrateTokenPair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterTokenPair(ctx context.Context, in *MsgDeregisterTokenPair, opts ...grpc.CallOption) (*MsgDeregisterTokenPairResponse, error) {
+	out := new(MsgDeregisterTokenPairResponse)
+	err := c.cc.Invoke(ctx, Msg_DeregisterTokenPair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -125,6 +168,19 @@ type MsgServer interface {
 	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error)
+	// UpdateCoinMetadata defines a governance operation for updating the bank
+	// metadata of the Cosmos coin of a token pair. The authority is hard-coded to
+	// the Cosmos SDK x/gov module account
+	UpdateCoinMetadata(context.Context, *MsgUpdateCoinMetadata) (*MsgUpdateCoinMetadataResponse, error)
+	// MigrateTokenPair defines a governance operation for migrating a native
+	// Cosmos coin token pair to a new ERC20 precompile address. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	MigrateTokenPair(context.Context, *MsgMigrateTokenPair) (*MsgMigrateTokenPairResponse, error)
+	// DeregisterTokenPair defines a governance operation for removing the token
+	// pair of an external ERC20 contract after returning the escrowed ERC20
+	// tokens to the holders of the Cosmos coins. The authority is hard-coded to
+	// the Cosmos SDK x/gov module account
+	DeregisterTokenPair(context.Context, *MsgDeregisterTokenPair) (*MsgDeregisterTokenPairResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -147,6 +203,15 @@ func (UnimplementedMsgServer) RegisterERC20(context.Context, *MsgRegisterERC20)
 func (UnimplementedMsgServer) ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleConversion not implemented")
 }
+func (UnimplementedMsgServer) UpdateCoinMetadata(context.Context, *MsgUpdateCoinMetadata) (*MsgUpdateCoinMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCoinMetadata not implemented")
+}
+func (UnimplementedMsgServer) MigrateTokenPair(context.Context, *MsgMigrateTokenPair) (*MsgMigrateTokenPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateTokenPair not implemented")
+}
+func (UnimplementedMsgServer) DeregisterTokenPair(context.Context, *MsgDeregisterTokenPair) (*MsgDeregisterTokenPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterTokenPair not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -250,6 +315,60 @@ func _Msg_ToggleConversion_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateCoinMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCoinMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCoinMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateCoinMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCoinMetadata(ctx, req.(*MsgUpdateCoinMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateTokenPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateTokenPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateTokenPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MigrateTokenPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateTokenPair(ctx, req.(*MsgMigrateTokenPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterTokenPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterTokenPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterTokenPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeregisterTokenPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterTokenPair(ctx, req.(*MsgDeregisterTokenPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -277,6 +396,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleConversion",
 			Handler:    _Msg_ToggleConversion_Handler,
 		},
+		{
+			MethodName: "UpdateCoinMetadata",
+			Handler:    _Msg_UpdateCoinMetadata_Handler,
+		},
+		{
+			MethodName: "MigrateTokenPair",
+			Handler:    _Msg_MigrateTokenPair_Handler,
+		},
+		{
+			MethodName: "DeregisterTokenPair",
+			Handler:    _Msg_DeregisterTokenPair_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/erc20/v1/tx.proto",
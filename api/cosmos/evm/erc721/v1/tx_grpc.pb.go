@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/evm/erc721/v1/tx.proto
+
+package erc721v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_ConvertERC721_FullMethodName  = "/cosmos.evm.erc721.v1.Msg/ConvertERC721"
+	Msg_ConvertNFT_FullMethodName     = "/cosmos.evm.erc721.v1.Msg/ConvertNFT"
+	Msg_UpdateParams_FullMethodName   = "/cosmos.evm.erc721.v1.Msg/UpdateParams"
+	Msg_RegisterERC721_FullMethodName = "/cosmos.evm.erc721.v1.Msg/RegisterERC721"
+	Msg_RegisterClass_FullMethodName  = "/cosmos.evm.erc721.v1.Msg/RegisterClass"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// ConvertERC721 escrows ERC721 tokens of a registered contract and mints
+	// their x/nft representation.
+	ConvertERC721(ctx context.Context, in *MsgConvertERC721, opts ...grpc.CallOption) (*MsgConvertERC721Response, error)
+	// ConvertNFT burns the x/nft representation of ERC721 tokens and releases
+	// the escrowed ERC721 tokens.
+	ConvertNFT(ctx context.Context, in *MsgConvertNFT, opts ...grpc.CallOption) (*MsgConvertNFTResponse, error)
+	// UpdateParams defines a governance operation for updating the x/erc721
+	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
+	// module account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterERC721 defines a governance operation for registering a token pair
+	// for the specified ERC721 contracts. The authority is hard-coded to the
+	// Cosmos SDK x/gov module account
+	RegisterERC721(ctx context.Context, in *MsgRegisterERC721, opts ...grpc.CallOption) (*MsgRegisterERC721Response, error)
+	// RegisterClass defines a governance operation for registering a token pair
+	// backed by an ERC721 precompile for the specified x/nft classes. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	RegisterClass(ctx context.Context, in *MsgRegisterClass, opts ...grpc.CallOption) (*MsgRegisterClassResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ConvertERC721(ctx context.Context, in *MsgConvertERC721, opts ...grpc.CallOption) (*MsgConvertERC721Response, error) {
+	out := new(MsgConvertERC721Response)
+	err := c.cc.Invoke(ctx, Msg_ConvertERC721_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertNFT(ctx context.Context, in *MsgConvertNFT, opts ...grpc.CallOption) (*MsgConvertNFTResponse, error) {
+	out := new(MsgConvertNFTResponse)
+	err := c.cc.Invoke(ctx, Msg_ConvertNFT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterERC721(ctx context.Context, in *MsgRegisterERC721, opts ...grpc.CallOption) (*MsgRegisterERC721Response, error) {
+	out := new(MsgRegisterERC721Response)
+	err := c.cc.Invoke(ctx, Msg_RegisterERC721_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterClass(ctx context.Context, in *MsgRegisterClass, opts ...grpc.CallOption) (*MsgRegisterClassResponse, error) {
+	out := new(MsgRegisterClassResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterClass_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// ConvertERC721 escrows ERC721 tokens of a registered contract and mints
+	// their x/nft representation.
+	ConvertERC721(context.Context, *MsgConvertERC721) (*MsgConvertERC721Response, error)
+	// ConvertNFT burns the x/nft representation of ERC721 tokens and releases
+	// the escrowed ERC721 tokens.
+	ConvertNFT(context.Context, *MsgConvertNFT) (*MsgConvertNFTResponse, error)
+	// UpdateParams defines a governance operation for updating the x/erc721
+	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
+	// module account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterERC721 defines a governance operation for registering a token pair
+	// for the specified ERC721 contracts. The authority is hard-coded to the
+	// Cosmos SDK x/gov module account
+	RegisterERC721(context.Context, *MsgRegisterERC721) (*MsgRegisterERC721Response, error)
+	// RegisterClass defines a governance operation for registering a token pair
+	// backed by an ERC721 precompile for the specified x/nft classes. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	RegisterClass(context.Context, *MsgRegisterClass) (*MsgRegisterClassResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) ConvertERC721(context.Context, *MsgConvertERC721) (*MsgConvertERC721Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC721 not implemented")
+}
+func (UnimplementedMsgServer) ConvertNFT(context.Context, *MsgConvertNFT) (*MsgConvertNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertNFT not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) RegisterERC721(context.Context, *MsgRegisterERC721) (*MsgRegisterERC721Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterERC721 not implemented")
+}
+func (UnimplementedMsgServer) RegisterClass(context.Context, *MsgRegisterClass) (*MsgRegisterClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterClass not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_ConvertERC721_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertERC721)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertERC721(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConvertERC721_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertERC721(ctx, req.(*MsgConvertERC721))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConvertNFT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertNFT(ctx, req.(*MsgConvertNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterERC721_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterERC721)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterERC721(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterERC721_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterERC721(ctx, req.(*MsgRegisterERC721))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterClass)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterClass(ctx, req.(*MsgRegisterClass))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.evm.erc721.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConvertERC721",
+			Handler:    _Msg_ConvertERC721_Handler,
+		},
+		{
+			MethodName: "ConvertNFT",
+			Handler:    _Msg_ConvertNFT_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterERC721",
+			Handler:    _Msg_RegisterERC721_Handler,
+		},
+		{
+			MethodName: "RegisterClass",
+			Handler:    _Msg_RegisterClass_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/evm/erc721/v1/tx.proto",
+}
@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName   = "/cosmos.evm.feemarket.v1.Query/Params"
-	Query_BaseFee_FullMethodName  = "/cosmos.evm.feemarket.v1.Query/BaseFee"
-	Query_BlockGas_FullMethodName = "/cosmos.evm.feemarket.v1.Query/BlockGas"
+	Query_Params_FullMethodName          = "/cosmos.evm.feemarket.v1.Query/Params"
+	Query_BaseFee_FullMethodName         = "/cosmos.evm.feemarket.v1.Query/BaseFee"
+	Query_BlockGas_FullMethodName        = "/cosmos.evm.feemarket.v1.Query/BlockGas"
+	Query_BurnedBaseFee_FullMethodName   = "/cosmos.evm.feemarket.v1.Query/BurnedBaseFee"
+	Query_AccountFeeDenom_FullMethodName = "/cosmos.evm.feemarket.v1.Query/AccountFeeDenom"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,12 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BurnedBaseFee queries the cumulative amount of the EVM denom burned from
+	// the base fee.
+	BurnedBaseFee(ctx context.Context, in *QueryBurnedBaseFeeRequest, opts ...grpc.CallOption) (*QueryBurnedBaseFeeResponse, error)
+	// AccountFeeDenom queries the fee denom used to pay the fees of the EVM
+	// transactions of an account.
+	AccountFeeDenom(ctx context.Context, in *QueryAccountFeeDenomRequest, opts ...grpc.CallOption) (*QueryAccountFeeDenomResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +79,24 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BurnedBaseFee(ctx context.Context, in *QueryBurnedBaseFeeRequest, opts ...grpc.CallOption) (*QueryBurnedBaseFeeResponse, error) {
+	out := new(QueryBurnedBaseFeeResponse)
+	err := c.cc.Invoke(ctx, Query_BurnedBaseFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountFeeDenom(ctx context.Context, in *QueryAccountFeeDenomRequest, opts ...grpc.CallOption) (*QueryAccountFeeDenomResponse, error) {
+	out := new(QueryAccountFeeDenomResponse)
+	err := c.cc.Invoke(ctx, Query_AccountFeeDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +107,12 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BurnedBaseFee queries the cumulative amount of the EVM denom burned from
+	// the base fee.
+	BurnedBaseFee(context.Context, *QueryBurnedBaseFeeRequest) (*QueryBurnedBaseFeeResponse, error)
+	// AccountFeeDenom queries the fee denom used to pay the fees of the EVM
+	// transactions of an account.
+	AccountFeeDenom(context.Context, *QueryAccountFeeDenomRequest) (*QueryAccountFeeDenomResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +129,12 @@ func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (
 func (UnimplementedQueryServer) BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (UnimplementedQueryServer) BurnedBaseFee(context.Context, *QueryBurnedBaseFeeRequest) (*QueryBurnedBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedBaseFee not implemented")
+}
+func (UnimplementedQueryServer) AccountFeeDenom(context.Context, *QueryAccountFeeDenomRequest) (*QueryAccountFeeDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountFeeDenom not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +202,42 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BurnedBaseFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedBaseFee(ctx, req.(*QueryBurnedBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountFeeDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountFeeDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountFeeDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountFeeDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountFeeDenom(ctx, req.(*QueryAccountFeeDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +257,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BurnedBaseFee",
+			Handler:    _Query_BurnedBaseFee_Handler,
+		},
+		{
+			MethodName: "AccountFeeDenom",
+			Handler:    _Query_AccountFeeDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/query.proto",
@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Msg_UpdateParams_FullMethodName = "/cosmos.evm.feemarket.v1.Msg/UpdateParams"
+	Msg_SetFeeDenom_FullMethodName  = "/cosmos.evm.feemarket.v1.Msg/SetFeeDenom"
 )
 
 // MsgClient is the client API for Msg service.
@@ -30,6 +31,9 @@ type MsgClient interface {
 	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetFeeDenom defines a method for an account to select the fee denom used
+	// to pay the fees of its EVM transactions.
+	SetFeeDenom(ctx context.Context, in *MsgSetFeeDenom, opts ...grpc.CallOption) (*MsgSetFeeDenomResponse, error)
 }
 
 type msgClient struct {
@@ -49,6 +53,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetFeeDenom(ctx context.Context, in *MsgSetFeeDenom, opts ...grpc.CallOption) (*MsgSetFeeDenomResponse, error) {
+	out := new(MsgSetFeeDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeeDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -57,6 +70,9 @@ type MsgServer interface {
 	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetFeeDenom defines a method for an account to select the fee denom used
+	// to pay the fees of its EVM transactions.
+	SetFeeDenom(context.Context, *MsgSetFeeDenom) (*MsgSetFeeDenomResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -67,6 +83,9 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) SetFeeDenom(context.Context, *MsgSetFeeDenom) (*MsgSetFeeDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeDenom not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -98,6 +117,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetFeeDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeeDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeDenom(ctx, req.(*MsgSetFeeDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -109,6 +146,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetFeeDenom",
+			Handler:    _Msg_SetFeeDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/tx.proto",
@@ -0,0 +1,412 @@
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ParseAlloc parses the alloc of a geth genesis file. Both a full geth
+// genesis and a standalone alloc are accepted.
+func ParseAlloc(bz []byte) (ethtypes.GenesisAlloc, error) {
+	// only the alloc of the geth genesis is decoded, the other fields are
+	// not required
+	var gethGenesis struct {
+		Alloc ethtypes.GenesisAlloc `json:"alloc"`
+	}
+	if err := json.Unmarshal(bz, &gethGenesis); err == nil && gethGenesis.Alloc != nil {
+		return gethGenesis.Alloc, nil
+	}
+
+	var alloc ethtypes.GenesisAlloc
+	if err := json.Unmarshal(bz, &alloc); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal geth genesis alloc: %w", err)
+	}
+	return alloc, nil
+}
+
+// ImportAlloc adds the accounts of the geth genesis alloc to the application
+// state. The balances, in the 18 decimals representation of the EVM coin, are
+// split between the bank balances in the EVM coin denom and the fractional
+// balances of the precisebank module, according to the decimals of the coin.
+//
+// The accounts must not exist in the application state, unless
+// appendAccounts is set. In that case, the balances are added to the existing
+// ones and the sequence of the account is raised to the nonce of the alloc.
+func ImportAlloc(
+	cdc codec.Codec,
+	appState map[string]json.RawMessage,
+	alloc ethtypes.GenesisAlloc,
+	coinInfo evmtypes.EvmCoinInfo,
+	appendAccounts bool,
+) error {
+	if err := coinInfo.Decimals.Validate(); err != nil {
+		return err
+	}
+	conversionFactor := coinInfo.Decimals.ConversionFactor()
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	var evmGenState evmtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[evmtypes.ModuleName], &evmGenState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", evmtypes.ModuleName, err)
+	}
+
+	precisebankGenState := precisebanktypes.DefaultGenesisState()
+	if conversionFactor.GT(sdkmath.OneInt()) {
+		if err := cdc.UnmarshalJSON(appState[precisebanktypes.ModuleName], precisebankGenState); err != nil {
+			return fmt.Errorf("failed to unmarshal %s genesis state: %w", precisebanktypes.ModuleName, err)
+		}
+	}
+
+	contracts := make(map[common.Address]bool, len(evmGenState.Accounts)+len(evmGenState.Preinstalls))
+	for _, account := range evmGenState.Accounts {
+		contracts[common.HexToAddress(account.Address)] = true
+	}
+	for _, preinstall := range evmGenState.Preinstalls {
+		contracts[common.HexToAddress(preinstall.Address)] = true
+	}
+
+	fractionalIndexes := make(map[string]int, len(precisebankGenState.Balances))
+	for i, balance := range precisebankGenState.Balances {
+		fractionalIndexes[balance.Address] = i
+	}
+	reserve := precisebankGenState.TotalAmountWithRemainder().Quo(conversionFactor)
+
+	for _, address := range sortedAddresses(alloc) {
+		account := alloc[address]
+		accAddr := sdk.AccAddress(address.Bytes())
+
+		if err := importAccount(&accs, accAddr, account.Nonce, appendAccounts); err != nil {
+			return err
+		}
+
+		balance := sdkmath.ZeroInt()
+		if account.Balance != nil {
+			if account.Balance.Sign() < 0 {
+				return fmt.Errorf("negative balance for account %s", address)
+			}
+			balance = sdkmath.NewIntFromBigInt(account.Balance)
+		}
+
+		integer, fractional := balance.Quo(conversionFactor), balance.Mod(conversionFactor)
+		if fractional.IsPositive() {
+			carry := addFractionalBalance(precisebankGenState, fractionalIndexes, accAddr.String(), fractional, conversionFactor)
+			integer = integer.Add(carry)
+		}
+		if integer.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(coinInfo.Denom, integer))
+			bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: accAddr.String(), Coins: coins})
+			bankGenState.Supply = bankGenState.Supply.Add(coins...)
+		}
+
+		if len(account.Code) == 0 && len(account.Storage) == 0 {
+			continue
+		}
+		if contracts[address] {
+			return fmt.Errorf("contract %s already exists in the %s genesis state", address, evmtypes.ModuleName)
+		}
+		evmGenState.Accounts = append(evmGenState.Accounts, newGenesisAccount(address, account))
+	}
+
+	accs = authtypes.SanitizeGenesisAccounts(accs)
+	authGenState.Accounts, err = authtypes.PackAccounts(accs)
+	if err != nil {
+		return fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+
+	if conversionFactor.GT(sdkmath.OneInt()) {
+		// the remainder completes the sum of the fractional balances to a
+		// multiple of the conversion factor, backed by the integer coins of
+		// the precisebank module account
+		precisebankGenState.Remainder = sdkmath.ZeroInt()
+		if offBy := precisebankGenState.Balances.SumAmount().Mod(conversionFactor); offBy.IsPositive() {
+			precisebankGenState.Remainder = conversionFactor.Sub(offBy)
+		}
+
+		if reserveDiff := precisebankGenState.TotalAmountWithRemainder().Quo(conversionFactor).Sub(reserve); reserveDiff.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(coinInfo.Denom, reserveDiff))
+			moduleAddr := authtypes.NewModuleAddress(precisebanktypes.ModuleName)
+			bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: moduleAddr.String(), Coins: coins})
+			bankGenState.Supply = bankGenState.Supply.Add(coins...)
+		}
+
+		precisebankGenStateBz, err := cdc.MarshalJSON(precisebankGenState)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s genesis state: %w", precisebanktypes.ModuleName, err)
+		}
+		appState[precisebanktypes.ModuleName] = precisebankGenStateBz
+	}
+
+	// merge the balances of the same address
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(mergeBalances(bankGenState.Balances))
+
+	authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s genesis state: %w", authtypes.ModuleName, err)
+	}
+	appState[authtypes.ModuleName] = authGenStateBz
+
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s genesis state: %w", banktypes.ModuleName, err)
+	}
+	appState[banktypes.ModuleName] = bankGenStateBz
+
+	evmGenStateBz, err := cdc.MarshalJSON(&evmGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s genesis state: %w", evmtypes.ModuleName, err)
+	}
+	appState[evmtypes.ModuleName] = evmGenStateBz
+
+	return nil
+}
+
+// ExportAlloc converts the EVM accounts of the application state into a geth
+// genesis alloc. The balances are returned in the 18 decimals representation
+// of the EVM coin. Accounts without balance, nonce, code and storage are
+// omitted, as well as the reserve of the precisebank module.
+func ExportAlloc(
+	cdc codec.Codec,
+	appState map[string]json.RawMessage,
+	coinInfo evmtypes.EvmCoinInfo,
+) (ethtypes.GenesisAlloc, error) {
+	if err := coinInfo.Decimals.Validate(); err != nil {
+		return nil, err
+	}
+	conversionFactor := coinInfo.Decimals.ConversionFactor()
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	var evmGenState evmtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[evmtypes.ModuleName], &evmGenState); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", evmtypes.ModuleName, err)
+	}
+
+	precisebankGenState := precisebanktypes.DefaultGenesisState()
+	if conversionFactor.GT(sdkmath.OneInt()) {
+		if err := cdc.UnmarshalJSON(appState[precisebanktypes.ModuleName], precisebankGenState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", precisebanktypes.ModuleName, err)
+		}
+	}
+
+	// the reserve of the precisebank module backs the fractional balances
+	reserveAddr := authtypes.NewModuleAddress(precisebanktypes.ModuleName)
+
+	alloc := make(ethtypes.GenesisAlloc)
+	accountOf := func(address common.Address) ethtypes.Account {
+		account, found := alloc[address]
+		if !found {
+			account.Balance = new(big.Int)
+		}
+		return account
+	}
+
+	for _, acc := range accs {
+		if len(acc.GetAddress()) != common.AddressLength || acc.GetSequence() == 0 {
+			continue
+		}
+		address := common.BytesToAddress(acc.GetAddress())
+		account := accountOf(address)
+		account.Nonce = acc.GetSequence()
+		alloc[address] = account
+	}
+
+	for _, balance := range bankGenState.Balances {
+		amount := balance.Coins.AmountOf(coinInfo.Denom)
+		accAddr, err := sdk.AccAddressFromBech32(balance.Address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid balance address %s: %w", balance.Address, err)
+		}
+		if !amount.IsPositive() || len(accAddr) != common.AddressLength || accAddr.Equals(reserveAddr) {
+			continue
+		}
+		address := common.BytesToAddress(accAddr)
+		account := accountOf(address)
+		account.Balance.Add(account.Balance, amount.Mul(conversionFactor).BigInt())
+		alloc[address] = account
+	}
+
+	for _, balance := range precisebankGenState.Balances {
+		accAddr, err := sdk.AccAddressFromBech32(balance.Address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fractional balance address %s: %w", balance.Address, err)
+		}
+		if len(accAddr) != common.AddressLength {
+			continue
+		}
+		address := common.BytesToAddress(accAddr)
+		account := accountOf(address)
+		account.Balance.Add(account.Balance, balance.Amount.BigInt())
+		alloc[address] = account
+	}
+
+	for _, genAccount := range evmGenState.Accounts {
+		address := common.HexToAddress(genAccount.Address)
+		account := accountOf(address)
+		account.Code = common.FromHex(genAccount.Code)
+		if len(genAccount.Storage) > 0 {
+			account.Storage = make(map[common.Hash]common.Hash, len(genAccount.Storage))
+			for _, s := range genAccount.Storage {
+				account.Storage[common.HexToHash(s.Key)] = common.HexToHash(s.Value)
+			}
+		}
+		alloc[address] = account
+	}
+
+	return alloc, nil
+}
+
+// DumpFromAlloc converts the genesis alloc into a geth state dump.
+//
+// NOTE: the state of the application is not stored in a Merkle Patricia trie,
+// so the state and storage roots are not included in the dump.
+func DumpFromAlloc(alloc ethtypes.GenesisAlloc) state.Dump {
+	dump := state.Dump{
+		Accounts: make(map[string]state.DumpAccount, len(alloc)),
+	}
+
+	for _, address := range sortedAddresses(alloc) {
+		account := alloc[address]
+		dumpAccount := state.DumpAccount{
+			Balance:  "0",
+			Nonce:    account.Nonce,
+			CodeHash: evmtypes.EmptyCodeHash,
+		}
+		if account.Balance != nil {
+			dumpAccount.Balance = account.Balance.String()
+		}
+		if len(account.Code) > 0 {
+			dumpAccount.CodeHash = crypto.Keccak256(account.Code)
+			dumpAccount.Code = account.Code
+		}
+		if len(account.Storage) > 0 {
+			dumpAccount.Storage = make(map[common.Hash]string, len(account.Storage))
+			for key, value := range account.Storage {
+				dumpAccount.Storage[key] = common.Bytes2Hex(common.TrimLeftZeroes(value.Bytes()))
+			}
+		}
+		dump.Accounts[address.String()] = dumpAccount
+	}
+
+	return dump
+}
+
+// importAccount adds the account to the genesis accounts, or raises the
+// sequence of the existing account to the nonce when appending.
+func importAccount(accs *authtypes.GenesisAccounts, accAddr sdk.AccAddress, nonce uint64, appendAccounts bool) error {
+	for _, acc := range *accs {
+		if !acc.GetAddress().Equals(accAddr) {
+			continue
+		}
+		if !appendAccounts {
+			return fmt.Errorf("account %s already exists", accAddr)
+		}
+		if acc.GetSequence() < nonce {
+			return acc.SetSequence(nonce)
+		}
+		return nil
+	}
+
+	// account numbers are assigned when the accounts are sanitized
+	*accs = append(*accs, authtypes.NewBaseAccount(accAddr, nil, 0, nonce))
+	return nil
+}
+
+// addFractionalBalance adds the amount to the fractional balance of the
+// address. It returns the integer amount carried over when the sum exceeds
+// the conversion factor, which is credited as bank balance.
+func addFractionalBalance(
+	gs *precisebanktypes.GenesisState,
+	indexes map[string]int,
+	address string,
+	amount, conversionFactor sdkmath.Int,
+) sdkmath.Int {
+	i, found := indexes[address]
+	if !found {
+		indexes[address] = len(gs.Balances)
+		gs.Balances = append(gs.Balances, precisebanktypes.NewFractionalBalance(address, amount))
+		return sdkmath.ZeroInt()
+	}
+
+	sum := gs.Balances[i].Amount.Add(amount)
+	gs.Balances[i].Amount = sum.Mod(conversionFactor)
+	return sum.Quo(conversionFactor)
+}
+
+// newGenesisAccount converts the alloc account into an EVM genesis account.
+// The storage slots are sorted and the empty ones are omitted.
+func newGenesisAccount(address common.Address, account ethtypes.Account) evmtypes.GenesisAccount {
+	keys := make([]common.Hash, 0, len(account.Storage))
+	for key, value := range account.Storage {
+		if value != (common.Hash{}) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Cmp(keys[j]) < 0 })
+
+	storage := make(evmtypes.Storage, len(keys))
+	for i, key := range keys {
+		storage[i] = evmtypes.NewState(key, account.Storage[key])
+	}
+
+	return evmtypes.GenesisAccount{
+		Address: address.String(),
+		Code:    common.Bytes2Hex(account.Code),
+		Storage: storage,
+	}
+}
+
+// mergeBalances merges the balances of the same address.
+func mergeBalances(balances []banktypes.Balance) []banktypes.Balance {
+	indexes := make(map[string]int, len(balances))
+	merged := make([]banktypes.Balance, 0, len(balances))
+	for _, balance := range balances {
+		i, found := indexes[balance.Address]
+		if !found {
+			indexes[balance.Address] = len(merged)
+			merged = append(merged, balance)
+			continue
+		}
+		merged[i].Coins = merged[i].Coins.Add(balance.Coins...)
+	}
+	return merged
+}
+
+// sortedAddresses returns the addresses of the alloc in ascending order.
+func sortedAddresses(alloc ethtypes.GenesisAlloc) []common.Address {
+	addresses := make([]common.Address, 0, len(alloc))
+	for address := range alloc {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool { return addresses[i].Cmp(addresses[j]) < 0 })
+	return addresses
+}
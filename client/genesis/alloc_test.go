@@ -0,0 +1,180 @@
+package genesis_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/client/genesis"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var sixDecimalsCoinInfo = evmtypes.EvmCoinInfo{
+	Denom:         "utest",
+	ExtendedDenom: "atest",
+	DisplayDenom:  "test",
+	Decimals:      evmtypes.SixDecimals,
+}
+
+func newCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+func newAppState(t *testing.T, cdc codec.Codec) map[string]json.RawMessage {
+	t.Helper()
+	return map[string]json.RawMessage{
+		authtypes.ModuleName:        cdc.MustMarshalJSON(authtypes.DefaultGenesisState()),
+		banktypes.ModuleName:        cdc.MustMarshalJSON(banktypes.DefaultGenesisState()),
+		evmtypes.ModuleName:         cdc.MustMarshalJSON(evmtypes.DefaultGenesisState()),
+		precisebanktypes.ModuleName: cdc.MustMarshalJSON(precisebanktypes.DefaultGenesisState()),
+	}
+}
+
+func TestParseAlloc(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	testCases := []struct {
+		name string
+		bz   string
+	}{
+		{"geth genesis", `{"config": {"chainId": 1}, "alloc": {"0x1000000000000000000000000000000000000001": {"balance": "0x10"}}}`},
+		{"standalone alloc", `{"1000000000000000000000000000000000000001": {"balance": "16"}}`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			alloc, err := genesis.ParseAlloc([]byte(tc.bz))
+			require.NoError(t, err)
+			require.Len(t, alloc, 1)
+			require.Equal(t, big.NewInt(16), alloc[addr].Balance)
+		})
+	}
+
+	_, err := genesis.ParseAlloc([]byte(`[]`))
+	require.Error(t, err)
+}
+
+func TestImportExportAlloc(t *testing.T) {
+	cdc := newCodec()
+	appState := newAppState(t, cdc)
+
+	eoa := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	dust := common.HexToAddress("0x3000000000000000000000000000000000000003")
+
+	alloc := ethtypes.GenesisAlloc{
+		eoa: {Balance: big.NewInt(1_500_000_000_003), Nonce: 2},
+		contract: {
+			Balance: new(big.Int),
+			Nonce:   1,
+			Code:    common.FromHex("0x6000"),
+			Storage: map[common.Hash]common.Hash{
+				common.HexToHash("0x01"): common.HexToHash("0x02"),
+				common.HexToHash("0x03"): {},
+			},
+		},
+		dust: {Balance: big.NewInt(700_000_000_000)},
+	}
+	require.NoError(t, genesis.ImportAlloc(cdc, appState, alloc, sixDecimalsCoinInfo, false))
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utest", 1)), balanceOf(bankGenState, sdk.AccAddress(eoa.Bytes())))
+	require.True(t, balanceOf(bankGenState, sdk.AccAddress(dust.Bytes())).IsZero())
+	// the reserve backs the fractional balances and the remainder
+	reserveAddr := authtypes.NewModuleAddress(precisebanktypes.ModuleName)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utest", 2)), balanceOf(bankGenState, reserveAddr))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utest", 3)), bankGenState.Supply)
+
+	var precisebankGenState precisebanktypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[precisebanktypes.ModuleName], &precisebankGenState)
+	require.Len(t, precisebankGenState.Balances, 2)
+	require.Equal(t, sdkmath.NewInt(799_999_999_997), precisebankGenState.Remainder)
+	require.True(t, precisebankGenState.TotalAmountWithRemainder().Mod(sdkmath.NewInt(1e12)).IsZero())
+
+	var evmGenState evmtypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[evmtypes.ModuleName], &evmGenState)
+	require.NoError(t, evmGenState.Validate())
+	require.Len(t, evmGenState.Accounts, 1)
+	require.Len(t, evmGenState.Accounts[0].Storage, 1, "empty slots are omitted")
+
+	exported, err := genesis.ExportAlloc(cdc, appState, sixDecimalsCoinInfo)
+	require.NoError(t, err)
+	require.Len(t, exported, 3)
+	require.Equal(t, alloc[eoa].Balance, exported[eoa].Balance)
+	require.Equal(t, alloc[eoa].Nonce, exported[eoa].Nonce)
+	require.Equal(t, alloc[dust].Balance, exported[dust].Balance)
+	require.Equal(t, alloc[contract].Code, exported[contract].Code)
+	require.Equal(t, uint64(1), exported[contract].Nonce)
+	require.Equal(t, map[common.Hash]common.Hash{
+		common.HexToHash("0x01"): common.HexToHash("0x02"),
+	}, exported[contract].Storage)
+
+	dump := genesis.DumpFromAlloc(exported)
+	require.Equal(t, "1500000000003", dump.Accounts[eoa.String()].Balance)
+	require.Equal(t, "02", dump.Accounts[contract.String()].Storage[common.HexToHash("0x01")])
+}
+
+func TestImportAllocExistingAccount(t *testing.T) {
+	cdc := newCodec()
+	appState := newAppState(t, cdc)
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	alloc := ethtypes.GenesisAlloc{addr: {Balance: big.NewInt(800_000_000_000), Nonce: 1}}
+	require.NoError(t, genesis.ImportAlloc(cdc, appState, alloc, sixDecimalsCoinInfo, false))
+
+	err := genesis.ImportAlloc(cdc, appState, alloc, sixDecimalsCoinInfo, false)
+	require.ErrorContains(t, err, "already exists")
+
+	// appending carries the fractional overflow to the bank balance
+	alloc[addr] = ethtypes.Account{Balance: big.NewInt(800_000_000_000), Nonce: 5}
+	require.NoError(t, genesis.ImportAlloc(cdc, appState, alloc, sixDecimalsCoinInfo, true))
+
+	exported, err := genesis.ExportAlloc(cdc, appState, sixDecimalsCoinInfo)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1_600_000_000_000), exported[addr].Balance)
+	require.Equal(t, uint64(5), exported[addr].Nonce)
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utest", 2)), bankGenState.Supply)
+}
+
+func TestImportAllocEighteenDecimals(t *testing.T) {
+	cdc := newCodec()
+	appState := newAppState(t, cdc)
+	delete(appState, precisebanktypes.ModuleName)
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	coinInfo := evmtypes.EvmCoinInfo{Denom: "atest", ExtendedDenom: "atest", Decimals: evmtypes.EighteenDecimals}
+	alloc := ethtypes.GenesisAlloc{addr: {Balance: big.NewInt(1_000_000_000_000_000_001)}}
+	require.NoError(t, genesis.ImportAlloc(cdc, appState, alloc, coinInfo, false))
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atest", 1_000_000_000_000_000_001)), bankGenState.Supply)
+
+	exported, err := genesis.ExportAlloc(cdc, appState, coinInfo)
+	require.NoError(t, err)
+	require.Equal(t, alloc[addr].Balance, exported[addr].Balance)
+}
+
+func balanceOf(gs *banktypes.GenesisState, addr sdk.AccAddress) sdk.Coins {
+	for _, balance := range gs.Balances {
+		if balance.Address == addr.String() {
+			return balance.Coins
+		}
+	}
+	return sdk.NewCoins()
+}
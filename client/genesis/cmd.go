@@ -0,0 +1,77 @@
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const flagAppendMode = "append"
+
+// ImportEthAllocCmd returns the command that imports the alloc of a geth
+// genesis file into the genesis of the application.
+func ImportEthAllocCmd(coinInfo evmtypes.EvmCoinInfo, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-eth-alloc <genesis.json>",
+		Short: "Import the alloc of a geth genesis file into genesis.json",
+		Long: fmt.Sprintf(`Import the accounts of a geth genesis alloc into genesis.json. Both a full geth
+genesis and a standalone alloc are accepted.
+
+The nonces are set as the sequences of the accounts, the code and storage are
+added to the %s genesis accounts and the balances, in the 18 decimals
+representation of the EVM coin, are converted to the %d decimals of the %s denom.
+`, evmtypes.ModuleName, coinInfo.Decimals, coinInfo.Denom),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			bz, err := os.ReadFile(filepath.Clean(args[0]))
+			if err != nil {
+				return fmt.Errorf("failed to read geth genesis file: %w", err)
+			}
+
+			alloc, err := ParseAlloc(bz)
+			if err != nil {
+				return err
+			}
+
+			appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(config.GenesisFile())
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			appendAccounts, _ := cmd.Flags().GetBool(flagAppendMode)
+			if err := ImportAlloc(clientCtx.Codec, appState, alloc, coinInfo, appendAccounts); err != nil {
+				return err
+			}
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			appGenesis.AppState = appStateJSON
+			return genutil.ExportGenesisFile(appGenesis, config.GenesisFile())
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Bool(flagAppendMode, false, "append the balances to the accounts already in the genesis.json file")
+
+	return cmd
+}
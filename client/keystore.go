@@ -0,0 +1,164 @@
+package client
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/hd"
+	"github.com/cosmos/evm/crypto/keystore"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
+)
+
+const (
+	flagKDF      = "kdf"
+	flagLightKDF = "light-kdf"
+)
+
+// ImportKeystoreCommand imports a key from a Web3 Secret Storage (keystore V3) JSON file.
+func ImportKeystoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-keystore <file> [name]",
+		Short: "Import an Ethereum keystore JSON file into the local keybase",
+		Long: `Import an Ethereum key from a Web3 Secret Storage (keystore V3) JSON file, as
+written by geth, MetaMask and other Ethereum wallets. Both the scrypt and pbkdf2
+key derivation functions are supported. If the name is omitted, the address of the
+key is used.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: runImportKeystoreCmd,
+	}
+}
+
+func runImportKeystoreCmd(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+	clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	keyJSON, err := os.ReadFile(filepath.Clean(args[0]))
+	if err != nil {
+		return err
+	}
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	keystorePassphrase, err := input.GetPassword("Enter the passphrase of the keystore:", inBuf)
+	if err != nil {
+		return err
+	}
+
+	privKey, address, err := keystore.Decrypt(keyJSON, keystorePassphrase)
+	if err != nil {
+		return err
+	}
+
+	name := address.Hex()
+	if len(args) > 1 {
+		name = args[1]
+	}
+
+	passphrase, err := input.GetPassword("Enter passphrase to encrypt your key:", inBuf)
+	if err != nil {
+		return err
+	}
+
+	armor := crypto.EncryptArmorPrivKey(privKey, passphrase, ethsecp256k1.KeyType)
+	if err := clientCtx.Keyring.ImportPrivKey(name, armor, passphrase); err != nil {
+		return err
+	}
+
+	cmd.PrintErrf("key %s imported, address %s\n", name, address.Hex())
+	return nil
+}
+
+// ExportKeystoreCommand exports a key as a Web3 Secret Storage (keystore V3) JSON file.
+func ExportKeystoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-keystore <name>",
+		Short: "Export an Ethereum key as a keystore JSON file",
+		Long: `Export an eth_secp256k1 key as a Web3 Secret Storage (keystore V3) JSON file,
+which can be imported in geth, MetaMask and other Ethereum wallets. The key is encrypted
+with the given passphrase.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runExportKeystoreCmd,
+	}
+
+	cmd.Flags().String(flagKDF, string(keystore.KDFScrypt), fmt.Sprintf("Key derivation function (%s|%s)", keystore.KDFScrypt, keystore.KDFPBKDF2))
+	cmd.Flags().Bool(flagLightKDF, false, "Use less secure key derivation parameters, faster to decrypt")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The keystore is written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+func runExportKeystoreCmd(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+	clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	kdf, _ := cmd.Flags().GetString(flagKDF)
+	if err := keystore.KDF(kdf).Validate(); err != nil {
+		return err
+	}
+	lightKDF, _ := cmd.Flags().GetBool(flagLightKDF)
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	passphrase, err := input.GetPassword("Enter passphrase to encrypt the keystore:", inBuf)
+	if err != nil {
+		return err
+	}
+	repeated, err := input.GetPassword("Repeat the passphrase:", inBuf)
+	if err != nil {
+		return err
+	}
+	if passphrase != repeated {
+		return fmt.Errorf("passphrases don't match")
+	}
+
+	armor, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], passphrase)
+	if err != nil {
+		return err
+	}
+
+	privKey, algo, err := crypto.UnarmorDecryptPrivKey(armor, passphrase)
+	if err != nil {
+		return err
+	}
+
+	if algo != ethsecp256k1.KeyType {
+		return fmt.Errorf("invalid key algorithm, got %s, expected %s", algo, ethsecp256k1.KeyType)
+	}
+
+	ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
+	if !ok {
+		return fmt.Errorf("invalid private key type %T, expected %T", privKey, &ethsecp256k1.PrivKey{})
+	}
+
+	keyJSON, err := keystore.Encrypt(ethPrivKey, passphrase, keystore.KDF(kdf), lightKDF)
+	if err != nil {
+		return err
+	}
+
+	outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+	if outputDocument == "" {
+		_, err := fmt.Fprintln(cmd.OutOrStdout(), string(keyJSON))
+		return err
+	}
+
+	if err := os.WriteFile(outputDocument, keyJSON, 0o600); err != nil {
+		return err
+	}
+
+	cmd.PrintErrf("key %s exported, address %s\n", args[0], common.BytesToAddress(ethPrivKey.PubKey().Address()).Hex())
+	return nil
+}
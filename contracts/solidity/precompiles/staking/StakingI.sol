@@ -115,6 +115,20 @@ struct UnbondingDelegationOutput {
     UnbondingDelegationEntry[] entries;
 }
 
+/// @dev Represents a validator and amount pair used by the batch staking methods.
+struct ValidatorAmount {
+    string validatorAddress;
+    uint256 amount;
+}
+
+/// @dev Represents a delegation between a delegator and a validator.
+struct DelegationResponse {
+    string delegatorAddress;
+    string validatorAddress;
+    uint256 shares;
+    Coin balance;
+}
+
 /// @dev The status of the validator.
 enum BondStatus {
     Unspecified,
@@ -216,6 +230,41 @@ interface StakingI {
         uint256 creationHeight
     ) external returns (bool success);
 
+    /// @dev Defines a method for delegating to multiple validators in a single call.
+    /// The batch is atomic: if any of the delegations fails, the whole call reverts.
+    /// @param delegatorAddress The address of the delegator
+    /// @param delegations The validators and the amounts of the bond denomination to be
+    /// delegated to each of them.
+    /// This amount should use the bond denomination precision stored in the bank metadata.
+    /// @return success Whether or not all the delegations were successful
+    function delegateBatch(
+        address delegatorAddress,
+        ValidatorAmount[] calldata delegations
+    ) external returns (bool success);
+
+    /// @dev Defines a method for undelegating from multiple validators in a single call.
+    /// The batch is atomic: if any of the undelegations fails, the whole call reverts.
+    /// @param delegatorAddress The address of the delegator
+    /// @param undelegations The validators and the amounts of the bond denomination to be
+    /// undelegated from each of them.
+    /// This amount should use the bond denomination precision stored in the bank metadata.
+    /// @return completionTimes The times when the undelegations are completed, in the same
+    /// order as the given undelegations
+    function undelegateBatch(
+        address delegatorAddress,
+        ValidatorAmount[] calldata undelegations
+    ) external returns (int64[] memory completionTimes);
+
+    /// @dev Defines a method for withdrawing the delegation rewards from multiple validators
+    /// in a single call. The batch is atomic: if any of the withdrawals fails, the whole call reverts.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddresses The addresses of the validators to claim the rewards from
+    /// @return amount The total amount of rewards claimed
+    function claimRewardsBatch(
+        address delegatorAddress,
+        string[] calldata validatorAddresses
+    ) external returns (Coin[] memory amount);
+
     /// @dev Queries the given amount of the bond denomination to a validator.
     /// @param delegatorAddress The address of the delegator.
     /// @param validatorAddress The address of the validator.
@@ -295,6 +344,54 @@ interface StakingI {
             PageResponse calldata pageResponse
         );
 
+    /// @dev Queries all delegations of a given delegator address.
+    /// @param delegatorAddress The address of the delegator.
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return delegations The delegations of the given delegator.
+    /// @return pageResponse The pagination response for the query.
+    function delegatorDelegations(
+        address delegatorAddress,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            DelegationResponse[] calldata delegations,
+            PageResponse calldata pageResponse
+        );
+
+    /// @dev Queries all unbonding delegations of a given delegator address.
+    /// @param delegatorAddress The address of the delegator.
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return unbondingDelegations The unbonding delegations of the given delegator.
+    /// @return pageResponse The pagination response for the query.
+    function delegatorUnbondingDelegations(
+        address delegatorAddress,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            UnbondingDelegationOutput[] calldata unbondingDelegations,
+            PageResponse calldata pageResponse
+        );
+
+    /// @dev Queries all delegations to a given validator.
+    /// @param validatorAddress The address of the validator.
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return delegations The delegations to the given validator.
+    /// @return pageResponse The pagination response for the query.
+    function validatorDelegations(
+        string memory validatorAddress,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            DelegationResponse[] calldata delegations,
+            PageResponse calldata pageResponse
+        );
+
     /// @dev CreateValidator defines an Event emitted when a create a new validator.
     /// @param validatorAddress The address of the validator
     /// @param value The amount of coin being self delegated
@@ -367,4 +464,15 @@ interface StakingI {
         uint256 amount,
         uint256 creationHeight
     );
+
+    /// @dev WithdrawDelegatorReward defines an Event emitted when the rewards of a delegation
+    /// are withdrawn through a claimRewardsBatch call.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The address of the validator
+    /// @param amount The amount of bond denomination being withdrawn
+    event WithdrawDelegatorReward(
+        address indexed delegatorAddress,
+        address indexed validatorAddress,
+        uint256 amount
+    );
 }
@@ -0,0 +1,165 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+)
+
+// KDF is the key derivation function used to encrypt a key in the Web3
+// Secret Storage format.
+type KDF string
+
+const (
+	// KDFScrypt is the scrypt key derivation function, used by geth.
+	KDFScrypt KDF = "scrypt"
+	// KDFPBKDF2 is the PBKDF2 key derivation function with HMAC-SHA256.
+	KDFPBKDF2 KDF = "pbkdf2"
+)
+
+const (
+	// version is the version of the Web3 Secret Storage format.
+	version = 3
+
+	// StandardPBKDF2C is the iteration count of PBKDF2, as used by wallets.
+	StandardPBKDF2C = 1 << 18
+	// LightPBKDF2C is a lower iteration count of PBKDF2, faster to decrypt
+	// but less secure.
+	LightPBKDF2C = 1 << 12
+
+	pbkdf2DKLen = 32
+)
+
+// encryptedKey is the JSON representation of a key in the Web3 Secret Storage
+// format.
+type encryptedKey struct {
+	Address string                  `json:"address"`
+	Crypto  gethkeystore.CryptoJSON `json:"crypto"`
+	ID      string                  `json:"id"`
+	Version int                     `json:"version"`
+}
+
+// Validate returns an error if the key derivation function is not supported.
+func (kdf KDF) Validate() error {
+	switch kdf {
+	case KDFScrypt, KDFPBKDF2:
+		return nil
+	default:
+		return fmt.Errorf("unsupported KDF %q, expected %s or %s", kdf, KDFScrypt, KDFPBKDF2)
+	}
+}
+
+// Encrypt encrypts the private key in the Web3 Secret Storage (keystore V3)
+// JSON format. The light parameters are faster to decrypt but less secure.
+func Encrypt(privKey *ethsecp256k1.PrivKey, passphrase string, kdf KDF, light bool) ([]byte, error) {
+	if err := kdf.Validate(); err != nil {
+		return nil, err
+	}
+
+	key, err := privKey.ToECDSA()
+	if err != nil {
+		return nil, err
+	}
+
+	var cryptoJSON gethkeystore.CryptoJSON
+	if kdf == KDFScrypt {
+		scryptN, scryptP := gethkeystore.StandardScryptN, gethkeystore.StandardScryptP
+		if light {
+			scryptN, scryptP = gethkeystore.LightScryptN, gethkeystore.LightScryptP
+		}
+		cryptoJSON, err = gethkeystore.EncryptDataV3(privKey.Bytes(), []byte(passphrase), scryptN, scryptP)
+	} else {
+		c := StandardPBKDF2C
+		if light {
+			c = LightPBKDF2C
+		}
+		cryptoJSON, err = encryptDataPBKDF2(privKey.Bytes(), []byte(passphrase), c)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	return json.Marshal(encryptedKey{
+		Address: hex.EncodeToString(address.Bytes()),
+		Crypto:  cryptoJSON,
+		ID:      id.String(),
+		Version: version,
+	})
+}
+
+// Decrypt decrypts the private key of the Web3 Secret Storage (keystore V3)
+// JSON. Both the scrypt and PBKDF2 key derivation functions are supported.
+func Decrypt(keyJSON []byte, passphrase string) (*ethsecp256k1.PrivKey, common.Address, error) {
+	key, err := gethkeystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to decrypt keystore: %w", err)
+	}
+
+	privKey := &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(key.PrivateKey)}
+	return privKey, key.Address, nil
+}
+
+// IsKeystoreJSON returns true if the data looks like a JSON keystore rather
+// than a raw key.
+func IsKeystoreJSON(data []byte) bool {
+	var key encryptedKey
+	return json.Unmarshal(data, &key) == nil && key.Crypto.CipherText != ""
+}
+
+// encryptDataPBKDF2 encrypts the data with a key derived with PBKDF2, in the
+// same format as gethkeystore.EncryptDataV3.
+func encryptDataPBKDF2(data, auth []byte, c int) (gethkeystore.CryptoJSON, error) {
+	salt := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return gethkeystore.CryptoJSON{}, err
+	}
+	derivedKey := pbkdf2.Key(auth, salt, c, pbkdf2DKLen, sha256.New)
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return gethkeystore.CryptoJSON{}, err
+	}
+
+	block, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return gethkeystore.CryptoJSON{}, err
+	}
+	cipherText := make([]byte, len(data))
+	cipher.NewCTR(block, iv).XORKeyStream(cipherText, data)
+
+	mac := crypto.Keccak256(derivedKey[16:32], cipherText)
+
+	cryptoJSON := gethkeystore.CryptoJSON{
+		Cipher:     "aes-128-ctr",
+		CipherText: hex.EncodeToString(cipherText),
+		KDF:        string(KDFPBKDF2),
+		KDFParams: map[string]interface{}{
+			"c":     c,
+			"dklen": pbkdf2DKLen,
+			"prf":   "hmac-sha256",
+			"salt":  hex.EncodeToString(salt),
+		},
+		MAC: hex.EncodeToString(mac),
+	}
+	cryptoJSON.CipherParams.IV = hex.EncodeToString(iv)
+	return cryptoJSON, nil
+}
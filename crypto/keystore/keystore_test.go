@@ -0,0 +1,62 @@
+package keystore_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/keystore"
+)
+
+// pbkdf2KeyJSON is the PBKDF2 test vector of the Web3 Secret Storage definition.
+const pbkdf2KeyJSON = `{
+	"crypto": {
+		"cipher": "aes-128-ctr",
+		"cipherparams": {"iv": "6087dab2f9fdbbfaddc31a909735c1e6"},
+		"ciphertext": "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46",
+		"kdf": "pbkdf2",
+		"kdfparams": {
+			"c": 262144,
+			"dklen": 32,
+			"prf": "hmac-sha256",
+			"salt": "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"
+		},
+		"mac": "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"
+	},
+	"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+	"version": 3
+}`
+
+func TestDecryptTestVector(t *testing.T) {
+	privKey, _, err := keystore.Decrypt([]byte(pbkdf2KeyJSON), "testpassword")
+	require.NoError(t, err)
+	require.Equal(t, common.FromHex("7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"), privKey.Bytes())
+
+	_, _, err = keystore.Decrypt([]byte(pbkdf2KeyJSON), "wrongpassword")
+	require.Error(t, err)
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	privKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	address := common.BytesToAddress(privKey.PubKey().Address())
+
+	for _, kdf := range []keystore.KDF{keystore.KDFScrypt, keystore.KDFPBKDF2} {
+		t.Run(string(kdf), func(t *testing.T) {
+			keyJSON, err := keystore.Encrypt(privKey, "passphrase", kdf, true)
+			require.NoError(t, err)
+			require.True(t, keystore.IsKeystoreJSON(keyJSON))
+
+			decrypted, decryptedAddress, err := keystore.Decrypt(keyJSON, "passphrase")
+			require.NoError(t, err)
+			require.True(t, privKey.Equals(decrypted))
+			require.Equal(t, address, decryptedAddress)
+		})
+	}
+
+	_, err = keystore.Encrypt(privKey, "passphrase", "argon2", true)
+	require.ErrorContains(t, err, "unsupported KDF")
+	require.False(t, keystore.IsKeystoreJSON([]byte("7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d")))
+}
@@ -6,6 +6,7 @@ import (
 	enccodec "github.com/cosmos/evm/encoding/codec"
 	"github.com/cosmos/evm/ethereum/eip712"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
+	erc721types "github.com/cosmos/evm/x/erc721/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/gogoproto/proto"
 
@@ -31,8 +32,9 @@ func MakeConfig(evmChainID uint64) sdktestutil.TestEncodingConfig {
 			Bech32Prefix: sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
 		},
 		CustomGetSigners: map[protoreflect.FullName]signing.GetSignersFunc{
-			evmtypes.MsgEthereumTxCustomGetSigner.MsgType:     evmtypes.MsgEthereumTxCustomGetSigner.Fn,
-			erc20types.MsgConvertERC20CustomGetSigner.MsgType: erc20types.MsgConvertERC20CustomGetSigner.Fn,
+			evmtypes.MsgEthereumTxCustomGetSigner.MsgType:       evmtypes.MsgEthereumTxCustomGetSigner.Fn,
+			erc20types.MsgConvertERC20CustomGetSigner.MsgType:   erc20types.MsgConvertERC20CustomGetSigner.Fn,
+			erc721types.MsgConvertERC721CustomGetSigner.MsgType: erc721types.MsgConvertERC721CustomGetSigner.Fn,
 		},
 	}
 
@@ -11,14 +11,18 @@ import (
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
+// DisabledAuthzMsgs defines the Msg types that cannot be granted or included on an
+// authz.MsgExec msgs field, both in Cosmos transactions and through the authz precompile.
+var DisabledAuthzMsgs = []string{
+	sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+	sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
+}
+
 // newCosmosAnteHandler creates the default ante handler for Cosmos transactions
 func newCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
-		cosmosante.NewRejectMessagesDecorator(), // reject MsgEthereumTxs
-		cosmosante.NewAuthzLimiterDecorator( // disable the Msg types that cannot be included on an authz.MsgExec msgs field
-			sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
-			sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
-		),
+		cosmosante.NewRejectMessagesDecorator(),                   // reject MsgEthereumTxs
+		cosmosante.NewAuthzLimiterDecorator(DisabledAuthzMsgs...), // disable the Msg types that cannot be included on an authz.MsgExec msgs field
 		ante.NewSetUpContextDecorator(),
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
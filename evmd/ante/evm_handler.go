@@ -8,12 +8,18 @@ import (
 
 // newMonoEVMAnteHandler creates the sdk.AnteHandler implementation for the EVM transactions.
 func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
-	return sdk.ChainAnteDecorators(
-		evmante.NewEVMMonoDecorator(
-			options.AccountKeeper,
-			options.FeeMarketKeeper,
-			options.EvmKeeper,
-			options.MaxTxGasWanted,
-		),
+	monoDecorator := evmante.NewEVMMonoDecorator(
+		options.AccountKeeper,
+		options.FeeMarketKeeper,
+		options.EvmKeeper,
+		options.MaxTxGasWanted,
 	)
+	if options.MempoolNonceGaps {
+		monoDecorator = monoDecorator.WithMempoolNonceGaps()
+	}
+	if options.ImpersonationChecker != nil {
+		monoDecorator = monoDecorator.WithImpersonation(options.ImpersonationChecker)
+	}
+
+	return sdk.ChainAnteDecorators(monoDecorator)
 }
@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"github.com/ethereum/go-ethereum/common"
+
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
@@ -30,6 +32,12 @@ type HandlerOptions struct {
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
 	MaxTxGasWanted         uint64
 	TxFeeChecker           ante.TxFeeChecker
+	// MempoolNonceGaps defines if EVM txs with nonce gaps and replacements are
+	// accepted during CheckTx. It must only be enabled with the EVM mempool.
+	MempoolNonceGaps bool
+	// ImpersonationChecker skips the signature verification of the EVM txs
+	// of impersonated senders. It must only be set by local dev nodes.
+	ImpersonationChecker func(common.Address) bool
 }
 
 // Validate checks if the keepers are defined
@@ -20,16 +20,28 @@ import (
 	evmconfig "github.com/cosmos/evm/config"
 	evmosencoding "github.com/cosmos/evm/encoding"
 	"github.com/cosmos/evm/evmd/ante"
+	evmmempool "github.com/cosmos/evm/mempool"
+	evmdev "github.com/cosmos/evm/server/dev"
 	srvflags "github.com/cosmos/evm/server/flags"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/erc20"
 	erc20keeper "github.com/cosmos/evm/x/erc20/keeper"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	erc20v2 "github.com/cosmos/evm/x/erc20/v2"
+	"github.com/cosmos/evm/x/erc721"
+	erc721keeper "github.com/cosmos/evm/x/erc721/keeper"
+	erc721types "github.com/cosmos/evm/x/erc721/types"
 	"github.com/cosmos/evm/x/feemarket"
 	feemarketkeeper "github.com/cosmos/evm/x/feemarket/keeper"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	ibccallbackskeeper "github.com/cosmos/evm/x/ibc/callbacks/keeper"
+	"github.com/cosmos/evm/x/ibc/nfttransfer"
+	nfttransferkeeper "github.com/cosmos/evm/x/ibc/nfttransfer/keeper"
+	nfttransfertypes "github.com/cosmos/evm/x/ibc/nfttransfer/types"
+	"github.com/cosmos/evm/x/ibc/ratelimit"
+	ratelimitkeeper "github.com/cosmos/evm/x/ibc/ratelimit/keeper"
+	ratelimittypes "github.com/cosmos/evm/x/ibc/ratelimit/types"
+	ratelimitv2 "github.com/cosmos/evm/x/ibc/ratelimit/v2"
 	// NOTE: override ICS20 keeper to support IBC transfers of ERC20 tokens
 	evmdconfig "github.com/cosmos/evm/evmd/cmd/evmd/config"
 	"github.com/cosmos/evm/x/ibc/transfer"
@@ -38,10 +50,21 @@ import (
 	"github.com/cosmos/evm/x/precisebank"
 	precisebankkeeper "github.com/cosmos/evm/x/precisebank/keeper"
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	"github.com/cosmos/evm/x/tokenfactory"
+	tokenfactorykeeper "github.com/cosmos/evm/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/cosmos/evm/x/tokenfactory/types"
 	"github.com/cosmos/evm/x/vm"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/gogoproto/proto"
+	ica "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icahost "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host"
+	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
 	ibctransfer "github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -67,6 +90,9 @@ import (
 	"cosmossdk.io/x/feegrant"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	feegrantmodule "cosmossdk.io/x/feegrant/module"
+	"cosmossdk.io/x/nft"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
+	nftmodule "cosmossdk.io/x/nft/module"
 	"cosmossdk.io/x/upgrade"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
@@ -86,6 +112,7 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -118,6 +145,9 @@ import (
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
+	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -147,6 +177,7 @@ var defaultNodeHome string
 var (
 	_ runtime.AppI            = (*EVMD)(nil)
 	_ servertypes.Application = (*EVMD)(nil)
+	_ evmdev.Application      = (*EVMD)(nil)
 	_ ibctesting.TestingApp   = (*EVMD)(nil)
 )
 
@@ -177,18 +208,26 @@ type EVMD struct {
 	AuthzKeeper           authzkeeper.Keeper
 	EvidenceKeeper        evidencekeeper.Keeper
 	FeeGrantKeeper        feegrantkeeper.Keeper
+	GroupKeeper           groupkeeper.Keeper
+	NFTKeeper             nftkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
 	// IBC keepers
-	IBCKeeper      *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
-	TransferKeeper transferkeeper.Keeper
-	CallbackKeeper ibccallbackskeeper.ContractKeeper
+	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	TransferKeeper      transferkeeper.Keeper
+	NFTTransferKeeper   nfttransferkeeper.Keeper
+	RateLimitKeeper     ratelimitkeeper.Keeper
+	ICAControllerKeeper icacontrollerkeeper.Keeper
+	ICAHostKeeper       icahostkeeper.Keeper
+	CallbackKeeper      ibccallbackskeeper.ContractKeeper
 
 	// Cosmos EVM keepers
-	FeeMarketKeeper   feemarketkeeper.Keeper
-	EVMKeeper         *evmkeeper.Keeper
-	Erc20Keeper       erc20keeper.Keeper
-	PreciseBankKeeper precisebankkeeper.Keeper
+	FeeMarketKeeper    feemarketkeeper.Keeper
+	EVMKeeper          *evmkeeper.Keeper
+	Erc20Keeper        erc20keeper.Keeper
+	Erc721Keeper       erc721keeper.Keeper
+	PreciseBankKeeper  precisebankkeeper.Keeper
+	TokenFactoryKeeper tokenfactorykeeper.Keeper
 
 	// the module manager
 	ModuleManager      *module.Manager
@@ -197,6 +236,9 @@ type EVMD struct {
 	// simulation manager
 	sm *module.SimulationManager
 
+	// devController drives the state overrides of a local dev node
+	devController *evmdev.Controller
+
 	// module configurator
 	configurator module.Configurator
 }
@@ -268,10 +310,13 @@ func NewExampleApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, consensusparamtypes.StoreKey,
 		upgradetypes.StoreKey, feegrant.StoreKey, evidencetypes.StoreKey, authzkeeper.StoreKey,
+		group.StoreKey, nftkeeper.StoreKey,
 		// ibc keys
-		ibcexported.StoreKey, ibctransfertypes.StoreKey,
+		ibcexported.StoreKey, ibctransfertypes.StoreKey, nfttransfertypes.StoreKey,
+		ratelimittypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey,
 		// Cosmos EVM store keys
-		evmtypes.StoreKey, feemarkettypes.StoreKey, erc20types.StoreKey, precisebanktypes.StoreKey,
+		evmtypes.StoreKey, feemarkettypes.StoreKey, erc20types.StoreKey, erc721types.StoreKey,
+		precisebanktypes.StoreKey, tokenfactorytypes.StoreKey,
 	)
 
 	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey)
@@ -395,6 +440,21 @@ func NewExampleApp(
 		appCodec,
 		app.MsgServiceRouter(),
 		app.AccountKeeper,
+	).SetBankKeeper(app.BankKeeper)
+
+	app.GroupKeeper = groupkeeper.NewKeeper(
+		keys[group.StoreKey],
+		appCodec,
+		app.MsgServiceRouter(),
+		app.AccountKeeper,
+		group.DefaultConfig(),
+	)
+
+	app.NFTKeeper = nftkeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[nftkeeper.StoreKey]),
+		appCodec,
+		app.AccountKeeper,
+		app.BankKeeper,
 	)
 
 	// get skipUpgradeHeights from the app options
@@ -455,6 +515,9 @@ func NewExampleApp(
 		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
 		keys[feemarkettypes.StoreKey],
 		tkeys[feemarkettypes.TransientKey],
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
 	)
 
 	// Set up PreciseBank keeper
@@ -463,6 +526,7 @@ func NewExampleApp(
 	app.PreciseBankKeeper = precisebankkeeper.NewKeeper(
 		appCodec,
 		keys[precisebanktypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.BankKeeper,
 		app.AccountKeeper,
 	)
@@ -494,6 +558,27 @@ func NewExampleApp(
 		&app.TransferKeeper,
 	)
 
+	app.Erc721Keeper = erc721keeper.NewKeeper(
+		keys[erc721types.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper,
+		app.NFTKeeper,
+		app.EVMKeeper,
+	)
+
+	// the erc721 keeper instantiates the ERC721 precompiles of the x/nft classes
+	app.EVMKeeper.SetErc721Keeper(&app.Erc721Keeper)
+
+	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
+		keys[tokenfactorytypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.BankKeeper,
+		app.DistrKeeper,
+		&app.Erc20Keeper,
+	)
+
 	// instantiate IBC transfer keeper AFTER the ERC-20 keeper to use it in the instantiation
 	app.TransferKeeper = transferkeeper.NewKeeper(
 		appCodec,
@@ -508,19 +593,57 @@ func NewExampleApp(
 		authAddr,
 	)
 
+	app.NFTTransferKeeper = nfttransferkeeper.NewKeeper(
+		keys[nfttransfertypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.NFTKeeper,
+	)
+
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		keys[ratelimittypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+	)
+
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[icacontrollertypes.StoreKey]),
+		app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper, // replaced with the callbacks middleware below
+		app.IBCKeeper.ChannelKeeper,
+		app.MsgServiceRouter(),
+		authAddr,
+	)
+
+	app.ICAHostKeeper = icahostkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[icahosttypes.StoreKey]),
+		app.GetSubspace(icahosttypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.AccountKeeper,
+		app.MsgServiceRouter(),
+		app.GRPCQueryRouter(),
+		authAddr,
+	)
+
 	/*
 		Create Transfer Stack
 
 		transfer stack contains (from bottom to top):
 			- IBC Callbacks Middleware (with EVM ContractKeeper)
+			- Rate Limit Middleware
 			- ERC-20 Middleware
 			- IBC Transfer
 
 		SendPacket, since it is originating from the application to core IBC:
-		 	transferKeeper.SendPacket ->  erc20.SendPacket -> callbacks.SendPacket -> channel.SendPacket
+		 	transferKeeper.SendPacket -> callbacks.SendPacket -> ratelimit.SendPacket -> channel.SendPacket
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-			channel.RecvPacket -> callbacks.OnRecvPacket -> erc20.OnRecvPacket -> transfer.OnRecvPacket
+			channel.RecvPacket -> callbacks.OnRecvPacket -> ratelimit.OnRecvPacket -> erc20.OnRecvPacket -> transfer.OnRecvPacket
 	*/
 
 	// create IBC module from top to bottom of stack
@@ -529,20 +652,78 @@ func NewExampleApp(
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 	maxCallbackGas := uint64(1_000_000)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	rateLimitMiddleware := ratelimit.NewIBCMiddleware(app.RateLimitKeeper, transferStack, app.IBCKeeper.ChannelKeeper)
+	transferStack = rateLimitMiddleware
 	app.CallbackKeeper = ibccallbackskeeper.NewKeeper(
 		app.AccountKeeper,
 		app.EVMKeeper,
 		app.Erc20Keeper,
+		app.IBCKeeper.ChannelKeeper,
+	)
+	// The ICS-20 and ICS-27 controller adapters are registered by default
+	app.CallbackKeeper.WithPacketAdapter(
+		nfttransfertypes.PortID,
+		ibccallbackskeeper.NewNFTTransferAdapter(app.EVMKeeper, app.Erc721Keeper),
 	)
-	transferStack = ibccallbacks.NewIBCMiddleware(transferStack, app.IBCKeeper.ChannelKeeper, app.CallbackKeeper, maxCallbackGas)
+	// The callbacks middleware sends the packets through the rate limit middleware, so the outgoing
+	// transfers count towards the outflow quotas before the source callback contract is called
+	transferStack = ibccallbacks.NewIBCMiddleware(transferStack, rateLimitMiddleware, app.CallbackKeeper, maxCallbackGas)
+	// Since the callbacks middleware itself is an ics4wrapper, it needs to be passed to the transfer keeper,
+	// so the transfers sent through MsgTransfer and the ICS-20 precompile trigger the send callbacks
+	app.TransferKeeper.WithICS4Wrapper(transferStack.(porttypes.ICS4Wrapper))
+
+	/*
+		Create Interchain Accounts Stack
+
+		SendPacket, since it is originating from the application to core IBC:
+			icaControllerKeeper.SendTx -> callbacks.SendPacket -> channel.SendPacket
+
+		RecvPacket, message that originates from core IBC and goes down to app, the flow is:
+			channel.RecvPacket -> icaHost.OnRecvPacket
+
+		Acknowledgements and timeouts of the controller packets are delivered to the
+		EVM contracts through the IBC Callbacks Middleware.
+	*/
+	var icaControllerStack porttypes.IBCModule
+	icaControllerStack = icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
+	icaControllerStack = ibccallbacks.NewIBCMiddleware(icaControllerStack, app.IBCKeeper.ChannelKeeper, app.CallbackKeeper, maxCallbackGas)
+	// Since the callbacks middleware itself is an ics4wrapper, it needs to be passed to the ICA controller keeper
+	app.ICAControllerKeeper.WithICS4Wrapper(icaControllerStack.(porttypes.ICS4Wrapper))
+
+	icaHostStack := icahost.NewIBCModule(app.ICAHostKeeper)
+
+	/*
+		Create NFT Transfer Stack
+
+		nft-transfer stack contains (from bottom to top):
+			- IBC Callbacks Middleware (with EVM ContractKeeper)
+			- ERC-721 Middleware
+			- ICS-721 NFT Transfer
+
+		SendPacket, since it is originating from the application to core IBC:
+			nftTransferKeeper.SendPacket -> callbacks.SendPacket -> channel.SendPacket
+
+		RecvPacket, message that originates from core IBC and goes down to app, the flow is:
+			channel.RecvPacket -> callbacks.OnRecvPacket -> erc721.OnRecvPacket -> nfttransfer.OnRecvPacket
+	*/
+	var nftTransferStack porttypes.IBCModule
+	nftTransferStack = nfttransfer.NewIBCModule(app.NFTTransferKeeper)
+	nftTransferStack = erc721.NewIBCMiddleware(app.Erc721Keeper, nftTransferStack)
+	nftTransferStack = ibccallbacks.NewIBCMiddleware(nftTransferStack, app.IBCKeeper.ChannelKeeper, app.CallbackKeeper, maxCallbackGas)
+	// Since the callbacks middleware itself is an ics4wrapper, it needs to be passed to the nft-transfer keeper
+	app.NFTTransferKeeper.WithICS4Wrapper(nftTransferStack.(porttypes.ICS4Wrapper))
 
 	var transferStackV2 ibcapi.IBCModule
 	transferStackV2 = transferv2.NewIBCModule(app.TransferKeeper)
 	transferStackV2 = erc20v2.NewIBCMiddleware(transferStackV2, app.Erc20Keeper)
+	transferStackV2 = ratelimitv2.NewIBCMiddleware(transferStackV2, app.RateLimitKeeper)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
+		AddRoute(icahosttypes.SubModuleName, icaHostStack).
+		AddRoute(nfttransfertypes.PortID, nftTransferStack)
 	ibcRouterV2 := ibcapi.NewRouter()
 	ibcRouterV2.AddRoute(ibctransfertypes.ModuleName, transferStackV2)
 
@@ -570,6 +751,13 @@ func NewExampleApp(
 			app.EVMKeeper,
 			app.GovKeeper,
 			app.SlashingKeeper,
+			app.AuthzKeeper,
+			app.FeeGrantKeeper,
+			&app.ICAControllerKeeper,
+			app.IBCKeeper,
+			app.MsgServiceRouter(),
+			app.GroupKeeper,
+			app.TokenFactoryKeeper,
 			app.AppCodec(),
 		),
 	)
@@ -594,17 +782,24 @@ func NewExampleApp(
 		upgrade.NewAppModule(app.UpgradeKeeper, app.AccountKeeper.AddressCodec()),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
 		// IBC modules
 		ibc.NewAppModule(app.IBCKeeper),
 		ibctm.NewAppModule(tmLightClientModule),
 		transferModule,
+		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
+		nfttransfer.NewAppModule(app.NFTTransferKeeper),
+		ratelimit.NewAppModule(app.RateLimitKeeper),
 		// Cosmos EVM modules
 		vm.NewAppModule(app.EVMKeeper, app.AccountKeeper, app.AccountKeeper.AddressCodec()),
 		feemarket.NewAppModule(app.FeeMarketKeeper),
 		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper),
+		erc721.NewAppModule(app.Erc721Keeper, app.AccountKeeper),
 		precisebank.NewAppModule(app.PreciseBankKeeper, app.BankKeeper, app.AccountKeeper),
+		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager which is in charge of setting up basic,
@@ -643,17 +838,18 @@ func NewExampleApp(
 		minttypes.ModuleName,
 
 		// IBC modules
-		ibcexported.ModuleName, ibctransfertypes.ModuleName,
+		ibcexported.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName, nfttransfertypes.ModuleName,
+		ratelimittypes.ModuleName,
 
 		// Cosmos EVM BeginBlockers
-		erc20types.ModuleName, feemarkettypes.ModuleName,
+		erc20types.ModuleName, erc721types.ModuleName, feemarkettypes.ModuleName,
 		evmtypes.ModuleName, // NOTE: EVM BeginBlocker must come after FeeMarket BeginBlocker
 
 		// TODO: remove no-ops? check if all are no-ops before removing
 		distrtypes.ModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName,
 		authtypes.ModuleName, banktypes.ModuleName, govtypes.ModuleName, genutiltypes.ModuleName,
-		authz.ModuleName, feegrant.ModuleName,
+		authz.ModuleName, feegrant.ModuleName, group.ModuleName, nft.ModuleName,
 		paramstypes.ModuleName, consensusparamtypes.ModuleName,
 		precisebanktypes.ModuleName,
 		vestingtypes.ModuleName,
@@ -662,15 +858,16 @@ func NewExampleApp(
 	// NOTE: the feemarket module should go last in order of end blockers that are actually doing something,
 	// to get the full block gas used.
 	app.ModuleManager.SetOrderEndBlockers(
-		govtypes.ModuleName, stakingtypes.ModuleName,
+		govtypes.ModuleName, group.ModuleName, stakingtypes.ModuleName,
 		authtypes.ModuleName, banktypes.ModuleName,
 
 		// Cosmos EVM EndBlockers
-		evmtypes.ModuleName, erc20types.ModuleName, feemarkettypes.ModuleName,
+		evmtypes.ModuleName, erc20types.ModuleName, erc721types.ModuleName, feemarkettypes.ModuleName,
 
 		// no-ops
-		ibcexported.ModuleName, ibctransfertypes.ModuleName,
-		distrtypes.ModuleName,
+		ibcexported.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName, nfttransfertypes.ModuleName,
+		ratelimittypes.ModuleName,
+		distrtypes.ModuleName, nft.ModuleName,
 		slashingtypes.ModuleName, minttypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName, consensusparamtypes.ModuleName,
@@ -695,10 +892,13 @@ func NewExampleApp(
 		feemarkettypes.ModuleName,
 		erc20types.ModuleName,
 		precisebanktypes.ModuleName,
+		tokenfactorytypes.ModuleName,
 
-		ibctransfertypes.ModuleName,
+		ibctransfertypes.ModuleName, icatypes.ModuleName, ratelimittypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
-		feegrant.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
+		feegrant.ModuleName, group.ModuleName, nft.ModuleName,
+		// NOTE: erc721 genesis must come after x/nft to register the classes of the token pairs
+		erc721types.ModuleName, nfttransfertypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -742,6 +942,8 @@ func NewExampleApp(
 	app.MountTransientStores(tkeys)
 
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
+	mempoolNonceGaps := app.setEVMMempool(appOpts)
+	app.setDevController(appOpts)
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
@@ -749,7 +951,7 @@ func NewExampleApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
-	app.setAnteHandler(app.txConfig, maxGasWanted)
+	app.setAnteHandler(app.txConfig, maxGasWanted, mempoolNonceGaps)
 
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
@@ -794,7 +996,7 @@ func NewExampleApp(
 	return app
 }
 
-func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64, mempoolNonceGaps bool) {
 	options := ante.HandlerOptions{
 		Cdc:                    app.appCodec,
 		AccountKeeper:          app.AccountKeeper,
@@ -808,6 +1010,10 @@ func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
 		SigGasConsumer:         evmante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
 		TxFeeChecker:           cosmosevmante.NewDynamicFeeChecker(app.FeeMarketKeeper),
+		MempoolNonceGaps:       mempoolNonceGaps,
+	}
+	if app.devController != nil {
+		options.ImpersonationChecker = app.devController.IsImpersonated
 	}
 	if err := options.Validate(); err != nil {
 		panic(err)
@@ -816,6 +1022,54 @@ func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
 	app.SetAnteHandler(ante.NewAnteHandler(options))
 }
 
+// setEVMMempool replaces the mempool and the ABCI proposal handlers with the
+// EVM mempool if it is enabled in the app config. It returns true if the EVM
+// mempool is used, in which case the ante handler must accept nonce gaps.
+func (app *EVMD) setEVMMempool(appOpts servertypes.AppOptions) bool {
+	maxTxs := cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs))
+	if !cast.ToBool(appOpts.Get(srvflags.EVMMempoolEnable)) || maxTxs < 0 {
+		return false
+	}
+
+	mpool := evmmempool.NewMempool(
+		evmmempool.Config{
+			PriceBump:    cast.ToUint64(appOpts.Get(srvflags.EVMMempoolPriceBump)),
+			AccountSlots: cast.ToUint64(appOpts.Get(srvflags.EVMMempoolAccountSlots)),
+			MaxTxs:       maxTxs,
+		},
+		app.AccountKeeper,
+		app.EVMKeeper,
+		NewEthSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter()),
+	)
+	app.SetMempool(mpool)
+	// drop the transactions included in the committed block before they are
+	// rechecked
+	app.SetPrepareCheckStater(mpool.PruneCommitted)
+
+	handler := baseapp.NewDefaultProposalHandler(mpool, app)
+	app.SetPrepareProposal(handler.PrepareProposalHandler())
+	app.SetProcessProposal(handler.ProcessProposalHandler())
+
+	return true
+}
+
+// setDevController creates the controller of the local dev node if the app is
+// started in dev mode.
+func (app *EVMD) setDevController(appOpts servertypes.AppOptions) {
+	if !cast.ToBool(appOpts.Get(srvflags.Dev)) {
+		return
+	}
+
+	storeKeys := make([]storetypes.StoreKey, 0, len(app.keys))
+	for _, key := range app.keys {
+		storeKeys = append(storeKeys, key)
+	}
+
+	app.devController = evmdev.NewController(app.EVMKeeper, app.CommitMultiStore(), storeKeys)
+	app.devController.SetIntervalMining(cast.ToDuration(appOpts.Get(srvflags.DevBlockTime)))
+	app.EVMKeeper.SetImpersonationChecker(app.devController.IsImpersonated)
+}
+
 func (app *EVMD) setPostHandler() {
 	postHandler, err := posthandler.NewPostHandler(
 		posthandler.HandlerOptions{},
@@ -863,7 +1117,21 @@ func (app *EVMD) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci
 }
 
 func (app *EVMD) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
-	return app.ModuleManager.PreBlock(ctx)
+	res, err := app.ModuleManager.PreBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if app.devController != nil {
+		app.devController.PreBlock(ctx)
+	}
+	return res, nil
+}
+
+// DevController returns the controller of the local dev node, or nil if the
+// app was not started in dev mode.
+func (app *EVMD) DevController() *evmdev.Controller {
+	return app.devController
 }
 
 // LoadHeight loads a particular height
@@ -1021,6 +1289,26 @@ func (app *EVMD) SetErc20Keeper(erc20Keeper erc20keeper.Keeper) {
 	app.Erc20Keeper = erc20Keeper
 }
 
+func (app *EVMD) GetErc721Keeper() *erc721keeper.Keeper {
+	return &app.Erc721Keeper
+}
+
+func (app *EVMD) GetNFTKeeper() nftkeeper.Keeper {
+	return app.NFTKeeper
+}
+
+func (app *EVMD) GetNFTTransferKeeper() nfttransferkeeper.Keeper {
+	return app.NFTTransferKeeper
+}
+
+func (app *EVMD) GetRateLimitKeeper() ratelimitkeeper.Keeper {
+	return app.RateLimitKeeper
+}
+
+func (app *EVMD) GetTokenFactoryKeeper() tokenfactorykeeper.Keeper {
+	return app.TokenFactoryKeeper
+}
+
 func (app *EVMD) GetGovKeeper() govkeeper.Keeper {
 	return app.GovKeeper
 }
@@ -1045,6 +1333,10 @@ func (app *EVMD) GetFeeGrantKeeper() feegrantkeeper.Keeper {
 	return app.FeeGrantKeeper
 }
 
+func (app *EVMD) GetGroupKeeper() groupkeeper.Keeper {
+	return app.GroupKeeper
+}
+
 func (app *EVMD) GetAccountKeeper() authkeeper.AccountKeeper {
 	return app.AccountKeeper
 }
@@ -1077,6 +1369,14 @@ func (app *EVMD) GetTransferKeeper() transferkeeper.Keeper {
 	return app.TransferKeeper
 }
 
+func (app *EVMD) GetICAControllerKeeper() *icacontrollerkeeper.Keeper {
+	return &app.ICAControllerKeeper
+}
+
+func (app *EVMD) GetICAHostKeeper() icahostkeeper.Keeper {
+	return app.ICAHostKeeper
+}
+
 func (app *EVMD) SetTransferKeeper(transferKeeper transferkeeper.Keeper) {
 	app.TransferKeeper = transferKeeper
 }
@@ -1128,6 +1428,8 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	keyTable.RegisterParamSet(&ibcconnectiontypes.Params{})
 	paramsKeeper.Subspace(ibcexported.ModuleName).WithKeyTable(keyTable)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName).WithKeyTable(ibctransfertypes.ParamKeyTable())
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName).WithKeyTable(icacontrollertypes.ParamKeyTable())
+	paramsKeeper.Subspace(icahosttypes.SubModuleName).WithKeyTable(icahosttypes.ParamKeyTable())
 	// TODO: do we need a keytable? copied from Evmos repo
 
 	return paramsKeeper
@@ -15,6 +15,7 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 	cosmosevmcmd "github.com/cosmos/evm/client"
+	cosmosevmgenesis "github.com/cosmos/evm/client/genesis"
 	cosmosevmkeyring "github.com/cosmos/evm/crypto/keyring"
 	"github.com/cosmos/evm/evmd"
 	evmdconfig "github.com/cosmos/evm/evmd/cmd/evmd/config"
@@ -175,9 +176,14 @@ func initRootCmd(rootCmd *cobra.Command, evmApp *evmd.EVMD) {
 	cfg.Seal()
 
 	defaultNodeHome := evmdconfig.MustGetDefaultNodeHome()
+	coinInfo := evmdconfig.ChainsCoinInfo[evmdconfig.EVMChainID]
+
+	genesisCmd := genutilcli.Commands(evmApp.TxConfig(), evmApp.BasicModuleManager, defaultNodeHome)
+	genesisCmd.AddCommand(cosmosevmgenesis.ImportEthAllocCmd(coinInfo, defaultNodeHome))
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(evmApp.BasicModuleManager, defaultNodeHome),
-		genutilcli.Commands(evmApp.TxConfig(), evmApp.BasicModuleManager, defaultNodeHome),
+		genesisCmd,
 		cmtcli.NewCompletionCmd(rootCmd, true),
 		debug.Cmd(),
 		confixcmd.ConfigCommand(),
@@ -194,6 +200,11 @@ func initRootCmd(rootCmd *cobra.Command, evmApp *evmd.EVMD) {
 		addModuleInitFlags,
 	)
 
+	// add Cosmos EVM geth compatible state export
+	rootCmd.AddCommand(
+		cosmosevmserver.NewExportEthAllocCmd(appExport, coinInfo, defaultNodeHome),
+	)
+
 	// add Cosmos EVM key commands
 	rootCmd.AddCommand(
 		cosmosevmcmd.KeyCommands(defaultNodeHome, true),
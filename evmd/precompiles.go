@@ -9,24 +9,39 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	"github.com/cosmos/evm/evmd/ante"
+	authzprecompile "github.com/cosmos/evm/precompiles/authz"
 	bankprecompile "github.com/cosmos/evm/precompiles/bank"
 	"github.com/cosmos/evm/precompiles/bech32"
 	cmn "github.com/cosmos/evm/precompiles/common"
+	dispatchprecompile "github.com/cosmos/evm/precompiles/dispatch"
 	distprecompile "github.com/cosmos/evm/precompiles/distribution"
+	feegrantprecompile "github.com/cosmos/evm/precompiles/feegrant"
 	govprecompile "github.com/cosmos/evm/precompiles/gov"
+	groupprecompile "github.com/cosmos/evm/precompiles/group"
+	ibccoreprecompile "github.com/cosmos/evm/precompiles/ibccore"
+	icaprecompile "github.com/cosmos/evm/precompiles/ica"
 	ics20precompile "github.com/cosmos/evm/precompiles/ics20"
 	"github.com/cosmos/evm/precompiles/p256"
 	slashingprecompile "github.com/cosmos/evm/precompiles/slashing"
 	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
+	tokenfactoryprecompile "github.com/cosmos/evm/precompiles/tokenfactory"
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
+	tokenfactorykeeper "github.com/cosmos/evm/x/tokenfactory/keeper"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v10/modules/core/04-channel/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
 	"cosmossdk.io/core/address"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 )
@@ -35,7 +50,7 @@ import (
 // Extend this struct, add a sane default to defaultOptionals, and an Option function to provide users with a non-breaking
 // way to provide custom args to certain precompiles.
 type Optionals struct {
-	AddressCodec       address.Codec // used by gov/staking
+	AddressCodec       address.Codec // used by gov/staking/authz/feegrant/ica/dispatch/group/tokenfactory
 	ValidatorAddrCodec address.Codec // used by slashing
 	ConsensusAddrCodec address.Codec // used by slashing
 }
@@ -83,6 +98,13 @@ func NewAvailableStaticPrecompiles(
 	evmKeeper *evmkeeper.Keeper,
 	govKeeper govkeeper.Keeper,
 	slashingKeeper slashingkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
+	icaControllerKeeper *icacontrollerkeeper.Keeper,
+	ibcKeeper *ibckeeper.Keeper,
+	msgRouter baseapp.MessageRouter,
+	groupKeeper groupkeeper.Keeper,
+	tokenFactoryKeeper tokenfactorykeeper.Keeper,
 	codec codec.Codec,
 	opts ...Option,
 ) map[common.Address]vm.PrecompiledContract {
@@ -101,7 +123,7 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate bech32 precompile: %w", err))
 	}
 
-	stakingPrecompile, err := stakingprecompile.NewPrecompile(stakingKeeper, options.AddressCodec)
+	stakingPrecompile, err := stakingprecompile.NewPrecompile(stakingKeeper, distributionKeeper, options.AddressCodec)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate staking precompile: %w", err))
 	}
@@ -142,6 +164,41 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate slashing precompile: %w", err))
 	}
 
+	authzPrecompile, err := authzprecompile.NewPrecompile(authzKeeper, codec, options.AddressCodec, ante.DisabledAuthzMsgs)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate authz precompile: %w", err))
+	}
+
+	feegrantPrecompile, err := feegrantprecompile.NewPrecompile(feegrantKeeper, codec, options.AddressCodec)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate feegrant precompile: %w", err))
+	}
+
+	icaPrecompile, err := icaprecompile.NewPrecompile(icaControllerKeeper, codec, options.AddressCodec)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate ICA precompile: %w", err))
+	}
+
+	ibcCorePrecompile, err := ibccoreprecompile.NewPrecompile(ibcKeeper, codec)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate IBC core precompile: %w", err))
+	}
+
+	dispatchPrecompile, err := dispatchprecompile.NewPrecompile(msgRouter, evmKeeper, codec, options.AddressCodec)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate dispatch precompile: %w", err))
+	}
+
+	groupPrecompile, err := groupprecompile.NewPrecompile(groupKeeper, codec, options.AddressCodec)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate group precompile: %w", err))
+	}
+
+	tokenFactoryPrecompile, err := tokenfactoryprecompile.NewPrecompile(tokenFactoryKeeper, options.AddressCodec)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate token factory precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -153,6 +210,13 @@ func NewAvailableStaticPrecompiles(
 	precompiles[bankPrecompile.Address()] = bankPrecompile
 	precompiles[govPrecompile.Address()] = govPrecompile
 	precompiles[slashingPrecompile.Address()] = slashingPrecompile
+	precompiles[authzPrecompile.Address()] = authzPrecompile
+	precompiles[feegrantPrecompile.Address()] = feegrantPrecompile
+	precompiles[icaPrecompile.Address()] = icaPrecompile
+	precompiles[ibcCorePrecompile.Address()] = ibcCorePrecompile
+	precompiles[dispatchPrecompile.Address()] = dispatchPrecompile
+	precompiles[groupPrecompile.Address()] = groupPrecompile
+	precompiles[tokenFactoryPrecompile.Address()] = tokenFactoryPrecompile
 
 	return precompiles
 }
@@ -0,0 +1,152 @@
+package ibc
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/precompiles/ibccore"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type IBCCorePrecompileTestSuite struct {
+	suite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA           *evmibctesting.TestChain
+	chainAPrecompile *ibccore.Precompile
+	chainB           *evmibctesting.TestChain
+	chainBPrecompile *ibccore.Precompile
+}
+
+func (suite *IBCCorePrecompileTestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 2, 0, integration.SetupEvmd)
+	suite.chainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(2))
+
+	var err error
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	suite.chainAPrecompile, err = ibccore.NewPrecompile(evmAppA.IBCKeeper, evmAppA.AppCodec())
+	suite.Require().NoError(err)
+
+	evmAppB := suite.chainB.App.(*evmd.EVMD)
+	suite.chainBPrecompile, err = ibccore.NewPrecompile(evmAppB.IBCKeeper, evmAppB.AppCodec())
+	suite.Require().NoError(err)
+}
+
+// query calls the given view method of the IBC core precompile on the chain and
+// returns the unpacked outputs.
+func (suite *IBCCorePrecompileTestSuite) query(
+	chain *evmibctesting.TestChain,
+	precompile *ibccore.Precompile,
+	method string,
+	args ...interface{},
+) []interface{} {
+	evmApp := chain.App.(*evmd.EVMD)
+	res, err := evmApp.EVMKeeper.CallEVM(
+		chain.GetContext(),
+		precompile.ABI,
+		common.BytesToAddress(chain.SenderAccount.GetAddress()),
+		precompile.Address(),
+		false,
+		nil,
+		method,
+		args...,
+	)
+	suite.Require().NoError(err)
+
+	out, err := precompile.Unpack(method, res.Ret)
+	suite.Require().NoError(err)
+	return out
+}
+
+func (suite *IBCCorePrecompileTestSuite) TestQueries() {
+	path := evmibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	portID := path.EndpointA.ChannelConfig.PortID
+	channelID := path.EndpointA.ChannelID
+
+	// light client
+	out := suite.query(suite.chainA, suite.chainAPrecompile, ibccore.ClientStateMethod, path.EndpointA.ClientID)
+	clientState := *abi.ConvertType(out[0], new(ibccore.ClientStateData)).(*ibccore.ClientStateData)
+	suite.Require().Equal("07-tendermint", clientState.ClientType)
+	suite.Require().Equal("Active", clientState.Status)
+	suite.Require().False(clientState.LatestHeight.IsZero())
+	suite.Require().NotEmpty(clientState.ClientState)
+
+	out = suite.query(suite.chainA, suite.chainAPrecompile, ibccore.ConsensusStateMethod, path.EndpointA.ClientID, clientState.LatestHeight)
+	suite.Require().True(out[0].(bool))
+	suite.Require().NotZero(out[1].(uint64))
+
+	out = suite.query(suite.chainA, suite.chainAPrecompile, ibccore.ConsensusStateMethod, path.EndpointA.ClientID, clienttypes.NewHeight(clientState.LatestHeight.RevisionNumber, clientState.LatestHeight.RevisionHeight+1000))
+	suite.Require().False(out[0].(bool))
+
+	// connection and channel ends
+	out = suite.query(suite.chainA, suite.chainAPrecompile, ibccore.ConnectionMethod, path.EndpointA.ConnectionID)
+	connection := *abi.ConvertType(out[0], new(ibccore.ConnectionData)).(*ibccore.ConnectionData)
+	suite.Require().Equal(path.EndpointA.ClientID, connection.ClientID)
+	suite.Require().Equal(path.EndpointB.ConnectionID, connection.CounterpartyConnectionID)
+
+	out = suite.query(suite.chainA, suite.chainAPrecompile, ibccore.ChannelMethod, portID, channelID)
+	channel := *abi.ConvertType(out[0], new(ibccore.ChannelData)).(*ibccore.ChannelData)
+	suite.Require().Equal(uint8(channeltypes.OPEN), channel.State)
+	suite.Require().Equal(path.EndpointB.ChannelID, channel.CounterpartyChannelID)
+	suite.Require().Equal([]string{path.EndpointA.ConnectionID}, channel.ConnectionHops)
+
+	out = suite.query(suite.chainA, suite.chainAPrecompile, ibccore.NextSequenceSendMethod, portID, channelID)
+	suite.Require().Equal(uint64(1), out[0].(uint64))
+
+	// send a transfer packet from chainA
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	bondDenom, err := evmAppA.StakingKeeper.BondDenom(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	msg := transfertypes.NewMsgTransfer(
+		portID,
+		channelID,
+		sdk.NewCoin(bondDenom, sdkmath.NewInt(100)),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0, "",
+	)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParseV1PacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	out = suite.query(suite.chainA, suite.chainAPrecompile, ibccore.NextSequenceSendMethod, portID, channelID)
+	suite.Require().Equal(uint64(2), out[0].(uint64))
+	out = suite.query(suite.chainA, suite.chainAPrecompile, ibccore.HasPacketCommitmentMethod, portID, channelID, packet.Sequence)
+	suite.Require().True(out[0].(bool))
+
+	// relay the packet and its acknowledgement
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	out = suite.query(suite.chainA, suite.chainAPrecompile, ibccore.HasPacketCommitmentMethod, portID, channelID, packet.Sequence)
+	suite.Require().False(out[0].(bool))
+	out = suite.query(suite.chainB, suite.chainBPrecompile, ibccore.HasPacketReceiptMethod, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	suite.Require().True(out[0].(bool))
+	out = suite.query(suite.chainB, suite.chainBPrecompile, ibccore.HasPacketAcknowledgementMethod, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	suite.Require().True(out[0].(bool))
+}
+
+func TestIBCCorePrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCCorePrecompileTestSuite))
+}
@@ -0,0 +1,201 @@
+package ibc
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/precompiles/ica"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	callbackstestutil "github.com/cosmos/evm/x/ibc/callbacks/testutil"
+	evmante "github.com/cosmos/evm/x/vm/ante"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type ICAPrecompileTestSuite struct {
+	suite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA           *evmibctesting.TestChain
+	chainAPrecompile *ica.Precompile
+	chainB           *evmibctesting.TestChain
+}
+
+func (suite *ICAPrecompileTestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 2, 0, integration.SetupEvmd)
+	suite.chainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(2))
+
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	var err error
+	suite.chainAPrecompile, err = ica.NewPrecompile(
+		evmAppA.GetICAControllerKeeper(),
+		evmAppA.AppCodec(),
+		evmAppA.AccountKeeper.AddressCodec(),
+	)
+	suite.Require().NoError(err)
+}
+
+// registerInterchainAccount registers an interchain account for the sender through the ICA
+// precompile on chainA and completes the channel handshake with chainB.
+func (suite *ICAPrecompileTestSuite) registerInterchainAccount(path *evmibctesting.Path, senderIdx int) {
+	senderAccount := suite.chainA.SenderAccounts[senderIdx]
+	owner := senderAccount.SenderAccount.GetAddress()
+
+	data, err := suite.chainAPrecompile.Pack(
+		ica.RegisterInterchainAccountMethod,
+		path.EndpointA.ConnectionID,
+		"",
+		uint8(channeltypes.ORDERED),
+	)
+	suite.Require().NoError(err)
+
+	res, _, _, err := suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().NoError(err)
+
+	path.EndpointA.ChannelID, err = evmibctesting.ParseChannelIDFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	portID, err := icatypes.NewControllerPortID(owner.String())
+	suite.Require().NoError(err)
+
+	path.EndpointA.ChannelConfig.PortID = portID
+	version := path.EndpointA.GetChannel().Version
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointB.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+}
+
+func (suite *ICAPrecompileTestSuite) TestRegisterAndSendTx() {
+	path := evmibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	senderIdx := 1
+	owner := suite.chainA.SenderAccounts[senderIdx].SenderAccount.GetAddress()
+	suite.registerInterchainAccount(path, senderIdx)
+
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	evmAppB := suite.chainB.App.(*evmd.EVMD)
+
+	// the interchain account address is the same on both chains
+	ctxA := evmante.BuildEvmExecutionCtx(suite.chainA.GetContext())
+	evmRes, err := evmAppA.EVMKeeper.CallEVM(
+		ctxA,
+		suite.chainAPrecompile.ABI,
+		common.BytesToAddress(owner),
+		suite.chainAPrecompile.Address(),
+		false,
+		nil,
+		ica.InterchainAccountMethod,
+		common.BytesToAddress(owner),
+		path.EndpointA.ConnectionID,
+	)
+	suite.Require().NoError(err)
+
+	var icaAddr string
+	err = suite.chainAPrecompile.UnpackIntoInterface(&icaAddr, ica.InterchainAccountMethod, evmRes.Ret)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(icaAddr)
+
+	hostAddr, found := evmAppB.GetICAHostKeeper().GetInterchainAccountAddress(
+		suite.chainB.GetContext(),
+		path.EndpointB.ConnectionID,
+		path.EndpointA.ChannelConfig.PortID,
+	)
+	suite.Require().True(found)
+	suite.Require().Equal(hostAddr, icaAddr)
+
+	// fund the interchain account on chainB
+	bondDenom, err := evmAppB.StakingKeeper.BondDenom(suite.chainB.GetContext())
+	suite.Require().NoError(err)
+	icaAccAddr := sdk.MustAccAddressFromBech32(icaAddr)
+	fundAmt := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1_000_000)))
+	_, err = suite.chainB.SendMsgs(banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), icaAccAddr, fundAmt))
+	suite.Require().NoError(err)
+
+	// deploy the callback contract on chainA
+	contractData, err := callbackstestutil.LoadCounterWithCallbacksContract()
+	suite.Require().NoError(err)
+	contractAddr, err := DeployContract(suite.T(), suite.chainA, testutiltypes.ContractDeploymentData{
+		Contract: contractData,
+	})
+	suite.Require().NoError(err)
+	// the deployment is committed outside of a tx, so the relayer account sequence has to be bumped
+	err = suite.chainA.SenderAccount.SetSequence(suite.chainA.SenderAccount.GetSequence() + 1)
+	suite.Require().NoError(err)
+
+	// send a bank transfer to be executed by the interchain account
+	receiver := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	sendAmt := sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(100)))
+	bankMsg := banktypes.NewMsgSend(icaAccAddr, receiver, sendAmt)
+	bz, err := evmAppB.AppCodec().Marshal(bankMsg)
+	suite.Require().NoError(err)
+
+	memo := fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "%d"}}`, contractAddr, 1_000_000)
+	data, err := suite.chainAPrecompile.Pack(
+		ica.SendTxMethod,
+		path.EndpointA.ConnectionID,
+		[]ica.CosmosMsg{{TypeURL: sdk.MsgTypeURL(bankMsg), Value: bz}},
+		memo,
+		uint64(time.Hour.Nanoseconds()),
+	)
+	suite.Require().NoError(err)
+
+	receiverBalance := evmAppB.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, bondDenom)
+
+	senderAccount := suite.chainA.SenderAccounts[senderIdx]
+	res, _, _, err := suite.chainA.SendEvmTx(senderAccount, senderIdx, suite.chainAPrecompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().NoError(err)
+	packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	// relay the packet and its acknowledgement
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	// the message was executed by the interchain account on chainB
+	afterReceiverBalance := evmAppB.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, bondDenom)
+	suite.Require().Equal(receiverBalance.Add(sendAmt[0]), afterReceiverBalance)
+
+	// the send and acknowledgement callbacks were delivered to the callback contract on chainA
+	counterRes, err := evmAppA.EVMKeeper.CallEVM(
+		suite.chainA.GetContext(),
+		contractData.ABI,
+		common.BytesToAddress(owner),
+		contractAddr,
+		false,
+		nil,
+		"getCounter",
+	)
+	suite.Require().NoError(err)
+
+	var counter *big.Int
+	err = contractData.ABI.UnpackIntoInterface(&counter, "getCounter", counterRes.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(2), counter)
+}
+
+func TestICAPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(ICAPrecompileTestSuite))
+}
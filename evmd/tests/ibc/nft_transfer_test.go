@@ -0,0 +1,230 @@
+package ibc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/erc721/keeper/testdata"
+	erc721types "github.com/cosmos/evm/x/erc721/types"
+	nfttransfertypes "github.com/cosmos/evm/x/ibc/nfttransfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NFTTransferTestSuite tests ICS-721 transfers of the NFTs of an ERC721
+// contract between two EVM chains, together with the ERC721 middleware.
+type NFTTransferTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *evmibctesting.TestChain
+	chainB *evmibctesting.TestChain
+
+	path *evmibctesting.Path
+
+	// pair is the token pair of the ERC721 contract deployed on chain A
+	pair erc721types.TokenPair
+}
+
+func (suite *NFTTransferTestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 2, 0, integration.SetupEvmd)
+	suite.chainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(2))
+
+	suite.path = evmibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = nfttransfertypes.PortID
+	suite.path.EndpointB.ChannelConfig.PortID = nfttransfertypes.PortID
+	suite.path.EndpointA.ChannelConfig.Version = nfttransfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = nfttransfertypes.Version
+	suite.path.Setup()
+
+	suite.pair = suite.setupConvertedERC721("1", "2")
+}
+
+func TestNFTTransferTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(NFTTransferTestSuite))
+}
+
+// setupConvertedERC721 deploys and registers an ERC721 contract on chain A,
+// mints the given tokens to the sender account and converts them into the
+// NFTs of the contract class.
+func (suite *NFTTransferTestSuite) setupConvertedERC721(tokenIDs ...string) erc721types.TokenPair {
+	ctx := suite.chainA.GetContext()
+	evmApp := suite.chainA.App.(*evmd.EVMD)
+	sender := suite.chainA.SenderAccount.GetAddress()
+
+	contract, err := testdata.LoadERC721Contract()
+	suite.Require().NoError(err)
+	contractAddr, err := DeployContract(suite.T(), suite.chainA, testutiltypes.ContractDeploymentData{Contract: contract})
+	suite.Require().NoError(err)
+	// the deployment increments the nonce of the sender account
+	err = suite.chainA.SenderAccount.SetSequence(suite.chainA.SenderAccount.GetSequence() + 1)
+	suite.Require().NoError(err)
+
+	for _, id := range tokenIDs {
+		tokenID, err := erc721types.ParseTokenID(id)
+		suite.Require().NoError(err)
+		_, err = evmApp.EVMKeeper.CallEVM(ctx, contract.ABI, erc721types.ModuleAddress, contractAddr, true, nil, "mint", common.BytesToAddress(sender), tokenID)
+		suite.Require().NoError(err, "failed to mint token %s", id)
+	}
+
+	_, err = evmApp.Erc721Keeper.RegisterERC721(ctx, &erc721types.MsgRegisterERC721{
+		Authority:       authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Erc721Addresses: []string{contractAddr.Hex()},
+	})
+	suite.Require().NoError(err)
+
+	_, err = evmApp.Erc721Keeper.ConvertERC721(ctx, erc721types.NewMsgConvertERC721(tokenIDs, sender, contractAddr, common.BytesToAddress(sender)))
+	suite.Require().NoError(err)
+	suite.chainA.NextBlock()
+
+	pair, err := evmApp.Erc721Keeper.GetERC721TokenPair(suite.chainA.GetContext(), contractAddr)
+	suite.Require().NoError(err)
+	return pair
+}
+
+// sendTransfer sends the given NFTs of the class from the sender account of the
+// source endpoint to the sender account of its counterparty and returns the
+// packet.
+func (suite *NFTTransferTestSuite) sendTransfer(src *evmibctesting.Endpoint, classID string, tokenIDs []string, timeoutTimestamp uint64) channeltypes.Packet {
+	msg := nfttransfertypes.NewMsgTransfer(
+		src.ChannelConfig.PortID, src.ChannelID,
+		classID, tokenIDs,
+		src.Chain.SenderAccount.GetAddress().String(),
+		src.Counterparty.Chain.SenderAccount.GetAddress().String(),
+		timeoutTimestamp, "",
+	)
+
+	res, err := src.Chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	return packet
+}
+
+// requireERC721Owner checks the owner of the ERC721 tokens of the pair on
+// chain A and that their NFT representations do not exist.
+func (suite *NFTTransferTestSuite) requireERC721Owner(owner common.Address, tokenIDs ...string) {
+	ctx := suite.chainA.GetContext()
+	evmApp := suite.chainA.App.(*evmd.EVMD)
+
+	for _, id := range tokenIDs {
+		tokenID, err := erc721types.ParseTokenID(id)
+		suite.Require().NoError(err)
+
+		erc721Owner, err := evmApp.Erc721Keeper.OwnerOf(ctx, suite.pair.GetERC721Contract(), tokenID)
+		suite.Require().NoError(err)
+		suite.Require().Equal(owner, erc721Owner, "unexpected owner of the ERC721 token %s", id)
+		suite.Require().False(evmApp.NFTKeeper.HasNFT(ctx, suite.pair.ClassId, id), "expected the NFT %s to be burned", id)
+	}
+}
+
+// TestTransferAndReturn checks that the NFTs of an ERC721 contract are escrowed
+// on the source chain, received as vouchers with an ERC-721 precompile on the
+// counterparty chain and automatically converted back to ERC721 tokens when
+// they return.
+func (suite *NFTTransferTestSuite) TestTransferAndReturn() {
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	evmAppB := suite.chainB.App.(*evmd.EVMD)
+	tokenIDs := []string{"1", "2"}
+
+	packet := suite.sendTransfer(suite.path.EndpointA, suite.pair.ClassId, tokenIDs, suite.chainA.GetTimeoutTimestamp())
+
+	escrowAddr := nfttransfertypes.GetEscrowAddress(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	for _, id := range tokenIDs {
+		owner := evmAppA.NFTKeeper.GetOwner(suite.chainA.GetContext(), suite.pair.ClassId, id)
+		suite.Require().Equal(escrowAddr, owner, "expected the NFT %s to be escrowed", id)
+	}
+
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	// the vouchers are minted to the receiver under the ibc/{hash} class
+	voucherClassID := nfttransfertypes.GetReceivedClassID(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		suite.pair.ClassId,
+	)
+	receiverB := suite.chainB.SenderAccount.GetAddress()
+	ctxB := suite.chainB.GetContext()
+	for _, id := range tokenIDs {
+		token, found := evmAppB.NFTKeeper.GetNFT(ctxB, voucherClassID, id)
+		suite.Require().True(found, "expected the voucher %s to be minted", id)
+		suite.Require().Equal(testdata.ERC721TokenURI, token.Uri)
+		suite.Require().Equal(receiverB, evmAppB.NFTKeeper.GetOwner(ctxB, voucherClassID, id))
+	}
+
+	// the voucher class is registered with an ERC-721 precompile
+	voucherPair, err := evmAppB.Erc721Keeper.GetClassTokenPair(ctxB, voucherClassID)
+	suite.Require().NoError(err)
+	suite.Require().True(voucherPair.Enabled)
+	suite.Require().Equal(erc721types.NewTokenPairForClass(voucherClassID), voucherPair)
+	_, found, err := evmAppB.Erc721Keeper.GetERC721PrecompileInstance(ctxB, voucherPair.GetERC721Contract())
+	suite.Require().NoError(err)
+	suite.Require().True(found, "expected the ERC-721 precompile of the voucher class")
+
+	// return a single token to chain A
+	packet = suite.sendTransfer(suite.path.EndpointB, voucherClassID, []string{"1"}, suite.chainB.GetTimeoutTimestamp())
+	suite.Require().False(evmAppB.NFTKeeper.HasNFT(suite.chainB.GetContext(), voucherClassID, "1"), "expected the voucher to be burned")
+
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	// the unescrowed NFT is converted back to the ERC721 token of the receiver
+	suite.requireERC721Owner(common.BytesToAddress(suite.chainA.SenderAccount.GetAddress()), "1")
+	suite.Require().Equal(escrowAddr, evmAppA.NFTKeeper.GetOwner(suite.chainA.GetContext(), suite.pair.ClassId, "2"))
+}
+
+// TestAcknowledgementErrorRefund checks that the NFTs are refunded and converted
+// back to ERC721 tokens when the counterparty chain writes an error
+// acknowledgement.
+func (suite *NFTTransferTestSuite) TestAcknowledgementErrorRefund() {
+	evmAppB := suite.chainB.App.(*evmd.EVMD)
+	params := nfttransfertypes.NewParams(true, false)
+	suite.Require().NoError(evmAppB.NFTTransferKeeper.SetParams(suite.chainB.GetContext(), params))
+	suite.chainB.NextBlock()
+
+	tokenIDs := []string{"1", "2"}
+	packet := suite.sendTransfer(suite.path.EndpointA, suite.pair.ClassId, tokenIDs, suite.chainA.GetTimeoutTimestamp())
+
+	_, ack, err := suite.path.RelayPacketWithResults(packet)
+	suite.Require().NoError(err)
+	suite.Require().Contains(string(ack), "error", "expected an error acknowledgement")
+
+	suite.requireERC721Owner(common.BytesToAddress(suite.chainA.SenderAccount.GetAddress()), tokenIDs...)
+}
+
+// TestTimeoutRefund checks that the NFTs are refunded and converted back to
+// ERC721 tokens when the packet times out.
+func (suite *NFTTransferTestSuite) TestTimeoutRefund() {
+	tokenIDs := []string{"1", "2"}
+	timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Minute).UnixNano())
+	packet := suite.sendTransfer(suite.path.EndpointA, suite.pair.ClassId, tokenIDs, timeoutTimestamp)
+
+	// advance chain B past the timeout
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	suite.requireERC721Owner(common.BytesToAddress(suite.chainA.SenderAccount.GetAddress()), tokenIDs...)
+
+	// the voucher class was never created on chain B
+	voucherClassID := nfttransfertypes.GetReceivedClassID(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		suite.pair.ClassId,
+	)
+	suite.Require().False(suite.chainB.App.(*evmd.EVMD).NFTKeeper.HasClass(suite.chainB.GetContext(), voucherClassID))
+}
@@ -0,0 +1,116 @@
+package ibc
+
+import (
+	"testing"
+	"time"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	ratelimittypes "github.com/cosmos/evm/x/ibc/ratelimit/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type RateLimitTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	evmChainA *evmibctesting.TestChain
+	chainB    *evmibctesting.TestChain
+
+	path *evmibctesting.Path
+}
+
+func (suite *RateLimitTestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 1, 1, integration.SetupEvmd)
+	suite.evmChainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetChainID(2))
+
+	suite.path = evmibctesting.NewTransferPath(suite.evmChainA, suite.chainB)
+	suite.path.Setup()
+}
+
+func TestRateLimitTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(RateLimitTestSuite))
+}
+
+func (suite *RateLimitTestSuite) TestOutflowQuota() {
+	evmApp := suite.evmChainA.App.(*evmd.EVMD)
+	ctxA := suite.evmChainA.GetContext()
+	bondDenom, err := evmApp.StakingKeeper.BondDenom(ctxA)
+	suite.Require().NoError(err)
+
+	quota := sdkmath.NewInt(100)
+	rateLimit := ratelimittypes.NewRateLimit(bondDenom, suite.path.EndpointA.ChannelID, sdkmath.ZeroInt(), quota, time.Hour)
+	evmApp.RateLimitKeeper.StoreRateLimit(ctxA, rateLimit)
+
+	transfer := func(amount sdkmath.Int) error {
+		msg := transfertypes.NewMsgTransfer(
+			suite.path.EndpointA.ChannelConfig.PortID,
+			suite.path.EndpointA.ChannelID,
+			sdk.NewCoin(bondDenom, amount),
+			suite.evmChainA.SenderAccount.GetAddress().String(),
+			suite.chainB.SenderAccount.GetAddress().String(),
+			clienttypes.NewHeight(1, 110), 0, "",
+		)
+		_, err := suite.evmChainA.SendMsgs(msg)
+		return err
+	}
+
+	suite.Require().NoError(transfer(quota))
+	err = transfer(sdkmath.OneInt())
+	suite.Require().ErrorContains(err, ratelimittypes.ErrQuotaExceeded.Error())
+
+	flow := evmApp.RateLimitKeeper.GetFlow(suite.evmChainA.GetContext(), suite.path.EndpointA.ChannelID, bondDenom)
+	suite.Require().Equal(quota.String(), flow.Outflow.String())
+	suite.Require().True(flow.Inflow.IsZero())
+}
+
+func (suite *RateLimitTestSuite) TestInflowQuota() {
+	evmApp := suite.evmChainA.App.(*evmd.EVMD)
+	chainBApp := suite.chainB.GetSimApp()
+	ctxB := suite.chainB.GetContext()
+	bondDenom, err := chainBApp.StakingKeeper.BondDenom(ctxB)
+	suite.Require().NoError(err)
+
+	quota := sdkmath.NewInt(100)
+	receivedDenom := transfertypes.NewDenom(bondDenom, transfertypes.NewHop(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID))
+	rateLimit := ratelimittypes.NewRateLimit(receivedDenom.IBCDenom(), suite.path.EndpointA.ChannelID, quota, sdkmath.ZeroInt(), time.Hour)
+	evmApp.RateLimitKeeper.StoreRateLimit(suite.evmChainA.GetContext(), rateLimit)
+
+	sender := suite.chainB.SenderAccount.GetAddress()
+	receiver := suite.evmChainA.SenderAccount.GetAddress()
+
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointB.ChannelConfig.PortID,
+		suite.path.EndpointB.ChannelID,
+		sdk.NewCoin(bondDenom, quota.AddRaw(1)),
+		sender.String(),
+		receiver.String(),
+		clienttypes.NewHeight(1, 110), 0, "",
+	)
+	res, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	// the packet failed on the evm chain and the tokens were refunded
+	balance := evmApp.BankKeeper.GetBalance(suite.evmChainA.GetContext(), receiver, receivedDenom.IBCDenom())
+	suite.Require().True(balance.Amount.IsZero())
+	flow := evmApp.RateLimitKeeper.GetFlow(suite.evmChainA.GetContext(), suite.path.EndpointA.ChannelID, receivedDenom.IBCDenom())
+	suite.Require().True(flow.Inflow.IsZero())
+
+	escrowAddr := transfertypes.GetEscrowAddress(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)
+	suite.Require().True(chainBApp.BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddr, bondDenom).IsZero())
+}
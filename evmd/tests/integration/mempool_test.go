@@ -0,0 +1,13 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/tests/integration/mempool"
+)
+
+func TestMempoolTestSuite(t *testing.T) {
+	suite.Run(t, mempool.NewMempoolTestSuite(CreateEvmd))
+}
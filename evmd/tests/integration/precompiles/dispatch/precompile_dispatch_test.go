@@ -0,0 +1,15 @@
+package dispatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/dispatch"
+)
+
+func TestDispatchPrecompileTestSuite(t *testing.T) {
+	s := dispatch.NewPrecompileTestSuite(integration.CreateEvmd)
+	suite.Run(t, s)
+}
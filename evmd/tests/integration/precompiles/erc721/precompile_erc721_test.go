@@ -0,0 +1,15 @@
+package erc721
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/erc721"
+)
+
+func TestErc721PrecompileTestSuite(t *testing.T) {
+	s := erc721.NewPrecompileTestSuite(integration.CreateEvmd)
+	suite.Run(t, s)
+}
@@ -0,0 +1,15 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/group"
+)
+
+func TestGroupPrecompileTestSuite(t *testing.T) {
+	s := group.NewPrecompileTestSuite(integration.CreateEvmd)
+	suite.Run(t, s)
+}
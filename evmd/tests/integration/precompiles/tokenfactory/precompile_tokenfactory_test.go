@@ -0,0 +1,15 @@
+package tokenfactory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/tokenfactory"
+)
+
+func TestTokenFactoryPrecompileTestSuite(t *testing.T) {
+	s := tokenfactory.NewPrecompileTestSuite(integration.CreateEvmd)
+	suite.Run(t, s)
+}
@@ -0,0 +1,14 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/tests/integration/x/erc721"
+)
+
+func TestERC721KeeperTestSuite(t *testing.T) {
+	s := erc721.NewKeeperTestSuite(CreateEvmd)
+	suite.Run(t, s)
+}
@@ -4,16 +4,22 @@ import (
 	"encoding/json"
 
 	erc20keeper "github.com/cosmos/evm/x/erc20/keeper"
+	erc721keeper "github.com/cosmos/evm/x/erc721/keeper"
 	feemarketkeeper "github.com/cosmos/evm/x/feemarket/keeper"
 	"github.com/cosmos/evm/x/ibc/callbacks/keeper"
+	nfttransferkeeper "github.com/cosmos/evm/x/ibc/nfttransfer/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
 	precisebankkeeper "github.com/cosmos/evm/x/precisebank/keeper"
+	tokenfactorykeeper "github.com/cosmos/evm/x/tokenfactory/keeper"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 
 	storetypes "cosmossdk.io/store/types"
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -24,6 +30,7 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -39,6 +46,9 @@ type EvmApp interface { //nolint:revive
 	GetEVMKeeper() *evmkeeper.Keeper
 	GetErc20Keeper() *erc20keeper.Keeper
 	SetErc20Keeper(erc20keeper.Keeper)
+	GetErc721Keeper() *erc721keeper.Keeper
+	GetNFTKeeper() nftkeeper.Keeper
+	GetNFTTransferKeeper() nfttransferkeeper.Keeper
 	GetGovKeeper() govkeeper.Keeper
 	GetSlashingKeeper() slashingkeeper.Keeper
 	GetEvidenceKeeper() *evidencekeeper.Keeper
@@ -51,9 +61,13 @@ type EvmApp interface { //nolint:revive
 	GetMintKeeper() mintkeeper.Keeper
 	GetPreciseBankKeeper() *precisebankkeeper.Keeper
 	GetFeeGrantKeeper() feegrantkeeper.Keeper
+	GetGroupKeeper() groupkeeper.Keeper
+	GetTokenFactoryKeeper() tokenfactorykeeper.Keeper
 	GetCallbackKeeper() keeper.ContractKeeper
 	GetTransferKeeper() transferkeeper.Keeper
 	SetTransferKeeper(transferKeeper transferkeeper.Keeper)
+	GetICAControllerKeeper() *icacontrollerkeeper.Keeper
+	GetICAHostKeeper() icahostkeeper.Keeper
 	DefaultGenesis() map[string]json.RawMessage
 	GetKey(storeKey string) *storetypes.KVStoreKey
 	GetAnteHandler() sdk.AnteHandler
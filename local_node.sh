@@ -33,6 +33,7 @@ set -e
 install=true
 overwrite=""
 BUILD_FOR_DEBUG=false
+DEV_MODE=false
 
 while [[ $# -gt 0 ]]; do
 	key="$1"
@@ -57,6 +58,11 @@ while [[ $# -gt 0 ]]; do
 		BUILD_FOR_DEBUG=true
 		shift # Move past the flag
 		;;
+	--dev)
+		echo "Flag --dev passed -> Starting a dev node with the evm, anvil and hardhat JSON-RPC namespaces."
+		DEV_MODE=true
+		shift # Move past the flag
+		;;
 	*)
 		echo "Unknown flag passed: $key -> Exiting script!"
 		exit 1
@@ -136,7 +142,7 @@ if [[ $overwrite == "y" || $overwrite == "Y" ]]; then
 	jq '.app_state["bank"]["denom_metadata"]=[{"description":"The native staking token for evmd.","denom_units":[{"denom":"atest","exponent":0,"aliases":["attotest"]},{"denom":"test","exponent":18,"aliases":[]}],"base":"atest","display":"test","name":"Test Token","symbol":"TEST","uri":"","uri_hash":""}]' "$GENESIS" >"$TMP_GENESIS" && mv "$TMP_GENESIS" "$GENESIS"
 
 	# Enable precompiles in EVM params
-	jq '.app_state["evm"]["params"]["active_static_precompiles"]=["0x0000000000000000000000000000000000000100","0x0000000000000000000000000000000000000400","0x0000000000000000000000000000000000000800","0x0000000000000000000000000000000000000801","0x0000000000000000000000000000000000000802","0x0000000000000000000000000000000000000803","0x0000000000000000000000000000000000000804","0x0000000000000000000000000000000000000805", "0x0000000000000000000000000000000000000806", "0x0000000000000000000000000000000000000807"]' "$GENESIS" >"$TMP_GENESIS" && mv "$TMP_GENESIS" "$GENESIS"
+	jq '.app_state["evm"]["params"]["active_static_precompiles"]=["0x0000000000000000000000000000000000000100","0x0000000000000000000000000000000000000400","0x0000000000000000000000000000000000000800","0x0000000000000000000000000000000000000801","0x0000000000000000000000000000000000000802","0x0000000000000000000000000000000000000803","0x0000000000000000000000000000000000000804","0x0000000000000000000000000000000000000805", "0x0000000000000000000000000000000000000806", "0x0000000000000000000000000000000000000807", "0x0000000000000000000000000000000000000808", "0x0000000000000000000000000000000000000809", "0x000000000000000000000000000000000000080a", "0x000000000000000000000000000000000000080b", "0x000000000000000000000000000000000000080c", "0x000000000000000000000000000000000000080d"]' "$GENESIS" >"$TMP_GENESIS" && mv "$TMP_GENESIS" "$GENESIS"
 
 	# Set EVM config
 	jq '.app_state["evm"]["params"]["evm_denom"]="atest"' "$GENESIS" >"$TMP_GENESIS" && mv "$TMP_GENESIS" "$GENESIS"
@@ -226,4 +232,5 @@ evmd start "$TRACE" \
 	--minimum-gas-prices=0.0001atest \
 	--home "$HOMEDIR" \
 	--json-rpc.api eth,txpool,personal,net,debug,web3 \
+	--dev="$DEV_MODE" \
 	--chain-id "$CHAINID"
@@ -0,0 +1,58 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// DefaultPriceBump is the default minimum price bump percentage required
+	// to replace a transaction already in the mempool.
+	DefaultPriceBump uint64 = 10
+
+	// DefaultAccountSlots is the default maximum number of transactions that
+	// can be held in the mempool for a single account.
+	DefaultAccountSlots uint64 = 64
+
+	// DefaultMaxTxs is the default maximum number of transactions held in the
+	// mempool.
+	DefaultMaxTxs = 5000
+)
+
+// Config defines the configuration values of the EVM mempool.
+type Config struct {
+	// PriceBump is the minimum price bump percentage that the fee cap and tip
+	// cap of a transaction must clear to replace a transaction with the same
+	// sender and nonce.
+	PriceBump uint64
+	// AccountSlots is the maximum number of pending and queued transactions
+	// held for a single account. When the limit is reached, the transaction
+	// with the highest nonce of the account is evicted in favor of a
+	// transaction with a lower nonce.
+	AccountSlots uint64
+	// MaxTxs is the maximum number of transactions held in the mempool.
+	// A value of 0 means no limit.
+	MaxTxs int
+}
+
+// DefaultConfig returns the default EVM mempool configuration.
+func DefaultConfig() Config {
+	return Config{
+		PriceBump:    DefaultPriceBump,
+		AccountSlots: DefaultAccountSlots,
+		MaxTxs:       DefaultMaxTxs,
+	}
+}
+
+// Validate returns an error if the mempool configuration is invalid.
+func (c Config) Validate() error {
+	if c.AccountSlots == 0 {
+		return errors.New("mempool account slots cannot be zero")
+	}
+
+	if c.MaxTxs < 0 {
+		return fmt.Errorf("mempool max txs cannot be negative: %d", c.MaxTxs)
+	}
+
+	return nil
+}
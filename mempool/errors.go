@@ -0,0 +1,23 @@
+package mempool
+
+import "errors"
+
+var (
+	// ErrNonceTooLow is returned when the nonce of a transaction is lower than
+	// the current sequence of the sender account.
+	ErrNonceTooLow = errors.New("nonce too low")
+
+	// ErrNonceConflict is returned when the nonces of a batch transaction
+	// overlap with a different transaction of the same sender in the mempool.
+	ErrNonceConflict = errors.New("nonce conflicts with a transaction in the mempool")
+
+	// ErrReplacementUnderpriced is returned when a transaction with the same
+	// sender and nonce of a transaction in the mempool does not clear the
+	// price bump threshold.
+	ErrReplacementUnderpriced = errors.New("replacement transaction underpriced")
+
+	// ErrAccountLimitExceeded is returned when the sender account reached the
+	// maximum number of transactions in the mempool and the transaction can
+	// not evict any of them.
+	ErrAccountLimitExceeded = errors.New("account limit exceeded")
+)
@@ -0,0 +1,22 @@
+package mempool
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the expected account keeper used by the mempool to
+// fetch the current sequence of the transaction senders.
+type AccountKeeper interface {
+	GetSequence(ctx context.Context, addr sdk.AccAddress) (uint64, error)
+}
+
+// EVMKeeper defines the expected EVM keeper used by the mempool to fetch the
+// base fee used to compute the effective tip of the transactions.
+type EVMKeeper interface {
+	// GetBaseFee returns the BaseFee param from the fee market module
+	// adapted according to the evm denom decimals
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
@@ -0,0 +1,111 @@
+package mempool
+
+import (
+	"container/heap"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ sdkmempool.Iterator = &iterator{}
+
+// senderCursor points to the next pending transaction of a sender.
+type senderCursor struct {
+	txs      []*mempoolTx
+	idx      int
+	baseFee  *big.Int
+	priority int64
+}
+
+// load computes the priority of the transaction the cursor points to. It
+// returns false if the cursor is exhausted or if the transaction can't pay
+// the base fee, in which case the following transactions of the sender
+// can't be executed either.
+func (c *senderCursor) load() bool {
+	if c.idx >= len(c.txs) {
+		return false
+	}
+
+	mtx := c.txs[c.idx]
+	tip, ok := mtx.effectiveTip(c.baseFee)
+	if !ok {
+		return false
+	}
+
+	c.priority = mtx.priority(tip)
+	return true
+}
+
+// current returns the transaction the cursor points to.
+func (c *senderCursor) current() *mempoolTx {
+	return c.txs[c.idx]
+}
+
+// cursorHeap is a max-heap of sender cursors ordered by the priority of their
+// current transaction. Ties are broken by arrival order.
+type cursorHeap []*senderCursor
+
+func (h cursorHeap) Len() int { return len(h) }
+
+func (h cursorHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+	return h[i].current().arrival < h[j].current().arrival
+}
+
+func (h cursorHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *cursorHeap) Push(x any) { *h = append(*h, x.(*senderCursor)) }
+
+func (h *cursorHeap) Pop() any {
+	old := *h
+	n := len(old)
+	cursor := old[n-1]
+	*h = old[:n-1]
+	return cursor
+}
+
+// iterator iterates over the pending transactions of the mempool. At every
+// step it returns the transaction with the highest priority among the next
+// transactions of every sender, which preserves the nonce order of each
+// sender.
+type iterator struct {
+	cursors cursorHeap
+}
+
+// newIterator returns an iterator over the given cursors, or nil if there
+// are no transactions to iterate over.
+func newIterator(cursors []*senderCursor) sdkmempool.Iterator {
+	if len(cursors) == 0 {
+		return nil
+	}
+
+	h := cursorHeap(cursors)
+	heap.Init(&h)
+	return &iterator{cursors: h}
+}
+
+// Next advances the iterator to the next transaction, returning nil when
+// there are no more transactions.
+func (it *iterator) Next() sdkmempool.Iterator {
+	cursor := it.cursors[0]
+	cursor.idx++
+
+	if cursor.load() {
+		heap.Fix(&it.cursors, 0)
+	} else {
+		heap.Pop(&it.cursors)
+	}
+
+	if len(it.cursors) == 0 {
+		return nil
+	}
+	return it
+}
+
+// Tx returns the transaction the iterator points to.
+func (it *iterator) Tx() sdk.Tx {
+	return it.cursors[0].current().tx
+}
@@ -0,0 +1,323 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ sdkmempool.ExtMempool = &Mempool{}
+
+// Mempool is an app-side mempool aware of the Ethereum transaction semantics.
+//
+// Transactions are kept in per-sender queues ordered by nonce. A transaction
+// is pending when all the nonces between the current sequence of the sender
+// and its own nonce are covered by other transactions of the queue, and it is
+// queued otherwise. Queued transactions are promoted to pending as soon as
+// the gap is filled, either by a new transaction or by the sender sequence
+// increasing after a block is committed. The transactions whose nonces are
+// below the committed sequence of their sender are dropped by PruneCommitted.
+//
+// A transaction with the same sender and nonce of a transaction in the
+// mempool replaces it when both its fee cap and tip cap clear the configured
+// price bump. Pending transactions are selected across senders by their
+// effective tip for the current base fee.
+//
+// NOTE: the mempool expects the ante handler to accept nonce gaps and
+// replacements during CheckTx (see MonoDecorator.WithMempoolNonceGaps).
+type Mempool struct {
+	mtx sync.RWMutex
+
+	config          Config
+	accountKeeper   AccountKeeper
+	evmKeeper       EVMKeeper
+	signerExtractor sdkmempool.SignerExtractionAdapter
+
+	accounts map[string]*account
+	count    int
+	arrivals uint64
+}
+
+// account holds the transactions of a sender indexed by their first nonce.
+type account struct {
+	address sdk.AccAddress
+	txs     map[uint64]*mempoolTx
+}
+
+// NewMempool creates a new EVM mempool. The signer extractor is used to get
+// the sender and sequence of Cosmos transactions.
+func NewMempool(
+	config Config,
+	accountKeeper AccountKeeper,
+	evmKeeper EVMKeeper,
+	signerExtractor sdkmempool.SignerExtractionAdapter,
+) *Mempool {
+	if err := config.Validate(); err != nil {
+		panic(err)
+	}
+
+	if signerExtractor == nil {
+		signerExtractor = sdkmempool.NewDefaultSignerExtractionAdapter()
+	}
+
+	return &Mempool{
+		config:          config,
+		accountKeeper:   accountKeeper,
+		evmKeeper:       evmKeeper,
+		signerExtractor: signerExtractor,
+		accounts:        make(map[string]*account),
+	}
+}
+
+// Insert adds the transaction to the queue of its sender. It replaces a
+// transaction with the same nonce if the price bump is cleared, and evicts
+// the transaction with the highest nonce of the sender if the account limit
+// is reached.
+func (mp *Mempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mtx, err := newMempoolTx(tx, mp.signerExtractor)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	acc, found := mp.accounts[mtx.sender.String()]
+	if !found {
+		acc = &account{
+			address: mtx.sender,
+			txs:     make(map[uint64]*mempoolTx),
+		}
+	}
+
+	// The ante handler checks the sequence of Cosmos txs strictly and
+	// increments it in the CheckTx state before the tx is inserted, so the
+	// sequence read here is already ahead of the tx and of the other Cosmos
+	// txs of the sender in the mempool. Only EVM txs, whose sequence is not
+	// incremented during CheckTx, are checked against it. For the same
+	// reason, the txs of the sender are not pruned against it, see
+	// PruneCommitted.
+	if mtx.isEthTx() {
+		nonce := mp.getSequence(ctx, mtx.sender)
+		if mtx.nonce < nonce {
+			return fmt.Errorf("%w: got %d, expected >= %d", ErrNonceTooLow, mtx.nonce, nonce)
+		}
+	}
+
+	mp.arrivals++
+	mtx.arrival = mp.arrivals
+
+	if existing, found := acc.txs[mtx.nonce]; found {
+		if existing.lastNonce != mtx.lastNonce {
+			return ErrNonceConflict
+		}
+		if !mp.canReplace(existing, mtx) {
+			return ErrReplacementUnderpriced
+		}
+		acc.txs[mtx.nonce] = mtx
+		return nil
+	}
+
+	for _, other := range acc.txs {
+		if other.overlaps(mtx) {
+			return ErrNonceConflict
+		}
+	}
+
+	if mp.config.MaxTxs > 0 && mp.count >= mp.config.MaxTxs {
+		return sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	if uint64(len(acc.txs)) >= mp.config.AccountSlots {
+		// evict the transaction that is the furthest from being executed
+		highest := acc.highestNonce()
+		if mtx.nonce > highest {
+			return ErrAccountLimitExceeded
+		}
+		delete(acc.txs, highest)
+		mp.count--
+	}
+
+	acc.txs[mtx.nonce] = mtx
+	mp.accounts[mtx.sender.String()] = acc
+	mp.count++
+
+	return nil
+}
+
+// Select returns an iterator over the pending transactions of the mempool,
+// ordered by nonce for each sender and by effective tip across senders.
+// Queued transactions and transactions that can't pay the current base fee
+// are skipped.
+func (mp *Mempool) Select(goCtx context.Context, _ [][]byte) sdkmempool.Iterator {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.selectPending(sdk.UnwrapSDKContext(goCtx))
+}
+
+// SelectBy iterates over the pending transactions of the mempool in the same
+// order as Select, until the callback returns false. It is safe to use
+// concurrently with Insert and Remove.
+func (mp *Mempool) SelectBy(goCtx context.Context, _ [][]byte, callback func(sdk.Tx) bool) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	iter := mp.selectPending(sdk.UnwrapSDKContext(goCtx))
+	for iter != nil && callback(iter.Tx()) {
+		iter = iter.Next()
+	}
+}
+
+// CountTx returns the number of pending and queued transactions in the mempool.
+func (mp *Mempool) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.count
+}
+
+// Remove removes the transaction from the mempool. It returns ErrTxNotFound
+// if the transaction is not in the mempool, including when it was replaced
+// by another transaction with the same nonce.
+func (mp *Mempool) Remove(tx sdk.Tx) error {
+	mtx, err := newMempoolTx(tx, mp.signerExtractor)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	acc, found := mp.accounts[mtx.sender.String()]
+	if !found {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	existing, found := acc.txs[mtx.nonce]
+	if !found || !existing.sameTx(mtx) {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	delete(acc.txs, mtx.nonce)
+	mp.count--
+
+	if len(acc.txs) == 0 {
+		delete(mp.accounts, mtx.sender.String())
+	}
+
+	return nil
+}
+
+// PruneCommitted removes the transactions whose nonces are lower than the
+// committed sequence of their sender, i.e. the transactions that were included
+// in a block or that were superseded by another transaction with the same
+// nonce. The context must be on the committed state, as the one passed to the
+// PrepareCheckStater of the app, since the CheckTx state includes the sequence
+// increments of the pending Cosmos transactions.
+func (mp *Mempool) PruneCommitted(ctx sdk.Context) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	for key, acc := range mp.accounts {
+		mp.count -= acc.prune(mp.getSequence(ctx, acc.address))
+		if len(acc.txs) == 0 {
+			delete(mp.accounts, key)
+		}
+	}
+}
+
+// canReplace returns true if the replacement fee cap and tip cap are higher
+// than the ones of the existing transaction by at least the price bump.
+func (mp *Mempool) canReplace(existing, replacement *mempoolTx) bool {
+	return clearsPriceBump(existing.gasFeeCap, replacement.gasFeeCap, mp.config.PriceBump) &&
+		clearsPriceBump(existing.gasTipCap, replacement.gasTipCap, mp.config.PriceBump)
+}
+
+// clearsPriceBump returns true if `newPrice >= oldPrice * (100 + priceBump) / 100`
+// and the new price is strictly higher than the old one.
+func clearsPriceBump(oldPrice, newPrice *big.Int, priceBump uint64) bool {
+	if newPrice.Cmp(oldPrice) <= 0 {
+		return false
+	}
+
+	threshold := new(big.Int).Mul(oldPrice, new(big.Int).SetUint64(100+priceBump))
+	threshold.Quo(threshold, big.NewInt(100))
+	return newPrice.Cmp(threshold) >= 0
+}
+
+// getSequence returns the current sequence of the account, or zero if the
+// account doesn't exist.
+func (mp *Mempool) getSequence(ctx sdk.Context, addr sdk.AccAddress) uint64 {
+	nonce, err := mp.accountKeeper.GetSequence(ctx, addr)
+	if err != nil {
+		return 0
+	}
+	return nonce
+}
+
+// selectPending builds the iterator over the pending transactions of all the
+// senders. The caller must hold the mempool lock.
+func (mp *Mempool) selectPending(ctx sdk.Context) sdkmempool.Iterator {
+	baseFee := mp.evmKeeper.GetBaseFee(ctx)
+
+	cursors := make([]*senderCursor, 0, len(mp.accounts))
+	for _, acc := range mp.accounts {
+		pending := acc.pending(mp.getSequence(ctx, acc.address))
+		if len(pending) == 0 {
+			continue
+		}
+
+		cursor := &senderCursor{txs: pending, baseFee: baseFee}
+		if cursor.load() {
+			cursors = append(cursors, cursor)
+		}
+	}
+
+	return newIterator(cursors)
+}
+
+// pending returns the transactions of the account that are executable in
+// nonce order, starting from the given sequence and stopping at the first
+// nonce gap.
+func (a *account) pending(nonce uint64) []*mempoolTx {
+	var pending []*mempoolTx
+	for {
+		mtx, found := a.txs[nonce]
+		if !found {
+			return pending
+		}
+		pending = append(pending, mtx)
+		nonce = mtx.lastNonce + 1
+	}
+}
+
+// prune removes the transactions with a nonce lower than the given sequence
+// and returns the number of removed transactions.
+func (a *account) prune(nonce uint64) int {
+	removed := 0
+	for n, mtx := range a.txs {
+		if mtx.lastNonce < nonce {
+			delete(a.txs, n)
+			removed++
+		}
+	}
+	return removed
+}
+
+// highestNonce returns the highest nonce of the account transactions.
+func (a *account) highestNonce() uint64 {
+	var highest uint64
+	for n := range a.txs {
+		if n > highest {
+			highest = n
+		}
+	}
+	return highest
+}
@@ -0,0 +1,334 @@
+package mempool_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/testutil/config"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// gwei is used for prices high enough to not be truncated by the priority reduction.
+const gwei = 1e9
+
+type mockAccountKeeper struct {
+	sequences map[string]uint64
+}
+
+func (m mockAccountKeeper) GetSequence(_ context.Context, addr sdk.AccAddress) (uint64, error) {
+	return m.sequences[addr.String()], nil
+}
+
+type mockEVMKeeper struct {
+	baseFee *big.Int
+}
+
+func (m *mockEVMKeeper) GetBaseFee(_ sdk.Context) *big.Int { return m.baseFee }
+
+type testSender struct {
+	privKey *ethsecp256k1.PrivKey
+	addr    sdk.AccAddress
+}
+
+func newTestSender(t *testing.T) testSender {
+	t.Helper()
+	privKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	return testSender{
+		privKey: privKey,
+		addr:    sdk.AccAddress(privKey.PubKey().Address().Bytes()),
+	}
+}
+
+type mempoolTestSuite struct {
+	t             *testing.T
+	ctx           sdk.Context
+	txConfig      client.TxConfig
+	accountKeeper mockAccountKeeper
+	evmKeeper     *mockEVMKeeper
+}
+
+func setupMempoolTest(t *testing.T) *mempoolTestSuite {
+	t.Helper()
+	chainID := uint64(config.EighteenDecimalsChainID)
+	require.NoError(t, config.EvmAppOptions(chainID))
+	cfg := encoding.MakeConfig(chainID)
+
+	return &mempoolTestSuite{
+		t:             t,
+		ctx:           sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger()),
+		txConfig:      cfg.TxConfig,
+		accountKeeper: mockAccountKeeper{sequences: make(map[string]uint64)},
+		evmKeeper:     &mockEVMKeeper{},
+	}
+}
+
+func (s *mempoolTestSuite) newMempool(cfg mempool.Config) *mempool.Mempool {
+	return mempool.NewMempool(cfg, s.accountKeeper, s.evmKeeper, nil)
+}
+
+// ethTx builds a signed dynamic fee EVM transaction.
+func (s *mempoolTestSuite) ethTx(sender testSender, nonce uint64, gasFeeCap, gasTipCap int64) sdk.Tx {
+	s.t.Helper()
+	to := common.BytesToAddress(sender.addr)
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:   evmtypes.GetEthChainConfig().ChainID,
+		Nonce:     nonce,
+		To:        &to,
+		GasLimit:  21000,
+		GasFeeCap: big.NewInt(gasFeeCap),
+		GasTipCap: big.NewInt(gasTipCap),
+	})
+	msg.From = sender.addr.Bytes()
+	ethSigner := ethtypes.LatestSignerForChainID(evmtypes.GetEthChainConfig().ChainID)
+	require.NoError(s.t, msg.Sign(ethSigner, utiltx.NewSigner(sender.privKey)))
+
+	tx, err := utiltx.PrepareEthTx(s.txConfig, nil, msg)
+	require.NoError(s.t, err)
+	return tx
+}
+
+// cosmosTx builds a Cosmos transaction signed by the sender with the given
+// sequence. The signature itself is left empty as the mempool doesn't verify
+// it.
+func (s *mempoolTestSuite) cosmosTx(sender testSender, sequence uint64, gasPrice int64) sdk.Tx {
+	s.t.Helper()
+	builder := s.txConfig.NewTxBuilder()
+	require.NoError(s.t, builder.SetMsgs(banktypes.NewMsgSend(sender.addr, sender.addr, sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 1)))))
+	require.NoError(s.t, builder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   sender.privKey.PubKey(),
+		Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT},
+		Sequence: sequence,
+	}))
+	builder.SetGasLimit(100000)
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), gasPrice*100000)))
+	return builder.GetTx()
+}
+
+// selectNonces returns the sender and nonce of the selected transactions.
+func (s *mempoolTestSuite) selectNonces(mp *mempool.Mempool) []sdkmempool.SignerData {
+	s.t.Helper()
+	var selected []sdkmempool.SignerData
+	mp.SelectBy(s.ctx, nil, func(tx sdk.Tx) bool {
+		msg, ok := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+		if !ok {
+			signers, err := sdkmempool.NewDefaultSignerExtractionAdapter().GetSigners(tx)
+			require.NoError(s.t, err)
+			selected = append(selected, signers[0])
+			return true
+		}
+		selected = append(selected, sdkmempool.NewSignerData(msg.GetFrom(), msg.AsTransaction().Nonce()))
+		return true
+	})
+	return selected
+}
+
+func TestMempoolNonceGap(t *testing.T) {
+	s := setupMempoolTest(t)
+	mp := s.newMempool(mempool.DefaultConfig())
+	alice := newTestSender(t)
+
+	// nonce 1 is queued until nonce 0 is received
+	require.NoError(t, mp.Insert(s.ctx, s.ethTx(alice, 1, 10, 1)))
+	require.Equal(t, 1, mp.CountTx())
+	require.Nil(t, mp.Select(s.ctx, nil))
+
+	require.NoError(t, mp.Insert(s.ctx, s.ethTx(alice, 0, 10, 1)))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []sdkmempool.SignerData{
+		sdkmempool.NewSignerData(alice.addr, 0),
+		sdkmempool.NewSignerData(alice.addr, 1),
+	}, s.selectNonces(mp))
+
+	// nonce 3 is queued, and promoted once the account sequence reaches it
+	require.NoError(t, mp.Insert(s.ctx, s.ethTx(alice, 3, 10, 1)))
+	require.Len(t, s.selectNonces(mp), 2)
+
+	s.accountKeeper.sequences[alice.addr.String()] = 3
+	require.Equal(t, []sdkmempool.SignerData{
+		sdkmempool.NewSignerData(alice.addr, 3),
+	}, s.selectNonces(mp))
+
+	// nonces lower than the account sequence are rejected
+	err := mp.Insert(s.ctx, s.ethTx(alice, 2, 10, 1))
+	require.ErrorIs(t, err, mempool.ErrNonceTooLow)
+}
+
+func TestMempoolCosmosTxs(t *testing.T) {
+	s := setupMempoolTest(t)
+	mp := s.newMempool(mempool.DefaultConfig())
+	alice := newTestSender(t)
+
+	// The ante handler increments the sequence of Cosmos txs in the CheckTx
+	// state before they are inserted in the mempool.
+	for sequence := uint64(0); sequence < 3; sequence++ {
+		s.accountKeeper.sequences[alice.addr.String()] = sequence + 1
+		require.NoError(t, mp.Insert(s.ctx, s.cosmosTx(alice, sequence, 10)))
+	}
+	require.Equal(t, 3, mp.CountTx())
+
+	// the transactions are selected from the committed sequence
+	s.accountKeeper.sequences[alice.addr.String()] = 0
+	require.Equal(t, []sdkmempool.SignerData{
+		sdkmempool.NewSignerData(alice.addr, 0),
+		sdkmempool.NewSignerData(alice.addr, 1),
+		sdkmempool.NewSignerData(alice.addr, 2),
+	}, s.selectNonces(mp))
+
+	require.NoError(t, mp.Remove(s.cosmosTx(alice, 0, 10)))
+	require.Equal(t, 2, mp.CountTx())
+}
+
+func TestMempoolCosmosAndEthTxs(t *testing.T) {
+	s := setupMempoolTest(t)
+	mp := s.newMempool(mempool.DefaultConfig())
+	alice := newTestSender(t)
+
+	// the sequence of the Cosmos tx is incremented in the CheckTx state, but
+	// the tx is not committed yet
+	s.accountKeeper.sequences[alice.addr.String()] = 1
+	require.NoError(t, mp.Insert(s.ctx, s.cosmosTx(alice, 0, 10)))
+
+	// the EVM tx following it doesn't drop it from the mempool
+	require.NoError(t, mp.Insert(s.ctx, s.ethTx(alice, 1, 10, 1)))
+	require.Equal(t, 2, mp.CountTx())
+
+	// both are selected from the committed sequence
+	s.accountKeeper.sequences[alice.addr.String()] = 0
+	require.Equal(t, []sdkmempool.SignerData{
+		sdkmempool.NewSignerData(alice.addr, 0),
+		sdkmempool.NewSignerData(alice.addr, 1),
+	}, s.selectNonces(mp))
+
+	// pruning against the committed sequence keeps them
+	mp.PruneCommitted(s.ctx)
+	require.Equal(t, 2, mp.CountTx())
+
+	// once the Cosmos tx is committed, it is pruned and the EVM tx is still
+	// selected
+	s.accountKeeper.sequences[alice.addr.String()] = 1
+	mp.PruneCommitted(s.ctx)
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []sdkmempool.SignerData{
+		sdkmempool.NewSignerData(alice.addr, 1),
+	}, s.selectNonces(mp))
+
+	s.accountKeeper.sequences[alice.addr.String()] = 2
+	mp.PruneCommitted(s.ctx)
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(s.ctx, nil))
+}
+
+func TestMempoolReplacement(t *testing.T) {
+	s := setupMempoolTest(t)
+	mp := s.newMempool(mempool.DefaultConfig())
+	alice := newTestSender(t)
+
+	original := s.ethTx(alice, 0, 100, 10)
+	require.NoError(t, mp.Insert(s.ctx, original))
+
+	testCases := []struct {
+		name      string
+		gasFeeCap int64
+		gasTipCap int64
+	}{
+		{"same prices", 100, 10},
+		{"fee cap below the price bump", 109, 20},
+		{"tip cap below the price bump", 200, 10},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := mp.Insert(s.ctx, s.ethTx(alice, 0, tc.gasFeeCap, tc.gasTipCap))
+			require.ErrorIs(t, err, mempool.ErrReplacementUnderpriced)
+		})
+	}
+
+	replacement := s.ethTx(alice, 0, 110, 11)
+	require.NoError(t, mp.Insert(s.ctx, replacement))
+	require.Equal(t, 1, mp.CountTx())
+
+	// the replaced transaction is no longer in the mempool
+	require.ErrorIs(t, mp.Remove(original), sdkmempool.ErrTxNotFound)
+	require.NoError(t, mp.Remove(replacement))
+	require.Equal(t, 0, mp.CountTx())
+}
+
+func TestMempoolAccountSlots(t *testing.T) {
+	s := setupMempoolTest(t)
+	cfg := mempool.DefaultConfig()
+	cfg.AccountSlots = 2
+	mp := s.newMempool(cfg)
+	alice := newTestSender(t)
+
+	require.NoError(t, mp.Insert(s.ctx, s.ethTx(alice, 0, 10, 1)))
+	require.NoError(t, mp.Insert(s.ctx, s.ethTx(alice, 2, 10, 1)))
+
+	// a higher nonce can't evict any transaction
+	err := mp.Insert(s.ctx, s.ethTx(alice, 5, 10, 1))
+	require.ErrorIs(t, err, mempool.ErrAccountLimitExceeded)
+
+	// a lower nonce evicts the transaction with the highest nonce
+	require.NoError(t, mp.Insert(s.ctx, s.ethTx(alice, 1, 10, 1)))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []sdkmempool.SignerData{
+		sdkmempool.NewSignerData(alice.addr, 0),
+		sdkmempool.NewSignerData(alice.addr, 1),
+	}, s.selectNonces(mp))
+}
+
+func TestMempoolMaxTxs(t *testing.T) {
+	s := setupMempoolTest(t)
+	cfg := mempool.DefaultConfig()
+	cfg.MaxTxs = 1
+	mp := s.newMempool(cfg)
+
+	require.NoError(t, mp.Insert(s.ctx, s.ethTx(newTestSender(t), 0, 10, 1)))
+	err := mp.Insert(s.ctx, s.ethTx(newTestSender(t), 0, 10, 1))
+	require.ErrorIs(t, err, sdkmempool.ErrMempoolTxMaxCapacity)
+}
+
+func TestMempoolSelectOrder(t *testing.T) {
+	s := setupMempoolTest(t)
+	mp := s.newMempool(mempool.DefaultConfig())
+	alice := newTestSender(t)
+	bob := newTestSender(t)
+	carol := newTestSender(t)
+
+	s.evmKeeper.baseFee = big.NewInt(100 * gwei)
+
+	require.NoError(t, mp.Insert(s.ctx, s.ethTx(alice, 0, 200*gwei, 50*gwei)))
+	require.NoError(t, mp.Insert(s.ctx, s.ethTx(alice, 1, 200*gwei, 1*gwei)))
+	// the effective tip of bob is capped by the fee cap: min(40, 130 - 100)
+	require.NoError(t, mp.Insert(s.ctx, s.ethTx(bob, 0, 130*gwei, 40*gwei)))
+	// carol can't pay the base fee
+	require.NoError(t, mp.Insert(s.ctx, s.ethTx(carol, 0, 90*gwei, 40*gwei)))
+
+	require.Equal(t, []sdkmempool.SignerData{
+		sdkmempool.NewSignerData(alice.addr, 0),
+		sdkmempool.NewSignerData(bob.addr, 0),
+		sdkmempool.NewSignerData(alice.addr, 1),
+	}, s.selectNonces(mp))
+
+	// carol is selected once the base fee decreases
+	s.evmKeeper.baseFee = big.NewInt(50 * gwei)
+	require.Len(t, s.selectNonces(mp), 4)
+}
@@ -0,0 +1,210 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	evmante "github.com/cosmos/evm/ante/evm"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// mempoolTx wraps a transaction with the information required by the mempool
+// to order it among the transactions of the same sender and among senders.
+type mempoolTx struct {
+	tx sdk.Tx
+	// hash is the ethereum hash of the first message of an EVM transaction. It
+	// is empty for Cosmos transactions.
+	hash   string
+	sender sdk.AccAddress
+	// nonce is the first nonce of the sender consumed by the transaction and
+	// lastNonce the last one. They only differ for batches of EVM messages.
+	nonce     uint64
+	lastNonce uint64
+	gas       uint64
+	// gasFeeCap and gasTipCap are the prices per unit of gas of the
+	// transaction in 18 decimals representation. For batches of EVM
+	// messages, the lowest prices of all the messages are used.
+	gasFeeCap *big.Int
+	gasTipCap *big.Int
+	// arrival is a monotonic counter used to break ties between transactions
+	// with the same priority.
+	arrival uint64
+}
+
+// newMempoolTx extracts the sender, nonces and prices of the transaction.
+func newMempoolTx(tx sdk.Tx, signerExtractor sdkmempool.SignerExtractionAdapter) (*mempoolTx, error) {
+	if isEthTx(tx) {
+		return newEthMempoolTx(tx)
+	}
+	return newCosmosMempoolTx(tx, signerExtractor)
+}
+
+// isEthTx returns true if the transaction carries the ethereum tx extension option.
+func isEthTx(tx sdk.Tx) bool {
+	txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return false
+	}
+	opts := txWithExtensions.GetExtensionOptions()
+	return len(opts) > 0 && opts[0].GetTypeUrl() == "/cosmos.evm.vm.v1.ExtensionOptionsEthereumTx"
+}
+
+func newEthMempoolTx(tx sdk.Tx) (*mempoolTx, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, errors.New("ethereum transaction without messages")
+	}
+
+	var mtx *mempoolTx
+	for _, msg := range msgs {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return nil, fmt.Errorf("invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		ethTx := ethMsg.AsTransaction()
+		if mtx == nil {
+			mtx = &mempoolTx{
+				tx:        tx,
+				hash:      ethMsg.Hash,
+				sender:    ethMsg.GetFrom(),
+				nonce:     ethTx.Nonce(),
+				lastNonce: ethTx.Nonce(),
+				gasFeeCap: ethTx.GasFeeCap(),
+				gasTipCap: ethTx.GasTipCap(),
+			}
+		}
+
+		mtx.gas += ethTx.Gas()
+		if ethTx.GasFeeCap().Cmp(mtx.gasFeeCap) < 0 {
+			mtx.gasFeeCap = ethTx.GasFeeCap()
+		}
+		if ethTx.GasTipCap().Cmp(mtx.gasTipCap) < 0 {
+			mtx.gasTipCap = ethTx.GasTipCap()
+		}
+
+		// only the nonces of the sender of the first message are tracked
+		if mtx.sender.Equals(sdk.AccAddress(ethMsg.GetFrom())) && ethTx.Nonce() > mtx.lastNonce {
+			mtx.lastNonce = ethTx.Nonce()
+		}
+	}
+
+	return mtx, nil
+}
+
+func newCosmosMempoolTx(tx sdk.Tx, signerExtractor sdkmempool.SignerExtractionAdapter) (*mempoolTx, error) {
+	signers, err := signerExtractor.GetSigners(tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) == 0 {
+		return nil, errors.New("tx must have at least one signer")
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, fmt.Errorf("invalid transaction type %T, expected %T", tx, (sdk.FeeTx)(nil))
+	}
+
+	gas := feeTx.GetGas()
+	if gas == 0 {
+		return nil, errors.New("gas cannot be zero")
+	}
+
+	// NOTE: the fees of Cosmos transactions are represented in the original
+	// decimals of the EVM coin, so they are scaled to 18 decimals to be
+	// compared with the prices of EVM transactions.
+	feeAmt := feeTx.GetFee().AmountOfNoDenomValidation(evmtypes.GetEVMCoinDenom())
+	gasFeeCap := evmtypes.ConvertAmountTo18DecimalsBigInt(
+		new(big.Int).Quo(feeAmt.BigInt(), new(big.Int).SetUint64(gas)),
+	)
+
+	// the tip cap defaults to the fee cap when there's no extension option.
+	gasTipCap := gasFeeCap
+	if hasExtOptsTx, ok := tx.(authante.HasExtensionOptionsTx); ok {
+		for _, opt := range hasExtOptsTx.GetExtensionOptions() {
+			if extOpt, ok := opt.GetCachedValue().(*cosmosevmtypes.ExtensionOptionDynamicFeeTx); ok {
+				maxPriorityPrice := extOpt.MaxPriorityPrice
+				if maxPriorityPrice.IsNil() {
+					maxPriorityPrice = sdkmath.LegacyZeroDec()
+				}
+				gasTipCap = evmtypes.ConvertAmountTo18DecimalsLegacy(maxPriorityPrice).TruncateInt().BigInt()
+				break
+			}
+		}
+	}
+
+	return &mempoolTx{
+		tx:        tx,
+		sender:    signers[0].Signer,
+		nonce:     signers[0].Sequence,
+		lastNonce: signers[0].Sequence,
+		gas:       gas,
+		gasFeeCap: gasFeeCap,
+		gasTipCap: gasTipCap,
+	}, nil
+}
+
+// effectiveTip returns the tip per unit of gas paid by the transaction for
+// the given base fee, following the EIP-1559 rules:
+// `effectiveTip = min(gasTipCap, gasFeeCap - baseFee)`.
+// It returns false if the fee cap doesn't cover the base fee.
+func (mtx *mempoolTx) effectiveTip(baseFee *big.Int) (*big.Int, bool) {
+	if baseFee == nil {
+		return mtx.gasFeeCap, true
+	}
+
+	if mtx.gasFeeCap.Cmp(baseFee) < 0 {
+		return nil, false
+	}
+
+	tip := new(big.Int).Sub(mtx.gasFeeCap, baseFee)
+	if tip.Cmp(mtx.gasTipCap) > 0 {
+		tip = mtx.gasTipCap
+	}
+	return tip, true
+}
+
+// priority returns the priority of the transaction, computed from the
+// effective tip paid with the given base fee in the same way the ante
+// handler computes it.
+func (mtx *mempoolTx) priority(tip *big.Int) int64 {
+	if mtx.gas > math.MaxInt64 {
+		return 0
+	}
+
+	fees := sdk.Coins{
+		{
+			Denom:  evmtypes.GetEVMCoinExtendedDenom(),
+			Amount: sdkmath.NewIntFromBigInt(new(big.Int).Mul(tip, new(big.Int).SetUint64(mtx.gas))),
+		},
+	}
+	return evmante.GetTxPriority(fees, int64(mtx.gas)) //#nosec G115 -- checked for int overflow above
+}
+
+// isEthTx returns true if the transaction is an EVM transaction.
+func (mtx *mempoolTx) isEthTx() bool {
+	return mtx.hash != ""
+}
+
+// overlaps returns true if the nonces of the transactions overlap.
+func (mtx *mempoolTx) overlaps(other *mempoolTx) bool {
+	return mtx.nonce <= other.lastNonce && other.nonce <= mtx.lastNonce
+}
+
+// sameTx returns true if both entries hold the same transaction.
+func (mtx *mempoolTx) sameTx(other *mempoolTx) bool {
+	if mtx.isEthTx() || other.isEthTx() {
+		return mtx.hash == other.hash
+	}
+	return mtx.nonce == other.nonce
+}
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev StakeAuthorizationType defines the staking message type allowed by a
+/// stake authorization.
+enum StakeAuthorizationType {
+    // Unspecified defines an invalid authorization type.
+    Unspecified,
+    // Delegate defines an authorization to perform a delegation.
+    Delegate,
+    // Undelegate defines an authorization to perform an undelegation.
+    Undelegate,
+    // Redelegate defines an authorization to perform a redelegation.
+    Redelegate,
+    // CancelUnbondingDelegation defines an authorization to cancel an unbonding delegation.
+    CancelUnbondingDelegation
+}
+
+/// @dev GrantData represents an authorization given by a granter to a grantee.
+struct GrantData {
+    /// @dev The address of the granter
+    address granter;
+    /// @dev The address of the grantee
+    address grantee;
+    /// @dev The type URL of the authorization, e.g. /cosmos.bank.v1beta1.SendAuthorization
+    string authorizationType;
+    /// @dev The type URL of the message the authorization applies to
+    string msgTypeUrl;
+    /// @dev The protoJSON encoded authorization
+    string authorization;
+    /// @dev The expiration of the grant as a unix timestamp, 0 if the grant does not expire
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the x/authz module
+interface IAuthz {
+    /// @dev Grant defines an Event emitted when an authorization is granted.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the authorized message
+    event Grant(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /// @dev Revoke defines an Event emitted when an authorization is revoked.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the revoked message
+    event Revoke(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /// @dev Exec defines an Event emitted when messages are executed on behalf of granters.
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrls the type URLs of the executed messages
+    event Exec(address indexed grantee, string[] msgTypeUrls);
+
+    /// TRANSACTIONS
+
+    /// @dev grantGeneric defines a method to grant a GenericAuthorization, which allows the
+    /// grantee to execute any message of the given type on behalf of the granter.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the authorized message
+    /// @param expiration The expiration as a unix timestamp, 0 for no expiration
+    /// @return success Whether the transaction was successful or not
+    function grantGeneric(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev grantSend defines a method to grant a SendAuthorization, which allows the
+    /// grantee to send up to the spend limit on behalf of the granter.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount the grantee can send
+    /// @param allowList The receivers allowed by the authorization, any receiver if empty
+    /// @param expiration The expiration as a unix timestamp, 0 for no expiration
+    /// @return success Whether the transaction was successful or not
+    function grantSend(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        address[] calldata allowList,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev grantStake defines a method to grant a StakeAuthorization, which allows the
+    /// grantee to perform the given staking operation on behalf of the granter.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param authorizationType The staking operation allowed by the authorization
+    /// @param maxTokens The maximum amount of tokens, no limit if the amount is zero
+    /// @param allowList The validators allowed by the authorization
+    /// @param denyList The validators denied by the authorization
+    /// @param expiration The expiration as a unix timestamp, 0 for no expiration
+    /// @return success Whether the transaction was successful or not
+    function grantStake(
+        address granter,
+        address grantee,
+        StakeAuthorizationType authorizationType,
+        Coin calldata maxTokens,
+        string[] calldata allowList,
+        string[] calldata denyList,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev revoke defines a method to revoke an authorization given to a grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the revoked message
+    /// @return success Whether the transaction was successful or not
+    function revoke(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev exec defines a method to execute messages on behalf of the granters. Messages
+    /// signed by the grantee itself are executed without an authorization.
+    /// @param grantee The address of the grantee
+    /// @param messages The protoJSON encoded messages to execute
+    /// @return results The data returned by the execution of each message
+    function exec(
+        address grantee,
+        string[] calldata messages
+    ) external returns (bytes[] memory results);
+
+    /// QUERIES
+
+    /// @dev grants returns the grants given by a granter to a grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the message to filter by, all messages if empty
+    /// @param pagination The pagination options
+    /// @return grants The grants given by the granter to the grantee
+    /// @return pageResponse The pagination information
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev granterGrants returns the grants given by a granter.
+    /// @param granter The address of the granter
+    /// @param pagination The pagination options
+    /// @return grants The grants given by the granter
+    /// @return pageResponse The pagination information
+    function granterGrants(
+        address granter,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev granteeGrants returns the grants received by a grantee.
+    /// @param grantee The address of the grantee
+    /// @param pagination The pagination options
+    /// @return grants The grants received by the grantee
+    /// @return pageResponse The pagination information
+    function granteeGrants(
+        address grantee,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GrantData[] memory grants, PageResponse memory pageResponse);
+}
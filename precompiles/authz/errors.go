@@ -0,0 +1,20 @@
+package authz
+
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidMsgTypeURL is raised when the message type URL is not valid.
+	ErrInvalidMsgTypeURL = "invalid msg type url: %v"
+	// ErrInvalidExpiration is raised when the expiration is not a valid unix timestamp.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidSpendLimit is raised when the spend limit is not valid.
+	ErrInvalidSpendLimit = "invalid spend limit: %v"
+	// ErrInvalidAllowList is raised when the allow list is not valid.
+	ErrInvalidAllowList = "invalid allow list: %v"
+	// ErrInvalidMessages is raised when the messages to execute are not valid.
+	ErrInvalidMessages = "invalid messages: %v"
+	// ErrDisabledMsg is raised when a message type is not allowed to be granted or executed.
+	ErrDisabledMsg = "unauthorized authz message: %s"
+)
@@ -0,0 +1,101 @@
+package authz
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeGrant defines the event type for the authz Grant transactions.
+	EventTypeGrant = "Grant"
+	// EventTypeRevoke defines the event type for the authz RevokeMethod transaction.
+	EventTypeRevoke = "Revoke"
+	// EventTypeExec defines the event type for the authz ExecMethod transaction.
+	EventTypeExec = "Exec"
+)
+
+// EmitGrantEvent creates a new event emitted on a Grant transaction.
+func (p Precompile) EmitGrantEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address, msgTypeURL string) error {
+	return p.emitGranterGranteeEvent(ctx, stateDB, EventTypeGrant, granter, grantee, msgTypeURL)
+}
+
+// EmitRevokeEvent creates a new event emitted on a Revoke transaction.
+func (p Precompile) EmitRevokeEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address, msgTypeURL string) error {
+	return p.emitGranterGranteeEvent(ctx, stateDB, EventTypeRevoke, granter, grantee, msgTypeURL)
+}
+
+// EmitExecEvent creates a new event emitted on an Exec transaction.
+func (p Precompile) EmitExecEvent(ctx sdk.Context, stateDB vm.StateDB, grantee common.Address, msgTypeURLs []string) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeExec]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(msgTypeURLs)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// emitGranterGranteeEvent emits an event indexed by the granter and the grantee,
+// with the message type URL as data.
+func (p Precompile) emitGranterGranteeEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, granter, grantee common.Address, msgTypeURL string) error {
+	// Prepare the event topics
+	event := p.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(granter)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(grantee)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(msgTypeURL)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package authz
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantsMethod defines the method name for the grants precompile request.
+	GrantsMethod = "grants"
+	// GranterGrantsMethod defines the method name for the granter grants precompile request.
+	GranterGrantsMethod = "granterGrants"
+	// GranteeGrantsMethod defines the method name for the grantee grants precompile request.
+	GranteeGrantsMethod = "granteeGrants"
+)
+
+// Grants implements the query logic for getting the grants given by a granter to a grantee.
+func (p *Precompile) Grants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGrantsArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.Grants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	granter, err := p.addrCdc.StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := p.addrCdc.StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrants(p.codec, common.BytesToAddress(granter), common.BytesToAddress(grantee), res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
+
+// GranterGrants implements the query logic for getting the grants given by a granter.
+func (p *Precompile) GranterGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranterGrantsArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantAuthorizations(p.codec, res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
+
+// GranteeGrants implements the query logic for getting the grants received by a grantee.
+func (p *Precompile) GranteeGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranteeGrantsArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantAuthorizations(p.codec, res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
@@ -0,0 +1,191 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const (
+	// GrantGenericMethod defines the ABI method name for the authz Grant transaction
+	// with a GenericAuthorization.
+	GrantGenericMethod = "grantGeneric"
+	// GrantSendMethod defines the ABI method name for the authz Grant transaction
+	// with a SendAuthorization.
+	GrantSendMethod = "grantSend"
+	// GrantStakeMethod defines the ABI method name for the authz Grant transaction
+	// with a StakeAuthorization.
+	GrantStakeMethod = "grantStake"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// GrantGeneric defines a method to grant a GenericAuthorization to a grantee.
+func (p *Precompile) GrantGeneric(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, err := NewMsgGrantGeneric(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granterHexAddr)
+}
+
+// GrantSend defines a method to grant a SendAuthorization to a grantee.
+func (p *Precompile) GrantSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, err := NewMsgGrantSend(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granterHexAddr)
+}
+
+// GrantStake defines a method to grant a StakeAuthorization to a grantee.
+func (p *Precompile) GrantStake(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, err := NewMsgGrantStake(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, granterHexAddr)
+}
+
+// Revoke defines a method to revoke an authorization given to a grantee.
+func (p *Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, err := NewMsgRevoke(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != granterHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granterHexAddr.String())
+	}
+
+	if _, err = p.authzKeeper.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := p.addrCdc.StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeEvent(ctx, stateDB, granterHexAddr, common.BytesToAddress(granteeAddr), msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec defines a method to execute messages on behalf of the granters.
+func (p *Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granteeHexAddr, err := NewMsgExec(args, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != granteeHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granteeHexAddr.String())
+	}
+
+	if err := p.authzLimiter.CheckDisabledMsgs([]sdk.Msg{msg}); err != nil {
+		return nil, fmt.Errorf(ErrDisabledMsg, err.Error())
+	}
+
+	res, err := p.authzKeeper.Exec(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	msgTypeURLs := make([]string, len(msg.Msgs))
+	for i, anyMsg := range msg.Msgs {
+		msgTypeURLs[i] = anyMsg.TypeUrl
+	}
+
+	if err = p.EmitExecEvent(ctx, stateDB, granteeHexAddr, msgTypeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Results)
+}
+
+// grant saves the authorization of the MsgGrant, after checking that the
+// granter is the caller and that the authorized message type is not disabled.
+func (p *Precompile) grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *authz.MsgGrant,
+	granterHexAddr common.Address,
+) ([]byte, error) {
+	msgSender := contract.Caller()
+	if msgSender != granterHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granterHexAddr.String())
+	}
+
+	if err := p.authzLimiter.CheckDisabledMsgs([]sdk.Msg{msg}); err != nil {
+		return nil, fmt.Errorf(ErrDisabledMsg, err.Error())
+	}
+
+	if _, err := p.authzKeeper.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := p.addrCdc.StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitGrantEvent(ctx, stateDB, granterHexAddr, common.BytesToAddress(granteeAddr), authorization.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,498 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/utils"
+
+	"cosmossdk.io/core/address"
+	sdkerrors "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// EventGrant defines the event data for the Grant transactions.
+type EventGrant struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+}
+
+// EventRevoke defines the event data for the Revoke transaction.
+type EventRevoke struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+}
+
+// EventExec defines the event data for the Exec transaction.
+type EventExec struct {
+	Grantee     common.Address
+	MsgTypeUrls []string //nolint:revive
+}
+
+// GrantData represents an authorization given by a granter to a grantee.
+type GrantData struct {
+	Granter           common.Address `abi:"granter"`
+	Grantee           common.Address `abi:"grantee"`
+	AuthorizationType string         `abi:"authorizationType"`
+	MsgTypeUrl        string         `abi:"msgTypeUrl"` //nolint:revive
+	Authorization     string         `abi:"authorization"`
+	Expiration        int64          `abi:"expiration"`
+}
+
+// GrantsOutput defines the output for the grants queries.
+type GrantsOutput struct {
+	Grants       []GrantData        `abi:"grants"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// GrantStakeInput defines the input for the GrantStake transaction.
+type GrantStakeInput struct {
+	Granter           common.Address
+	Grantee           common.Address
+	AuthorizationType uint8
+	MaxTokens         cmn.Coin
+	AllowList         []string
+	DenyList          []string
+	Expiration        int64
+}
+
+// GranterGrantsInput defines the input for the GranterGrants query.
+type GranterGrantsInput struct {
+	Granter    common.Address
+	Pagination query.PageRequest
+}
+
+// GranteeGrantsInput defines the input for the GranteeGrants query.
+type GranteeGrantsInput struct {
+	Grantee    common.Address
+	Pagination query.PageRequest
+}
+
+// GrantsInput defines the input for the Grants query.
+type GrantsInput struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+	Pagination query.PageRequest
+}
+
+// NewMsgGrantGeneric creates a new MsgGrant with a GenericAuthorization.
+func NewMsgGrantGeneric(args []interface{}, addrCdc address.Codec) (*authz.MsgGrant, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	granter, grantee, err := checkGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	expiration, ok := args[3].(int64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[3])
+	}
+
+	msg, err := newMsgGrant(granter, grantee, authz.NewGenericAuthorization(msgTypeURL), expiration, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, granter, nil
+}
+
+// NewMsgGrantSend creates a new MsgGrant with a SendAuthorization.
+func NewMsgGrantSend(args []interface{}, addrCdc address.Codec) (*authz.MsgGrant, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	granter, grantee, err := checkGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	coins, err := cmn.ToCoins(args[2])
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSpendLimit, args[2])
+	}
+
+	spendLimit, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSpendLimit, err.Error())
+	}
+
+	allowList, ok := args[3].([]common.Address)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidAllowList, args[3])
+	}
+
+	expiration, ok := args[4].(int64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[4])
+	}
+
+	authorization := banktypes.NewSendAuthorization(spendLimit, nil)
+	allowed := make([]string, len(allowList))
+	for i, addr := range allowList {
+		if allowed[i], err = addrCdc.BytesToString(addr.Bytes()); err != nil {
+			return nil, common.Address{}, fmt.Errorf(ErrInvalidAllowList, addr)
+		}
+	}
+	authorization.AllowList = allowed
+
+	msg, err := newMsgGrant(granter, grantee, authorization, expiration, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, granter, nil
+}
+
+// NewMsgGrantStake creates a new MsgGrant with a StakeAuthorization.
+func NewMsgGrantStake(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.MsgGrant, common.Address, error) {
+	if len(args) != 7 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+
+	var input GrantStakeInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to GrantStakeInput: %s", err)
+	}
+
+	granter, grantee, err := checkGranterGrantee(input.Granter, input.Grantee)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	allowList, err := parseValidators(input.AllowList)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	denyList, err := parseValidators(input.DenyList)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	var maxTokens *sdk.Coin
+	if input.MaxTokens.Amount != nil && input.MaxTokens.Amount.Sign() != 0 {
+		coin := input.MaxTokens.ToSDKType()
+		if err := coin.Validate(); err != nil {
+			return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, err.Error())
+		}
+		maxTokens = &coin
+	}
+
+	authorization, err := stakingtypes.NewStakeAuthorization(
+		allowList,
+		denyList,
+		stakingtypes.AuthorizationType(input.AuthorizationType),
+		maxTokens,
+	)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg, err := newMsgGrant(granter, grantee, authorization, input.Expiration, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, granter, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke.
+func NewMsgRevoke(args []interface{}, addrCdc address.Codec) (*authz.MsgRevoke, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	granter, grantee, err := checkGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return &authz.MsgRevoke{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: msgTypeURL,
+	}, granter, nil
+}
+
+// NewMsgExec creates a new MsgExec from the protoJSON encoded messages.
+func NewMsgExec(args []interface{}, cdc codec.Codec, addrCdc address.Codec) (*authz.MsgExec, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	jsonMsgs, ok := args[1].([]string)
+	if !ok || len(jsonMsgs) == 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMessages, args[1])
+	}
+
+	msgs := make([]*codectypes.Any, len(jsonMsgs))
+	for i, jsonMsg := range jsonMsgs {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON([]byte(jsonMsg), &msg); err != nil {
+			return nil, common.Address{}, sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, common.Address{}, sdkerrors.Wrapf(err, "message %d", i)
+		}
+		msgs[i] = anyMsg
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return &authz.MsgExec{
+		Grantee: granteeAddr,
+		Msgs:    msgs,
+	}, grantee, nil
+}
+
+// ParseGrantsArgs parses the arguments of the Grants query.
+func ParseGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGrantsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput: %s", err)
+	}
+
+	granter, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	grantee, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &authz.QueryGrantsRequest{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeUrl: input.MsgTypeUrl,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseGranterGrantsArgs parses the arguments of the GranterGrants query.
+func ParseGranterGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput: %s", err)
+	}
+
+	granter, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	return &authz.QueryGranterGrantsRequest{
+		Granter:    granter,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseGranteeGrantsArgs parses the arguments of the GranteeGrants query.
+func ParseGranteeGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput: %s", err)
+	}
+
+	grantee, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &authz.QueryGranteeGrantsRequest{
+		Grantee:    grantee,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromGrants populates the output from the grants given by the granter to the grantee.
+func (o *GrantsOutput) FromGrants(cdc codec.Codec, granter, grantee common.Address, grants []*authz.Grant, pageRes *query.PageResponse) (*GrantsOutput, error) {
+	o.Grants = make([]GrantData, len(grants))
+	for i, grant := range grants {
+		data, err := newGrantData(cdc, granter, grantee, grant.Authorization, grant.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = data
+	}
+	o.setPageResponse(pageRes)
+	return o, nil
+}
+
+// FromGrantAuthorizations populates the output from the grants of a granter or a grantee.
+func (o *GrantsOutput) FromGrantAuthorizations(cdc codec.Codec, grants []*authz.GrantAuthorization, pageRes *query.PageResponse) (*GrantsOutput, error) {
+	o.Grants = make([]GrantData, len(grants))
+	for i, grant := range grants {
+		granter, err := utils.HexAddressFromBech32String(grant.Granter)
+		if err != nil {
+			return nil, err
+		}
+		grantee, err := utils.HexAddressFromBech32String(grant.Grantee)
+		if err != nil {
+			return nil, err
+		}
+		data, err := newGrantData(cdc, granter, grantee, grant.Authorization, grant.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = data
+	}
+	o.setPageResponse(pageRes)
+	return o, nil
+}
+
+func (o *GrantsOutput) setPageResponse(pageRes *query.PageResponse) {
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+}
+
+// newGrantData returns the GrantData of the authorization, encoded in protoJSON.
+func newGrantData(cdc codec.Codec, granter, grantee common.Address, authorizationAny *codectypes.Any, expiration *time.Time) (GrantData, error) {
+	var authorization authz.Authorization
+	if err := cdc.InterfaceRegistry().UnpackAny(authorizationAny, &authorization); err != nil {
+		return GrantData{}, err
+	}
+
+	authorizationJSON, err := cdc.MarshalJSON(authorization)
+	if err != nil {
+		return GrantData{}, err
+	}
+
+	data := GrantData{
+		Granter:           granter,
+		Grantee:           grantee,
+		AuthorizationType: authorizationAny.TypeUrl,
+		MsgTypeUrl:        authorization.MsgTypeURL(),
+		Authorization:     string(authorizationJSON),
+	}
+	if expiration != nil {
+		data.Expiration = expiration.Unix()
+	}
+	return data, nil
+}
+
+// newMsgGrant creates a new MsgGrant of the authorization with the given unix
+// expiration, where 0 means the grant does not expire.
+func newMsgGrant(granter, grantee common.Address, authorization authz.Authorization, expiration int64, addrCdc address.Codec) (*authz.MsgGrant, error) {
+	if expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, expiration)
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	msg := &authz.MsgGrant{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}
+	if expiration != 0 {
+		expirationTime := time.Unix(expiration, 0).UTC()
+		msg.Grant.Expiration = &expirationTime
+	}
+
+	if err := msg.SetAuthorization(authorization); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// checkGranterGrantee checks the granter and grantee arguments are valid addresses.
+func checkGranterGrantee(granterArg, granteeArg interface{}) (common.Address, common.Address, error) {
+	granter, ok := granterArg.(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, granterArg)
+	}
+
+	grantee, ok := granteeArg.(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, granteeArg)
+	}
+
+	return granter, grantee, nil
+}
+
+// parseValidators parses the bech32 validator operator addresses.
+func parseValidators(validators []string) ([]sdk.ValAddress, error) {
+	valAddrs := make([]sdk.ValAddress, len(validators))
+	for i, validator := range validators {
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return nil, fmt.Errorf(cmn.ErrInvalidValidator, validator)
+		}
+		valAddrs[i] = valAddr
+	}
+	return valAddrs, nil
+}
@@ -0,0 +1,202 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestNewMsgGrantGeneric(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		args           []interface{}
+		wantErr        bool
+		errMsg         string
+		wantExpiration *time.Time
+	}{
+		{
+			name:           "valid",
+			args:           []interface{}{granter, grantee, msgTypeURL, expiration.Unix()},
+			wantExpiration: &expiration,
+		},
+		{
+			name: "valid - no expiration",
+			args: []interface{}{granter, grantee, msgTypeURL, int64(0)},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name:    "empty granter address",
+			args:    []interface{}{common.Address{}, grantee, msgTypeURL, int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGranter, common.Address{}),
+		},
+		{
+			name:    "invalid grantee type",
+			args:    []interface{}{granter, "not-an-address", msgTypeURL, int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGrantee, "not-an-address"),
+		},
+		{
+			name:    "empty msg type url",
+			args:    []interface{}{granter, grantee, "", int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidMsgTypeURL, ""),
+		},
+		{
+			name:    "negative expiration",
+			args:    []interface{}{granter, grantee, msgTypeURL, int64(-1)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidExpiration, -1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, granterAddr, err := NewMsgGrantGeneric(tt.args, addrCodec)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, granter, granterAddr)
+			require.Equal(t, sdk.AccAddress(granter.Bytes()).String(), msg.Granter)
+			require.Equal(t, sdk.AccAddress(grantee.Bytes()).String(), msg.Grantee)
+			require.Equal(t, tt.wantExpiration, msg.Grant.Expiration)
+
+			authorization, err := msg.GetAuthorization()
+			require.NoError(t, err)
+			require.Equal(t, authz.NewGenericAuthorization(msgTypeURL), authorization)
+		})
+	}
+}
+
+func TestNewMsgGrantSend(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	receiver := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	spendLimit := []cmn.Coin{{Denom: "stake", Amount: big.NewInt(1000)}}
+
+	msg, _, err := NewMsgGrantSend([]interface{}{granter, grantee, spendLimit, []common.Address{receiver}, int64(0)}, addrCodec)
+	require.NoError(t, err)
+
+	authorization, err := msg.GetAuthorization()
+	require.NoError(t, err)
+	sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), sendAuthz.SpendLimit)
+	require.Equal(t, []string{sdk.AccAddress(receiver.Bytes()).String()}, sendAuthz.AllowList)
+
+	_, _, err = NewMsgGrantSend([]interface{}{granter, grantee, "invalid-coins", []common.Address{}, int64(0)}, addrCodec)
+	require.ErrorContains(t, err, "invalid spend limit")
+
+	_, _, err = NewMsgGrantSend([]interface{}{granter, grantee, spendLimit, "invalid-list", int64(0)}, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(ErrInvalidAllowList, "invalid-list"))
+}
+
+func TestNewMsgGrantStake(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	abi, err := LoadABI()
+	require.NoError(t, err)
+	method := abi.Methods[GrantStakeMethod]
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	validator := sdk.ValAddress(common.HexToAddress("0x1111111111111111111111111111111111111111").Bytes()).String()
+
+	maxTokens := cmn.Coin{Denom: "stake", Amount: big.NewInt(100)}
+	msg, _, err := NewMsgGrantStake(&method, []interface{}{
+		granter, grantee, uint8(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE),
+		maxTokens, []string{validator}, []string{}, int64(0),
+	}, addrCodec)
+	require.NoError(t, err)
+
+	authorization, err := msg.GetAuthorization()
+	require.NoError(t, err)
+	stakeAuthz, ok := authorization.(*stakingtypes.StakeAuthorization)
+	require.True(t, ok)
+	require.Equal(t, sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}), stakeAuthz.MsgTypeURL())
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), *stakeAuthz.MaxTokens)
+	require.Equal(t, []string{validator}, stakeAuthz.GetAllowList().Address)
+
+	// a zero amount means no limit
+	msg, _, err = NewMsgGrantStake(&method, []interface{}{
+		granter, grantee, uint8(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE),
+		cmn.Coin{Amount: big.NewInt(0)}, []string{}, []string{validator}, int64(0),
+	}, addrCodec)
+	require.NoError(t, err)
+	authorization, err = msg.GetAuthorization()
+	require.NoError(t, err)
+	require.Nil(t, authorization.(*stakingtypes.StakeAuthorization).MaxTokens)
+
+	_, _, err = NewMsgGrantStake(&method, []interface{}{
+		granter, grantee, uint8(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE),
+		maxTokens, []string{"invalid"}, []string{}, int64(0),
+	}, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidValidator, "invalid"))
+}
+
+func TestNewMsgRevoke(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	msg, granterAddr, err := NewMsgRevoke([]interface{}{granter, grantee, msgTypeURL}, addrCodec)
+	require.NoError(t, err)
+	require.Equal(t, granter, granterAddr)
+	require.Equal(t, msgTypeURL, msg.MsgTypeUrl)
+
+	_, _, err = NewMsgRevoke([]interface{}{granter, grantee}, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 2))
+
+	_, _, err = NewMsgRevoke([]interface{}{granter, grantee, ""}, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(ErrInvalidMsgTypeURL, ""))
+}
+
+func TestParseGranterGrantsArgs(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	abi, err := LoadABI()
+	require.NoError(t, err)
+	method := abi.Methods[GranterGrantsMethod]
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	pagination := query.PageRequest{Limit: 10, CountTotal: true}
+
+	req, err := ParseGranterGrantsArgs(&method, []interface{}{granter, pagination}, addrCodec)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(granter.Bytes()).String(), req.Granter)
+	require.Equal(t, uint64(10), req.Pagination.Limit)
+	require.True(t, req.Pagination.CountTotal)
+
+	_, err = ParseGranterGrantsArgs(&method, []interface{}{granter}, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1))
+}
@@ -1,6 +1,8 @@
 // SPDX-License-Identifier: LGPL-3.0-only
 pragma solidity >=0.8.18;
 
+import "../common/Types.sol";
+
 /// @dev The IBank contract's address.
 address constant IBANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
 
@@ -15,12 +17,46 @@ struct Balance {
     uint256 amount;
 }
 
+/// @dev Output specifies the receiver and the coins sent to it in a multiSend.
+struct Output {
+    /// to defines the address of the receiver.
+    address to;
+    /// amount defines the coins sent to the receiver.
+    Coin[] amount;
+}
+
 /**
  * @author Evmos Team
  * @title Bank Interface
- * @dev Interface for querying balances and supply from the Bank module.
+ * @dev Interface for querying balances and supply and sending coins through the Bank module.
  */
 interface IBank {
+    /// @dev Transfer defines an Event emitted when a coin is sent through send or multiSend.
+    /// @param from the address of the sender.
+    /// @param to the address of the receiver.
+    /// @param denom the denomination of the coin sent.
+    /// @param amount the amount sent, in the original decimals of the x/bank.
+    event Transfer(address indexed from, address indexed to, string denom, uint256 amount);
+
+    /// @dev send defines a method for sending native coins from the caller
+    /// to the receiver. The coins must be enabled for sending and the receiver
+    /// must not be a blocked address.
+    /// @param to the address of the receiver.
+    /// @param amount the coins to send, in the original decimals of the x/bank.
+    /// @return success true if the coins were sent.
+    function send(
+        address to,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev multiSend defines a method for sending native coins from the caller
+    /// to multiple receivers.
+    /// @param outputs the receivers and the coins sent to each of them.
+    /// @return success true if the coins were sent.
+    function multiSend(
+        Output[] calldata outputs
+    ) external returns (bool success);
+
     /// @dev balances defines a method for retrieving all the native token balances
     /// for a given account.
     /// @param account the address of the account to query balances for.
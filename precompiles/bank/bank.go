@@ -30,6 +30,9 @@ const (
 
 	// GasSupplyOf defines the gas cost for a single ERC-20 supplyOf query, taken from totalSupply of ERC20
 	GasSupplyOf = 2_477
+
+	// GasSend defines the gas cost for sending a single coin, taken from transfer of ERC20
+	GasSend = 9_000
 )
 
 var _ vm.PrecompiledContract = &Precompile{}
@@ -97,23 +100,33 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasTotalSupply
 	case SupplyOfMethod:
 		return GasSupplyOf
+	case SendMethod, MultiSendMethod:
+		return GasSend
 	}
 
 	return 0
 }
 
-// Run executes the precompiled contract bank query methods defined in the ABI.
+// Run executes the precompiled contract bank methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
-	ctx, _, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
 	if err != nil {
 		return nil, err
 	}
 
-	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// Start the balance change handler before executing the precompile.
+	p.GetBalanceHandler().BeforeBalanceChange(ctx)
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
 	switch method.Name {
+	// Bank transactions
+	case SendMethod:
+		bz, err = p.Send(ctx, contract, stateDB, method, args)
+	case MultiSendMethod:
+		bz, err = p.MultiSend(ctx, contract, stateDB, method, args)
 	// Bank queries
 	case BalancesMethod:
 		bz, err = p.Balances(ctx, contract, method, args)
@@ -135,11 +148,20 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		return nil, vm.ErrOutOfGas
 	}
 
+	// Process the native balance changes after the method execution.
+	if err = p.GetBalanceHandler().AfterBalanceChange(ctx, stateDB); err != nil {
+		return nil, err
+	}
+
 	return bz, nil
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
-// It returns false since all bank methods are queries.
-func (Precompile) IsTransaction(_ *abi.Method) bool {
-	return false
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case SendMethod, MultiSendMethod:
+		return true
+	default:
+		return false
+	}
 }
@@ -0,0 +1,12 @@
+package bank
+
+const (
+	// ErrInvalidReceiver is raised when the receiver address is not valid.
+	ErrInvalidReceiver = "invalid receiver address: %v"
+	// ErrInvalidCoins is raised when the coins to send are not valid.
+	ErrInvalidCoins = "invalid coins: %v"
+	// ErrInvalidOutputs is raised when the outputs of a multiSend are not valid.
+	ErrInvalidOutputs = "invalid outputs: %v"
+	// ErrBlockedAddress is raised when the receiver is not allowed to receive funds.
+	ErrBlockedAddress = "%s is not allowed to receive funds"
+)
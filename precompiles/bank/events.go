@@ -0,0 +1,58 @@
+package bank
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeTransfer defines the event type for the bank Send and MultiSend transactions.
+	EventTypeTransfer = "Transfer"
+)
+
+// EmitTransferEvent creates a new Transfer event for each of the coins sent
+// on a Send or MultiSend transaction.
+func (p Precompile) EmitTransferEvent(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, coins sdk.Coins) error {
+	event := p.Events[EventTypeTransfer]
+
+	// Prepare the event topics
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(from)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(to)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data: denom, amount
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	for _, coin := range coins {
+		packed, err := arguments.Pack(coin.Denom, coin.Amount.BigInt())
+		if err != nil {
+			return err
+		}
+
+		stateDB.AddLog(&ethtypes.Log{
+			Address:     p.Address(),
+			Topics:      topics,
+			Data:        packed,
+			BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+		})
+	}
+
+	return nil
+}
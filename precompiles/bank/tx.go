@@ -0,0 +1,110 @@
+package bank
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// SendMethod defines the ABI method name for the bank Send
+	// transaction.
+	SendMethod = "send"
+	// MultiSendMethod defines the ABI method name for the bank MultiSend
+	// transaction.
+	MultiSendMethod = "multiSend"
+)
+
+// Send transfers the given coins from the caller to the receiver address.
+// The amounts have the original decimals precision stored in the x/bank.
+// This method charges the caller the corresponding value of an ERC-20
+// transfer call for each coin sent.
+func (p Precompile) Send(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	to, coins, err := ParseSendArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("error calling send in bank precompile: %s", err)
+	}
+
+	// NOTE: we already charged for a single transfer so we only charge
+	// for the additional coins
+	for i := 1; i < len(coins); i++ {
+		ctx.GasMeter().ConsumeGas(GasSend, "bank extension send method")
+	}
+
+	if err := p.send(ctx, stateDB, contract.Caller(), to, coins); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// MultiSend transfers coins from the caller to each of the given outputs.
+// The amounts have the original decimals precision stored in the x/bank.
+// This method charges the caller the corresponding value of an ERC-20
+// transfer call for each coin sent.
+func (p Precompile) MultiSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	outputs, err := ParseMultiSendArgs(method, args)
+	if err != nil {
+		return nil, fmt.Errorf("error calling multiSend in bank precompile: %s", err)
+	}
+
+	// NOTE: we already charged for a single transfer so we only charge
+	// for the additional coins
+	transfers := 0
+	for _, output := range outputs {
+		transfers += len(output.Coins)
+	}
+	for i := 1; i < transfers; i++ {
+		ctx.GasMeter().ConsumeGas(GasSend, "bank extension multiSend method")
+	}
+
+	from := contract.Caller()
+	for _, output := range outputs {
+		if err := p.send(ctx, stateDB, from, output.To, output.Coins); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// send transfers the coins from the sender to the receiver after checking that
+// the coins can be sent and the receiver is allowed to receive funds, and emits
+// the Transfer events. The sender is always the caller of the precompile, so the
+// spendable balance is checked against msg.sender.
+func (p Precompile) send(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	from, to common.Address,
+	coins sdk.Coins,
+) error {
+	if err := p.bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+		return err
+	}
+
+	if p.bankKeeper.BlockedAddr(to.Bytes()) {
+		return fmt.Errorf(ErrBlockedAddress, to)
+	}
+
+	if err := p.bankKeeper.SendCoins(ctx, from.Bytes(), to.Bytes(), coins); err != nil {
+		return err
+	}
+
+	return p.EmitTransferEvent(ctx, stateDB, from, to, coins)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
@@ -17,6 +18,31 @@ type Balance struct {
 	Amount          *big.Int
 }
 
+// EventTransfer defines the event data for the bank Transfer event.
+type EventTransfer struct {
+	From   common.Address
+	To     common.Address
+	Denom  string
+	Amount *big.Int
+}
+
+// Output defines a receiver and the coins sent to it in a bank MultiSend transaction.
+type Output struct {
+	To     common.Address `abi:"to"`
+	Amount []cmn.Coin     `abi:"amount"`
+}
+
+// MultiSendInput defines the input for the bank MultiSend transaction.
+type MultiSendInput struct {
+	Outputs []Output `abi:"outputs"`
+}
+
+// SendOutput contains the receiver and the validated coins of a bank transfer.
+type SendOutput struct {
+	To    common.Address
+	Coins sdk.Coins
+}
+
 // ParseBalancesArgs parses the call arguments for the bank Balances query.
 func ParseBalancesArgs(args []interface{}) (sdk.AccAddress, error) {
 	if len(args) != 1 {
@@ -44,3 +70,78 @@ func ParseSupplyOfArgs(args []interface{}) (common.Address, error) {
 
 	return erc20Address, nil
 }
+
+// ParseSendArgs parses the call arguments for the bank Send transaction.
+func ParseSendArgs(args []interface{}) (common.Address, sdk.Coins, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	to, ok := args[0].(common.Address)
+	if !ok || to == (common.Address{}) {
+		return common.Address{}, nil, fmt.Errorf(ErrInvalidReceiver, args[0])
+	}
+
+	amount, err := cmn.ToCoins(args[1])
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf(ErrInvalidCoins, err)
+	}
+
+	coins, err := newSendCoins(amount)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	return to, coins, nil
+}
+
+// ParseMultiSendArgs parses the call arguments for the bank MultiSend transaction.
+func ParseMultiSendArgs(method *abi.Method, args []interface{}) ([]SendOutput, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input MultiSendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to MultiSendInput struct: %s", err)
+	}
+
+	if len(input.Outputs) == 0 {
+		return nil, fmt.Errorf(ErrInvalidOutputs, "no outputs provided")
+	}
+
+	outputs := make([]SendOutput, len(input.Outputs))
+	for i, output := range input.Outputs {
+		if output.To == (common.Address{}) {
+			return nil, fmt.Errorf(ErrInvalidReceiver, output.To)
+		}
+
+		coins, err := newSendCoins(output.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		outputs[i] = SendOutput{To: output.To, Coins: coins}
+	}
+
+	return outputs, nil
+}
+
+// newSendCoins converts the given coins to sdk.Coins and checks that they are a
+// non-empty set of positive coins without duplicated denominations.
+func newSendCoins(amount []cmn.Coin) (sdk.Coins, error) {
+	if len(amount) == 0 {
+		return nil, fmt.Errorf(ErrInvalidCoins, "no coins provided")
+	}
+
+	coins, err := cmn.NewSdkCoinsFromCoins(amount)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidCoins, err)
+	}
+
+	if err := coins.Validate(); err != nil {
+		return nil, fmt.Errorf(ErrInvalidCoins, err)
+	}
+
+	return coins, nil
+}
@@ -2,6 +2,23 @@
 pragma solidity >=0.8.18;
 
 interface ICallbacks {
+    /// @dev Callback function to be called on the source chain
+    /// after the packet is sent by the contract or a caller that set the contract as
+    /// the source callback. The contract address is passed the packet information to
+    /// approve, reject or record the packet. Any revert aborts the packet send.
+    /// It is only called if the contract returns true from the ERC-165
+    /// supportsInterface(bytes4) for type(ICallbacks).interfaceId.
+    /// @param channelId the channnel identifier of the packet
+    /// @param portId the port identifier of the packet
+    /// @param sequence the sequence number of the packet
+    /// @param data the data of the packet
+    function onPacketSend(
+        string memory channelId,
+        string memory portId,
+        uint64 sequence,
+        bytes memory data
+    ) external;
+
     /// @dev Callback function to be called on the source chain
     /// after the packet life cycle is completed and acknowledgement is processed
     /// by source chain. The contract address is passed the packet information and acknowledgmeent
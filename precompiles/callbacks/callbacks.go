@@ -31,7 +31,7 @@ var (
 
 // PrecompileMetaData contains all meta data concerning the Precompile contract.
 var PrecompileMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"channelId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"portId\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"acknowledgement\",\"type\":\"bytes\"}],\"name\":\"onPacketAcknowledgement\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"channelId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"portId\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"onPacketTimeout\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"channelId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"portId\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"acknowledgement\",\"type\":\"bytes\"}],\"name\":\"onPacketAcknowledgement\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"channelId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"portId\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"onPacketSend\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"channelId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"portId\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"onPacketTimeout\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 }
 
 // PrecompileABI is the input ABI used to generate the binding from.
@@ -201,6 +201,27 @@ func (_Precompile *PrecompileTransactorSession) OnPacketAcknowledgement(channelI
 	return _Precompile.Contract.OnPacketAcknowledgement(&_Precompile.TransactOpts, channelId, portId, sequence, data, acknowledgement)
 }
 
+// OnPacketSend is a paid mutator transaction binding the contract method 0x4043b9d2.
+//
+// Solidity: function onPacketSend(string channelId, string portId, uint64 sequence, bytes data) returns()
+func (_Precompile *PrecompileTransactor) OnPacketSend(opts *bind.TransactOpts, channelId string, portId string, sequence uint64, data []byte) (*types.Transaction, error) {
+	return _Precompile.contract.Transact(opts, "onPacketSend", channelId, portId, sequence, data)
+}
+
+// OnPacketSend is a paid mutator transaction binding the contract method 0x4043b9d2.
+//
+// Solidity: function onPacketSend(string channelId, string portId, uint64 sequence, bytes data) returns()
+func (_Precompile *PrecompileSession) OnPacketSend(channelId string, portId string, sequence uint64, data []byte) (*types.Transaction, error) {
+	return _Precompile.Contract.OnPacketSend(&_Precompile.TransactOpts, channelId, portId, sequence, data)
+}
+
+// OnPacketSend is a paid mutator transaction binding the contract method 0x4043b9d2.
+//
+// Solidity: function onPacketSend(string channelId, string portId, uint64 sequence, bytes data) returns()
+func (_Precompile *PrecompileTransactorSession) OnPacketSend(channelId string, portId string, sequence uint64, data []byte) (*types.Transaction, error) {
+	return _Precompile.Contract.OnPacketSend(&_Precompile.TransactOpts, channelId, portId, sequence, data)
+}
+
 // OnPacketTimeout is a paid mutator transaction binding the contract method 0x1f8ee603.
 //
 // Solidity: function onPacketTimeout(string channelId, string portId, uint64 sequence, bytes data) returns()
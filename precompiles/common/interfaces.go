@@ -11,4 +11,7 @@ type BankKeeper interface {
 	IterateTotalSupply(ctx context.Context, cb func(coin sdk.Coin) bool)
 	GetSupply(ctx context.Context, denom string) sdk.Coin
 	SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
+	BlockedAddr(addr sdk.AccAddress) bool
+	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 }
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IDispatch contract's address.
+address constant DISPATCH_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080b;
+
+/// @dev The IDispatch contract's instance.
+IDispatch constant DISPATCH_CONTRACT = IDispatch(DISPATCH_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title Dispatch Precompile Contract
+/// @dev The interface through which solidity contracts execute Cosmos SDK messages
+/// on behalf of the caller. Only the message types allowed by governance in the
+/// EVM module parameters can be executed.
+interface IDispatch {
+    /// @dev Dispatch defines an Event emitted when a Cosmos SDK message is executed.
+    /// @param sender the address of the caller that signed the message
+    /// @param typeUrl the type URL of the executed message
+    event Dispatch(address indexed sender, string typeUrl);
+
+    /// @dev dispatch executes a proto-encoded Cosmos SDK message with the signer set to the caller.
+    /// @param typeUrl the type URL of the message, e.g. /cosmos.bank.v1beta1.MsgSend
+    /// @param value the proto-encoded message
+    /// @return response the proto-encoded message response
+    function dispatch(
+        string memory typeUrl,
+        bytes memory value
+    ) external returns (bytes memory response);
+
+    /// @dev dispatchJSON executes a JSON-encoded Cosmos SDK message with the signer set to the caller.
+    /// @param typeUrl the type URL of the message, e.g. /cosmos.bank.v1beta1.MsgSend
+    /// @param value the JSON-encoded message, without the "@type" field
+    /// @return response the proto-encoded message response
+    function dispatchJSON(
+        string memory typeUrl,
+        string memory value
+    ) external returns (bytes memory response);
+
+    /// @dev allowedMessages returns the type URLs of the messages that can be dispatched.
+    /// @return typeUrls the allowed message type URLs
+    function allowedMessages() external view returns (string[] memory typeUrls);
+}
@@ -0,0 +1,16 @@
+package dispatch
+
+const (
+	// ErrInvalidMsgTypeURL is raised when the message type URL is not valid.
+	ErrInvalidMsgTypeURL = "invalid msg type url: %v"
+	// ErrInvalidMsgValue is raised when the encoded message is not valid.
+	ErrInvalidMsgValue = "invalid msg value: %v"
+	// ErrMsgNotAllowed is raised when the message type is not allowed to be dispatched.
+	ErrMsgNotAllowed = "msg type %s is not allowed to be dispatched"
+	// ErrUnsupportedSigner is raised when the signer of the message can't be set to the caller.
+	ErrUnsupportedSigner = "unsupported signer for msg type %s"
+	// ErrInvalidSigners is raised when the signers of the message are not the caller.
+	ErrInvalidSigners = "invalid msg signers, expected %s"
+	// ErrNoHandler is raised when no message handler is registered for the message type.
+	ErrNoHandler = "no handler registered for msg type %s"
+)
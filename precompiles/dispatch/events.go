@@ -0,0 +1,49 @@
+package dispatch
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeDispatch defines the event type for the dispatch transactions.
+	EventTypeDispatch = "Dispatch"
+)
+
+// EmitDispatchEvent creates a new event emitted on a Dispatch or DispatchJSON transaction.
+func (p Precompile) EmitDispatchEvent(ctx sdk.Context, stateDB vm.StateDB, sender common.Address, typeURL string) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeDispatch]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(sender)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(typeURL)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package dispatch
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// AllowedMessagesMethod defines the method name for the allowed messages precompile request.
+	AllowedMessagesMethod = "allowedMessages"
+)
+
+// AllowedMessages implements the query logic for getting the type URLs of the
+// messages that can be dispatched, as set by governance in the EVM module parameters.
+func (p *Precompile) AllowedMessages(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	typeURLs := p.evmKeeper.GetParams(ctx).AllowedDispatchMsgs
+	if typeURLs == nil {
+		typeURLs = []string{}
+	}
+
+	return method.Outputs.Pack(typeURLs)
+}
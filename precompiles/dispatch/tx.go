@@ -0,0 +1,146 @@
+package dispatch
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DispatchMethod defines the ABI method name to dispatch a proto-encoded Cosmos message.
+	DispatchMethod = "dispatch"
+	// DispatchJSONMethod defines the ABI method name to dispatch a JSON-encoded Cosmos message.
+	DispatchJSONMethod = "dispatchJSON"
+)
+
+// Dispatch executes a proto-encoded Cosmos message with the caller as signer.
+func (p *Precompile) Dispatch(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	typeURL, value, err := ParseDispatchArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkAllowed(ctx, typeURL); err != nil {
+		return nil, err
+	}
+
+	signer, err := p.addrCdc.BytesToString(contract.Caller().Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := NewMsg(p.codec, typeURL, value, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.dispatch(ctx, contract, stateDB, method, typeURL, msg)
+}
+
+// DispatchJSON executes a JSON-encoded Cosmos message with the caller as signer.
+func (p *Precompile) DispatchJSON(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	typeURL, value, err := ParseDispatchJSONArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkAllowed(ctx, typeURL); err != nil {
+		return nil, err
+	}
+
+	signer, err := p.addrCdc.BytesToString(contract.Caller().Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := NewMsgFromJSON(p.codec, typeURL, value, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.dispatch(ctx, contract, stateDB, method, typeURL, msg)
+}
+
+// checkAllowed returns an error if the message type is not in the governance
+// allow-list of the EVM module parameters.
+func (p *Precompile) checkAllowed(ctx sdk.Context, typeURL string) error {
+	if !p.evmKeeper.GetParams(ctx).IsDispatchMsgAllowed(typeURL) {
+		return fmt.Errorf(ErrMsgNotAllowed, typeURL)
+	}
+
+	return nil
+}
+
+// dispatch checks that the caller is the only signer of the message and executes
+// it through the message router. The events of the message are emitted on the
+// current context so that the native balance changes are reconciled with the stateDB.
+func (p *Precompile) dispatch(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	typeURL string,
+	msg sdk.Msg,
+) ([]byte, error) {
+	signers, _, err := p.codec.GetMsgV1Signers(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	caller := contract.Caller()
+	for _, signer := range signers {
+		if !bytes.Equal(signer, caller.Bytes()) {
+			return nil, fmt.Errorf(ErrInvalidSigners, caller.String())
+		}
+	}
+
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	handler := p.router.Handler(msg)
+	if handler == nil {
+		return nil, fmt.Errorf(ErrNoHandler, typeURL)
+	}
+
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// emit the events of the dispatched message
+	events := make(sdk.Events, 0, len(res.Events))
+	for _, event := range res.Events {
+		events = append(events, sdk.Event(event))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	var response []byte
+	if len(res.MsgResponses) > 0 {
+		response = res.MsgResponses[0].Value
+	}
+
+	if err = p.EmitDispatchEvent(ctx, stateDB, caller, typeURL); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(response)
+}
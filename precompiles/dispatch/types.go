@@ -0,0 +1,167 @@
+package dispatch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EventDispatch defines the event data for the Dispatch transaction.
+type EventDispatch struct {
+	Sender  common.Address
+	TypeUrl string //nolint:revive,stylecheck // follows the ABI naming
+}
+
+// ParseDispatchArgs parses the arguments of the dispatch method into the
+// message type URL and the proto-encoded message.
+func ParseDispatchArgs(args []interface{}) (string, []byte, error) {
+	if len(args) != 2 {
+		return "", nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	typeURL, err := parseTypeURL(args[0])
+	if err != nil {
+		return "", nil, err
+	}
+
+	value, ok := args[1].([]byte)
+	if !ok {
+		return "", nil, fmt.Errorf(ErrInvalidMsgValue, args[1])
+	}
+
+	return typeURL, value, nil
+}
+
+// ParseDispatchJSONArgs parses the arguments of the dispatchJSON method into the
+// message type URL and the JSON-encoded message.
+func ParseDispatchJSONArgs(args []interface{}) (string, string, error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	typeURL, err := parseTypeURL(args[0])
+	if err != nil {
+		return "", "", err
+	}
+
+	value, ok := args[1].(string)
+	if !ok || value == "" {
+		return "", "", fmt.Errorf(ErrInvalidMsgValue, args[1])
+	}
+
+	return typeURL, value, nil
+}
+
+// NewMsg decodes the proto-encoded message of the given type URL, with all of
+// its signer fields set to the given signer address.
+func NewMsg(cdc codec.Codec, typeURL string, value []byte, signer string) (sdk.Msg, error) {
+	msg, err := resolveMsg(cdc, typeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := setSigners(cdc, typeURL, value, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cdc.Unmarshal(bz, msg); err != nil {
+		return nil, fmt.Errorf(ErrInvalidMsgValue, err)
+	}
+
+	return msg, nil
+}
+
+// NewMsgFromJSON decodes the JSON-encoded message of the given type URL, with all
+// of its signer fields set to the given signer address.
+func NewMsgFromJSON(cdc codec.Codec, typeURL string, value string, signer string) (sdk.Msg, error) {
+	msg, err := resolveMsg(cdc, typeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cdc.UnmarshalJSON([]byte(value), msg); err != nil {
+		return nil, fmt.Errorf(ErrInvalidMsgValue, err)
+	}
+
+	bz, err := cdc.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMsg(cdc, typeURL, bz, signer)
+}
+
+// parseTypeURL parses the message type URL argument.
+func parseTypeURL(arg interface{}) (string, error) {
+	typeURL, ok := arg.(string)
+	if !ok || !strings.HasPrefix(typeURL, "/") {
+		return "", fmt.Errorf(ErrInvalidMsgTypeURL, arg)
+	}
+
+	return typeURL, nil
+}
+
+// resolveMsg returns a new empty message of the given type URL.
+func resolveMsg(cdc codec.Codec, typeURL string) (sdk.Msg, error) {
+	resolved, err := cdc.InterfaceRegistry().Resolve(typeURL)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidMsgTypeURL, typeURL)
+	}
+
+	msg, ok := resolved.(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidMsgTypeURL, typeURL)
+	}
+
+	return msg, nil
+}
+
+// setSigners overwrites the signer fields of the proto-encoded message, as defined by
+// the cosmos.msg.v1.signer option, with the given signer address.
+//
+// NOTE: only top-level string signer fields are supported.
+func setSigners(cdc codec.Codec, typeURL string, value []byte, signer string) ([]byte, error) {
+	name := protoreflect.FullName(strings.TrimPrefix(typeURL, "/"))
+	desc, err := cdc.InterfaceRegistry().FindDescriptorByName(name)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidMsgTypeURL, typeURL)
+	}
+
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidMsgTypeURL, typeURL)
+	}
+
+	signerFields, ok := protov2.GetExtension(msgDesc.Options(), msgv1.E_Signer).([]string)
+	if !ok || len(signerFields) == 0 {
+		return nil, fmt.Errorf(ErrUnsupportedSigner, typeURL)
+	}
+
+	msg := dynamicpb.NewMessage(msgDesc)
+	if err := protov2.Unmarshal(value, msg); err != nil {
+		return nil, fmt.Errorf(ErrInvalidMsgValue, err)
+	}
+
+	for _, fieldName := range signerFields {
+		field := msgDesc.Fields().ByName(protoreflect.Name(fieldName))
+		if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+			return nil, fmt.Errorf(ErrUnsupportedSigner, typeURL)
+		}
+
+		msg.Set(field, protoreflect.ValueOfString(signer))
+	}
+
+	return protov2.MarshalOptions{Deterministic: true}.Marshal(msg)
+}
@@ -0,0 +1,117 @@
+package dispatch
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestParseDispatchArgs(t *testing.T) {
+	typeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{typeURL, []byte{0x1}},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			name:    "type URL without leading slash",
+			args:    []interface{}{"cosmos.bank.v1beta1.MsgSend", []byte{0x1}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidMsgTypeURL, "cosmos.bank.v1beta1.MsgSend"),
+		},
+		{
+			name:    "invalid value type",
+			args:    []interface{}{typeURL, "value"},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidMsgValue, "value"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotTypeURL, value, err := ParseDispatchArgs(tt.args)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, typeURL, gotTypeURL)
+			require.Equal(t, []byte{0x1}, value)
+		})
+	}
+
+	_, _, err := ParseDispatchJSONArgs([]interface{}{typeURL, ""})
+	require.ErrorContains(t, err, fmt.Sprintf(ErrInvalidMsgValue, ""))
+}
+
+func TestNewMsg(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	caller := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	other := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	callerBech32, err := addrCodec.BytesToString(caller.Bytes())
+	require.NoError(t, err)
+	otherBech32, err := addrCodec.BytesToString(other.Bytes())
+	require.NoError(t, err)
+
+	typeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	send := &banktypes.MsgSend{
+		FromAddress: otherBech32,
+		ToAddress:   otherBech32,
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+	}
+
+	// the signer is overwritten with the caller
+	bz, err := cdc.Marshal(send)
+	require.NoError(t, err)
+	msg, err := NewMsg(cdc, typeURL, bz, callerBech32)
+	require.NoError(t, err)
+	sendMsg, ok := msg.(*banktypes.MsgSend)
+	require.True(t, ok)
+	require.Equal(t, callerBech32, sendMsg.FromAddress)
+	require.Equal(t, otherBech32, sendMsg.ToAddress)
+	require.Equal(t, send.Amount, sendMsg.Amount)
+
+	// the signer is set when missing from the JSON message
+	msg, err = NewMsgFromJSON(cdc, typeURL, fmt.Sprintf(`{"to_address":%q,"amount":[{"denom":"stake","amount":"1"}]}`, otherBech32), callerBech32)
+	require.NoError(t, err)
+	sendMsg, ok = msg.(*banktypes.MsgSend)
+	require.True(t, ok)
+	require.Equal(t, callerBech32, sendMsg.FromAddress)
+	require.Equal(t, otherBech32, sendMsg.ToAddress)
+
+	_, err = NewMsg(cdc, "/cosmos.bank.v1beta1.MsgUnknown", bz, callerBech32)
+	require.ErrorContains(t, err, fmt.Sprintf(ErrInvalidMsgTypeURL, "/cosmos.bank.v1beta1.MsgUnknown"))
+
+	_, err = NewMsg(cdc, typeURL, []byte{0xff}, callerBech32)
+	require.ErrorContains(t, err, "invalid msg value")
+
+	_, err = NewMsgFromJSON(cdc, typeURL, "{", callerBech32)
+	require.ErrorContains(t, err, "invalid msg value")
+}
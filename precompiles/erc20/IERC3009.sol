@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/**
+ * @author Evmos Team
+ * @title ERC-3009 Interface
+ * @dev Interface for transfers authorized by signatures, as defined in
+ * https://eips.ethereum.org/EIPS/eip-3009[EIP-3009]. Authorizations are identified
+ * by a random 32-byte nonce chosen by the authorizer instead of a sequential nonce.
+ */
+interface IERC3009 {
+    /// @dev Emitted when an authorization is used.
+    /// @param authorizer The address of the authorizer.
+    /// @param nonce The nonce of the used authorization.
+    event AuthorizationUsed(address indexed authorizer, bytes32 indexed nonce);
+
+    /// @dev Emitted when an authorization is canceled.
+    /// @param authorizer The address of the authorizer.
+    /// @param nonce The nonce of the canceled authorization.
+    event AuthorizationCanceled(address indexed authorizer, bytes32 indexed nonce);
+
+    /// @dev Returns the state of an authorization.
+    /// @param authorizer The address of the authorizer.
+    /// @param nonce The nonce of the authorization.
+    /// @return True if the nonce is used or canceled.
+    function authorizationState(
+        address authorizer,
+        bytes32 nonce
+    ) external view returns (bool);
+
+    /// @dev Executes a transfer with a signed authorization.
+    /// @param from The payer's address (the authorizer).
+    /// @param to The payee's address.
+    /// @param value The amount to be transferred.
+    /// @param validAfter The time after which this is valid (unix time).
+    /// @param validBefore The time before which this is valid (unix time).
+    /// @param nonce Unique nonce.
+    /// @param v The v of the signature.
+    /// @param r The r of the signature.
+    /// @param s The s of the signature.
+    function transferWithAuthorization(
+        address from,
+        address to,
+        uint256 value,
+        uint256 validAfter,
+        uint256 validBefore,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /// @dev Receives a transfer with a signed authorization from the payer.
+    /// This has an additional check to ensure that the payee's address matches
+    /// the caller of this function to prevent front-running attacks.
+    /// @param from The payer's address (the authorizer).
+    /// @param to The payee's address.
+    /// @param value The amount to be transferred.
+    /// @param validAfter The time after which this is valid (unix time).
+    /// @param validBefore The time before which this is valid (unix time).
+    /// @param nonce Unique nonce.
+    /// @param v The v of the signature.
+    /// @param r The r of the signature.
+    /// @param s The s of the signature.
+    function receiveWithAuthorization(
+        address from,
+        address to,
+        uint256 value,
+        uint256 validAfter,
+        uint256 validBefore,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /// @dev Attempts to cancel an authorization.
+    /// @param authorizer The authorizer's address.
+    /// @param nonce The nonce of the authorization.
+    /// @param v The v of the signature.
+    /// @param r The r of the signature.
+    /// @param s The s of the signature.
+    function cancelAuthorization(
+        address authorizer,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+}
@@ -40,6 +40,17 @@ const (
 	GasTotalSupply       = 2_480
 	GasBalanceOf         = 2_870
 	GasAllowance         = 3_225
+
+	// GasEcrecover is the gas charged for each signature verification of the EIP-2612
+	// and EIP-3009 methods, matching the cost of the ecrecover precompile.
+	GasEcrecover                 = 3_000
+	GasPermit                    = GasApprove + GasEcrecover
+	GasTransferWithAuthorization = GasTransfer + GasEcrecover
+	GasReceiveWithAuthorization  = GasTransfer + GasEcrecover
+	GasCancelAuthorization       = 2_000 + GasEcrecover
+	GasNonces                    = 2_500
+	GasDomainSeparator           = GasName
+	GasAuthorizationState        = 2_500
 )
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
@@ -116,6 +127,15 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasIncreaseAllowance
 	case DecreaseAllowanceMethod:
 		return GasDecreaseAllowance
+	// EIP-2612 and EIP-3009 transactions
+	case PermitMethod:
+		return GasPermit
+	case TransferWithAuthorizationMethod:
+		return GasTransferWithAuthorization
+	case ReceiveWithAuthorizationMethod:
+		return GasReceiveWithAuthorization
+	case CancelAuthorizationMethod:
+		return GasCancelAuthorization
 	// ERC-20 queries
 	case NameMethod:
 		return GasName
@@ -129,6 +149,13 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasBalanceOf
 	case AllowanceMethod:
 		return GasAllowance
+	// EIP-2612 and EIP-3009 queries
+	case NoncesMethod:
+		return GasNonces
+	case DomainSeparatorMethod:
+		return GasDomainSeparator
+	case AuthorizationStateMethod:
+		return GasAuthorizationState
 	default:
 		return 0
 	}
@@ -192,7 +219,11 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 		TransferFromMethod,
 		ApproveMethod,
 		IncreaseAllowanceMethod,
-		DecreaseAllowanceMethod:
+		DecreaseAllowanceMethod,
+		PermitMethod,
+		TransferWithAuthorizationMethod,
+		ReceiveWithAuthorizationMethod,
+		CancelAuthorizationMethod:
 		return true
 	default:
 		return false
@@ -219,6 +250,15 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.IncreaseAllowance(ctx, contract, stateDB, method, args)
 	case DecreaseAllowanceMethod:
 		bz, err = p.DecreaseAllowance(ctx, contract, stateDB, method, args)
+	// EIP-2612 and EIP-3009 transactions
+	case PermitMethod:
+		bz, err = p.Permit(ctx, contract, stateDB, method, args)
+	case TransferWithAuthorizationMethod:
+		bz, err = p.TransferWithAuthorization(ctx, contract, stateDB, method, args)
+	case ReceiveWithAuthorizationMethod:
+		bz, err = p.ReceiveWithAuthorization(ctx, contract, stateDB, method, args)
+	case CancelAuthorizationMethod:
+		bz, err = p.CancelAuthorization(ctx, contract, stateDB, method, args)
 	// ERC-20 queries
 	case NameMethod:
 		bz, err = p.Name(ctx, contract, stateDB, method, args)
@@ -232,6 +272,13 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
 	case AllowanceMethod:
 		bz, err = p.Allowance(ctx, contract, stateDB, method, args)
+	// EIP-2612 and EIP-3009 queries
+	case NoncesMethod:
+		bz, err = p.Nonces(ctx, contract, stateDB, method, args)
+	case DomainSeparatorMethod:
+		bz, err = p.DomainSeparator(ctx, contract, stateDB, method, args)
+	case AuthorizationStateMethod:
+		bz, err = p.AuthorizationState(ctx, contract, stateDB, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -26,6 +26,18 @@ var (
 	ErrIncreaseNonPositiveValue = errors.New("cannot increase allowance with non-positive values")
 	ErrNegativeAmount           = errors.New("cannot approve negative values")
 	ErrSpenderIsOwner           = errors.New("spender cannot be the owner")
+	ErrInvalidSignature         = errors.New("invalid signature")
+
+	// EIP-2612 errors
+	ErrPermitExpired          = errors.New("ERC20Permit: expired deadline")
+	ErrPermitInvalidSignature = errors.New("ERC20Permit: invalid signature")
+
+	// EIP-3009 errors
+	ErrAuthorizationNotYetValid      = errors.New("EIP3009: authorization is not yet valid")
+	ErrAuthorizationExpired          = errors.New("EIP3009: authorization is expired")
+	ErrAuthorizationUsed             = errors.New("EIP3009: authorization is used or canceled")
+	ErrAuthorizationInvalidSignature = errors.New("EIP3009: invalid signature")
+	ErrCallerNotPayee                = errors.New("EIP3009: caller must be the payee")
 
 	// ERC20 errors
 	ErrDecreasedAllowanceBelowZero  = errors.New("ERC20: decreased allowance below zero")
@@ -19,6 +19,12 @@ const (
 
 	// EventTypeApproval defines the event type for the ERC-20 Approval event.
 	EventTypeApproval = "Approval"
+
+	// EventTypeAuthorizationUsed defines the event type for the EIP-3009 AuthorizationUsed event.
+	EventTypeAuthorizationUsed = "AuthorizationUsed"
+
+	// EventTypeAuthorizationCanceled defines the event type for the EIP-3009 AuthorizationCanceled event.
+	EventTypeAuthorizationCanceled = "AuthorizationCanceled"
 )
 
 // EmitTransferEvent creates a new Transfer event emitted on transfer and transferFrom transactions.
@@ -93,3 +99,35 @@ func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, owner
 
 	return nil
 }
+
+// EmitAuthorizationEvent creates a new AuthorizationUsed or AuthorizationCanceled event
+// emitted on TransferWithAuthorization, ReceiveWithAuthorization and CancelAuthorization
+// transactions.
+func (p Precompile) EmitAuthorizationEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, authorizer common.Address, nonce common.Hash) error {
+	// Prepare the event topics
+	event := p.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(authorizer)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(nonce)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        nil,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // block height won't exceed uint64
+	})
+
+	return nil
+}
@@ -13,4 +13,8 @@ type Erc20Keeper interface {
 	GetAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address) (*big.Int, error)
 	SetAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address, value *big.Int) error
 	DeleteAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address) error
+	GetNonce(ctx sdk.Context, erc20 common.Address, owner common.Address) uint64
+	UseNonce(ctx sdk.Context, erc20 common.Address, owner common.Address) uint64
+	IsAuthorizationUsed(ctx sdk.Context, erc20 common.Address, authorizer common.Address, nonce common.Hash) bool
+	SetAuthorizationUsed(ctx sdk.Context, erc20 common.Address, authorizer common.Address, nonce common.Hash)
 }
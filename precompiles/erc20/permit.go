@@ -0,0 +1,338 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PermitMethod defines the ABI method name for the EIP-2612 permit
+	// transaction.
+	PermitMethod = "permit"
+	// TransferWithAuthorizationMethod defines the ABI method name for the EIP-3009
+	// transferWithAuthorization transaction.
+	TransferWithAuthorizationMethod = "transferWithAuthorization"
+	// ReceiveWithAuthorizationMethod defines the ABI method name for the EIP-3009
+	// receiveWithAuthorization transaction.
+	ReceiveWithAuthorizationMethod = "receiveWithAuthorization"
+	// CancelAuthorizationMethod defines the ABI method name for the EIP-3009
+	// cancelAuthorization transaction.
+	CancelAuthorizationMethod = "cancelAuthorization"
+	// NoncesMethod defines the ABI method name for the EIP-2612 nonces
+	// query.
+	NoncesMethod = "nonces"
+	// DomainSeparatorMethod defines the ABI method name for the EIP-2612
+	// DOMAIN_SEPARATOR query.
+	DomainSeparatorMethod = "DOMAIN_SEPARATOR"
+	// AuthorizationStateMethod defines the ABI method name for the EIP-3009
+	// authorizationState query.
+	AuthorizationStateMethod = "authorizationState"
+
+	// DomainVersion defines the version of the EIP-712 signing domain.
+	DomainVersion = "1"
+)
+
+var (
+	// DomainTypeHash is the EIP-712 type hash of the signing domain.
+	DomainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	// PermitTypeHash is the EIP-712 type hash of the EIP-2612 Permit message.
+	PermitTypeHash = crypto.Keccak256Hash([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+	// TransferWithAuthorizationTypeHash is the EIP-712 type hash of the EIP-3009
+	// TransferWithAuthorization message.
+	TransferWithAuthorizationTypeHash = crypto.Keccak256Hash([]byte("TransferWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)"))
+	// ReceiveWithAuthorizationTypeHash is the EIP-712 type hash of the EIP-3009
+	// ReceiveWithAuthorization message.
+	ReceiveWithAuthorizationTypeHash = crypto.Keccak256Hash([]byte("ReceiveWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)"))
+	// CancelAuthorizationTypeHash is the EIP-712 type hash of the EIP-3009
+	// CancelAuthorization message.
+	CancelAuthorizationTypeHash = crypto.Keccak256Hash([]byte("CancelAuthorization(address authorizer,bytes32 nonce)"))
+)
+
+// Permit sets the given value as the allowance of the spender over the owner's
+// tokens, given the owner's EIP-712 signed approval. It consumes the current
+// nonce of the owner and emits the Approval event on success.
+func (p Precompile) Permit(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	input, err := ParsePermitArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if big.NewInt(ctx.BlockTime().Unix()).Cmp(input.Deadline) > 0 {
+		return nil, ErrPermitExpired
+	}
+
+	nonce := p.erc20Keeper.UseNonce(ctx, p.Address(), input.Owner)
+	structHash := crypto.Keccak256Hash(
+		PermitTypeHash.Bytes(),
+		common.LeftPadBytes(input.Owner.Bytes(), 32),
+		common.LeftPadBytes(input.Spender.Bytes(), 32),
+		common.BigToHash(input.Value).Bytes(),
+		common.BigToHash(new(big.Int).SetUint64(nonce)).Bytes(),
+		common.BigToHash(input.Deadline).Bytes(),
+	)
+
+	if err := p.verifySignature(ctx, structHash, input.Owner, input.V, input.R, input.S); err != nil {
+		return nil, ErrPermitInvalidSignature
+	}
+
+	if input.Value.Sign() == 0 {
+		err = p.erc20Keeper.DeleteAllowance(ctx, p.Address(), input.Owner, input.Spender)
+	} else {
+		err = p.setAllowance(ctx, input.Owner, input.Spender, input.Value)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, input.Owner, input.Spender, input.Value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// TransferWithAuthorization executes a transfer of tokens from the authorizer to
+// the payee, given the authorizer's EIP-712 signed authorization. It emits the
+// AuthorizationUsed and Transfer events on success.
+func (p *Precompile) TransferWithAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.transferWithAuthorization(ctx, contract, stateDB, method, args, TransferWithAuthorizationTypeHash)
+}
+
+// ReceiveWithAuthorization executes a transfer of tokens from the authorizer to
+// the caller, given the authorizer's EIP-712 signed authorization. Unlike
+// TransferWithAuthorization, the payee must be the caller to prevent front-running
+// of the authorization. It emits the AuthorizationUsed and Transfer events on success.
+func (p *Precompile) ReceiveWithAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	return p.transferWithAuthorization(ctx, contract, stateDB, method, args, ReceiveWithAuthorizationTypeHash)
+}
+
+// CancelAuthorization cancels an unused authorization, given the authorizer's
+// EIP-712 signed cancellation. It emits the AuthorizationCanceled event on success.
+func (p Precompile) CancelAuthorization(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	input, err := ParseCancelAuthorizationArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := common.Hash(input.Nonce)
+	if p.erc20Keeper.IsAuthorizationUsed(ctx, p.Address(), input.Authorizer, nonce) {
+		return nil, ErrAuthorizationUsed
+	}
+
+	structHash := crypto.Keccak256Hash(
+		CancelAuthorizationTypeHash.Bytes(),
+		common.LeftPadBytes(input.Authorizer.Bytes(), 32),
+		nonce.Bytes(),
+	)
+
+	if err := p.verifySignature(ctx, structHash, input.Authorizer, input.V, input.R, input.S); err != nil {
+		return nil, ErrAuthorizationInvalidSignature
+	}
+
+	p.erc20Keeper.SetAuthorizationUsed(ctx, p.Address(), input.Authorizer, nonce)
+
+	if err := p.EmitAuthorizationEvent(ctx, stateDB, EventTypeAuthorizationCanceled, input.Authorizer, nonce); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Nonces returns the current EIP-2612 permit nonce of the given owner.
+func (p Precompile) Nonces(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseNoncesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.erc20Keeper.GetNonce(ctx, p.Address(), owner)
+
+	return method.Outputs.Pack(new(big.Int).SetUint64(nonce))
+}
+
+// DomainSeparator returns the EIP-712 domain separator used to sign permits and
+// authorizations for the token.
+func (p Precompile) DomainSeparator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	return method.Outputs.Pack(p.domainSeparator(ctx))
+}
+
+// AuthorizationState returns true if the EIP-3009 authorization with the given
+// nonce of the authorizer was used or canceled.
+func (p Precompile) AuthorizationState(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authorizer, nonce, err := ParseAuthorizationStateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	used := p.erc20Keeper.IsAuthorizationUsed(ctx, p.Address(), authorizer, nonce)
+
+	return method.Outputs.Pack(used)
+}
+
+// transferWithAuthorization is a common function that handles the EIP-3009
+// TransferWithAuthorization and ReceiveWithAuthorization methods. It checks the
+// validity window and the signature of the authorization, marks it as used and
+// transfers the tokens.
+func (p *Precompile) transferWithAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+	typeHash common.Hash,
+) ([]byte, error) {
+	input, err := ParseAuthorizationArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if typeHash == ReceiveWithAuthorizationTypeHash && contract.Caller() != input.To {
+		return nil, ErrCallerNotPayee
+	}
+
+	now := big.NewInt(ctx.BlockTime().Unix())
+	if now.Cmp(input.ValidAfter) <= 0 {
+		return nil, ErrAuthorizationNotYetValid
+	}
+	if now.Cmp(input.ValidBefore) >= 0 {
+		return nil, ErrAuthorizationExpired
+	}
+
+	nonce := common.Hash(input.Nonce)
+	if p.erc20Keeper.IsAuthorizationUsed(ctx, p.Address(), input.From, nonce) {
+		return nil, ErrAuthorizationUsed
+	}
+
+	structHash := crypto.Keccak256Hash(
+		typeHash.Bytes(),
+		common.LeftPadBytes(input.From.Bytes(), 32),
+		common.LeftPadBytes(input.To.Bytes(), 32),
+		common.BigToHash(input.Value).Bytes(),
+		common.BigToHash(input.ValidAfter).Bytes(),
+		common.BigToHash(input.ValidBefore).Bytes(),
+		nonce.Bytes(),
+	)
+
+	if err := p.verifySignature(ctx, structHash, input.From, input.V, input.R, input.S); err != nil {
+		return nil, ErrAuthorizationInvalidSignature
+	}
+
+	p.erc20Keeper.SetAuthorizationUsed(ctx, p.Address(), input.From, nonce)
+
+	if err := p.EmitAuthorizationEvent(ctx, stateDB, EventTypeAuthorizationUsed, input.From, nonce); err != nil {
+		return nil, err
+	}
+
+	if err := p.send(ctx, stateDB, input.From, input.To, input.Value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// domainSeparator returns the EIP-712 domain separator of the token. The domain
+// name is the token name and falls back to the token pair denomination when no
+// name can be derived, the chain ID is the EVM chain ID and the verifying contract
+// is the precompile address.
+func (p Precompile) domainSeparator(ctx sdk.Context) common.Hash {
+	name, err := p.name(ctx)
+	if err != nil {
+		name = p.tokenPair.Denom
+	}
+
+	return crypto.Keccak256Hash(
+		DomainTypeHash.Bytes(),
+		crypto.Keccak256([]byte(name)),
+		crypto.Keccak256([]byte(DomainVersion)),
+		common.BigToHash(evmtypes.GetEthChainConfig().ChainID).Bytes(),
+		common.LeftPadBytes(p.Address().Bytes(), 32),
+	)
+}
+
+// verifySignature checks that the given signature over the EIP-712 typed data
+// hash of the struct hash was produced by the signer.
+func (p Precompile) verifySignature(
+	ctx sdk.Context,
+	structHash common.Hash,
+	signer common.Address,
+	v uint8,
+	r, s [32]byte,
+) error {
+	if v < 27 {
+		return ErrInvalidSignature
+	}
+
+	recoveryID := v - 27
+	if !crypto.ValidateSignatureValues(recoveryID, new(big.Int).SetBytes(r[:]), new(big.Int).SetBytes(s[:]), true) {
+		return ErrInvalidSignature
+	}
+
+	domainSeparator := p.domainSeparator(ctx)
+	digest := crypto.Keccak256([]byte("\x19\x01"), domainSeparator.Bytes(), structHash.Bytes())
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig[:32], r[:])
+	copy(sig[32:64], s[:])
+	sig[64] = recoveryID
+
+	pubKey, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		return err
+	}
+
+	if crypto.PubkeyToAddress(*pubKey) != signer {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
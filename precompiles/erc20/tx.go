@@ -78,14 +78,6 @@ func (p *Precompile) transfer(
 	from, to common.Address,
 	amount *big.Int,
 ) (data []byte, err error) {
-	coins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: math.NewIntFromBigInt(amount)}}
-
-	msg := banktypes.NewMsgSend(from.Bytes(), to.Bytes(), coins)
-
-	if err = msg.Amount.Validate(); err != nil {
-		return nil, err
-	}
-
 	isTransferFrom := method.Name == TransferFromMethod
 	spenderAddr := contract.Caller()
 	newAllowance := big.NewInt(0)
@@ -115,13 +107,7 @@ func (p *Precompile) transfer(
 		}
 	}
 
-	msgSrv := bankkeeper.NewMsgServerImpl(p.BankKeeper)
-	if _, err = msgSrv.Send(ctx, msg); err != nil {
-		// This should return an error to avoid the contract from being executed and an event being emitted
-		return nil, ConvertErrToERC20Error(err)
-	}
-
-	if err = p.EmitTransferEvent(ctx, stateDB, from, to, amount); err != nil {
+	if err = p.send(ctx, stateDB, from, to, amount); err != nil {
 		return nil, err
 	}
 
@@ -135,3 +121,28 @@ func (p *Precompile) transfer(
 
 	return method.Outputs.Pack(true)
 }
+
+// send executes a bank Send message of the given amount of tokens from the from
+// address to the destination address and emits the Transfer event.
+func (p *Precompile) send(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	from, to common.Address,
+	amount *big.Int,
+) error {
+	coins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: math.NewIntFromBigInt(amount)}}
+
+	msg := banktypes.NewMsgSend(from.Bytes(), to.Bytes(), coins)
+
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.BankKeeper)
+	if _, err := msgSrv.Send(ctx, msg); err != nil {
+		// This should return an error to avoid the contract from being executed and an event being emitted
+		return ConvertErrToERC20Error(err)
+	}
+
+	return p.EmitTransferEvent(ctx, stateDB, from, to, amount)
+}
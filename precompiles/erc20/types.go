@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -21,6 +22,48 @@ type EventApproval struct {
 	Value   *big.Int
 }
 
+// EventAuthorization defines the event data for the EIP-3009 AuthorizationUsed
+// and AuthorizationCanceled events.
+type EventAuthorization struct {
+	Authorizer common.Address
+	Nonce      [32]byte
+}
+
+// PermitInput defines the input arguments of the EIP-2612 permit method.
+type PermitInput struct {
+	Owner    common.Address `abi:"owner"`
+	Spender  common.Address `abi:"spender"`
+	Value    *big.Int       `abi:"value"`
+	Deadline *big.Int       `abi:"deadline"`
+	V        uint8          `abi:"v"`
+	R        [32]byte       `abi:"r"`
+	S        [32]byte       `abi:"s"`
+}
+
+// AuthorizationInput defines the input arguments of the EIP-3009
+// transferWithAuthorization and receiveWithAuthorization methods.
+type AuthorizationInput struct {
+	From        common.Address `abi:"from"`
+	To          common.Address `abi:"to"`
+	Value       *big.Int       `abi:"value"`
+	ValidAfter  *big.Int       `abi:"validAfter"`
+	ValidBefore *big.Int       `abi:"validBefore"`
+	Nonce       [32]byte       `abi:"nonce"`
+	V           uint8          `abi:"v"`
+	R           [32]byte       `abi:"r"`
+	S           [32]byte       `abi:"s"`
+}
+
+// CancelAuthorizationInput defines the input arguments of the EIP-3009
+// cancelAuthorization method.
+type CancelAuthorizationInput struct {
+	Authorizer common.Address `abi:"authorizer"`
+	Nonce      [32]byte       `abi:"nonce"`
+	V          uint8          `abi:"v"`
+	R          [32]byte       `abi:"r"`
+	S          [32]byte       `abi:"s"`
+}
+
 // ParseTransferArgs parses the arguments from the transfer method and returns
 // the destination address (to) and amount.
 func ParseTransferArgs(args []interface{}) (
@@ -127,3 +170,80 @@ func ParseBalanceOfArgs(args []interface{}) (common.Address, error) {
 
 	return account, nil
 }
+
+// ParsePermitArgs parses the arguments of the permit method.
+func ParsePermitArgs(method *abi.Method, args []interface{}) (*PermitInput, error) {
+	if len(args) != 7 {
+		return nil, fmt.Errorf("invalid number of arguments; expected 7; got: %d", len(args))
+	}
+
+	var input PermitInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to PermitInput struct: %s", err)
+	}
+
+	return &input, nil
+}
+
+// ParseAuthorizationArgs parses the arguments of the transferWithAuthorization and
+// receiveWithAuthorization methods.
+func ParseAuthorizationArgs(method *abi.Method, args []interface{}) (*AuthorizationInput, error) {
+	if len(args) != 9 {
+		return nil, fmt.Errorf("invalid number of arguments; expected 9; got: %d", len(args))
+	}
+
+	var input AuthorizationInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AuthorizationInput struct: %s", err)
+	}
+
+	return &input, nil
+}
+
+// ParseCancelAuthorizationArgs parses the arguments of the cancelAuthorization method.
+func ParseCancelAuthorizationArgs(method *abi.Method, args []interface{}) (*CancelAuthorizationInput, error) {
+	if len(args) != 5 {
+		return nil, fmt.Errorf("invalid number of arguments; expected 5; got: %d", len(args))
+	}
+
+	var input CancelAuthorizationInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to CancelAuthorizationInput struct: %s", err)
+	}
+
+	return &input, nil
+}
+
+// ParseNoncesArgs parses the nonces arguments and returns the owner address.
+func ParseNoncesArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	return owner, nil
+}
+
+// ParseAuthorizationStateArgs parses the authorizationState arguments and returns
+// the authorizer address and the authorization nonce.
+func ParseAuthorizationStateArgs(args []interface{}) (common.Address, common.Hash, error) {
+	if len(args) != 2 {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	authorizer, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid authorizer address: %v", args[0])
+	}
+
+	nonce, ok := args[1].([32]byte)
+	if !ok {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid nonce: %v", args[1])
+	}
+
+	return authorizer, nonce, nil
+}
@@ -0,0 +1,225 @@
+package erc721
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	erc721types "github.com/cosmos/evm/x/erc721/types"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+const (
+	// abiPath defines the path to the ERC-721 precompile ABI JSON file.
+	abiPath = "abi.json"
+
+	GasTransferFrom      = 30_500
+	GasSafeTransferFrom  = 32_000
+	GasApprove           = 8_100
+	GasSetApprovalForAll = 8_100
+	GasName              = 3_421
+	GasSymbol            = 3_464
+	GasTokenURI          = 3_600
+	GasBalanceOf         = 2_870
+	GasOwnerOf           = 2_870
+	GasGetApproved       = 3_225
+	GasIsApprovedForAll  = 3_225
+	GasSupportsInterface = 450
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Precompile defines the precompiled contract for ERC-721. Each instance
+// represents a module owned x/nft class.
+type Precompile struct {
+	cmn.Precompile
+	tokenPair    erc721types.TokenPair
+	nftKeeper    erc721types.NFTKeeper
+	erc721Keeper Erc721Keeper
+}
+
+// LoadABI loads the ERC-721 ABI from the embedded abi.json file
+// for the ERC-721 precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, abiPath)
+}
+
+// NewPrecompile creates a new ERC-721 Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	tokenPair erc721types.TokenPair,
+	nftKeeper erc721types.NFTKeeper,
+	erc721Keeper Erc721Keeper,
+) (*Precompile, error) {
+	newABI, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newABI,
+			KvGasConfig:          storetypes.GasConfig{},
+			TransientKVGasConfig: storetypes.GasConfig{},
+		},
+		tokenPair:    tokenPair,
+		nftKeeper:    nftKeeper,
+		erc721Keeper: erc721Keeper,
+	}
+	// Address defines the address of the ERC-721 precompile contract.
+	p.SetAddress(p.tokenPair.GetERC721Contract())
+	return p, nil
+}
+
+// RequiredGas calculates the contract gas used for the
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	methodID := input[:4]
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		return 0
+	}
+
+	switch method.Name {
+	// ERC-721 transactions
+	case TransferFromMethod:
+		return GasTransferFrom
+	case SafeTransferFromMethod, SafeTransferFromWithDataMethod:
+		return GasSafeTransferFrom
+	case ApproveMethod:
+		return GasApprove
+	case SetApprovalForAllMethod:
+		return GasSetApprovalForAll
+	// ERC-721 queries
+	case NameMethod:
+		return GasName
+	case SymbolMethod:
+		return GasSymbol
+	case TokenURIMethod:
+		return GasTokenURI
+	case BalanceOfMethod:
+		return GasBalanceOf
+	case OwnerOfMethod:
+		return GasOwnerOf
+	case GetApprovedMethod:
+		return GasGetApproved
+	case IsApprovedForAllMethod:
+		return GasIsApprovedForAll
+	case SupportsInterfaceMethod:
+		return GasSupportsInterface
+	default:
+		return 0
+	}
+}
+
+// Run executes the precompiled contract ERC-721 methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	bz, err = p.run(evm, contract, readOnly)
+	if err != nil {
+		return cmn.ReturnRevertError(evm, err)
+	}
+
+	return bz, nil
+}
+
+func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	// ERC721 precompiles cannot receive funds because they are not managed by an
+	// EOA and will not be possible to recover funds sent to an instance of them.
+	if value := contract.Value(); value.Sign() == 1 {
+		return nil, fmt.Errorf(ErrCannotReceiveFunds, contract.Value().String())
+	}
+
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start the balance change handler before executing the precompile.
+	p.GetBalanceHandler().BeforeBalanceChange(ctx)
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// ERC-721 transactions
+	case TransferFromMethod:
+		bz, err = p.TransferFrom(ctx, contract, stateDB, method, args)
+	case SafeTransferFromMethod, SafeTransferFromWithDataMethod:
+		bz, err = p.SafeTransferFrom(ctx, evm, contract, stateDB, method, args)
+	case ApproveMethod:
+		bz, err = p.Approve(ctx, contract, stateDB, method, args)
+	case SetApprovalForAllMethod:
+		bz, err = p.SetApprovalForAll(ctx, contract, stateDB, method, args)
+	// ERC-721 queries
+	case NameMethod:
+		bz, err = p.Name(ctx, contract, stateDB, method, args)
+	case SymbolMethod:
+		bz, err = p.Symbol(ctx, contract, stateDB, method, args)
+	case TokenURIMethod:
+		bz, err = p.TokenURI(ctx, contract, stateDB, method, args)
+	case BalanceOfMethod:
+		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
+	case OwnerOfMethod:
+		bz, err = p.OwnerOf(ctx, contract, stateDB, method, args)
+	case GetApprovedMethod:
+		bz, err = p.GetApproved(ctx, contract, stateDB, method, args)
+	case IsApprovedForAllMethod:
+		bz, err = p.IsApprovedForAll(ctx, contract, stateDB, method, args)
+	case SupportsInterfaceMethod:
+		bz, err = p.SupportsInterface(ctx, contract, stateDB, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost, nil, tracing.GasChangeCallPrecompiledContract) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	// Process the native balance changes after the method execution.
+	err = p.GetBalanceHandler().AfterBalanceChange(ctx, stateDB)
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case TransferFromMethod,
+		SafeTransferFromMethod,
+		SafeTransferFromWithDataMethod,
+		ApproveMethod,
+		SetApprovalForAllMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// classID returns the x/nft class represented by the precompile.
+func (p Precompile) classID() string {
+	return p.tokenPair.ClassId
+}
@@ -0,0 +1,22 @@
+package erc721
+
+import (
+	"errors"
+)
+
+// Errors that have formatted information are defined here as a string.
+const (
+	ErrCannotReceiveFunds   = "cannot receive funds, received: %s"
+	ErrNonexistentToken     = "ERC721: invalid token ID %s"
+	ErrIncorrectOwner       = "ERC721: transfer from incorrect owner %s"
+	ErrInsufficientApproval = "ERC721: caller %s is not token owner or approved"
+	ErrInvalidReceiver      = "ERC721: transfer to invalid receiver %s"
+	ErrInvalidOperator      = "ERC721: invalid operator %s"
+)
+
+var (
+	// ERC721 errors
+	ErrInvalidOwner           = errors.New("ERC721: address zero is not a valid owner")
+	ErrApprovalToCurrentOwner = errors.New("ERC721: approval to current owner")
+	ErrNonERC721Receiver      = errors.New("ERC721: transfer to non ERC721Receiver implementer")
+)
@@ -0,0 +1,108 @@
+package erc721
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeTransfer defines the event type for the ERC-721 Transfer event.
+	EventTypeTransfer = "Transfer"
+
+	// EventTypeApproval defines the event type for the ERC-721 Approval event.
+	EventTypeApproval = "Approval"
+
+	// EventTypeApprovalForAll defines the event type for the ERC-721 ApprovalForAll event.
+	EventTypeApprovalForAll = "ApprovalForAll"
+)
+
+// EmitTransferEvent creates a new Transfer event emitted on transferFrom and
+// safeTransferFrom transactions.
+func (p Precompile) EmitTransferEvent(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, tokenID *big.Int) error {
+	return p.emitIndexedEvent(ctx, stateDB, EventTypeTransfer, from, to, tokenID)
+}
+
+// EmitApprovalEvent creates a new Approval event emitted on approve transactions.
+func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, owner, approved common.Address, tokenID *big.Int) error {
+	return p.emitIndexedEvent(ctx, stateDB, EventTypeApproval, owner, approved, tokenID)
+}
+
+// emitIndexedEvent emits an event whose three arguments are indexed.
+func (p Precompile) emitIndexedEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, from, to common.Address, tokenID *big.Int) error {
+	// Prepare the event topics
+	event := p.Events[eventType]
+	topics := make([]common.Hash, 4)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(from)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(to)
+	if err != nil {
+		return err
+	}
+
+	topics[3], err = cmn.MakeTopic(tokenID)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        nil,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // block height won't exceed uint64
+	})
+
+	return nil
+}
+
+// EmitApprovalForAllEvent creates a new ApprovalForAll event emitted on
+// setApprovalForAll transactions.
+func (p Precompile) EmitApprovalForAllEvent(ctx sdk.Context, stateDB vm.StateDB, owner, operator common.Address, approved bool) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeApprovalForAll]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(operator)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(approved)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // block height won't exceed uint64
+	})
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package erc721
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Erc721Keeper defines the expected x/erc721 keeper interface used to store the
+// ERC-721 approvals of the module owned classes.
+//
+// revive:disable-next-line exported
+type Erc721Keeper interface {
+	GetTokenApproval(ctx sdk.Context, erc721 common.Address, tokenID *big.Int, owner common.Address) common.Address
+	SetTokenApproval(ctx sdk.Context, erc721 common.Address, tokenID *big.Int, owner common.Address, spender common.Address)
+	DeleteTokenApproval(ctx sdk.Context, erc721 common.Address, tokenID *big.Int)
+	IsApprovedForAll(ctx sdk.Context, erc721 common.Address, owner common.Address, operator common.Address) bool
+	SetApprovalForAll(ctx sdk.Context, erc721 common.Address, owner common.Address, operator common.Address, approved bool)
+}
@@ -0,0 +1,203 @@
+package erc721
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// NameMethod defines the ABI method name for the ERC-721 name query.
+	NameMethod = "name"
+	// SymbolMethod defines the ABI method name for the ERC-721 symbol query.
+	SymbolMethod = "symbol"
+	// TokenURIMethod defines the ABI method name for the ERC-721 tokenURI query.
+	TokenURIMethod = "tokenURI"
+	// BalanceOfMethod defines the ABI method name for the ERC-721 balanceOf query.
+	BalanceOfMethod = "balanceOf"
+	// OwnerOfMethod defines the ABI method name for the ERC-721 ownerOf query.
+	OwnerOfMethod = "ownerOf"
+	// GetApprovedMethod defines the ABI method name for the ERC-721 getApproved query.
+	GetApprovedMethod = "getApproved"
+	// IsApprovedForAllMethod defines the ABI method name for the ERC-721
+	// isApprovedForAll query.
+	IsApprovedForAllMethod = "isApprovedForAll"
+	// SupportsInterfaceMethod defines the ABI method name for the ERC-165
+	// supportsInterface query.
+	SupportsInterfaceMethod = "supportsInterface"
+)
+
+// supportedInterfaces are the ERC-165 interface ids implemented by the
+// precompile: ERC-165, ERC-721 and the ERC-721 metadata extension.
+var supportedInterfaces = map[[4]byte]bool{
+	{0x01, 0xff, 0xc9, 0xa7}: true,
+	{0x80, 0xac, 0x58, 0xcd}: true,
+	{0x5b, 0x5e, 0x13, 0x9f}: true,
+}
+
+// Name returns the name of the x/nft class. If the class has no name, the
+// class id is returned.
+func (p Precompile) Name(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	class, found := p.nftKeeper.GetClass(ctx, p.classID())
+	if !found || class.Name == "" {
+		return method.Outputs.Pack(p.classID())
+	}
+
+	return method.Outputs.Pack(class.Name)
+}
+
+// Symbol returns the symbol of the x/nft class.
+func (p Precompile) Symbol(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	class, _ := p.nftKeeper.GetClass(ctx, p.classID())
+	return method.Outputs.Pack(class.Symbol)
+}
+
+// TokenURI returns the uri of the given token.
+func (p Precompile) TokenURI(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	tokenID, err := ParseTokenIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	token, found := p.nftKeeper.GetNFT(ctx, p.classID(), tokenID.String())
+	if !found {
+		return nil, fmt.Errorf(ErrNonexistentToken, tokenID)
+	}
+
+	return method.Outputs.Pack(token.Uri)
+}
+
+// BalanceOf returns the number of tokens of the class owned by the given
+// address.
+func (p Precompile) BalanceOf(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseBalanceOfArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if owner == (common.Address{}) {
+		return nil, ErrInvalidOwner
+	}
+
+	balance := p.nftKeeper.GetBalance(ctx, p.classID(), owner.Bytes())
+	return method.Outputs.Pack(new(big.Int).SetUint64(balance))
+}
+
+// OwnerOf returns the owner of the given token.
+func (p Precompile) OwnerOf(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	tokenID, err := ParseTokenIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := p.ownerOf(ctx, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(owner)
+}
+
+// GetApproved returns the account approved for the given token. The zero
+// address is returned if there is no approval.
+func (p Precompile) GetApproved(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	tokenID, err := ParseTokenIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := p.ownerOf(ctx, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	approved := p.erc721Keeper.GetTokenApproval(ctx, p.Address(), tokenID, owner)
+	return method.Outputs.Pack(approved)
+}
+
+// IsApprovedForAll returns true if the operator is allowed to manage all the
+// tokens of the owner.
+func (p Precompile) IsApprovedForAll(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, operator, err := ParseIsApprovedForAllArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	approved := p.erc721Keeper.IsApprovedForAll(ctx, p.Address(), owner, operator)
+	return method.Outputs.Pack(approved)
+}
+
+// SupportsInterface returns true if the precompile implements the interface
+// with the given ERC-165 id.
+func (p Precompile) SupportsInterface(
+	_ sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	interfaceID, err := ParseSupportsInterfaceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(supportedInterfaces[interfaceID])
+}
+
+// ownerOf returns the owner of the given token, or an error if the token does
+// not exist.
+func (p Precompile) ownerOf(ctx sdk.Context, tokenID *big.Int) (common.Address, error) {
+	owner := p.nftKeeper.GetOwner(ctx, p.classID(), tokenID.String())
+	if owner.Empty() {
+		return common.Address{}, fmt.Errorf(ErrNonexistentToken, tokenID)
+	}
+
+	return common.BytesToAddress(owner), nil
+}
@@ -0,0 +1,252 @@
+package erc721
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TransferFromMethod defines the ABI method name for the ERC-721 transferFrom
+	// transaction.
+	TransferFromMethod = "transferFrom"
+	// SafeTransferFromMethod defines the ABI method name for the ERC-721
+	// safeTransferFrom transaction without data.
+	SafeTransferFromMethod = "safeTransferFrom"
+	// SafeTransferFromWithDataMethod defines the ABI method name for the ERC-721
+	// safeTransferFrom transaction with data. The name is the one assigned by
+	// the ABI parser to the overloaded method.
+	SafeTransferFromWithDataMethod = "safeTransferFrom0"
+	// ApproveMethod defines the ABI method name for the ERC-721 approve
+	// transaction.
+	ApproveMethod = "approve"
+	// SetApprovalForAllMethod defines the ABI method name for the ERC-721
+	// setApprovalForAll transaction.
+	SetApprovalForAllMethod = "setApprovalForAll"
+)
+
+// onERC721ReceivedSelector is the selector of the IERC721Receiver
+// onERC721Received(address,address,uint256,bytes) method, which receivers must
+// return to accept a safe transfer.
+var onERC721ReceivedSelector = []byte{0x15, 0x0b, 0x7a, 0x02}
+
+// onERC721ReceivedArgs are the arguments of the IERC721Receiver
+// onERC721Received method.
+var onERC721ReceivedArgs = abi.Arguments{
+	{Type: mustNewType("address")},
+	{Type: mustNewType("address")},
+	{Type: mustNewType("uint256")},
+	{Type: mustNewType("bytes")},
+}
+
+// TransferFrom transfers the given token from the owner to the destination
+// address. The caller must be the owner or be approved for the token.
+func (p *Precompile) TransferFrom(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	from, to, tokenID, err := ParseTransferFromArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.transfer(ctx, contract, stateDB, from, to, tokenID); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// SafeTransferFrom transfers the given token like TransferFrom and, if the
+// destination address is a contract, checks that it accepts the token by
+// calling its onERC721Received method.
+func (p *Precompile) SafeTransferFrom(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	from, to, tokenID, data, err := ParseSafeTransferFromArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.transfer(ctx, contract, stateDB, from, to, tokenID); err != nil {
+		return nil, err
+	}
+
+	if stateDB.GetCodeSize(to) == 0 {
+		return method.Outputs.Pack()
+	}
+
+	if err := p.checkOnERC721Received(evm, contract, from, to, tokenID, data); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Approve approves the given address to transfer the token. The caller must be
+// the owner of the token or an operator of the owner. Approving the zero
+// address clears the approval.
+func (p *Precompile) Approve(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	to, tokenID, err := ParseApproveArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := p.ownerOf(ctx, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if to == owner {
+		return nil, ErrApprovalToCurrentOwner
+	}
+
+	caller := contract.Caller()
+	if caller != owner && !p.erc721Keeper.IsApprovedForAll(ctx, p.Address(), owner, caller) {
+		return nil, fmt.Errorf(ErrInsufficientApproval, caller)
+	}
+
+	if to == (common.Address{}) {
+		p.erc721Keeper.DeleteTokenApproval(ctx, p.Address(), tokenID)
+	} else {
+		p.erc721Keeper.SetTokenApproval(ctx, p.Address(), tokenID, owner, to)
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, to, tokenID); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// SetApprovalForAll approves or removes the given operator as an operator for
+// all the tokens of the caller.
+func (p *Precompile) SetApprovalForAll(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	operator, approved, err := ParseSetApprovalForAllArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := contract.Caller()
+	if operator == owner || operator == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidOperator, operator)
+	}
+
+	p.erc721Keeper.SetApprovalForAll(ctx, p.Address(), owner, operator, approved)
+
+	if err := p.EmitApprovalForAllEvent(ctx, stateDB, owner, operator, approved); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// transfer moves the token on x/nft after checking the ownership and the
+// approvals of the caller, clears the token approval and emits the Transfer
+// event.
+func (p *Precompile) transfer(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	from, to common.Address,
+	tokenID *big.Int,
+) error {
+	if to == (common.Address{}) {
+		return fmt.Errorf(ErrInvalidReceiver, to)
+	}
+
+	owner, err := p.ownerOf(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+
+	if owner != from {
+		return fmt.Errorf(ErrIncorrectOwner, from)
+	}
+
+	spender := contract.Caller()
+	if !p.isAuthorized(ctx, owner, spender, tokenID) {
+		return fmt.Errorf(ErrInsufficientApproval, spender)
+	}
+
+	// the approval of a token is cleared when it is transferred
+	p.erc721Keeper.DeleteTokenApproval(ctx, p.Address(), tokenID)
+
+	if err := p.nftKeeper.Transfer(ctx, p.classID(), tokenID.String(), to.Bytes()); err != nil {
+		return err
+	}
+
+	return p.EmitTransferEvent(ctx, stateDB, from, to, tokenID)
+}
+
+// isAuthorized returns true if the spender is the owner of the token, an
+// operator of the owner or the account approved for the token.
+func (p Precompile) isAuthorized(ctx sdk.Context, owner, spender common.Address, tokenID *big.Int) bool {
+	return spender == owner ||
+		p.erc721Keeper.IsApprovedForAll(ctx, p.Address(), owner, spender) ||
+		p.erc721Keeper.GetTokenApproval(ctx, p.Address(), tokenID, owner) == spender
+}
+
+// checkOnERC721Received calls the onERC721Received method of the receiver
+// contract and checks that it returns its selector. The gas used by the call
+// is charged to the precompile call.
+func (p Precompile) checkOnERC721Received(
+	evm *vm.EVM,
+	contract *vm.Contract,
+	from, to common.Address,
+	tokenID *big.Int,
+	data []byte,
+) error {
+	packed, err := onERC721ReceivedArgs.Pack(contract.Caller(), from, tokenID, data)
+	if err != nil {
+		return err
+	}
+
+	gas := contract.Gas
+	ret, leftOverGas, err := evm.Call(p.Address(), to, append(bytes.Clone(onERC721ReceivedSelector), packed...), gas, uint256.NewInt(0))
+	if !contract.UseGas(gas-leftOverGas, nil, tracing.GasChangeCallPrecompiledContract) {
+		return vm.ErrOutOfGas
+	}
+	if err != nil || len(ret) < 32 || !bytes.Equal(ret[:4], onERC721ReceivedSelector) {
+		return ErrNonERC721Receiver
+	}
+
+	return nil
+}
+
+// mustNewType returns the ABI type for the given solidity type.
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
@@ -0,0 +1,195 @@
+package erc721
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+)
+
+// EventTransfer defines the event data for the ERC721 Transfer events.
+type EventTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int //nolint:revive,stylecheck // follows the ABI naming
+}
+
+// EventApproval defines the event data for the ERC721 Approval events.
+type EventApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int //nolint:revive,stylecheck // follows the ABI naming
+}
+
+// EventApprovalForAll defines the event data for the ERC721 ApprovalForAll events.
+type EventApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+}
+
+// ParseTransferFromArgs parses the arguments from the transferFrom method and
+// returns the from and to addresses and the token id.
+func ParseTransferFromArgs(args []interface{}) (
+	from, to common.Address, tokenID *big.Int, err error,
+) {
+	if len(args) != 3 {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	from, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf("invalid from address: %v", args[0])
+	}
+
+	to, ok = args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf("invalid to address: %v", args[1])
+	}
+
+	tokenID, ok = args[2].(*big.Int)
+	if !ok || tokenID == nil {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf("invalid token id: %v", args[2])
+	}
+
+	return from, to, tokenID, nil
+}
+
+// ParseSafeTransferFromArgs parses the arguments from both safeTransferFrom
+// overloads and returns the from and to addresses, the token id and the data
+// forwarded to the receiver. The data is empty for the overload without it.
+func ParseSafeTransferFromArgs(args []interface{}) (
+	from, to common.Address, tokenID *big.Int, data []byte, err error,
+) {
+	switch len(args) {
+	case 3:
+		from, to, tokenID, err = ParseTransferFromArgs(args)
+		return from, to, tokenID, []byte{}, err
+	case 4:
+		from, to, tokenID, err = ParseTransferFromArgs(args[:3])
+		if err != nil {
+			return common.Address{}, common.Address{}, nil, nil, err
+		}
+
+		data, ok := args[3].([]byte)
+		if !ok {
+			return common.Address{}, common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "data", []byte{}, args[3])
+		}
+		return from, to, tokenID, data, nil
+	default:
+		return common.Address{}, common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+}
+
+// ParseApproveArgs parses the arguments from the approve method and returns
+// the approved address and the token id.
+func ParseApproveArgs(args []interface{}) (
+	to common.Address, tokenID *big.Int, err error,
+) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	to, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf("invalid to address: %v", args[0])
+	}
+
+	tokenID, ok = args[1].(*big.Int)
+	if !ok || tokenID == nil {
+		return common.Address{}, nil, fmt.Errorf("invalid token id: %v", args[1])
+	}
+
+	return to, tokenID, nil
+}
+
+// ParseSetApprovalForAllArgs parses the arguments from the setApprovalForAll
+// method and returns the operator address and the approval status.
+func ParseSetApprovalForAllArgs(args []interface{}) (
+	operator common.Address, approved bool, err error,
+) {
+	if len(args) != 2 {
+		return common.Address{}, false, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	operator, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, false, fmt.Errorf("invalid operator address: %v", args[0])
+	}
+
+	approved, ok = args[1].(bool)
+	if !ok {
+		return common.Address{}, false, fmt.Errorf(cmn.ErrInvalidType, "approved", true, args[1])
+	}
+
+	return operator, approved, nil
+}
+
+// ParseBalanceOfArgs parses the arguments from the balanceOf method and returns
+// the owner address.
+func ParseBalanceOfArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	return owner, nil
+}
+
+// ParseTokenIDArgs parses the arguments from the queries that only take a
+// token id, i.e. ownerOf, tokenURI and getApproved.
+func ParseTokenIDArgs(args []interface{}) (*big.Int, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	tokenID, ok := args[0].(*big.Int)
+	if !ok || tokenID == nil {
+		return nil, fmt.Errorf("invalid token id: %v", args[0])
+	}
+
+	return tokenID, nil
+}
+
+// ParseIsApprovedForAllArgs parses the arguments from the isApprovedForAll
+// method and returns the owner and operator addresses.
+func ParseIsApprovedForAllArgs(args []interface{}) (
+	owner, operator common.Address, err error,
+) {
+	if len(args) != 2 {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	operator, ok = args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, fmt.Errorf("invalid operator address: %v", args[1])
+	}
+
+	return owner, operator, nil
+}
+
+// ParseSupportsInterfaceArgs parses the arguments from the supportsInterface
+// method and returns the interface id.
+func ParseSupportsInterfaceArgs(args []interface{}) ([4]byte, error) {
+	if len(args) != 1 {
+		return [4]byte{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	interfaceID, ok := args[0].([4]byte)
+	if !ok {
+		return [4]byte{}, fmt.Errorf(cmn.ErrInvalidType, "interfaceId", [4]byte{}, args[0])
+	}
+
+	return interfaceID, nil
+}
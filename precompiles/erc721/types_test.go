@@ -0,0 +1,108 @@
+package erc721
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+)
+
+func TestParseSafeTransferFromArgs(t *testing.T) {
+	from := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	to := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	tokenID := big.NewInt(1)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		expData []byte
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "valid without data",
+			args:    []interface{}{from, to, tokenID},
+			expData: []byte{},
+		},
+		{
+			name:    "valid with data",
+			args:    []interface{}{from, to, tokenID, []byte("data")},
+			expData: []byte("data"),
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name:    "invalid from address",
+			args:    []interface{}{"from", to, tokenID},
+			wantErr: true,
+			errMsg:  "invalid from address",
+		},
+		{
+			name:    "nil token id",
+			args:    []interface{}{from, to, (*big.Int)(nil)},
+			wantErr: true,
+			errMsg:  "invalid token id",
+		},
+		{
+			name:    "invalid data",
+			args:    []interface{}{from, to, tokenID, "data"},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidType, "data", []byte{}, "data"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotFrom, gotTo, gotTokenID, data, err := ParseSafeTransferFromArgs(tt.args)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, from, gotFrom)
+			require.Equal(t, to, gotTo)
+			require.Equal(t, tokenID, gotTokenID)
+			require.Equal(t, tt.expData, data)
+		})
+	}
+}
+
+func TestParseSetApprovalForAllArgs(t *testing.T) {
+	operator := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	got, approved, err := ParseSetApprovalForAllArgs([]interface{}{operator, true})
+	require.NoError(t, err)
+	require.Equal(t, operator, got)
+	require.True(t, approved)
+
+	_, _, err = ParseSetApprovalForAllArgs([]interface{}{operator})
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1))
+
+	_, _, err = ParseSetApprovalForAllArgs([]interface{}{operator, "true"})
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidType, "approved", true, "true"))
+}
+
+func TestLoadABI(t *testing.T) {
+	abi, err := LoadABI()
+	require.NoError(t, err)
+
+	for _, method := range []string{
+		TransferFromMethod,
+		SafeTransferFromMethod,
+		SafeTransferFromWithDataMethod,
+		ApproveMethod,
+		SetApprovalForAllMethod,
+	} {
+		require.Contains(t, abi.Methods, method)
+	}
+	require.Len(t, abi.Methods[SafeTransferFromWithDataMethod].Inputs, 4)
+}
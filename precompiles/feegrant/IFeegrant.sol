@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev AllowanceData represents a fee allowance given by a granter to a grantee.
+struct AllowanceData {
+    /// @dev The address of the granter
+    address granter;
+    /// @dev The address of the grantee
+    address grantee;
+    /// @dev The type URL of the allowance, e.g. /cosmos.feegrant.v1beta1.BasicAllowance
+    string allowanceType;
+    /// @dev The maximum amount of fees the grantee can use, no limit if empty
+    Coin[] spendLimit;
+    /// @dev The expiration of the allowance as a unix timestamp, 0 if the allowance does not expire
+    int64 expiration;
+    /// @dev The protoJSON encoded allowance
+    string allowance;
+}
+
+/// @author Evmos Team
+/// @title Feegrant Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the x/feegrant module
+interface IFeegrant {
+    /// @dev GrantAllowance defines an Event emitted when a fee allowance is granted.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param allowanceType the type URL of the allowance
+    event GrantAllowance(address indexed granter, address indexed grantee, string allowanceType);
+
+    /// @dev RevokeAllowance defines an Event emitted when a fee allowance is revoked.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// TRANSACTIONS
+
+    /// @dev grantBasicAllowance defines a method to grant a BasicAllowance, which allows the
+    /// grantee to pay fees with the funds of the granter up to the spend limit.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of fees the grantee can use, no limit if empty
+    /// @param expiration The expiration as a unix timestamp, 0 for no expiration
+    /// @return success Whether the transaction was successful or not
+    function grantBasicAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev grantPeriodicAllowance defines a method to grant a PeriodicAllowance, which
+    /// additionally limits the fees the grantee can use in each period.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of fees the grantee can use, no limit if empty
+    /// @param expiration The expiration as a unix timestamp, 0 for no expiration
+    /// @param period The duration of a period in seconds
+    /// @param periodSpendLimit The maximum amount of fees the grantee can use in a period
+    /// @return success Whether the transaction was successful or not
+    function grantPeriodicAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] calldata periodSpendLimit
+    ) external returns (bool success);
+
+    /// @dev grantAllowedMsgAllowance defines a method to grant an AllowedMsgAllowance, which
+    /// restricts a basic or periodic allowance to the given message types.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of fees the grantee can use, no limit if empty
+    /// @param expiration The expiration as a unix timestamp, 0 for no expiration
+    /// @param period The duration of a period in seconds, 0 for a basic allowance
+    /// @param periodSpendLimit The maximum amount of fees the grantee can use in a period
+    /// @param allowedMessages The type URLs of the messages the fees can be used for
+    /// @return success Whether the transaction was successful or not
+    function grantAllowedMsgAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] calldata periodSpendLimit,
+        string[] calldata allowedMessages
+    ) external returns (bool success);
+
+    /// @dev revokeAllowance defines a method to revoke the fee allowance given to a grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @return success Whether the transaction was successful or not
+    function revokeAllowance(
+        address granter,
+        address grantee
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev allowance returns the fee allowance given by a granter to a grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @return allowance The fee allowance
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (AllowanceData memory allowance);
+
+    /// @dev allowances returns the fee allowances received by a grantee.
+    /// @param grantee The address of the grantee
+    /// @param pagination The pagination options
+    /// @return allowances The fee allowances received by the grantee
+    /// @return pageResponse The pagination information
+    function allowances(
+        address grantee,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (AllowanceData[] memory allowances, PageResponse memory pageResponse);
+
+    /// @dev allowancesByGranter returns the fee allowances given by a granter.
+    /// @param granter The address of the granter
+    /// @param pagination The pagination options
+    /// @return allowances The fee allowances given by the granter
+    /// @return pageResponse The pagination information
+    function allowancesByGranter(
+        address granter,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (AllowanceData[] memory allowances, PageResponse memory pageResponse);
+}
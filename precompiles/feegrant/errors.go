@@ -0,0 +1,16 @@
+package feegrant
+
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidExpiration is raised when the expiration is not a valid unix timestamp.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidSpendLimit is raised when the spend limit is not valid.
+	ErrInvalidSpendLimit = "invalid spend limit: %v"
+	// ErrInvalidPeriod is raised when the period of a periodic allowance is not valid.
+	ErrInvalidPeriod = "invalid period: %v"
+	// ErrInvalidAllowedMessages is raised when the allowed messages are not valid.
+	ErrInvalidAllowedMessages = "invalid allowed messages: %v"
+)
@@ -0,0 +1,145 @@
+package feegrant
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	feegranttypes "cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantBasicAllowanceMethod defines the ABI method name for the feegrant GrantAllowance
+	// transaction with a BasicAllowance.
+	GrantBasicAllowanceMethod = "grantBasicAllowance"
+	// GrantPeriodicAllowanceMethod defines the ABI method name for the feegrant GrantAllowance
+	// transaction with a PeriodicAllowance.
+	GrantPeriodicAllowanceMethod = "grantPeriodicAllowance"
+	// GrantAllowedMsgAllowanceMethod defines the ABI method name for the feegrant GrantAllowance
+	// transaction with an AllowedMsgAllowance.
+	GrantAllowedMsgAllowanceMethod = "grantAllowedMsgAllowance"
+	// RevokeAllowanceMethod defines the ABI method name for the feegrant RevokeAllowance transaction.
+	RevokeAllowanceMethod = "revokeAllowance"
+)
+
+// GrantBasicAllowance defines a method to grant a BasicAllowance to a grantee.
+func (p *Precompile) GrantBasicAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, err := NewMsgGrantBasicAllowance(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, granterHexAddr)
+}
+
+// GrantPeriodicAllowance defines a method to grant a PeriodicAllowance to a grantee.
+func (p *Precompile) GrantPeriodicAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, err := NewMsgGrantPeriodicAllowance(args, ctx.BlockTime(), p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, granterHexAddr)
+}
+
+// GrantAllowedMsgAllowance defines a method to grant an AllowedMsgAllowance to a grantee.
+func (p *Precompile) GrantAllowedMsgAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, err := NewMsgGrantAllowedMsgAllowance(args, ctx.BlockTime(), p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, granterHexAddr)
+}
+
+// RevokeAllowance defines a method to revoke the fee allowance given to a grantee.
+func (p *Precompile) RevokeAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, err := NewMsgRevokeAllowance(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != granterHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granterHexAddr.String())
+	}
+
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err = msgSrv.RevokeAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := p.addrCdc.StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeAllowanceEvent(ctx, stateDB, granterHexAddr, common.BytesToAddress(granteeAddr)); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// grantAllowance saves the fee allowance of the MsgGrantAllowance, after checking
+// that the granter is the caller.
+func (p *Precompile) grantAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *feegranttypes.MsgGrantAllowance,
+	granterHexAddr common.Address,
+) ([]byte, error) {
+	msgSender := contract.Caller()
+	if msgSender != granterHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granterHexAddr.String())
+	}
+
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err := msgSrv.GrantAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := p.addrCdc.StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitGrantAllowanceEvent(ctx, stateDB, granterHexAddr, common.BytesToAddress(granteeAddr), msg.Allowance.TypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,466 @@
+package feegrant
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/utils"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/address"
+	feegranttypes "cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// EventGrantAllowance defines the event data for the GrantAllowance transactions.
+type EventGrantAllowance struct {
+	Granter       common.Address
+	Grantee       common.Address
+	AllowanceType string
+}
+
+// EventRevokeAllowance defines the event data for the RevokeAllowance transaction.
+type EventRevokeAllowance struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// AllowanceData represents a fee allowance given by a granter to a grantee.
+type AllowanceData struct {
+	Granter       common.Address `abi:"granter"`
+	Grantee       common.Address `abi:"grantee"`
+	AllowanceType string         `abi:"allowanceType"`
+	SpendLimit    []cmn.Coin     `abi:"spendLimit"`
+	Expiration    int64          `abi:"expiration"`
+	Allowance     string         `abi:"allowance"`
+}
+
+// AllowanceOutput defines the output for the Allowance query.
+type AllowanceOutput struct {
+	Allowance AllowanceData
+}
+
+// AllowancesOutput defines the output for the Allowances and AllowancesByGranter queries.
+type AllowancesOutput struct {
+	Allowances   []AllowanceData    `abi:"allowances"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// AllowancesInput defines the input for the Allowances query.
+type AllowancesInput struct {
+	Grantee    common.Address
+	Pagination query.PageRequest
+}
+
+// AllowancesByGranterInput defines the input for the AllowancesByGranter query.
+type AllowancesByGranterInput struct {
+	Granter    common.Address
+	Pagination query.PageRequest
+}
+
+// NewMsgGrantBasicAllowance creates a new MsgGrantAllowance with a BasicAllowance.
+func NewMsgGrantBasicAllowance(args []interface{}, addrCdc address.Codec) (*feegranttypes.MsgGrantAllowance, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	granter, grantee, err := checkGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	basic, err := newBasicAllowance(args[2], args[3])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg, err := newMsgGrantAllowance(granter, grantee, basic, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, granter, nil
+}
+
+// NewMsgGrantPeriodicAllowance creates a new MsgGrantAllowance with a PeriodicAllowance,
+// which first period starts at the given block time.
+func NewMsgGrantPeriodicAllowance(args []interface{}, blockTime time.Time, addrCdc address.Codec) (*feegranttypes.MsgGrantAllowance, common.Address, error) {
+	if len(args) != 6 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	granter, grantee, err := checkGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	basic, err := newBasicAllowance(args[2], args[3])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	periodic, err := newPeriodicAllowance(basic, args[4], args[5], blockTime)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg, err := newMsgGrantAllowance(granter, grantee, periodic, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, granter, nil
+}
+
+// NewMsgGrantAllowedMsgAllowance creates a new MsgGrantAllowance with an AllowedMsgAllowance,
+// which wraps a PeriodicAllowance if the period is not zero, or a BasicAllowance otherwise.
+func NewMsgGrantAllowedMsgAllowance(args []interface{}, blockTime time.Time, addrCdc address.Codec) (*feegranttypes.MsgGrantAllowance, common.Address, error) {
+	if len(args) != 7 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+
+	granter, grantee, err := checkGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	basic, err := newBasicAllowance(args[2], args[3])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	var allowance feegranttypes.FeeAllowanceI = basic
+	if period, ok := args[4].(int64); !ok || period != 0 {
+		if allowance, err = newPeriodicAllowance(basic, args[4], args[5], blockTime); err != nil {
+			return nil, common.Address{}, err
+		}
+	}
+
+	allowedMessages, ok := args[6].([]string)
+	if !ok || len(allowedMessages) == 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidAllowedMessages, args[6])
+	}
+
+	allowedMsgAllowance, err := feegranttypes.NewAllowedMsgAllowance(allowance, allowedMessages)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg, err := newMsgGrantAllowance(granter, grantee, allowedMsgAllowance, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, granter, nil
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance.
+func NewMsgRevokeAllowance(args []interface{}, addrCdc address.Codec) (*feegranttypes.MsgRevokeAllowance, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := checkGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	granterAddr, granteeAddr, err := encodeGranterGrantee(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return &feegranttypes.MsgRevokeAllowance{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}, granter, nil
+}
+
+// ParseAllowanceArgs parses the arguments of the Allowance query.
+func ParseAllowanceArgs(args []interface{}, addrCdc address.Codec) (*feegranttypes.QueryAllowanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := checkGranterGrantee(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	granterAddr, granteeAddr, err := encodeGranterGrantee(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegranttypes.QueryAllowanceRequest{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}, nil
+}
+
+// ParseAllowancesArgs parses the arguments of the Allowances query.
+func ParseAllowancesArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegranttypes.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput: %s", err)
+	}
+
+	grantee, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &feegranttypes.QueryAllowancesRequest{
+		Grantee:    grantee,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseAllowancesByGranterArgs parses the arguments of the AllowancesByGranter query.
+func ParseAllowancesByGranterArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegranttypes.QueryAllowancesByGranterRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesByGranterInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesByGranterInput: %s", err)
+	}
+
+	granter, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	return &feegranttypes.QueryAllowancesByGranterRequest{
+		Granter:    granter,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromResponse populates the output from the Allowance query response.
+func (o *AllowanceOutput) FromResponse(cdc codec.Codec, res *feegranttypes.QueryAllowanceResponse) (*AllowanceOutput, error) {
+	allowance, err := NewAllowanceData(cdc, res.Allowance)
+	if err != nil {
+		return nil, err
+	}
+	o.Allowance = allowance
+	return o, nil
+}
+
+// FromGrants populates the output from the grants returned by the allowances queries.
+func (o *AllowancesOutput) FromGrants(cdc codec.Codec, grants []*feegranttypes.Grant, pageRes *query.PageResponse) (*AllowancesOutput, error) {
+	o.Allowances = make([]AllowanceData, len(grants))
+	for i, grant := range grants {
+		allowance, err := NewAllowanceData(cdc, grant)
+		if err != nil {
+			return nil, err
+		}
+		o.Allowances[i] = allowance
+	}
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+	return o, nil
+}
+
+// NewAllowanceData returns the AllowanceData of the fee grant, with the allowance
+// encoded in protoJSON. The spend limit and expiration are the ones of the basic
+// allowance, for any allowance type.
+func NewAllowanceData(cdc codec.Codec, grant *feegranttypes.Grant) (AllowanceData, error) {
+	if grant == nil {
+		return AllowanceData{}, fmt.Errorf("fee allowance not found")
+	}
+
+	granter, err := utils.HexAddressFromBech32String(grant.Granter)
+	if err != nil {
+		return AllowanceData{}, err
+	}
+
+	grantee, err := utils.HexAddressFromBech32String(grant.Grantee)
+	if err != nil {
+		return AllowanceData{}, err
+	}
+
+	var allowance feegranttypes.FeeAllowanceI
+	if err := cdc.InterfaceRegistry().UnpackAny(grant.Allowance, &allowance); err != nil {
+		return AllowanceData{}, err
+	}
+
+	allowanceMsg, ok := allowance.(proto.Message)
+	if !ok {
+		return AllowanceData{}, fmt.Errorf("cannot proto marshal %T", allowance)
+	}
+
+	allowanceJSON, err := cdc.MarshalJSON(allowanceMsg)
+	if err != nil {
+		return AllowanceData{}, err
+	}
+
+	data := AllowanceData{
+		Granter:       granter,
+		Grantee:       grantee,
+		AllowanceType: grant.Allowance.TypeUrl,
+		SpendLimit:    []cmn.Coin{},
+		Allowance:     string(allowanceJSON),
+	}
+
+	if basic := basicAllowanceOf(allowance); basic != nil {
+		data.SpendLimit = cmn.NewCoinsResponse(basic.SpendLimit)
+	}
+
+	expiration, err := allowance.ExpiresAt()
+	if err != nil {
+		return AllowanceData{}, err
+	}
+	if expiration != nil {
+		data.Expiration = expiration.Unix()
+	}
+
+	return data, nil
+}
+
+// basicAllowanceOf returns the basic allowance of the fee allowance, if any.
+func basicAllowanceOf(allowance feegranttypes.FeeAllowanceI) *feegranttypes.BasicAllowance {
+	switch allowance := allowance.(type) {
+	case *feegranttypes.BasicAllowance:
+		return allowance
+	case *feegranttypes.PeriodicAllowance:
+		return &allowance.Basic
+	case *feegranttypes.AllowedMsgAllowance:
+		inner, err := allowance.GetAllowance()
+		if err != nil {
+			return nil
+		}
+		return basicAllowanceOf(inner)
+	default:
+		return nil
+	}
+}
+
+// newBasicAllowance creates a new BasicAllowance with the given spend limit and
+// unix expiration, where 0 means the allowance does not expire.
+func newBasicAllowance(spendLimitArg, expirationArg interface{}) (*feegranttypes.BasicAllowance, error) {
+	spendLimit, err := toSdkCoins(spendLimitArg)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidSpendLimit, spendLimitArg)
+	}
+
+	expiration, ok := expirationArg.(int64)
+	if !ok || expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, expirationArg)
+	}
+
+	basic := &feegranttypes.BasicAllowance{SpendLimit: spendLimit}
+	if expiration != 0 {
+		expirationTime := time.Unix(expiration, 0).UTC()
+		basic.Expiration = &expirationTime
+	}
+
+	return basic, nil
+}
+
+// newPeriodicAllowance creates a new PeriodicAllowance with the given period in
+// seconds and period spend limit, which first period starts at the block time.
+func newPeriodicAllowance(basic *feegranttypes.BasicAllowance, periodArg, periodSpendLimitArg interface{}, blockTime time.Time) (*feegranttypes.PeriodicAllowance, error) {
+	periodSeconds, ok := periodArg.(int64)
+	if !ok || periodSeconds <= 0 {
+		return nil, fmt.Errorf(ErrInvalidPeriod, periodArg)
+	}
+
+	periodSpendLimit, err := toSdkCoins(periodSpendLimitArg)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidSpendLimit, periodSpendLimitArg)
+	}
+
+	period := time.Duration(periodSeconds) * time.Second
+	return &feegranttypes.PeriodicAllowance{
+		Basic:            *basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      blockTime.Add(period),
+	}, nil
+}
+
+// newMsgGrantAllowance creates a new MsgGrantAllowance of the fee allowance.
+func newMsgGrantAllowance(granter, grantee common.Address, allowance feegranttypes.FeeAllowanceI, addrCdc address.Codec) (*feegranttypes.MsgGrantAllowance, error) {
+	granterAddr, granteeAddr, err := encodeGranterGrantee(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	allowanceMsg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cannot proto marshal %T", allowance)
+	}
+
+	allowanceAny, err := codectypes.NewAnyWithValue(allowanceMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegranttypes.MsgGrantAllowance{
+		Granter:   granterAddr,
+		Grantee:   granteeAddr,
+		Allowance: allowanceAny,
+	}, nil
+}
+
+// toSdkCoins converts the Coin array argument to sdk.Coins, where an empty
+// array results in nil coins.
+func toSdkCoins(arg interface{}) (sdk.Coins, error) {
+	coins, err := cmn.ToCoins(arg)
+	if err != nil {
+		return nil, err
+	}
+	if len(coins) == 0 {
+		return nil, nil
+	}
+	return cmn.NewSdkCoinsFromCoins(coins)
+}
+
+// checkGranterGrantee checks the granter and grantee arguments are valid addresses.
+func checkGranterGrantee(granterArg, granteeArg interface{}) (common.Address, common.Address, error) {
+	granter, ok := granterArg.(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, granterArg)
+	}
+
+	grantee, ok := granteeArg.(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, granteeArg)
+	}
+
+	return granter, grantee, nil
+}
+
+// encodeGranterGrantee encodes the granter and grantee addresses with the address codec.
+func encodeGranterGrantee(granter, grantee common.Address, addrCdc address.Codec) (string, string, error) {
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode granter address: %w", err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode grantee address: %w", err)
+	}
+
+	return granterAddr, granteeAddr, nil
+}
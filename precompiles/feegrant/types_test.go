@@ -0,0 +1,241 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	feegranttypes "cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestNewMsgGrantBasicAllowance(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	spendLimit := []cmn.Coin{{Denom: "stake", Amount: big.NewInt(1000)}}
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		args           []interface{}
+		wantErr        bool
+		errMsg         string
+		wantSpendLimit sdk.Coins
+		wantExpiration *time.Time
+	}{
+		{
+			name:           "valid",
+			args:           []interface{}{granter, grantee, spendLimit, expiration.Unix()},
+			wantSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+			wantExpiration: &expiration,
+		},
+		{
+			name: "valid - no spend limit and no expiration",
+			args: []interface{}{granter, grantee, []cmn.Coin{}, int64(0)},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name:    "empty granter address",
+			args:    []interface{}{common.Address{}, grantee, spendLimit, int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGranter, common.Address{}),
+		},
+		{
+			name:    "invalid grantee type",
+			args:    []interface{}{granter, "not-an-address", spendLimit, int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGrantee, "not-an-address"),
+		},
+		{
+			name:    "invalid spend limit",
+			args:    []interface{}{granter, grantee, "invalid-coins", int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidSpendLimit, "invalid-coins"),
+		},
+		{
+			name:    "negative expiration",
+			args:    []interface{}{granter, grantee, spendLimit, int64(-1)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidExpiration, -1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, granterAddr, err := NewMsgGrantBasicAllowance(tt.args, addrCodec)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, granter, granterAddr)
+			require.Equal(t, sdk.AccAddress(granter.Bytes()).String(), msg.Granter)
+			require.Equal(t, sdk.AccAddress(grantee.Bytes()).String(), msg.Grantee)
+
+			allowance, err := msg.GetFeeAllowanceI()
+			require.NoError(t, err)
+			basic, ok := allowance.(*feegranttypes.BasicAllowance)
+			require.True(t, ok)
+			require.Equal(t, tt.wantSpendLimit, basic.SpendLimit)
+			require.Equal(t, tt.wantExpiration, basic.Expiration)
+		})
+	}
+}
+
+func TestNewMsgGrantPeriodicAllowance(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	spendLimit := []cmn.Coin{{Denom: "stake", Amount: big.NewInt(1000)}}
+	periodSpendLimit := []cmn.Coin{{Denom: "stake", Amount: big.NewInt(100)}}
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	msg, _, err := NewMsgGrantPeriodicAllowance([]interface{}{granter, grantee, spendLimit, int64(0), int64(3600), periodSpendLimit}, blockTime, addrCodec)
+	require.NoError(t, err)
+
+	allowance, err := msg.GetFeeAllowanceI()
+	require.NoError(t, err)
+	periodic, ok := allowance.(*feegranttypes.PeriodicAllowance)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), periodic.Basic.SpendLimit)
+	require.Equal(t, time.Hour, periodic.Period)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), periodic.PeriodSpendLimit)
+	require.Equal(t, periodic.PeriodSpendLimit, periodic.PeriodCanSpend)
+	require.Equal(t, blockTime.Add(time.Hour), periodic.PeriodReset)
+	require.NoError(t, periodic.ValidateBasic())
+
+	_, _, err = NewMsgGrantPeriodicAllowance([]interface{}{granter, grantee, spendLimit, int64(0), int64(0), periodSpendLimit}, blockTime, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(ErrInvalidPeriod, 0))
+
+	_, _, err = NewMsgGrantPeriodicAllowance([]interface{}{granter, grantee, spendLimit, int64(0)}, blockTime, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 4))
+}
+
+func TestNewMsgGrantAllowedMsgAllowance(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	spendLimit := []cmn.Coin{{Denom: "stake", Amount: big.NewInt(1000)}}
+	periodSpendLimit := []cmn.Coin{{Denom: "stake", Amount: big.NewInt(100)}}
+	allowedMessages := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// a zero period wraps a basic allowance
+	msg, _, err := NewMsgGrantAllowedMsgAllowance([]interface{}{
+		granter, grantee, spendLimit, int64(0), int64(0), []cmn.Coin{}, allowedMessages,
+	}, blockTime, addrCodec)
+	require.NoError(t, err)
+
+	allowance, err := msg.GetFeeAllowanceI()
+	require.NoError(t, err)
+	allowedMsg, ok := allowance.(*feegranttypes.AllowedMsgAllowance)
+	require.True(t, ok)
+	require.Equal(t, allowedMessages, allowedMsg.AllowedMessages)
+	inner, err := allowedMsg.GetAllowance()
+	require.NoError(t, err)
+	require.IsType(t, &feegranttypes.BasicAllowance{}, inner)
+
+	// a non-zero period wraps a periodic allowance
+	msg, _, err = NewMsgGrantAllowedMsgAllowance([]interface{}{
+		granter, grantee, spendLimit, int64(0), int64(60), periodSpendLimit, allowedMessages,
+	}, blockTime, addrCodec)
+	require.NoError(t, err)
+
+	allowance, err = msg.GetFeeAllowanceI()
+	require.NoError(t, err)
+	inner, err = allowance.(*feegranttypes.AllowedMsgAllowance).GetAllowance()
+	require.NoError(t, err)
+	require.IsType(t, &feegranttypes.PeriodicAllowance{}, inner)
+
+	_, _, err = NewMsgGrantAllowedMsgAllowance([]interface{}{
+		granter, grantee, spendLimit, int64(0), int64(0), []cmn.Coin{}, []string{},
+	}, blockTime, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(ErrInvalidAllowedMessages, []string{}))
+}
+
+func TestNewMsgRevokeAllowance(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+
+	msg, granterAddr, err := NewMsgRevokeAllowance([]interface{}{granter, grantee}, addrCodec)
+	require.NoError(t, err)
+	require.Equal(t, granter, granterAddr)
+	require.Equal(t, sdk.AccAddress(granter.Bytes()).String(), msg.Granter)
+	require.Equal(t, sdk.AccAddress(grantee.Bytes()).String(), msg.Grantee)
+
+	_, _, err = NewMsgRevokeAllowance([]interface{}{granter}, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1))
+}
+
+func TestParseAllowancesArgs(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	abi, err := LoadABI()
+	require.NoError(t, err)
+	method := abi.Methods[AllowancesMethod]
+
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	pagination := query.PageRequest{Limit: 10, CountTotal: true}
+
+	req, err := ParseAllowancesArgs(&method, []interface{}{grantee, pagination}, addrCodec)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(grantee.Bytes()).String(), req.Grantee)
+	require.Equal(t, uint64(10), req.Pagination.Limit)
+	require.True(t, req.Pagination.CountTotal)
+
+	_, err = ParseAllowancesArgs(&method, []interface{}{grantee}, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1))
+}
+
+func TestNewAllowanceData(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	feegranttypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	granter := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	grantee := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	basic := &feegranttypes.BasicAllowance{SpendLimit: spendLimit, Expiration: &expiration}
+	allowedMsg, err := feegranttypes.NewAllowedMsgAllowance(basic, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	require.NoError(t, err)
+
+	grant, err := feegranttypes.NewGrant(sdk.AccAddress(granter.Bytes()), sdk.AccAddress(grantee.Bytes()), allowedMsg)
+	require.NoError(t, err)
+
+	data, err := NewAllowanceData(cdc, &grant)
+	require.NoError(t, err)
+	require.Equal(t, granter, data.Granter)
+	require.Equal(t, grantee, data.Grantee)
+	require.Equal(t, "/cosmos.feegrant.v1beta1.AllowedMsgAllowance", data.AllowanceType)
+	require.Equal(t, cmn.NewCoinsResponse(spendLimit), data.SpendLimit)
+	require.Equal(t, expiration.Unix(), data.Expiration)
+	require.Contains(t, data.Allowance, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+
+	_, err = NewAllowanceData(cdc, nil)
+	require.ErrorContains(t, err, "fee allowance not found")
+}
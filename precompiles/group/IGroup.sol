@@ -0,0 +1,328 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IGroup contract's address.
+address constant GROUP_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080C;
+
+/// @dev The IGroup contract's instance.
+IGroup constant GROUP_CONTRACT = IGroup(GROUP_PRECOMPILE_ADDRESS);
+
+/// @dev VoteOption enumerates the valid vote options for a given group proposal.
+enum VoteOption {
+    // Unspecified defines a no-op vote option.
+    Unspecified,
+    // Yes defines a yes vote option.
+    Yes,
+    // Abstain defines an abstain vote option.
+    Abstain,
+    // No defines a no vote option.
+    No,
+    // NoWithVeto defines a no with veto vote option.
+    NoWithVeto
+}
+
+/// @dev Exec enumerates the execution modes of a group proposal.
+enum Exec {
+    // Unspecified defines that the proposal is not executed immediately.
+    Unspecified,
+    // Try defines that the proposal is executed immediately if it is accepted.
+    Try
+}
+
+/// @dev DecisionPolicyType enumerates the supported decision policies of a group policy.
+enum DecisionPolicyType {
+    // Unspecified defines an invalid decision policy.
+    Unspecified,
+    // Threshold defines a decision policy where a proposal passes when the sum of
+    // the yes votes weights reaches the threshold.
+    Threshold,
+    // Percentage defines a decision policy where a proposal passes when the
+    // percentage of yes votes of the total weight reaches the percentage.
+    Percentage
+}
+
+/// @dev MemberRequest represents a group member to add, update or remove (zero weight).
+struct MemberRequest {
+    address member;
+    string weight;
+    string metadata;
+}
+
+/// @dev DecisionPolicy represents the decision policy of a group policy.
+struct DecisionPolicy {
+    DecisionPolicyType policyType;
+    // value is the threshold weight or the percentage as a decimal string, e.g. "0.5"
+    string value;
+    // votingPeriod is the duration of the voting period in seconds
+    int64 votingPeriod;
+    // minExecutionPeriod is the minimum duration in seconds after the proposal
+    // submission before the proposal can be executed
+    int64 minExecutionPeriod;
+}
+
+/// @dev GroupInfo represents the information of a group.
+struct GroupInfo {
+    uint64 id;
+    string admin;
+    string metadata;
+    uint64 version;
+    string totalWeight;
+    uint64 createdAt;
+}
+
+/// @dev GroupMember represents a member of a group.
+struct GroupMember {
+    uint64 groupId;
+    address member;
+    string weight;
+    string metadata;
+    uint64 addedAt;
+}
+
+/// @dev GroupPolicyInfo represents the information of a group policy.
+struct GroupPolicyInfo {
+    string groupPolicy;
+    uint64 groupId;
+    string admin;
+    string metadata;
+    uint64 version;
+    DecisionPolicy decisionPolicy;
+    uint64 createdAt;
+}
+
+/// @dev TallyResultData represents the tally result of a group proposal.
+struct TallyResultData {
+    string yes;
+    string abstain;
+    string no;
+    string noWithVeto;
+}
+
+/// @dev ProposalData represents a group proposal.
+struct ProposalData {
+    uint64 id;
+    string groupPolicy;
+    string metadata;
+    address[] proposers;
+    uint64 submitTime;
+    uint64 groupVersion;
+    uint64 groupPolicyVersion;
+    uint8 status;
+    TallyResultData finalTallyResult;
+    uint64 votingPeriodEnd;
+    uint8 executorResult;
+    string[] messages;
+    string title;
+    string summary;
+}
+
+/// @dev VoteData represents a vote on a group proposal.
+struct VoteData {
+    uint64 proposalId;
+    address voter;
+    VoteOption option;
+    string metadata;
+    uint64 submitTime;
+}
+
+/// @author Evmos Team
+/// @title Group Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the x/group module.
+/// Group policy accounts are module accounts with 32-byte addresses, so they are represented
+/// by their bech32 address.
+interface IGroup {
+    /// @dev CreateGroup defines an Event emitted when a group is created.
+    /// @param admin the address of the group admin
+    /// @param groupId the id of the group
+    event CreateGroup(address indexed admin, uint64 groupId);
+
+    /// @dev UpdateGroupMembers defines an Event emitted when the members of a group are updated.
+    /// @param admin the address of the group admin
+    /// @param groupId the id of the group
+    event UpdateGroupMembers(address indexed admin, uint64 groupId);
+
+    /// @dev CreateGroupPolicy defines an Event emitted when a group policy is created.
+    /// @param admin the address of the group admin
+    /// @param groupId the id of the group
+    /// @param groupPolicy the bech32 address of the group policy
+    event CreateGroupPolicy(address indexed admin, uint64 groupId, string groupPolicy);
+
+    /// @dev SubmitProposal defines an Event emitted when a proposal is submitted.
+    /// @param proposer the address of the proposer
+    /// @param proposalId the id of the proposal
+    event SubmitProposal(address indexed proposer, uint64 proposalId);
+
+    /// @dev WithdrawProposal defines an Event emitted when a proposal is withdrawn.
+    /// @param proposer the address of the proposer or group policy admin
+    /// @param proposalId the id of the proposal
+    event WithdrawProposal(address indexed proposer, uint64 proposalId);
+
+    /// @dev Vote defines an Event emitted when a proposal is voted.
+    /// @param voter the address of the voter
+    /// @param proposalId the id of the proposal
+    /// @param option the vote option
+    event Vote(address indexed voter, uint64 proposalId, uint8 option);
+
+    /// @dev Exec defines an Event emitted when a proposal is executed.
+    /// @param executor the address of the executor
+    /// @param proposalId the id of the proposal
+    /// @param result the executor result of the proposal
+    event Exec(address indexed executor, uint64 proposalId, uint8 result);
+
+    /// TRANSACTIONS
+
+    /// @dev createGroup defines a method to create a group administered by the admin.
+    /// @param admin the address of the group admin
+    /// @param members the members of the group
+    /// @param metadata the metadata of the group
+    /// @return groupId the id of the group
+    function createGroup(
+        address admin,
+        MemberRequest[] calldata members,
+        string calldata metadata
+    ) external returns (uint64 groupId);
+
+    /// @dev updateGroupMembers defines a method to add, update or remove (zero weight) group members.
+    /// @param admin the address of the group admin
+    /// @param groupId the id of the group
+    /// @param memberUpdates the members to update
+    /// @return success Whether the transaction was successful or not
+    function updateGroupMembers(
+        address admin,
+        uint64 groupId,
+        MemberRequest[] calldata memberUpdates
+    ) external returns (bool success);
+
+    /// @dev createGroupPolicy defines a method to create a group policy account of a group.
+    /// @param admin the address of the group admin
+    /// @param groupId the id of the group
+    /// @param metadata the metadata of the group policy
+    /// @param decisionPolicy the decision policy of the group policy
+    /// @return groupPolicy the bech32 address of the group policy
+    function createGroupPolicy(
+        address admin,
+        uint64 groupId,
+        string calldata metadata,
+        DecisionPolicy calldata decisionPolicy
+    ) external returns (string memory groupPolicy);
+
+    /// @dev createGroupWithPolicy defines a method to create a group and a group policy account.
+    /// @param admin the address of the group and group policy admin
+    /// @param members the members of the group
+    /// @param groupMetadata the metadata of the group
+    /// @param groupPolicyMetadata the metadata of the group policy
+    /// @param groupPolicyAsAdmin whether the group policy is set as the admin of the group and the group policy
+    /// @param decisionPolicy the decision policy of the group policy
+    /// @return groupId the id of the group
+    /// @return groupPolicy the bech32 address of the group policy
+    function createGroupWithPolicy(
+        address admin,
+        MemberRequest[] calldata members,
+        string calldata groupMetadata,
+        string calldata groupPolicyMetadata,
+        bool groupPolicyAsAdmin,
+        DecisionPolicy calldata decisionPolicy
+    ) external returns (uint64 groupId, string memory groupPolicy);
+
+    /// @notice submitProposal creates a new group proposal from a protoJSON document,
+    /// e.g. {"messages": [...], "metadata": "", "title": "", "summary": ""}.
+    /// @dev submitProposal defines a method to submit a proposal to a group policy.
+    /// @param proposer the address of the proposer, which must be a group member
+    /// @param groupPolicy the bech32 address of the group policy
+    /// @param jsonProposal the JSON proposal
+    /// @param exec the execution mode of the proposal
+    /// @return proposalId the id of the proposal
+    function submitProposal(
+        address proposer,
+        string calldata groupPolicy,
+        bytes calldata jsonProposal,
+        Exec exec
+    ) external returns (uint64 proposalId);
+
+    /// @dev withdrawProposal defines a method to withdraw a proposal.
+    /// @param proposer the address of the proposer or group policy admin
+    /// @param proposalId the id of the proposal
+    /// @return success Whether the transaction was successful or not
+    function withdrawProposal(
+        address proposer,
+        uint64 proposalId
+    ) external returns (bool success);
+
+    /// @dev vote defines a method to vote on a proposal.
+    /// @param voter the address of the voter, which must be a group member
+    /// @param proposalId the id of the proposal
+    /// @param option the vote option
+    /// @param metadata the metadata of the vote
+    /// @param exec the execution mode of the proposal
+    /// @return success Whether the transaction was successful or not
+    function vote(
+        address voter,
+        uint64 proposalId,
+        VoteOption option,
+        string calldata metadata,
+        Exec exec
+    ) external returns (bool success);
+
+    /// @dev exec defines a method to execute an accepted proposal.
+    /// @param executor the address of the executor
+    /// @param proposalId the id of the proposal
+    /// @return result the executor result of the proposal
+    function exec(
+        address executor,
+        uint64 proposalId
+    ) external returns (uint8 result);
+
+    /// QUERIES
+
+    /// @dev getGroupInfo returns the information of a group.
+    /// @param groupId the id of the group
+    /// @return groupInfo the information of the group
+    function getGroupInfo(
+        uint64 groupId
+    ) external view returns (GroupInfo memory groupInfo);
+
+    /// @dev getGroupMembers returns the members of a group.
+    /// @param groupId the id of the group
+    /// @param pagination the pagination options
+    /// @return members the members of the group
+    /// @return pageResponse the pagination information
+    function getGroupMembers(
+        uint64 groupId,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GroupMember[] memory members, PageResponse memory pageResponse);
+
+    /// @dev getGroupPolicyInfo returns the information of a group policy.
+    /// @param groupPolicy the bech32 address of the group policy
+    /// @return groupPolicyInfo the information of the group policy
+    function getGroupPolicyInfo(
+        string calldata groupPolicy
+    ) external view returns (GroupPolicyInfo memory groupPolicyInfo);
+
+    /// @dev getProposal returns a group proposal.
+    /// @param proposalId the id of the proposal
+    /// @return proposal the proposal data
+    function getProposal(
+        uint64 proposalId
+    ) external view returns (ProposalData memory proposal);
+
+    /// @dev getTallyResult returns the tally of the votes of a proposal.
+    /// @param proposalId the id of the proposal
+    /// @return tallyResult the tally result of the proposal
+    function getTallyResult(
+        uint64 proposalId
+    ) external view returns (TallyResultData memory tallyResult);
+
+    /// @dev getVote returns the vote of a voter on a proposal.
+    /// @param proposalId the id of the proposal
+    /// @param voter the address of the voter
+    /// @return vote the vote of the voter
+    function getVote(
+        uint64 proposalId,
+        address voter
+    ) external view returns (VoteData memory vote);
+}
@@ -0,0 +1,32 @@
+package group
+
+const (
+	// ErrInvalidAdmin is raised when the admin address is not valid.
+	ErrInvalidAdmin = "invalid admin address: %s"
+	// ErrInvalidProposer is raised when the proposer address is not valid.
+	ErrInvalidProposer = "invalid proposer address: %s"
+	// ErrInvalidVoter is raised when the voter address is not valid.
+	ErrInvalidVoter = "invalid voter address: %s"
+	// ErrInvalidExecutor is raised when the executor address is not valid.
+	ErrInvalidExecutor = "invalid executor address: %s"
+	// ErrInvalidMember is raised when a member address is not valid.
+	ErrInvalidMember = "invalid member address: %s"
+	// ErrInvalidGroupID is raised when the group id is not valid.
+	ErrInvalidGroupID = "invalid group id: %v"
+	// ErrInvalidProposalID is raised when the proposal id is not valid.
+	ErrInvalidProposalID = "invalid proposal id: %v"
+	// ErrInvalidGroupPolicy is raised when the group policy address is not valid.
+	ErrInvalidGroupPolicy = "invalid group policy address: %v"
+	// ErrInvalidDecisionPolicy is raised when the decision policy type is not supported.
+	ErrInvalidDecisionPolicy = "invalid decision policy type: %d"
+	// ErrInvalidProposalJSON is raised when the proposal JSON is not valid.
+	ErrInvalidProposalJSON = "invalid proposal json: %s"
+	// ErrInvalidOption is raised when the vote option is not valid.
+	ErrInvalidOption = "invalid vote option: %v"
+	// ErrInvalidExec is raised when the execution mode is not valid.
+	ErrInvalidExec = "invalid exec mode: %v"
+	// ErrInvalidMetadata is raised when the metadata is not valid.
+	ErrInvalidMetadata = "invalid metadata: %v"
+	// ErrNotEVMAddress is raised when an account address cannot be represented as an EVM address.
+	ErrNotEVMAddress = "account %s is not a 20-byte address"
+)
@@ -0,0 +1,253 @@
+package group
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeCreateGroup defines the event type for the group CreateGroupMethod transaction.
+	EventTypeCreateGroup = "CreateGroup"
+	// EventTypeUpdateGroupMembers defines the event type for the group UpdateGroupMembersMethod transaction.
+	EventTypeUpdateGroupMembers = "UpdateGroupMembers"
+	// EventTypeCreateGroupPolicy defines the event type for the group CreateGroupPolicyMethod and CreateGroupWithPolicyMethod transactions.
+	EventTypeCreateGroupPolicy = "CreateGroupPolicy"
+	// EventTypeSubmitProposal defines the event type for the group SubmitProposalMethod transaction.
+	EventTypeSubmitProposal = "SubmitProposal"
+	// EventTypeWithdrawProposal defines the event type for the group WithdrawProposalMethod transaction.
+	EventTypeWithdrawProposal = "WithdrawProposal"
+	// EventTypeVote defines the event type for the group VoteMethod transaction.
+	EventTypeVote = "Vote"
+	// EventTypeExec defines the event type for the group ExecMethod transaction.
+	EventTypeExec = "Exec"
+)
+
+// EmitCreateGroupEvent creates a new event emitted on a CreateGroup transaction.
+func (p Precompile) EmitCreateGroupEvent(ctx sdk.Context, stateDB vm.StateDB, adminAddress common.Address, groupID uint64) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeCreateGroup]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(adminAddress)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(groupID)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitUpdateGroupMembersEvent creates a new event emitted on an UpdateGroupMembers transaction.
+func (p Precompile) EmitUpdateGroupMembersEvent(ctx sdk.Context, stateDB vm.StateDB, adminAddress common.Address, groupID uint64) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeUpdateGroupMembers]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(adminAddress)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(groupID)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitCreateGroupPolicyEvent creates a new event emitted on a CreateGroupPolicy or CreateGroupWithPolicy transaction.
+func (p Precompile) EmitCreateGroupPolicyEvent(ctx sdk.Context, stateDB vm.StateDB, adminAddress common.Address, groupID uint64, groupPolicy string) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeCreateGroupPolicy]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(adminAddress)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(groupID, groupPolicy)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitSubmitProposalEvent creates a new event emitted on a SubmitProposal transaction.
+func (p Precompile) EmitSubmitProposalEvent(ctx sdk.Context, stateDB vm.StateDB, proposerAddress common.Address, proposalID uint64) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeSubmitProposal]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(proposerAddress)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(proposalID)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitWithdrawProposalEvent creates a new event emitted on a WithdrawProposal transaction.
+func (p Precompile) EmitWithdrawProposalEvent(ctx sdk.Context, stateDB vm.StateDB, proposerAddress common.Address, proposalID uint64) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeWithdrawProposal]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(proposerAddress)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(proposalID)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitVoteEvent creates a new event emitted on a Vote transaction.
+func (p Precompile) EmitVoteEvent(ctx sdk.Context, stateDB vm.StateDB, voterAddress common.Address, proposalID uint64, option uint8) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeVote]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(voterAddress)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(proposalID, option)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitExecEvent creates a new event emitted on an Exec transaction.
+func (p Precompile) EmitExecEvent(ctx sdk.Context, stateDB vm.StateDB, executorAddress common.Address, proposalID uint64, result uint8) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeExec]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(executorAddress)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(proposalID, result)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package group
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for group.
+type Precompile struct {
+	cmn.Precompile
+	groupKeeper groupkeeper.Keeper
+	codec       codec.Codec
+	addrCdc     address.Codec
+}
+
+// LoadABI loads the group ABI from the embedded abi.json file
+// for the group precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new group Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	groupKeeper groupkeeper.Keeper,
+	codec codec.Codec,
+	addrCdc address.Codec,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		groupKeeper: groupKeeper,
+		codec:       codec,
+		addrCdc:     addrCdc,
+	}
+
+	// SetAddress defines the address of the group precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.GroupPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the precompiled contract group methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	bz, err = p.run(evm, contract, readOnly)
+	if err != nil {
+		return cmn.ReturnRevertError(evm, err)
+	}
+
+	return bz, nil
+}
+
+func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start the balance change handler before executing the precompile.
+	p.GetBalanceHandler().BeforeBalanceChange(ctx)
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// group transactions
+	case CreateGroupMethod:
+		bz, err = p.CreateGroup(ctx, contract, stateDB, method, args)
+	case UpdateGroupMembersMethod:
+		bz, err = p.UpdateGroupMembers(ctx, contract, stateDB, method, args)
+	case CreateGroupPolicyMethod:
+		bz, err = p.CreateGroupPolicy(ctx, contract, stateDB, method, args)
+	case CreateGroupWithPolicyMethod:
+		bz, err = p.CreateGroupWithPolicy(ctx, contract, stateDB, method, args)
+	case SubmitProposalMethod:
+		bz, err = p.SubmitProposal(ctx, contract, stateDB, method, args)
+	case WithdrawProposalMethod:
+		bz, err = p.WithdrawProposal(ctx, contract, stateDB, method, args)
+	case VoteMethod:
+		bz, err = p.Vote(ctx, contract, stateDB, method, args)
+	case ExecMethod:
+		bz, err = p.Exec(ctx, contract, stateDB, method, args)
+
+	// group queries
+	case GetGroupInfoMethod:
+		bz, err = p.GetGroupInfo(ctx, method, contract, args)
+	case GetGroupMembersMethod:
+		bz, err = p.GetGroupMembers(ctx, method, contract, args)
+	case GetGroupPolicyInfoMethod:
+		bz, err = p.GetGroupPolicyInfo(ctx, method, contract, args)
+	case GetProposalMethod:
+		bz, err = p.GetProposal(ctx, method, contract, args)
+	case GetTallyResultMethod:
+		bz, err = p.GetTallyResult(ctx, method, contract, args)
+	case GetVoteMethod:
+		bz, err = p.GetVote(ctx, method, contract, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost, nil, tracing.GasChangeCallPrecompiledContract) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	// Process the native balance changes after the method execution.
+	err = p.GetBalanceHandler().AfterBalanceChange(ctx, stateDB)
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case CreateGroupMethod, UpdateGroupMembersMethod,
+		CreateGroupPolicyMethod, CreateGroupWithPolicyMethod,
+		SubmitProposalMethod, WithdrawProposalMethod,
+		VoteMethod, ExecMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "group")
+}
@@ -0,0 +1,162 @@
+package group
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GetGroupInfoMethod defines the method name for the group info precompile request.
+	GetGroupInfoMethod = "getGroupInfo"
+	// GetGroupMembersMethod defines the method name for the group members precompile request.
+	GetGroupMembersMethod = "getGroupMembers"
+	// GetGroupPolicyInfoMethod defines the method name for the group policy info precompile request.
+	GetGroupPolicyInfoMethod = "getGroupPolicyInfo"
+	// GetProposalMethod defines the method name for the proposal precompile request.
+	GetProposalMethod = "getProposal"
+	// GetTallyResultMethod defines the method name for the tally result precompile request.
+	GetTallyResultMethod = "getTallyResult"
+	// GetVoteMethod defines the method name for the vote precompile request.
+	GetVoteMethod = "getVote"
+)
+
+// GetGroupInfo implements the query logic for getting the information of a group.
+func (p *Precompile) GetGroupInfo(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGroupInfoArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.groupKeeper.GroupInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output := new(GroupInfoOutput).FromResponse(res)
+	return method.Outputs.Pack(output.GroupInfo)
+}
+
+// GetGroupMembers implements the query logic for getting the members of a group.
+func (p *Precompile) GetGroupMembers(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGroupMembersArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.groupKeeper.GroupMembers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GroupMembersOutput).FromResponse(res, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.Members, output.PageResponse)
+}
+
+// GetGroupPolicyInfo implements the query logic for getting the information of a group policy.
+func (p *Precompile) GetGroupPolicyInfo(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGroupPolicyInfoArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.groupKeeper.GroupPolicyInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GroupPolicyInfoOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.GroupPolicyInfo)
+}
+
+// GetProposal implements the query logic for getting a group proposal.
+func (p *Precompile) GetProposal(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseProposalArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.groupKeeper.Proposal(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(ProposalOutput).FromResponse(res, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.Proposal)
+}
+
+// GetTallyResult implements the query logic for getting the tally of the votes of a
+// group proposal.
+func (p *Precompile) GetTallyResult(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseTallyResultArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.groupKeeper.TallyResult(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output := new(TallyResultOutput).FromResponse(res)
+	return method.Outputs.Pack(output.TallyResult)
+}
+
+// GetVote implements the query logic for getting the vote of a voter on a group proposal.
+func (p *Precompile) GetVote(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseVoteArgs(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.groupKeeper.VoteByProposalVoter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(VoteOutput).FromResponse(res, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(output.Vote)
+}
@@ -0,0 +1,275 @@
+package group
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// CreateGroupMethod defines the ABI method name for the group CreateGroup transaction.
+	CreateGroupMethod = "createGroup"
+	// UpdateGroupMembersMethod defines the ABI method name for the group UpdateGroupMembers transaction.
+	UpdateGroupMembersMethod = "updateGroupMembers"
+	// CreateGroupPolicyMethod defines the ABI method name for the group CreateGroupPolicy transaction.
+	CreateGroupPolicyMethod = "createGroupPolicy"
+	// CreateGroupWithPolicyMethod defines the ABI method name for the group CreateGroupWithPolicy transaction.
+	CreateGroupWithPolicyMethod = "createGroupWithPolicy"
+	// SubmitProposalMethod defines the ABI method name for the group SubmitProposal transaction.
+	SubmitProposalMethod = "submitProposal"
+	// WithdrawProposalMethod defines the ABI method name for the group WithdrawProposal transaction.
+	WithdrawProposalMethod = "withdrawProposal"
+	// VoteMethod defines the ABI method name for the group Vote transaction.
+	VoteMethod = "vote"
+	// ExecMethod defines the ABI method name for the group Exec transaction.
+	ExecMethod = "exec"
+)
+
+// CreateGroup defines a method to create a group.
+func (p *Precompile) CreateGroup(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, adminHexAddr, err := NewMsgCreateGroup(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != adminHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), adminHexAddr.String())
+	}
+
+	res, err := p.groupKeeper.CreateGroup(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitCreateGroupEvent(ctx, stateDB, adminHexAddr, res.GroupId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.GroupId)
+}
+
+// UpdateGroupMembers defines a method to add, update or remove the members of a group.
+func (p *Precompile) UpdateGroupMembers(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, adminHexAddr, err := NewMsgUpdateGroupMembers(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != adminHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), adminHexAddr.String())
+	}
+
+	_, err = p.groupKeeper.UpdateGroupMembers(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitUpdateGroupMembersEvent(ctx, stateDB, adminHexAddr, msg.GroupId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CreateGroupPolicy defines a method to create a group policy account.
+func (p *Precompile) CreateGroupPolicy(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, adminHexAddr, err := NewMsgCreateGroupPolicy(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != adminHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), adminHexAddr.String())
+	}
+
+	res, err := p.groupKeeper.CreateGroupPolicy(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitCreateGroupPolicyEvent(ctx, stateDB, adminHexAddr, msg.GroupId, res.Address); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Address)
+}
+
+// CreateGroupWithPolicy defines a method to create a group together with a group policy account.
+func (p *Precompile) CreateGroupWithPolicy(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, adminHexAddr, err := NewMsgCreateGroupWithPolicy(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != adminHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), adminHexAddr.String())
+	}
+
+	res, err := p.groupKeeper.CreateGroupWithPolicy(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitCreateGroupEvent(ctx, stateDB, adminHexAddr, res.GroupId); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitCreateGroupPolicyEvent(ctx, stateDB, adminHexAddr, res.GroupId, res.GroupPolicyAddress); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.GroupId, res.GroupPolicyAddress)
+}
+
+// SubmitProposal defines a method to submit a proposal to a group policy.
+func (p *Precompile) SubmitProposal(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgSubmitProposal(args, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != proposerHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), proposerHexAddr.String())
+	}
+
+	res, err := p.groupKeeper.SubmitProposal(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitSubmitProposalEvent(ctx, stateDB, proposerHexAddr, res.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ProposalId)
+}
+
+// WithdrawProposal defines a method to withdraw a proposal.
+func (p *Precompile) WithdrawProposal(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgWithdrawProposal(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != proposerHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), proposerHexAddr.String())
+	}
+
+	_, err = p.groupKeeper.WithdrawProposal(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitWithdrawProposalEvent(ctx, stateDB, proposerHexAddr, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Vote defines a method to vote on a proposal.
+func (p *Precompile) Vote(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, voterHexAddr, err := NewMsgVote(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != voterHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), voterHexAddr.String())
+	}
+
+	_, err = p.groupKeeper.Vote(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitVoteEvent(ctx, stateDB, voterHexAddr, msg.ProposalId, uint8(msg.Option)); err != nil { //nolint:gosec // G115
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec defines a method to execute an accepted proposal.
+func (p *Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, executorHexAddr, err := NewMsgExec(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != executorHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), executorHexAddr.String())
+	}
+
+	res, err := p.groupKeeper.Exec(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitExecEvent(ctx, stateDB, executorHexAddr, msg.ProposalId, uint8(res.Result)); err != nil { //nolint:gosec // G115
+		return nil, err
+	}
+
+	return method.Outputs.Pack(uint8(res.Result)) //nolint:gosec // G115
+}
@@ -0,0 +1,839 @@
+package group
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/core/address"
+	sdkerrors "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+const (
+	// DecisionPolicyTypeThreshold defines the threshold decision policy type.
+	DecisionPolicyTypeThreshold uint8 = 1
+	// DecisionPolicyTypePercentage defines the percentage decision policy type.
+	DecisionPolicyTypePercentage uint8 = 2
+)
+
+// EventCreateGroup defines the event data for the CreateGroup transaction.
+type EventCreateGroup struct {
+	Admin   common.Address
+	GroupId uint64 //nolint:revive
+}
+
+// EventUpdateGroupMembers defines the event data for the UpdateGroupMembers transaction.
+type EventUpdateGroupMembers struct {
+	Admin   common.Address
+	GroupId uint64 //nolint:revive
+}
+
+// EventCreateGroupPolicy defines the event data for the CreateGroupPolicy
+// and CreateGroupWithPolicy transactions.
+type EventCreateGroupPolicy struct {
+	Admin       common.Address
+	GroupId     uint64 //nolint:revive
+	GroupPolicy string
+}
+
+// EventSubmitProposal defines the event data for the SubmitProposal transaction.
+type EventSubmitProposal struct {
+	Proposer   common.Address
+	ProposalId uint64 //nolint:revive
+}
+
+// EventWithdrawProposal defines the event data for the WithdrawProposal transaction.
+type EventWithdrawProposal struct {
+	Proposer   common.Address
+	ProposalId uint64 //nolint:revive
+}
+
+// EventVote defines the event data for the Vote transaction.
+type EventVote struct {
+	Voter      common.Address
+	ProposalId uint64 //nolint:revive
+	Option     uint8
+}
+
+// EventExec defines the event data for the Exec transaction.
+type EventExec struct {
+	Executor   common.Address
+	ProposalId uint64 //nolint:revive
+	Result     uint8
+}
+
+// MemberRequest represents a group member to add, update or remove.
+type MemberRequest struct {
+	Member   common.Address `abi:"member"`
+	Weight   string         `abi:"weight"`
+	Metadata string         `abi:"metadata"`
+}
+
+// DecisionPolicy represents the decision policy of a group policy.
+type DecisionPolicy struct {
+	PolicyType         uint8  `abi:"policyType"`
+	Value              string `abi:"value"`
+	VotingPeriod       int64  `abi:"votingPeriod"`
+	MinExecutionPeriod int64  `abi:"minExecutionPeriod"`
+}
+
+// CreateGroupInput defines the input for the CreateGroup transaction.
+type CreateGroupInput struct {
+	Admin    common.Address
+	Members  []MemberRequest
+	Metadata string
+}
+
+// UpdateGroupMembersInput defines the input for the UpdateGroupMembers transaction.
+type UpdateGroupMembersInput struct {
+	Admin         common.Address
+	GroupId       uint64 //nolint:revive
+	MemberUpdates []MemberRequest
+}
+
+// CreateGroupPolicyInput defines the input for the CreateGroupPolicy transaction.
+type CreateGroupPolicyInput struct {
+	Admin          common.Address
+	GroupId        uint64 //nolint:revive
+	Metadata       string
+	DecisionPolicy DecisionPolicy
+}
+
+// CreateGroupWithPolicyInput defines the input for the CreateGroupWithPolicy transaction.
+type CreateGroupWithPolicyInput struct {
+	Admin               common.Address
+	Members             []MemberRequest
+	GroupMetadata       string
+	GroupPolicyMetadata string
+	GroupPolicyAsAdmin  bool
+	DecisionPolicy      DecisionPolicy
+}
+
+// GroupMembersInput defines the input for the GroupMembers query.
+type GroupMembersInput struct {
+	GroupId    uint64 //nolint:revive
+	Pagination query.PageRequest
+}
+
+// GroupInfo represents the information of a group.
+type GroupInfo struct {
+	Id          uint64 `abi:"id"` //nolint
+	Admin       string `abi:"admin"`
+	Metadata    string `abi:"metadata"`
+	Version     uint64 `abi:"version"`
+	TotalWeight string `abi:"totalWeight"`
+	CreatedAt   uint64 `abi:"createdAt"`
+}
+
+// GroupInfoOutput defines the output for the GroupInfo query.
+type GroupInfoOutput struct {
+	GroupInfo GroupInfo
+}
+
+// GroupMember represents a member of a group.
+type GroupMember struct {
+	GroupId  uint64         `abi:"groupId"` //nolint:revive
+	Member   common.Address `abi:"member"`
+	Weight   string         `abi:"weight"`
+	Metadata string         `abi:"metadata"`
+	AddedAt  uint64         `abi:"addedAt"`
+}
+
+// GroupMembersOutput defines the output for the GroupMembers query.
+type GroupMembersOutput struct {
+	Members      []GroupMember      `abi:"members"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// GroupPolicyInfo represents the information of a group policy.
+type GroupPolicyInfo struct {
+	GroupPolicy    string         `abi:"groupPolicy"`
+	GroupId        uint64         `abi:"groupId"` //nolint:revive
+	Admin          string         `abi:"admin"`
+	Metadata       string         `abi:"metadata"`
+	Version        uint64         `abi:"version"`
+	DecisionPolicy DecisionPolicy `abi:"decisionPolicy"`
+	CreatedAt      uint64         `abi:"createdAt"`
+}
+
+// GroupPolicyInfoOutput defines the output for the GroupPolicyInfo query.
+type GroupPolicyInfoOutput struct {
+	GroupPolicyInfo GroupPolicyInfo
+}
+
+// TallyResultData represents the tally result of a group proposal.
+type TallyResultData struct {
+	Yes        string
+	Abstain    string
+	No         string
+	NoWithVeto string
+}
+
+// TallyResultOutput defines the output for the TallyResult query.
+type TallyResultOutput struct {
+	TallyResult TallyResultData
+}
+
+// ProposalData represents a group proposal.
+type ProposalData struct {
+	Id                 uint64           `abi:"id"` //nolint
+	GroupPolicy        string           `abi:"groupPolicy"`
+	Metadata           string           `abi:"metadata"`
+	Proposers          []common.Address `abi:"proposers"`
+	SubmitTime         uint64           `abi:"submitTime"`
+	GroupVersion       uint64           `abi:"groupVersion"`
+	GroupPolicyVersion uint64           `abi:"groupPolicyVersion"`
+	Status             uint8            `abi:"status"`
+	FinalTallyResult   TallyResultData  `abi:"finalTallyResult"`
+	VotingPeriodEnd    uint64           `abi:"votingPeriodEnd"`
+	ExecutorResult     uint8            `abi:"executorResult"`
+	Messages           []string         `abi:"messages"`
+	Title              string           `abi:"title"`
+	Summary            string           `abi:"summary"`
+}
+
+// ProposalOutput defines the output for the Proposal query.
+type ProposalOutput struct {
+	Proposal ProposalData
+}
+
+// VoteData represents a vote on a group proposal.
+type VoteData struct {
+	ProposalId uint64         `abi:"proposalId"` //nolint:revive
+	Voter      common.Address `abi:"voter"`
+	Option     uint8          `abi:"option"`
+	Metadata   string         `abi:"metadata"`
+	SubmitTime uint64         `abi:"submitTime"`
+}
+
+// VoteOutput defines the output for the Vote query.
+type VoteOutput struct {
+	Vote VoteData
+}
+
+// NewMsgCreateGroup creates a new MsgCreateGroup instance.
+func NewMsgCreateGroup(method *abi.Method, args []interface{}, addrCdc address.Codec) (*group.MsgCreateGroup, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input CreateGroupInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to CreateGroupInput: %s", err)
+	}
+
+	admin, err := bech32FromHex(addrCdc, input.Admin, ErrInvalidAdmin)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	members, err := NewMemberRequests(input.Members, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &group.MsgCreateGroup{
+		Admin:    admin,
+		Members:  members,
+		Metadata: input.Metadata,
+	}
+
+	return msg, input.Admin, nil
+}
+
+// NewMsgUpdateGroupMembers creates a new MsgUpdateGroupMembers instance.
+func NewMsgUpdateGroupMembers(method *abi.Method, args []interface{}, addrCdc address.Codec) (*group.MsgUpdateGroupMembers, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	var input UpdateGroupMembersInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to UpdateGroupMembersInput: %s", err)
+	}
+
+	admin, err := bech32FromHex(addrCdc, input.Admin, ErrInvalidAdmin)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	members, err := NewMemberRequests(input.MemberUpdates, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &group.MsgUpdateGroupMembers{
+		Admin:         admin,
+		GroupId:       input.GroupId,
+		MemberUpdates: members,
+	}
+
+	return msg, input.Admin, nil
+}
+
+// NewMsgCreateGroupPolicy creates a new MsgCreateGroupPolicy instance.
+func NewMsgCreateGroupPolicy(method *abi.Method, args []interface{}, addrCdc address.Codec) (*group.MsgCreateGroupPolicy, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input CreateGroupPolicyInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to CreateGroupPolicyInput: %s", err)
+	}
+
+	admin, err := bech32FromHex(addrCdc, input.Admin, ErrInvalidAdmin)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	policy, err := NewDecisionPolicy(input.DecisionPolicy)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &group.MsgCreateGroupPolicy{
+		Admin:    admin,
+		GroupId:  input.GroupId,
+		Metadata: input.Metadata,
+	}
+	if err := msg.SetDecisionPolicy(policy); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Admin, nil
+}
+
+// NewMsgCreateGroupWithPolicy creates a new MsgCreateGroupWithPolicy instance.
+func NewMsgCreateGroupWithPolicy(method *abi.Method, args []interface{}, addrCdc address.Codec) (*group.MsgCreateGroupWithPolicy, common.Address, error) {
+	if len(args) != 6 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	var input CreateGroupWithPolicyInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to CreateGroupWithPolicyInput: %s", err)
+	}
+
+	admin, err := bech32FromHex(addrCdc, input.Admin, ErrInvalidAdmin)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	members, err := NewMemberRequests(input.Members, addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	policy, err := NewDecisionPolicy(input.DecisionPolicy)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &group.MsgCreateGroupWithPolicy{
+		Admin:               admin,
+		Members:             members,
+		GroupMetadata:       input.GroupMetadata,
+		GroupPolicyMetadata: input.GroupPolicyMetadata,
+		GroupPolicyAsAdmin:  input.GroupPolicyAsAdmin,
+	}
+	if err := msg.SetDecisionPolicy(policy); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Admin, nil
+}
+
+// NewMsgSubmitProposal constructs a MsgSubmitProposal from a JSON proposal with the
+// same envelope as the gov precompile, i.e. {"messages", "metadata", "title", "summary"}.
+// args: [proposerAddress, groupPolicy, jsonBlob, exec]
+func NewMsgSubmitProposal(args []interface{}, cdc codec.Codec, addrCdc address.Codec) (*group.MsgSubmitProposal, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	proposer, ok := args[0].(common.Address)
+	if !ok || proposer == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, args[0])
+	}
+
+	groupPolicy, ok := args[1].(string)
+	if !ok || groupPolicy == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGroupPolicy, args[1])
+	}
+
+	jsonBlob, ok := args[2].([]byte)
+	if !ok || len(jsonBlob) == 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalJSON, "jsonBlob arg")
+	}
+
+	exec, ok := args[3].(uint8)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidExec, args[3])
+	}
+
+	var prop struct {
+		Messages []json.RawMessage `json:"messages"`
+		Metadata string            `json:"metadata"`
+		Title    string            `json:"title"`
+		Summary  string            `json:"summary"`
+	}
+	if err := json.Unmarshal(jsonBlob, &prop); err != nil {
+		return nil, common.Address{}, sdkerrors.Wrap(err, "invalid proposal JSON")
+	}
+
+	anys := make([]*codectypes.Any, len(prop.Messages))
+	for i, m := range prop.Messages {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(m, &msg); err != nil {
+			return nil, common.Address{}, sdkerrors.Wrapf(err, "message %d", i)
+		}
+		anyVal, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+		anys[i] = anyVal
+	}
+
+	proposerAddr, err := bech32FromHex(addrCdc, proposer, ErrInvalidProposer)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &group.MsgSubmitProposal{
+		GroupPolicyAddress: groupPolicy,
+		Proposers:          []string{proposerAddr},
+		Metadata:           prop.Metadata,
+		Messages:           anys,
+		Exec:               group.Exec(exec),
+		Title:              prop.Title,
+		Summary:            prop.Summary,
+	}
+
+	return msg, proposer, nil
+}
+
+// NewMsgWithdrawProposal creates a new MsgWithdrawProposal instance.
+// args: [proposerAddress, proposalID]
+func NewMsgWithdrawProposal(args []interface{}, addrCdc address.Codec) (*group.MsgWithdrawProposal, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	proposer, ok := args[0].(common.Address)
+	if !ok || proposer == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, args[0])
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	proposerAddr, err := bech32FromHex(addrCdc, proposer, ErrInvalidProposer)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return &group.MsgWithdrawProposal{
+		ProposalId: proposalID,
+		Address:    proposerAddr,
+	}, proposer, nil
+}
+
+// NewMsgVote creates a new MsgVote instance.
+// args: [voterAddress, proposalID, option, metadata, exec]
+func NewMsgVote(args []interface{}, addrCdc address.Codec) (*group.MsgVote, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	voter, ok := args[0].(common.Address)
+	if !ok || voter == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidVoter, args[0])
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	option, ok := args[2].(uint8)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidOption, args[2])
+	}
+
+	metadata, ok := args[3].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMetadata, args[3])
+	}
+
+	exec, ok := args[4].(uint8)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidExec, args[4])
+	}
+
+	voterAddr, err := bech32FromHex(addrCdc, voter, ErrInvalidVoter)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return &group.MsgVote{
+		ProposalId: proposalID,
+		Voter:      voterAddr,
+		Option:     group.VoteOption(option),
+		Metadata:   metadata,
+		Exec:       group.Exec(exec),
+	}, voter, nil
+}
+
+// NewMsgExec creates a new MsgExec instance.
+// args: [executorAddress, proposalID]
+func NewMsgExec(args []interface{}, addrCdc address.Codec) (*group.MsgExec, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	executor, ok := args[0].(common.Address)
+	if !ok || executor == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidExecutor, args[0])
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	executorAddr, err := bech32FromHex(addrCdc, executor, ErrInvalidExecutor)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return &group.MsgExec{
+		ProposalId: proposalID,
+		Executor:   executorAddr,
+	}, executor, nil
+}
+
+// NewMemberRequests converts the ABI member requests to the group module member requests.
+func NewMemberRequests(members []MemberRequest, addrCdc address.Codec) ([]group.MemberRequest, error) {
+	res := make([]group.MemberRequest, len(members))
+	for i, m := range members {
+		member, err := bech32FromHex(addrCdc, m.Member, ErrInvalidMember)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = group.MemberRequest{
+			Address:  member,
+			Weight:   m.Weight,
+			Metadata: m.Metadata,
+		}
+	}
+	return res, nil
+}
+
+// NewDecisionPolicy converts the ABI decision policy to a group module decision policy.
+// The voting and minimum execution periods are expressed in seconds.
+func NewDecisionPolicy(policy DecisionPolicy) (group.DecisionPolicy, error) {
+	votingPeriod := time.Duration(policy.VotingPeriod) * time.Second
+	minExecutionPeriod := time.Duration(policy.MinExecutionPeriod) * time.Second
+
+	switch policy.PolicyType {
+	case DecisionPolicyTypeThreshold:
+		return group.NewThresholdDecisionPolicy(policy.Value, votingPeriod, minExecutionPeriod), nil
+	case DecisionPolicyTypePercentage:
+		return group.NewPercentageDecisionPolicy(policy.Value, votingPeriod, minExecutionPeriod), nil
+	default:
+		return nil, fmt.Errorf(ErrInvalidDecisionPolicy, policy.PolicyType)
+	}
+}
+
+// ParseGroupInfoArgs parses the arguments for the GroupInfo query.
+func ParseGroupInfoArgs(args []interface{}) (*group.QueryGroupInfoRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	groupID, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidGroupID, args[0])
+	}
+
+	return &group.QueryGroupInfoRequest{
+		GroupId: groupID,
+	}, nil
+}
+
+// ParseGroupMembersArgs parses the arguments for the GroupMembers query.
+func ParseGroupMembersArgs(method *abi.Method, args []interface{}) (*group.QueryGroupMembersRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GroupMembersInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GroupMembersInput: %s", err)
+	}
+
+	return &group.QueryGroupMembersRequest{
+		GroupId:    input.GroupId,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseGroupPolicyInfoArgs parses the arguments for the GroupPolicyInfo query.
+func ParseGroupPolicyInfoArgs(args []interface{}) (*group.QueryGroupPolicyInfoRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	groupPolicy, ok := args[0].(string)
+	if !ok || groupPolicy == "" {
+		return nil, fmt.Errorf(ErrInvalidGroupPolicy, args[0])
+	}
+
+	return &group.QueryGroupPolicyInfoRequest{
+		Address: groupPolicy,
+	}, nil
+}
+
+// ParseProposalArgs parses the arguments for the Proposal query.
+func ParseProposalArgs(args []interface{}) (*group.QueryProposalRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	proposalID, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidProposalID, args[0])
+	}
+
+	return &group.QueryProposalRequest{
+		ProposalId: proposalID,
+	}, nil
+}
+
+// ParseTallyResultArgs parses the arguments for the TallyResult query.
+func ParseTallyResultArgs(args []interface{}) (*group.QueryTallyResultRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	proposalID, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidProposalID, args[0])
+	}
+
+	return &group.QueryTallyResultRequest{
+		ProposalId: proposalID,
+	}, nil
+}
+
+// ParseVoteArgs parses the arguments for the Vote query.
+func ParseVoteArgs(args []interface{}, addrCdc address.Codec) (*group.QueryVoteByProposalVoterRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	proposalID, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidProposalID, args[0])
+	}
+
+	voter, ok := args[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidVoter, args[1])
+	}
+
+	voterAddr, err := bech32FromHex(addrCdc, voter, ErrInvalidVoter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryVoteByProposalVoterRequest{
+		ProposalId: proposalID,
+		Voter:      voterAddr,
+	}, nil
+}
+
+// FromResponse populates the GroupInfoOutput from a QueryGroupInfoResponse.
+func (o *GroupInfoOutput) FromResponse(res *group.QueryGroupInfoResponse) *GroupInfoOutput {
+	o.GroupInfo = GroupInfo{
+		Id:          res.Info.Id,
+		Admin:       res.Info.Admin,
+		Metadata:    res.Info.Metadata,
+		Version:     res.Info.Version,
+		TotalWeight: res.Info.TotalWeight,
+		CreatedAt:   uint64(res.Info.CreatedAt.Unix()), //nolint:gosec // G115
+	}
+	return o
+}
+
+// FromResponse populates the GroupMembersOutput from a QueryGroupMembersResponse.
+func (o *GroupMembersOutput) FromResponse(res *group.QueryGroupMembersResponse, addrCdc address.Codec) (*GroupMembersOutput, error) {
+	o.Members = make([]GroupMember, len(res.Members))
+	for i, m := range res.Members {
+		member, err := hexFromBech32(addrCdc, m.Member.Address)
+		if err != nil {
+			return nil, err
+		}
+		o.Members[i] = GroupMember{
+			GroupId:  m.GroupId,
+			Member:   member,
+			Weight:   m.Member.Weight,
+			Metadata: m.Member.Metadata,
+			AddedAt:  uint64(m.Member.AddedAt.Unix()), //nolint:gosec // G115
+		}
+	}
+
+	if res.Pagination != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: res.Pagination.NextKey,
+			Total:   res.Pagination.Total,
+		}
+	}
+	return o, nil
+}
+
+// FromResponse populates the GroupPolicyInfoOutput from a QueryGroupPolicyInfoResponse.
+func (o *GroupPolicyInfoOutput) FromResponse(res *group.QueryGroupPolicyInfoResponse) (*GroupPolicyInfoOutput, error) {
+	policy, err := res.Info.GetDecisionPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	var decisionPolicy DecisionPolicy
+	switch p := policy.(type) {
+	case *group.ThresholdDecisionPolicy:
+		decisionPolicy = newDecisionPolicyOutput(DecisionPolicyTypeThreshold, p.Threshold, p.Windows)
+	case *group.PercentageDecisionPolicy:
+		decisionPolicy = newDecisionPolicyOutput(DecisionPolicyTypePercentage, p.Percentage, p.Windows)
+	}
+
+	o.GroupPolicyInfo = GroupPolicyInfo{
+		GroupPolicy:    res.Info.Address,
+		GroupId:        res.Info.GroupId,
+		Admin:          res.Info.Admin,
+		Metadata:       res.Info.Metadata,
+		Version:        res.Info.Version,
+		DecisionPolicy: decisionPolicy,
+		CreatedAt:      uint64(res.Info.CreatedAt.Unix()), //nolint:gosec // G115
+	}
+	return o, nil
+}
+
+// FromResponse populates the ProposalOutput from a QueryProposalResponse.
+func (o *ProposalOutput) FromResponse(res *group.QueryProposalResponse, addrCdc address.Codec) (*ProposalOutput, error) {
+	proposers := make([]common.Address, len(res.Proposal.Proposers))
+	for i, p := range res.Proposal.Proposers {
+		proposer, err := hexFromBech32(addrCdc, p)
+		if err != nil {
+			return nil, err
+		}
+		proposers[i] = proposer
+	}
+
+	msgs := make([]string, len(res.Proposal.Messages))
+	for i, msg := range res.Proposal.Messages {
+		msgs[i] = msg.TypeUrl
+	}
+
+	o.Proposal = ProposalData{
+		Id:                 res.Proposal.Id,
+		GroupPolicy:        res.Proposal.GroupPolicyAddress,
+		Metadata:           res.Proposal.Metadata,
+		Proposers:          proposers,
+		SubmitTime:         uint64(res.Proposal.SubmitTime.Unix()), //nolint:gosec // G115
+		GroupVersion:       res.Proposal.GroupVersion,
+		GroupPolicyVersion: res.Proposal.GroupPolicyVersion,
+		Status:             uint8(res.Proposal.Status), //nolint:gosec // G115
+		FinalTallyResult:   newTallyResultData(res.Proposal.FinalTallyResult),
+		VotingPeriodEnd:    uint64(res.Proposal.VotingPeriodEnd.Unix()), //nolint:gosec // G115
+		ExecutorResult:     uint8(res.Proposal.ExecutorResult),          //nolint:gosec // G115
+		Messages:           msgs,
+		Title:              res.Proposal.Title,
+		Summary:            res.Proposal.Summary,
+	}
+	return o, nil
+}
+
+// FromResponse populates the TallyResultOutput from a QueryTallyResultResponse.
+func (o *TallyResultOutput) FromResponse(res *group.QueryTallyResultResponse) *TallyResultOutput {
+	o.TallyResult = newTallyResultData(res.Tally)
+	return o
+}
+
+// FromResponse populates the VoteOutput from a QueryVoteByProposalVoterResponse.
+func (o *VoteOutput) FromResponse(res *group.QueryVoteByProposalVoterResponse, addrCdc address.Codec) (*VoteOutput, error) {
+	voter, err := hexFromBech32(addrCdc, res.Vote.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	o.Vote = VoteData{
+		ProposalId: res.Vote.ProposalId,
+		Voter:      voter,
+		Option:     uint8(res.Vote.Option), //nolint:gosec // G115
+		Metadata:   res.Vote.Metadata,
+		SubmitTime: uint64(res.Vote.SubmitTime.Unix()), //nolint:gosec // G115
+	}
+	return o, nil
+}
+
+func newTallyResultData(tally group.TallyResult) TallyResultData {
+	return TallyResultData{
+		Yes:        tally.YesCount,
+		Abstain:    tally.AbstainCount,
+		No:         tally.NoCount,
+		NoWithVeto: tally.NoWithVetoCount,
+	}
+}
+
+func newDecisionPolicyOutput(policyType uint8, value string, windows *group.DecisionPolicyWindows) DecisionPolicy {
+	policy := DecisionPolicy{
+		PolicyType: policyType,
+		Value:      value,
+	}
+	if windows != nil {
+		policy.VotingPeriod = int64(windows.VotingPeriod / time.Second)
+		policy.MinExecutionPeriod = int64(windows.MinExecutionPeriod / time.Second)
+	}
+	return policy
+}
+
+// bech32FromHex converts a non-zero EVM address to its bech32 representation.
+func bech32FromHex(addrCdc address.Codec, addr common.Address, errFormat string) (string, error) {
+	if addr == (common.Address{}) {
+		return "", fmt.Errorf(errFormat, addr)
+	}
+
+	bech32, err := addrCdc.BytesToString(addr.Bytes())
+	if err != nil {
+		return "", fmt.Errorf(errFormat, err)
+	}
+	return bech32, nil
+}
+
+// hexFromBech32 converts a bech32 account address to an EVM address. It fails for
+// accounts that are not 20 bytes long, e.g. group policy accounts.
+func hexFromBech32(addrCdc address.Codec, addr string) (common.Address, error) {
+	bz, err := addrCdc.StringToBytes(addr)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(bz) != common.AddressLength {
+		return common.Address{}, fmt.Errorf(ErrNotEVMAddress, addr)
+	}
+	return common.BytesToAddress(bz), nil
+}
@@ -0,0 +1,196 @@
+package group
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+func TestNewMsgCreateGroupWithPolicy(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	abi, err := LoadABI()
+	require.NoError(t, err)
+	method := abi.Methods[CreateGroupWithPolicyMethod]
+
+	admin := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	member := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	adminBech32, err := addrCodec.BytesToString(admin.Bytes())
+	require.NoError(t, err)
+	memberBech32, err := addrCodec.BytesToString(member.Bytes())
+	require.NoError(t, err)
+
+	members := []MemberRequest{{Member: member, Weight: "2", Metadata: "member"}}
+	policy := DecisionPolicy{PolicyType: DecisionPolicyTypePercentage, Value: "0.5", VotingPeriod: 60, MinExecutionPeriod: 10}
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{admin, members, "group", "policy", true, policy},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
+		},
+		{
+			name:    "empty admin address",
+			args:    []interface{}{common.Address{}, members, "group", "policy", true, policy},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidAdmin, common.Address{}),
+		},
+		{
+			name:    "empty member address",
+			args:    []interface{}{admin, []MemberRequest{{Weight: "1"}}, "group", "policy", true, policy},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidMember, common.Address{}),
+		},
+		{
+			name:    "invalid decision policy type",
+			args:    []interface{}{admin, members, "group", "policy", true, DecisionPolicy{Value: "1"}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidDecisionPolicy, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, gotAdmin, err := NewMsgCreateGroupWithPolicy(&method, tt.args, addrCodec)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, admin, gotAdmin)
+			require.Equal(t, adminBech32, msg.Admin)
+			require.Equal(t, []group.MemberRequest{{Address: memberBech32, Weight: "2", Metadata: "member"}}, msg.Members)
+			require.Equal(t, "group", msg.GroupMetadata)
+			require.Equal(t, "policy", msg.GroupPolicyMetadata)
+			require.True(t, msg.GroupPolicyAsAdmin)
+
+			decisionPolicy, err := msg.GetDecisionPolicy()
+			require.NoError(t, err)
+			require.Equal(t, group.NewPercentageDecisionPolicy("0.5", time.Minute, 10*time.Second), decisionPolicy)
+		})
+	}
+}
+
+func TestNewMsgSubmitProposal(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	proposer := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	proposerBech32, err := addrCodec.BytesToString(proposer.Bytes())
+	require.NoError(t, err)
+	policy := sdk.AccAddress(make([]byte, 32)).String()
+
+	send := &banktypes.MsgSend{
+		FromAddress: policy,
+		ToAddress:   proposerBech32,
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+	}
+	sendJSON, err := cdc.MarshalInterfaceJSON(send)
+	require.NoError(t, err)
+	proposal := []byte(fmt.Sprintf(`{"messages":[%s],"metadata":"meta","title":"title","summary":"summary"}`, sendJSON))
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{proposer, policy, proposal, uint8(group.Exec_EXEC_TRY)},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name:    "empty proposer address",
+			args:    []interface{}{common.Address{}, policy, proposal, uint8(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidProposer, common.Address{}),
+		},
+		{
+			name:    "empty group policy",
+			args:    []interface{}{proposer, "", proposal, uint8(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGroupPolicy, ""),
+		},
+		{
+			name:    "empty proposal",
+			args:    []interface{}{proposer, policy, []byte{}, uint8(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidProposalJSON, "jsonBlob arg"),
+		},
+		{
+			name:    "unknown message type",
+			args:    []interface{}{proposer, policy, []byte(`{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgUnknown"}]}`), uint8(0)},
+			wantErr: true,
+			errMsg:  "message 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, gotProposer, err := NewMsgSubmitProposal(tt.args, cdc, addrCodec)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, proposer, gotProposer)
+			require.Equal(t, policy, msg.GroupPolicyAddress)
+			require.Equal(t, []string{proposerBech32}, msg.Proposers)
+			require.Equal(t, group.Exec_EXEC_TRY, msg.Exec)
+			require.Equal(t, "meta", msg.Metadata)
+			require.Equal(t, "title", msg.Title)
+			require.Equal(t, "summary", msg.Summary)
+			require.Len(t, msg.Messages, 1)
+			require.Equal(t, sdk.MsgTypeURL(send), msg.Messages[0].TypeUrl)
+		})
+	}
+}
+
+func TestHexFromBech32(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	bech32, err := addrCodec.BytesToString(addr.Bytes())
+	require.NoError(t, err)
+
+	got, err := hexFromBech32(addrCodec, bech32)
+	require.NoError(t, err)
+	require.Equal(t, addr, got)
+
+	// group policy accounts are 32 bytes long
+	policy, err := addrCodec.BytesToString(make([]byte, 32))
+	require.NoError(t, err)
+	_, err = hexFromBech32(addrCodec, policy)
+	require.ErrorContains(t, err, fmt.Sprintf(ErrNotEVMAddress, policy))
+}
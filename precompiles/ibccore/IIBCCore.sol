@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IIBCCore contract's address.
+address constant IBC_CORE_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080a;
+
+/// @dev The IIBCCore contract's instance.
+IIBCCore constant IBC_CORE_CONTRACT = IIBCCore(IBC_CORE_PRECOMPILE_ADDRESS);
+
+/// @dev ClientStateData represents the state of an IBC light client.
+struct ClientStateData {
+    /// @dev The type of the client, e.g. 07-tendermint
+    string clientType;
+    /// @dev The status of the client (Active, Frozen, Expired, Unknown or Unauthorized)
+    string status;
+    /// @dev The latest height the client was updated to
+    Height latestHeight;
+    /// @dev The proto-encoded client state, wrapped in an Any
+    bytes clientState;
+}
+
+/// @dev ConnectionData represents an IBC connection end.
+struct ConnectionData {
+    /// @dev The state of the connection (0: UNINITIALIZED, 1: INIT, 2: TRYOPEN, 3: OPEN)
+    uint8 state;
+    /// @dev The identifier of the client associated with the connection
+    string clientId;
+    /// @dev The identifier of the client on the counterparty chain
+    string counterpartyClientId;
+    /// @dev The identifier of the connection on the counterparty chain
+    string counterpartyConnectionId;
+    /// @dev The delay period in nanoseconds of the connection
+    uint64 delayPeriod;
+    /// @dev The identifiers of the compatible connection versions
+    string[] versions;
+}
+
+/// @dev ChannelData represents an IBC channel end.
+struct ChannelData {
+    /// @dev The state of the channel (0: UNINITIALIZED, 1: INIT, 2: TRYOPEN, 3: OPEN, 4: CLOSED)
+    uint8 state;
+    /// @dev The ordering of the channel (1: UNORDERED, 2: ORDERED)
+    uint8 ordering;
+    /// @dev The port identifier on the counterparty chain
+    string counterpartyPortId;
+    /// @dev The channel identifier on the counterparty chain
+    string counterpartyChannelId;
+    /// @dev The connections the channel's packets travel through
+    string[] connectionHops;
+    /// @dev The version of the channel
+    string version;
+}
+
+/// @author Evmos Team
+/// @title IBC Core Precompile Contract
+/// @dev The interface through which solidity contracts will read the IBC core state of the light clients,
+/// connections, channels and packets.
+interface IIBCCore {
+    /// @dev clientState returns the state of the light client with the given identifier.
+    /// @param clientId the identifier of the client
+    /// @return clientState the state of the client
+    function clientState(
+        string memory clientId
+    ) external view returns (ClientStateData memory clientState);
+
+    /// @dev consensusState returns whether the light client has a consensus state stored
+    /// at the given height and the timestamp of that consensus state.
+    /// @param clientId the identifier of the client
+    /// @param height the height of the consensus state
+    /// @return exists true if the client has a consensus state at the height
+    /// @return timestamp the timestamp in nanoseconds of the consensus state, 0 if it does not exist
+    function consensusState(
+        string memory clientId,
+        Height memory height
+    ) external view returns (bool exists, uint64 timestamp);
+
+    /// @dev connection returns the connection end with the given identifier.
+    /// @param connectionId the identifier of the connection
+    /// @return connection the connection end
+    function connection(
+        string memory connectionId
+    ) external view returns (ConnectionData memory connection);
+
+    /// @dev channel returns the channel end with the given port and channel identifiers.
+    /// @param portId the port identifier of the channel
+    /// @param channelId the identifier of the channel
+    /// @return channel the channel end
+    function channel(
+        string memory portId,
+        string memory channelId
+    ) external view returns (ChannelData memory channel);
+
+    /// @dev nextSequenceSend returns the sequence of the next packet to be sent on the channel.
+    /// @param portId the port identifier of the channel
+    /// @param channelId the identifier of the channel
+    /// @return sequence the next send sequence
+    function nextSequenceSend(
+        string memory portId,
+        string memory channelId
+    ) external view returns (uint64 sequence);
+
+    /// @dev nextSequenceRecv returns the sequence of the next packet to be received on the channel.
+    /// @param portId the port identifier of the channel
+    /// @param channelId the identifier of the channel
+    /// @return sequence the next receive sequence
+    function nextSequenceRecv(
+        string memory portId,
+        string memory channelId
+    ) external view returns (uint64 sequence);
+
+    /// @dev nextSequenceAck returns the sequence of the next packet to be acknowledged on the channel.
+    /// @param portId the port identifier of the channel
+    /// @param channelId the identifier of the channel
+    /// @return sequence the next acknowledgement sequence
+    function nextSequenceAck(
+        string memory portId,
+        string memory channelId
+    ) external view returns (uint64 sequence);
+
+    /// @dev hasPacketCommitment returns whether a packet sent on the channel is still in flight,
+    /// i.e. it has been neither acknowledged nor timed out.
+    /// @param portId the port identifier of the channel
+    /// @param channelId the identifier of the channel
+    /// @param sequence the sequence of the packet
+    /// @return exists true if the packet commitment exists
+    function hasPacketCommitment(
+        string memory portId,
+        string memory channelId,
+        uint64 sequence
+    ) external view returns (bool exists);
+
+    /// @dev hasPacketReceipt returns whether a packet was received on an unordered channel.
+    /// @param portId the port identifier of the channel
+    /// @param channelId the identifier of the channel
+    /// @param sequence the sequence of the packet
+    /// @return exists true if the packet receipt exists
+    function hasPacketReceipt(
+        string memory portId,
+        string memory channelId,
+        uint64 sequence
+    ) external view returns (bool exists);
+
+    /// @dev hasPacketAcknowledgement returns whether a packet received on the channel has
+    /// been acknowledged.
+    /// @param portId the port identifier of the channel
+    /// @param channelId the identifier of the channel
+    /// @param sequence the sequence of the packet
+    /// @return exists true if the packet acknowledgement exists
+    function hasPacketAcknowledgement(
+        string memory portId,
+        string memory channelId,
+        uint64 sequence
+    ) external view returns (bool exists);
+}
@@ -0,0 +1,22 @@
+package ibccore
+
+const (
+	// ErrInvalidClientID is raised when the client identifier is not valid.
+	ErrInvalidClientID = "invalid client ID: %v"
+	// ErrInvalidConnectionID is raised when the connection identifier is not valid.
+	ErrInvalidConnectionID = "invalid connection ID: %v"
+	// ErrInvalidPortID is raised when the port identifier is not valid.
+	ErrInvalidPortID = "invalid port ID: %v"
+	// ErrInvalidChannelID is raised when the channel identifier is not valid.
+	ErrInvalidChannelID = "invalid channel ID: %v"
+	// ErrInvalidHeight is raised when the height is not valid.
+	ErrInvalidHeight = "invalid height: %v"
+	// ErrInvalidSequence is raised when the packet sequence is not valid.
+	ErrInvalidSequence = "invalid sequence: %v"
+	// ErrClientNotFound is raised when the light client does not exist.
+	ErrClientNotFound = "client not found: %s"
+	// ErrConnectionNotFound is raised when the connection does not exist.
+	ErrConnectionNotFound = "connection not found: %s"
+	// ErrChannelNotFound is raised when the channel does not exist.
+	ErrChannelNotFound = "channel not found: port ID (%s) channel ID (%s)"
+)
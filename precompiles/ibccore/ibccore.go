@@ -0,0 +1,149 @@
+package ibccore
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the read-only precompiled contract for the IBC core state.
+type Precompile struct {
+	cmn.Precompile
+	ibcKeeper *ibckeeper.Keeper
+	codec     codec.Codec
+}
+
+// LoadABI loads the IBC core ABI from the embedded abi.json file
+// for the IBC core precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new IBC core Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	ibcKeeper *ibckeeper.Keeper,
+	codec codec.Codec,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		ibcKeeper: ibcKeeper,
+		codec:     codec,
+	}
+
+	// SetAddress defines the address of the IBC core precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.IBCCorePrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the precompiled contract IBC core query methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	bz, err = p.run(evm, contract, readOnly)
+	if err != nil {
+		return cmn.ReturnRevertError(evm, err)
+	}
+
+	return bz, nil
+}
+
+func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, _, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// light client queries
+	case ClientStateMethod:
+		bz, err = p.ClientState(ctx, method, contract, args)
+	case ConsensusStateMethod:
+		bz, err = p.ConsensusState(ctx, method, contract, args)
+	// connection and channel queries
+	case ConnectionMethod:
+		bz, err = p.Connection(ctx, method, contract, args)
+	case ChannelMethod:
+		bz, err = p.Channel(ctx, method, contract, args)
+	case NextSequenceSendMethod, NextSequenceRecvMethod, NextSequenceAckMethod:
+		bz, err = p.NextSequence(ctx, method, contract, args)
+	// packet queries
+	case HasPacketCommitmentMethod, HasPacketReceiptMethod, HasPacketAcknowledgementMethod:
+		bz, err = p.HasPacketState(ctx, method, contract, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost, nil, tracing.GasChangeCallPrecompiledContract) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+// It returns false since all IBC core methods are queries.
+func (Precompile) IsTransaction(_ *abi.Method) bool {
+	return false
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "ibccore")
+}
@@ -0,0 +1,211 @@
+package ibccore
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ClientStateMethod defines the method name for the client state precompile request.
+	ClientStateMethod = "clientState"
+	// ConsensusStateMethod defines the method name for the consensus state precompile request.
+	ConsensusStateMethod = "consensusState"
+	// ConnectionMethod defines the method name for the connection precompile request.
+	ConnectionMethod = "connection"
+	// ChannelMethod defines the method name for the channel precompile request.
+	ChannelMethod = "channel"
+	// NextSequenceSendMethod defines the method name for the next send sequence precompile request.
+	NextSequenceSendMethod = "nextSequenceSend"
+	// NextSequenceRecvMethod defines the method name for the next receive sequence precompile request.
+	NextSequenceRecvMethod = "nextSequenceRecv"
+	// NextSequenceAckMethod defines the method name for the next acknowledgement sequence precompile request.
+	NextSequenceAckMethod = "nextSequenceAck"
+	// HasPacketCommitmentMethod defines the method name for the packet commitment precompile request.
+	HasPacketCommitmentMethod = "hasPacketCommitment"
+	// HasPacketReceiptMethod defines the method name for the packet receipt precompile request.
+	HasPacketReceiptMethod = "hasPacketReceipt"
+	// HasPacketAcknowledgementMethod defines the method name for the packet acknowledgement precompile request.
+	HasPacketAcknowledgementMethod = "hasPacketAcknowledgement"
+)
+
+// ClientState implements the query logic for getting the state of a light client.
+func (p *Precompile) ClientState(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	clientID, err := ParseClientStateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	clientKeeper := p.ibcKeeper.ClientKeeper
+	clientState, found := clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return nil, fmt.Errorf(ErrClientNotFound, clientID)
+	}
+
+	clientType, _, err := clienttypes.ParseClientIdentifier(clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := p.codec.MarshalInterface(clientState)
+	if err != nil {
+		return nil, err
+	}
+
+	output := ClientStateData{
+		ClientType:   clientType,
+		Status:       string(clientKeeper.GetClientStatus(ctx, clientID)),
+		LatestHeight: clientKeeper.GetClientLatestHeight(ctx, clientID),
+		ClientState:  bz,
+	}
+
+	return method.Outputs.Pack(output)
+}
+
+// ConsensusState implements the query logic for checking if a light client has a consensus
+// state at a given height and getting its timestamp.
+func (p *Precompile) ConsensusState(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	clientID, height, err := ParseConsensusStateArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	clientKeeper := p.ibcKeeper.ClientKeeper
+	if _, found := clientKeeper.GetClientState(ctx, clientID); !found {
+		return nil, fmt.Errorf(ErrClientNotFound, clientID)
+	}
+
+	if _, found := clientKeeper.GetClientConsensusState(ctx, clientID, height); !found {
+		return method.Outputs.Pack(false, uint64(0))
+	}
+
+	timestamp, err := clientKeeper.GetClientTimestampAtHeight(ctx, clientID, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true, timestamp)
+}
+
+// Connection implements the query logic for getting a connection end.
+func (p *Precompile) Connection(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	connectionID, err := ParseConnectionArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	connection, found := p.ibcKeeper.ConnectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return nil, fmt.Errorf(ErrConnectionNotFound, connectionID)
+	}
+
+	return method.Outputs.Pack(NewConnectionData(connection))
+}
+
+// Channel implements the query logic for getting a channel end.
+func (p *Precompile) Channel(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	portID, channelID, err := ParseChannelArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, found := p.ibcKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return nil, fmt.Errorf(ErrChannelNotFound, portID, channelID)
+	}
+
+	return method.Outputs.Pack(NewChannelData(channel))
+}
+
+// NextSequence implements the query logic for getting the next send, receive or
+// acknowledgement sequence of a channel.
+func (p *Precompile) NextSequence(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	portID, channelID, err := ParseChannelArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sequence uint64
+		found    bool
+	)
+
+	channelKeeper := p.ibcKeeper.ChannelKeeper
+	switch method.Name {
+	case NextSequenceSendMethod:
+		sequence, found = channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	case NextSequenceRecvMethod:
+		sequence, found = channelKeeper.GetNextSequenceRecv(ctx, portID, channelID)
+	case NextSequenceAckMethod:
+		sequence, found = channelKeeper.GetNextSequenceAck(ctx, portID, channelID)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if !found {
+		return nil, fmt.Errorf(ErrChannelNotFound, portID, channelID)
+	}
+
+	return method.Outputs.Pack(sequence)
+}
+
+// HasPacketState implements the query logic for checking if a packet commitment, receipt
+// or acknowledgement is stored for a packet of a channel.
+func (p *Precompile) HasPacketState(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	portID, channelID, sequence, err := ParsePacketArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var exists bool
+
+	channelKeeper := p.ibcKeeper.ChannelKeeper
+	switch method.Name {
+	case HasPacketCommitmentMethod:
+		exists = channelKeeper.HasPacketCommitment(ctx, portID, channelID, sequence)
+	case HasPacketReceiptMethod:
+		_, exists = channelKeeper.GetPacketReceipt(ctx, portID, channelID, sequence)
+	case HasPacketAcknowledgementMethod:
+		exists = channelKeeper.HasPacketAcknowledgement(ctx, portID, channelID, sequence)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	return method.Outputs.Pack(exists)
+}
@@ -0,0 +1,177 @@
+package ibccore
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// ClientStateData represents the state of an IBC light client.
+type ClientStateData struct {
+	ClientType   string             `abi:"clientType"`
+	Status       string             `abi:"status"`
+	LatestHeight clienttypes.Height `abi:"latestHeight"`
+	ClientState  []byte             `abi:"clientState"`
+}
+
+// ConnectionData represents an IBC connection end.
+type ConnectionData struct {
+	State                    uint8    `abi:"state"`
+	ClientID                 string   `abi:"clientId"`
+	CounterpartyClientID     string   `abi:"counterpartyClientId"`
+	CounterpartyConnectionID string   `abi:"counterpartyConnectionId"`
+	DelayPeriod              uint64   `abi:"delayPeriod"`
+	Versions                 []string `abi:"versions"`
+}
+
+// ChannelData represents an IBC channel end.
+type ChannelData struct {
+	State                 uint8    `abi:"state"`
+	Ordering              uint8    `abi:"ordering"`
+	CounterpartyPortID    string   `abi:"counterpartyPortId"`
+	CounterpartyChannelID string   `abi:"counterpartyChannelId"`
+	ConnectionHops        []string `abi:"connectionHops"`
+	Version               string   `abi:"version"`
+}
+
+// ConsensusStateInput defines the input for the ConsensusState query.
+type ConsensusStateInput struct {
+	ClientID string             `abi:"clientId"`
+	Height   clienttypes.Height `abi:"height"`
+}
+
+// NewConnectionData creates a new ConnectionData from the given connection end.
+func NewConnectionData(connection connectiontypes.ConnectionEnd) ConnectionData {
+	versions := make([]string, len(connection.Versions))
+	for i, version := range connection.Versions {
+		versions[i] = version.GetIdentifier()
+	}
+
+	return ConnectionData{
+		State:                    uint8(connection.State), //nolint:gosec // G115 // state is a small enum
+		ClientID:                 connection.ClientId,
+		CounterpartyClientID:     connection.Counterparty.ClientId,
+		CounterpartyConnectionID: connection.Counterparty.ConnectionId,
+		DelayPeriod:              connection.DelayPeriod,
+		Versions:                 versions,
+	}
+}
+
+// NewChannelData creates a new ChannelData from the given channel end.
+func NewChannelData(channel channeltypes.Channel) ChannelData {
+	return ChannelData{
+		State:                 uint8(channel.State),    //nolint:gosec // G115 // state is a small enum
+		Ordering:              uint8(channel.Ordering), //nolint:gosec // G115 // ordering is a small enum
+		CounterpartyPortID:    channel.Counterparty.PortId,
+		CounterpartyChannelID: channel.Counterparty.ChannelId,
+		ConnectionHops:        channel.ConnectionHops,
+		Version:               channel.Version,
+	}
+}
+
+// ParseClientStateArgs parses the arguments of the ClientState query.
+func ParseClientStateArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return parseClientID(args[0])
+}
+
+// ParseConsensusStateArgs parses the arguments of the ConsensusState query.
+func ParseConsensusStateArgs(method *abi.Method, args []interface{}) (string, clienttypes.Height, error) {
+	if len(args) != 2 {
+		return "", clienttypes.Height{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input ConsensusStateInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return "", clienttypes.Height{}, fmt.Errorf("error while unpacking args to ConsensusStateInput struct: %s", err)
+	}
+
+	clientID, err := parseClientID(input.ClientID)
+	if err != nil {
+		return "", clienttypes.Height{}, err
+	}
+
+	if input.Height.IsZero() {
+		return "", clienttypes.Height{}, fmt.Errorf(ErrInvalidHeight, input.Height)
+	}
+
+	return clientID, input.Height, nil
+}
+
+// ParseConnectionArgs parses the arguments of the Connection query.
+func ParseConnectionArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	connectionID, ok := args[0].(string)
+	if !ok || host.ConnectionIdentifierValidator(connectionID) != nil {
+		return "", fmt.Errorf(ErrInvalidConnectionID, args[0])
+	}
+
+	return connectionID, nil
+}
+
+// ParseChannelArgs parses the port and channel identifiers of the Channel and
+// next sequence queries.
+func ParseChannelArgs(args []interface{}) (string, string, error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	return parsePortChannel(args[0], args[1])
+}
+
+// ParsePacketArgs parses the port and channel identifiers and the sequence of the
+// packet state queries.
+func ParsePacketArgs(args []interface{}) (string, string, uint64, error) {
+	if len(args) != 3 {
+		return "", "", 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	portID, channelID, err := parsePortChannel(args[0], args[1])
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	sequence, ok := args[2].(uint64)
+	if !ok || sequence == 0 {
+		return "", "", 0, fmt.Errorf(ErrInvalidSequence, args[2])
+	}
+
+	return portID, channelID, sequence, nil
+}
+
+// parseClientID checks that the given argument is a valid client identifier.
+func parseClientID(arg interface{}) (string, error) {
+	clientID, ok := arg.(string)
+	if !ok || host.ClientIdentifierValidator(clientID) != nil {
+		return "", fmt.Errorf(ErrInvalidClientID, arg)
+	}
+
+	return clientID, nil
+}
+
+// parsePortChannel checks that the given arguments are valid port and channel identifiers.
+func parsePortChannel(portArg, channelArg interface{}) (string, string, error) {
+	portID, ok := portArg.(string)
+	if !ok || host.PortIdentifierValidator(portID) != nil {
+		return "", "", fmt.Errorf(ErrInvalidPortID, portArg)
+	}
+
+	channelID, ok := channelArg.(string)
+	if !ok || host.ChannelIdentifierValidator(channelID) != nil {
+		return "", "", fmt.Errorf(ErrInvalidChannelID, channelArg)
+	}
+
+	return portID, channelID, nil
+}
@@ -0,0 +1,124 @@
+package ibccore
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+)
+
+func TestParseConsensusStateArgs(t *testing.T) {
+	abi, err := LoadABI()
+	require.NoError(t, err)
+	method := abi.Methods[ConsensusStateMethod]
+
+	height := clienttypes.NewHeight(1, 100)
+	clientID, parsedHeight, err := ParseConsensusStateArgs(&method, []interface{}{"07-tendermint-0", height})
+	require.NoError(t, err)
+	require.Equal(t, "07-tendermint-0", clientID)
+	require.Equal(t, height, parsedHeight)
+
+	_, _, err = ParseConsensusStateArgs(&method, []interface{}{"07-tendermint-0", clienttypes.ZeroHeight()})
+	require.ErrorContains(t, err, fmt.Sprintf(ErrInvalidHeight, clienttypes.ZeroHeight()))
+
+	_, _, err = ParseConsensusStateArgs(&method, []interface{}{"", height})
+	require.ErrorContains(t, err, fmt.Sprintf(ErrInvalidClientID, ""))
+
+	_, _, err = ParseConsensusStateArgs(&method, []interface{}{"07-tendermint-0"})
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1))
+}
+
+func TestParsePacketArgs(t *testing.T) {
+	testCases := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{"transfer", "channel-0", uint64(1)},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			name:    "invalid port ID",
+			args:    []interface{}{"", "channel-0", uint64(1)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidPortID, ""),
+		},
+		{
+			name:    "invalid channel ID",
+			args:    []interface{}{"transfer", "c", uint64(1)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidChannelID, "c"),
+		},
+		{
+			name:    "zero sequence",
+			args:    []interface{}{"transfer", "channel-0", uint64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidSequence, 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			portID, channelID, sequence, err := ParsePacketArgs(tc.args)
+			if tc.wantErr {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "transfer", portID)
+			require.Equal(t, "channel-0", channelID)
+			require.Equal(t, uint64(1), sequence)
+		})
+	}
+}
+
+func TestNewConnectionData(t *testing.T) {
+	connection := connectiontypes.NewConnectionEnd(
+		connectiontypes.OPEN,
+		"07-tendermint-0",
+		connectiontypes.NewCounterparty("07-tendermint-1", "connection-1", commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+		connectiontypes.GetCompatibleVersions(),
+		10,
+	)
+
+	data := NewConnectionData(connection)
+	require.Equal(t, uint8(connectiontypes.OPEN), data.State)
+	require.Equal(t, "07-tendermint-0", data.ClientID)
+	require.Equal(t, "07-tendermint-1", data.CounterpartyClientID)
+	require.Equal(t, "connection-1", data.CounterpartyConnectionID)
+	require.Equal(t, uint64(10), data.DelayPeriod)
+	require.Equal(t, []string{"1"}, data.Versions)
+}
+
+func TestNewChannelData(t *testing.T) {
+	channel := channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.UNORDERED,
+		channeltypes.NewCounterparty("transfer", "channel-1"),
+		[]string{"connection-0"},
+		"ics20-1",
+	)
+
+	data := NewChannelData(channel)
+	require.Equal(t, uint8(channeltypes.OPEN), data.State)
+	require.Equal(t, uint8(channeltypes.UNORDERED), data.Ordering)
+	require.Equal(t, "transfer", data.CounterpartyPortID)
+	require.Equal(t, "channel-1", data.CounterpartyChannelID)
+	require.Equal(t, []string{"connection-0"}, data.ConnectionHops)
+	require.Equal(t, "ics20-1", data.Version)
+}
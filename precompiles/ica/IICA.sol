@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IICA contract's address.
+address constant ICA_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IICA contract's instance.
+IICA constant ICA_CONTRACT = IICA(ICA_PRECOMPILE_ADDRESS);
+
+/// @dev CosmosMsg defines a proto-encoded Cosmos SDK message to be executed by the interchain account.
+struct CosmosMsg {
+    /// @dev The type URL of the message, e.g. /cosmos.bank.v1beta1.MsgSend
+    string typeUrl;
+    /// @dev The proto-encoded message
+    bytes value;
+}
+
+/// @author Evmos Team
+/// @title ICA Precompile Contract
+/// @dev The interface through which solidity contracts will control interchain accounts
+/// on counterparty chains using the ICS-27 controller.
+interface IICA {
+    /// @dev RegisterInterchainAccount defines an Event emitted when the registration of
+    /// an interchain account is initiated.
+    /// @param owner the address of the owner of the interchain account
+    /// @param connectionId the connection identifier of the controller chain
+    /// @param channelId the identifier of the channel opened for the interchain account
+    /// @param portId the controller port identifier of the owner
+    event RegisterInterchainAccount(address indexed owner, string connectionId, string channelId, string portId);
+
+    /// @dev SendTx defines an Event emitted when a packet with messages is sent to an interchain account.
+    /// @param owner the address of the owner of the interchain account
+    /// @param connectionId the connection identifier of the controller chain
+    /// @param sequence the sequence of the sent packet
+    event SendTx(address indexed owner, string connectionId, uint64 sequence);
+
+    /// @dev registerInterchainAccount initiates the channel handshake to register an interchain
+    /// account owned by the caller on the chain at the other end of the connection.
+    /// @param connectionId the connection identifier of the controller chain
+    /// @param version the channel version, the default ICS-27 metadata is used if empty
+    /// @param ordering the channel ordering (1 for UNORDERED, 2 for ORDERED)
+    /// @return channelId the identifier of the channel opened for the interchain account
+    /// @return portId the controller port identifier of the owner
+    function registerInterchainAccount(
+        string memory connectionId,
+        string memory version,
+        uint8 ordering
+    ) external returns (string memory channelId, string memory portId);
+
+    /// @dev sendTx sends a packet with the given messages to be executed by the interchain account
+    /// of the caller. The acknowledgement or timeout of the packet is delivered to the contract set in
+    /// the memo as {"src_callback": {"address": "0x..."}}.
+    /// @param connectionId the connection identifier of the controller chain
+    /// @param msgs the proto-encoded messages to execute on the host chain
+    /// @param memo the memo of the packet
+    /// @param relativeTimeout the packet timeout in nanoseconds relative to the current block time
+    /// @return sequence the sequence of the sent packet
+    function sendTx(
+        string memory connectionId,
+        CosmosMsg[] memory msgs,
+        string memory memo,
+        uint64 relativeTimeout
+    ) external returns (uint64 sequence);
+
+    /// @dev interchainAccount returns the address of the interchain account of the owner
+    /// on the chain at the other end of the connection.
+    /// @param owner the address of the owner of the interchain account
+    /// @param connectionId the connection identifier of the controller chain
+    /// @return account the address of the interchain account on the host chain, empty if not registered
+    function interchainAccount(
+        address owner,
+        string memory connectionId
+    ) external view returns (string memory account);
+}
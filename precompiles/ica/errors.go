@@ -0,0 +1,18 @@
+package ica
+
+const (
+	// ErrInvalidConnectionID is raised when the connection identifier is not valid.
+	ErrInvalidConnectionID = "invalid connection ID: %v"
+	// ErrInvalidVersion is raised when the channel version is not valid.
+	ErrInvalidVersion = "invalid version: %v"
+	// ErrInvalidOrdering is raised when the channel ordering is not valid.
+	ErrInvalidOrdering = "invalid channel ordering: %v"
+	// ErrInvalidOwner is raised when the interchain account owner address is not valid.
+	ErrInvalidOwner = "invalid owner address: %v"
+	// ErrInvalidMsgs is raised when the messages to execute on the host chain are not valid.
+	ErrInvalidMsgs = "invalid messages: %v"
+	// ErrInvalidMemo is raised when the packet memo is not valid.
+	ErrInvalidMemo = "invalid memo: %v"
+	// ErrInvalidRelativeTimeout is raised when the relative packet timeout is not valid.
+	ErrInvalidRelativeTimeout = "invalid relative timeout: %v"
+)
@@ -0,0 +1,96 @@
+package ica
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeRegisterInterchainAccount defines the event type for the ICA RegisterInterchainAccount transaction.
+	EventTypeRegisterInterchainAccount = "RegisterInterchainAccount"
+	// EventTypeSendTx defines the event type for the ICA SendTx transaction.
+	EventTypeSendTx = "SendTx"
+)
+
+// EmitRegisterInterchainAccountEvent creates a new event emitted on a RegisterInterchainAccount transaction.
+func (p Precompile) EmitRegisterInterchainAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID, channelID, portID string,
+) error {
+	event := p.Events[EventTypeRegisterInterchainAccount]
+	topics, err := ownerTopics(event, owner)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data: connectionId, channelId, portId
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(connectionID, channelID, portID)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitSendTxEvent creates a new event emitted on a SendTx transaction.
+func (p Precompile) EmitSendTxEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID string,
+	sequence uint64,
+) error {
+	event := p.Events[EventTypeSendTx]
+	topics, err := ownerTopics(event, owner)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data: connectionId, sequence
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(connectionID, sequence)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// ownerTopics returns the topics of an event indexed by the interchain account owner.
+func ownerTopics(event abi.Event, owner common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
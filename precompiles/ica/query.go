@@ -0,0 +1,32 @@
+package ica
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// InterchainAccountMethod defines the method name for the interchain account precompile request.
+	InterchainAccountMethod = "interchainAccount"
+)
+
+// InterchainAccount implements the query logic for getting the address of the interchain
+// account of an owner. It returns an empty string if the account is not registered.
+func (p *Precompile) InterchainAccount(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	portID, connectionID, err := ParseInterchainAccountArgs(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: the address is empty if the account was not found
+	address, _ := p.controllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+
+	return method.Outputs.Pack(address)
+}
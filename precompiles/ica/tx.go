@@ -0,0 +1,79 @@
+package ica
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RegisterInterchainAccountMethod defines the ABI method name for the ICA controller
+	// RegisterInterchainAccount transaction.
+	RegisterInterchainAccountMethod = "registerInterchainAccount"
+	// SendTxMethod defines the ABI method name for the ICA controller SendTx transaction.
+	SendTxMethod = "sendTx"
+)
+
+// RegisterInterchainAccount initiates the channel handshake to register an interchain
+// account owned by the caller on the chain at the other end of the connection.
+func (p *Precompile) RegisterInterchainAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	// The interchain account is always owned by the caller, so that contracts
+	// can only control their own accounts.
+	owner := contract.Caller()
+
+	msg, err := NewMsgRegisterInterchainAccount(owner, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSrv := icacontrollerkeeper.NewMsgServerImpl(p.controllerKeeper)
+	res, err := msgSrv.RegisterInterchainAccount(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRegisterInterchainAccountEvent(ctx, stateDB, owner, msg.ConnectionId, res.ChannelId, res.PortId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ChannelId, res.PortId)
+}
+
+// SendTx sends a packet with proto-encoded messages to be executed by the interchain
+// account of the caller. The acknowledgement or timeout of the packet is delivered to the
+// contract set as source callback in the memo.
+func (p *Precompile) SendTx(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner := contract.Caller()
+
+	msg, err := NewMsgSendTx(method, owner, args, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSrv := icacontrollerkeeper.NewMsgServerImpl(p.controllerKeeper)
+	res, err := msgSrv.SendTx(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitSendTxEvent(ctx, stateDB, owner, msg.ConnectionId, res.Sequence); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
@@ -0,0 +1,203 @@
+package ica
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	"cosmossdk.io/core/address"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// EventRegisterInterchainAccount defines the event data for the RegisterInterchainAccount transaction.
+type EventRegisterInterchainAccount struct {
+	Owner        common.Address `abi:"owner"`
+	ConnectionID string         `abi:"connectionId"`
+	ChannelID    string         `abi:"channelId"`
+	PortID       string         `abi:"portId"`
+}
+
+// EventSendTx defines the event data for the SendTx transaction.
+type EventSendTx struct {
+	Owner        common.Address `abi:"owner"`
+	ConnectionID string         `abi:"connectionId"`
+	Sequence     uint64         `abi:"sequence"`
+}
+
+// CosmosMsg defines a proto-encoded Cosmos SDK message executed by an interchain account.
+type CosmosMsg struct {
+	TypeURL string `abi:"typeUrl"`
+	Value   []byte `abi:"value"`
+}
+
+// SendTxInput defines the input for the SendTx transaction.
+type SendTxInput struct {
+	ConnectionID    string      `abi:"connectionId"`
+	Msgs            []CosmosMsg `abi:"msgs"`
+	Memo            string      `abi:"memo"`
+	RelativeTimeout uint64      `abi:"relativeTimeout"`
+}
+
+// RegisterInterchainAccountOutput defines the output for the RegisterInterchainAccount transaction.
+type RegisterInterchainAccountOutput struct {
+	ChannelID string `abi:"channelId"`
+	PortID    string `abi:"portId"`
+}
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount for an
+// interchain account owned by the given address.
+func NewMsgRegisterInterchainAccount(
+	owner common.Address,
+	args []interface{},
+	addrCdc address.Codec,
+) (*icacontrollertypes.MsgRegisterInterchainAccount, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	connectionID, err := parseConnectionID(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	version, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidVersion, args[1])
+	}
+
+	ordering, ok := args[2].(uint8)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidOrdering, args[2])
+	}
+
+	order := channeltypes.Order(ordering)
+	if order != channeltypes.ORDERED && order != channeltypes.UNORDERED {
+		return nil, fmt.Errorf(ErrInvalidOrdering, ordering)
+	}
+
+	ownerAddr, err := addrCdc.BytesToString(owner.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidOwner, owner)
+	}
+
+	return icacontrollertypes.NewMsgRegisterInterchainAccount(connectionID, ownerAddr, version, order), nil
+}
+
+// NewMsgSendTx creates a new MsgSendTx with the proto-encoded messages to be executed by the
+// interchain account of the given owner.
+//
+// NOTE: the messages are serialized with the proto3 encoding, which is the encoding of the
+// default ICS-27 channel metadata.
+func NewMsgSendTx(
+	method *abi.Method,
+	owner common.Address,
+	args []interface{},
+	cdc codec.Codec,
+	addrCdc address.Codec,
+) (*icacontrollertypes.MsgSendTx, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input SendTxInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to SendTxInput struct: %s", err)
+	}
+
+	connectionID, err := parseConnectionID(input.ConnectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(input.Msgs) == 0 {
+		return nil, fmt.Errorf(ErrInvalidMsgs, "no messages provided")
+	}
+
+	anys := make([]*codectypes.Any, len(input.Msgs))
+	for i, msg := range input.Msgs {
+		if msg.TypeURL == "" {
+			return nil, fmt.Errorf(ErrInvalidMsgs, fmt.Sprintf("empty type URL for message %d", i))
+		}
+		anys[i] = &codectypes.Any{TypeUrl: msg.TypeURL, Value: msg.Value}
+	}
+
+	data, err := cdc.Marshal(&icatypes.CosmosTx{Messages: anys})
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidMsgs, err)
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: input.Memo,
+	}
+	if err := packetData.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf(ErrInvalidMemo, err)
+	}
+
+	// NOTE: the relative timeout is added to the block time in nanoseconds
+	if input.RelativeTimeout == 0 || input.RelativeTimeout > math.MaxInt64 {
+		return nil, fmt.Errorf(ErrInvalidRelativeTimeout, input.RelativeTimeout)
+	}
+
+	ownerAddr, err := addrCdc.BytesToString(owner.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidOwner, owner)
+	}
+
+	return icacontrollertypes.NewMsgSendTx(ownerAddr, connectionID, input.RelativeTimeout, packetData), nil
+}
+
+// ParseInterchainAccountArgs parses the arguments of the InterchainAccount query and returns
+// the controller port identifier of the owner and the connection identifier.
+func ParseInterchainAccountArgs(args []interface{}, addrCdc address.Codec) (string, string, error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok || owner == (common.Address{}) {
+		return "", "", fmt.Errorf(ErrInvalidOwner, args[0])
+	}
+
+	connectionID, err := parseConnectionID(args[1])
+	if err != nil {
+		return "", "", err
+	}
+
+	ownerAddr, err := addrCdc.BytesToString(owner.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf(ErrInvalidOwner, owner)
+	}
+
+	portID, err := icatypes.NewControllerPortID(ownerAddr)
+	if err != nil {
+		return "", "", err
+	}
+
+	return portID, connectionID, nil
+}
+
+// parseConnectionID checks that the given argument is a valid connection identifier.
+func parseConnectionID(arg interface{}) (string, error) {
+	connectionID, ok := arg.(string)
+	if !ok {
+		return "", fmt.Errorf(ErrInvalidConnectionID, arg)
+	}
+
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		return "", fmt.Errorf(ErrInvalidConnectionID, connectionID)
+	}
+
+	return connectionID, nil
+}
@@ -0,0 +1,146 @@
+package ica
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestNewMsgRegisterInterchainAccount(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	owner := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	tests := []struct {
+		name     string
+		args     []interface{}
+		wantErr  bool
+		errMsg   string
+		wantOrd  channeltypes.Order
+		wantVers string
+	}{
+		{
+			name:    "valid - ordered with default version",
+			args:    []interface{}{"connection-0", "", uint8(channeltypes.ORDERED)},
+			wantOrd: channeltypes.ORDERED,
+		},
+		{
+			name:     "valid - unordered with version",
+			args:     []interface{}{"connection-0", "custom-version", uint8(channeltypes.UNORDERED)},
+			wantOrd:  channeltypes.UNORDERED,
+			wantVers: "custom-version",
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			name:    "invalid connection ID",
+			args:    []interface{}{"", "", uint8(channeltypes.ORDERED)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidConnectionID, ""),
+		},
+		{
+			name:    "invalid version type",
+			args:    []interface{}{"connection-0", 1, uint8(channeltypes.ORDERED)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidVersion, 1),
+		},
+		{
+			name:    "none ordering",
+			args:    []interface{}{"connection-0", "", uint8(channeltypes.NONE)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidOrdering, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := NewMsgRegisterInterchainAccount(owner, tt.args, addrCodec)
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, sdk.AccAddress(owner.Bytes()).String(), msg.Owner)
+			require.Equal(t, "connection-0", msg.ConnectionId)
+			require.Equal(t, tt.wantVers, msg.Version)
+			require.Equal(t, tt.wantOrd, msg.Ordering)
+		})
+	}
+}
+
+func TestNewMsgSendTx(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	abi, err := LoadABI()
+	require.NoError(t, err)
+	method := abi.Methods[SendTxMethod]
+
+	owner := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: "cosmos1host",
+		ToAddress:   "cosmos1receiver",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+	bz, err := cdc.Marshal(bankMsg)
+	require.NoError(t, err)
+	msgs := []CosmosMsg{{TypeURL: sdk.MsgTypeURL(bankMsg), Value: bz}}
+	memo := `{"src_callback":{"address":"0x0987654321098765432109876543210987654321"}}`
+
+	msg, err := NewMsgSendTx(&method, owner, []interface{}{"connection-0", msgs, memo, uint64(600_000_000_000)}, cdc, addrCodec)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(owner.Bytes()).String(), msg.Owner)
+	require.Equal(t, "connection-0", msg.ConnectionId)
+	require.Equal(t, uint64(600_000_000_000), msg.RelativeTimeout)
+	require.Equal(t, icatypes.EXECUTE_TX, msg.PacketData.Type)
+	require.Equal(t, memo, msg.PacketData.Memo)
+
+	// the packet data can be deserialized by the host chain
+	decoded, err := icatypes.DeserializeCosmosTx(cdc, msg.PacketData.Data, icatypes.EncodingProtobuf)
+	require.NoError(t, err)
+	require.Len(t, decoded, 1)
+	require.Equal(t, bankMsg, decoded[0])
+
+	_, err = NewMsgSendTx(&method, owner, []interface{}{"connection-0", []CosmosMsg{}, memo, uint64(1)}, cdc, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(ErrInvalidMsgs, "no messages provided"))
+
+	_, err = NewMsgSendTx(&method, owner, []interface{}{"connection-0", msgs, memo, uint64(0)}, cdc, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(ErrInvalidRelativeTimeout, 0))
+
+	_, err = NewMsgSendTx(&method, owner, []interface{}{"connection-0", msgs}, cdc, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 2))
+}
+
+func TestParseInterchainAccountArgs(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	owner := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	portID, connectionID, err := ParseInterchainAccountArgs([]interface{}{owner, "connection-0"}, addrCodec)
+	require.NoError(t, err)
+	require.Equal(t, icatypes.ControllerPortPrefix+sdk.AccAddress(owner.Bytes()).String(), portID)
+	require.Equal(t, "connection-0", connectionID)
+
+	_, _, err = ParseInterchainAccountArgs([]interface{}{common.Address{}, "connection-0"}, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(ErrInvalidOwner, common.Address{}))
+
+	_, _, err = ParseInterchainAccountArgs([]interface{}{owner}, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1))
+}
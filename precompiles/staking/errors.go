@@ -7,4 +7,8 @@ const (
 	ErrDifferentOriginFromValidator = "origin address %s is not the same as validator operator address %s"
 	// ErrCannotCallFromContract is raised when a function cannot be called from a smart contract.
 	ErrCannotCallFromContract = "this method can only be called directly to the precompile, not from a smart contract"
+	// ErrEmptyBatch is raised when a batch method is called without any entries.
+	ErrEmptyBatch = "batch must contain at least one entry"
+	// ErrBatchEntryFailed is raised when an entry of a batch method fails, reverting the whole batch.
+	ErrBatchEntryFailed = "batch entry for validator %s failed: %w"
 )
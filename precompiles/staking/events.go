@@ -12,7 +12,10 @@ import (
 
 	cmn "github.com/cosmos/evm/precompiles/common"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -29,6 +32,8 @@ const (
 	EventTypeRedelegate = "Redelegate"
 	// EventTypeCancelUnbondingDelegation defines the event type for the staking CancelUnbondingDelegation transaction.
 	EventTypeCancelUnbondingDelegation = "CancelUnbondingDelegation"
+	// EventTypeWithdrawDelegatorReward defines the event type for the staking ClaimRewardsBatch transaction.
+	EventTypeWithdrawDelegatorReward = "WithdrawDelegatorReward"
 )
 
 // EmitCreateValidatorEvent creates a new create validator event emitted on a CreateValidator transaction.
@@ -226,7 +231,37 @@ func (p Precompile) EmitCancelUnbondingDelegationEvent(ctx sdk.Context, stateDB
 	return nil
 }
 
-// createStakingTxTopics creates the topics for staking transactions Delegate, Undelegate, Redelegate and CancelUnbondingDelegation.
+// EmitWithdrawDelegatorRewardEvent creates a new withdraw delegator reward event emitted for each
+// validator on a ClaimRewardsBatch transaction.
+func (p Precompile) EmitWithdrawDelegatorRewardEvent(ctx sdk.Context, stateDB vm.StateDB, msg *distributiontypes.MsgWithdrawDelegatorReward, delegatorAddr common.Address, amount math.Int) error {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event topics
+	event := p.Events[EventTypeWithdrawDelegatorReward]
+	topics, err := p.createStakingTxTopics(3, event, delegatorAddr, common.BytesToAddress(valAddr.Bytes()))
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	var b bytes.Buffer
+	b.Write(cmn.PackNum(reflect.ValueOf(amount.BigInt())))
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        b.Bytes(),
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// createStakingTxTopics creates the topics for staking transactions Delegate, Undelegate, Redelegate, CancelUnbondingDelegation
+// and the WithdrawDelegatorReward events of ClaimRewardsBatch.
 func (p Precompile) createStakingTxTopics(topicsLen uint64, event abi.Event, delegatorAddr common.Address, validatorAddr common.Address) ([]common.Hash, error) {
 	topics := make([]common.Hash, topicsLen)
 	// NOTE: If your solidity event contains indexed event types, then they become a topic rather than part of the data property of the log.
@@ -33,6 +33,15 @@ const (
 	// RedelegationsMethod defines the ABI method name for the staking
 	// Redelegations query.
 	RedelegationsMethod = "redelegations"
+	// DelegatorDelegationsMethod defines the ABI method name for the staking
+	// DelegatorDelegations query.
+	DelegatorDelegationsMethod = "delegatorDelegations"
+	// DelegatorUnbondingDelegationsMethod defines the ABI method name for the staking
+	// DelegatorUnbondingDelegations query.
+	DelegatorUnbondingDelegationsMethod = "delegatorUnbondingDelegations"
+	// ValidatorDelegationsMethod defines the ABI method name for the staking
+	// ValidatorDelegations query.
+	ValidatorDelegationsMethod = "validatorDelegations"
 )
 
 // Delegation returns the delegation that a delegator has with a specific validator.
@@ -196,3 +205,75 @@ func (p Precompile) Redelegations(
 
 	return out.Pack(method.Outputs)
 }
+
+// DelegatorDelegations returns all the delegations of a delegator with pagination.
+func (p Precompile) DelegatorDelegations(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDelegatorDelegationsRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	queryServer := stakingkeeper.Querier{Keeper: &p.stakingKeeper}
+
+	res, err := queryServer.DelegatorDelegations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(DelegationsOutput).FromResponse(res.DelegationResponses, res.Pagination)
+
+	return out.Pack(method.Outputs)
+}
+
+// DelegatorUnbondingDelegations returns all the unbonding delegations of a delegator with pagination.
+func (p Precompile) DelegatorUnbondingDelegations(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDelegatorUnbondingDelegationsRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	queryServer := stakingkeeper.Querier{Keeper: &p.stakingKeeper}
+
+	res, err := queryServer.DelegatorUnbondingDelegations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(UnbondingDelegationsOutput).FromResponse(res)
+
+	return out.Pack(method.Outputs)
+}
+
+// ValidatorDelegations returns all the delegations to a validator with pagination.
+func (p Precompile) ValidatorDelegations(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewValidatorDelegationsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	queryServer := stakingkeeper.Querier{Keeper: &p.stakingKeeper}
+
+	res, err := queryServer.ValidatorDelegations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(DelegationsOutput).FromResponse(res.DelegationResponses, res.Pagination)
+
+	return out.Pack(method.Outputs)
+}
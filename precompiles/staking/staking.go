@@ -16,6 +16,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 )
 
@@ -29,8 +30,9 @@ var f embed.FS
 // Precompile defines the precompiled contract for staking.
 type Precompile struct {
 	cmn.Precompile
-	stakingKeeper stakingkeeper.Keeper
-	addrCdc       address.Codec
+	stakingKeeper      stakingkeeper.Keeper
+	distributionKeeper distributionkeeper.Keeper
+	addrCdc            address.Codec
 }
 
 // LoadABI loads the staking ABI from the embedded abi.json file
@@ -43,6 +45,7 @@ func LoadABI() (abi.ABI, error) {
 // PrecompiledContract interface.
 func NewPrecompile(
 	stakingKeeper stakingkeeper.Keeper,
+	distributionKeeper distributionkeeper.Keeper,
 	addrCdc address.Codec,
 ) (*Precompile, error) {
 	abi, err := LoadABI()
@@ -56,8 +59,9 @@ func NewPrecompile(
 			KvGasConfig:          storetypes.KVGasConfig(),
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 		},
-		stakingKeeper: stakingKeeper,
-		addrCdc:       addrCdc,
+		stakingKeeper:      stakingKeeper,
+		distributionKeeper: distributionKeeper,
+		addrCdc:            addrCdc,
 	}
 	// SetAddress defines the address of the staking precompiled contract.
 	p.SetAddress(common.HexToAddress(evmtypes.StakingPrecompileAddress))
@@ -119,6 +123,12 @@ func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Redelegate(ctx, contract, stateDB, method, args)
 	case CancelUnbondingDelegationMethod:
 		bz, err = p.CancelUnbondingDelegation(ctx, contract, stateDB, method, args)
+	case DelegateBatchMethod:
+		bz, err = p.DelegateBatch(ctx, contract, stateDB, method, args)
+	case UndelegateBatchMethod:
+		bz, err = p.UndelegateBatch(ctx, contract, stateDB, method, args)
+	case ClaimRewardsBatchMethod:
+		bz, err = p.ClaimRewardsBatch(ctx, contract, stateDB, method, args)
 	// Staking queries
 	case DelegationMethod:
 		bz, err = p.Delegation(ctx, contract, method, args)
@@ -132,6 +142,12 @@ func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Redelegation(ctx, method, contract, args)
 	case RedelegationsMethod:
 		bz, err = p.Redelegations(ctx, method, contract, args)
+	case DelegatorDelegationsMethod:
+		bz, err = p.DelegatorDelegations(ctx, method, contract, args)
+	case DelegatorUnbondingDelegationsMethod:
+		bz, err = p.DelegatorUnbondingDelegations(ctx, method, contract, args)
+	case ValidatorDelegationsMethod:
+		bz, err = p.ValidatorDelegations(ctx, method, contract, args)
 	}
 
 	if err != nil {
@@ -161,6 +177,9 @@ func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //   - Undelegate
 //   - Redelegate
 //   - CancelUnbondingDelegation
+//   - DelegateBatch
+//   - UndelegateBatch
+//   - ClaimRewardsBatch
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case CreateValidatorMethod,
@@ -168,7 +187,10 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 		DelegateMethod,
 		UndelegateMethod,
 		RedelegateMethod,
-		CancelUnbondingDelegationMethod:
+		CancelUnbondingDelegationMethod,
+		DelegateBatchMethod,
+		UndelegateBatchMethod,
+		ClaimRewardsBatchMethod:
 		return true
 	default:
 		return false
@@ -10,6 +10,7 @@ import (
 	cmn "github.com/cosmos/evm/precompiles/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 )
 
@@ -30,6 +31,15 @@ const (
 	// CancelUnbondingDelegationMethod defines the ABI method name for the staking
 	// CancelUnbondingDelegation transaction.
 	CancelUnbondingDelegationMethod = "cancelUnbondingDelegation"
+	// DelegateBatchMethod defines the ABI method name for the staking DelegateBatch
+	// transaction.
+	DelegateBatchMethod = "delegateBatch"
+	// UndelegateBatchMethod defines the ABI method name for the staking UndelegateBatch
+	// transaction.
+	UndelegateBatchMethod = "undelegateBatch"
+	// ClaimRewardsBatchMethod defines the ABI method name for the staking ClaimRewardsBatch
+	// transaction.
+	ClaimRewardsBatchMethod = "claimRewardsBatch"
 )
 
 // CreateValidator performs create validator.
@@ -321,3 +331,151 @@ func (p Precompile) CancelUnbondingDelegation(
 
 	return method.Outputs.Pack(true)
 }
+
+// DelegateBatch performs a delegation of coins from a delegator to each of the given validators.
+// The batch is atomic: if any of the delegations fails, the whole call is reverted.
+func (p *Precompile) DelegateBatch(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgs, delegatorHexAddr, err := NewMsgDelegateBatch(args, bondDenom, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ delegator_address: %s, delegations: %d }",
+			delegatorHexAddr,
+			len(msgs),
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != delegatorHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
+	}
+
+	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
+	for _, msg := range msgs {
+		if _, err = msgSrv.Delegate(ctx, msg); err != nil {
+			return nil, fmt.Errorf(ErrBatchEntryFailed, msg.ValidatorAddress, err)
+		}
+
+		if err = p.EmitDelegateEvent(ctx, stateDB, msg, delegatorHexAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// UndelegateBatch performs the undelegation of coins from each of the given validators for a delegator.
+// The batch is atomic: if any of the undelegations fails, the whole call is reverted.
+func (p *Precompile) UndelegateBatch(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgs, delegatorHexAddr, err := NewMsgUndelegateBatch(args, bondDenom, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ delegator_address: %s, undelegations: %d }",
+			delegatorHexAddr,
+			len(msgs),
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != delegatorHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
+	}
+
+	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
+	completionTimes := make([]int64, len(msgs))
+	for i, msg := range msgs {
+		res, err := msgSrv.Undelegate(ctx, msg)
+		if err != nil {
+			return nil, fmt.Errorf(ErrBatchEntryFailed, msg.ValidatorAddress, err)
+		}
+
+		completionTimes[i] = res.CompletionTime.UTC().Unix()
+		if err = p.EmitUnbondEvent(ctx, stateDB, msg, delegatorHexAddr, completionTimes[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(completionTimes)
+}
+
+// ClaimRewardsBatch withdraws the rewards of a delegator from each of the given validators.
+// The batch is atomic: if any of the withdrawals fails, the whole call is reverted.
+func (p *Precompile) ClaimRewardsBatch(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgs, delegatorHexAddr, err := NewMsgWithdrawDelegatorRewardBatch(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ delegator_address: %s, validators: %d }",
+			delegatorHexAddr,
+			len(msgs),
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != delegatorHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
+	}
+
+	msgSrv := distributionkeeper.NewMsgServerImpl(p.distributionKeeper)
+	totalCoins := sdk.Coins{}
+	for _, msg := range msgs {
+		res, err := msgSrv.WithdrawDelegatorReward(ctx, msg)
+		if err != nil {
+			return nil, fmt.Errorf(ErrBatchEntryFailed, msg.ValidatorAddress, err)
+		}
+
+		if err = p.EmitWithdrawDelegatorRewardEvent(ctx, stateDB, msg, delegatorHexAddr, res.Amount.AmountOf(bondDenom)); err != nil {
+			return nil, err
+		}
+
+		totalCoins = totalCoins.Add(res.Amount...)
+	}
+
+	return method.Outputs.Pack(cmn.NewCoinsResponse(totalCoins))
+}
@@ -20,6 +20,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -76,6 +77,13 @@ type EventCancelUnbonding struct {
 	CreationHeight   *big.Int
 }
 
+// EventWithdrawDelegatorReward defines the event data for the staking ClaimRewardsBatch transaction.
+type EventWithdrawDelegatorReward struct {
+	DelegatorAddress common.Address
+	ValidatorAddress common.Address
+	Amount           *big.Int
+}
+
 // Description use golang type alias defines a validator description.
 type Description = struct {
 	Moniker         string "json:\"moniker\""
@@ -93,6 +101,13 @@ type Commission = struct {
 	MaxChangeRate *big.Int "json:\"maxChangeRate\""
 }
 
+// ValidatorAmount use golang type alias defines a validator and amount pair
+// used by the batch staking methods.
+type ValidatorAmount = struct {
+	ValidatorAddress string   "json:\"validatorAddress\""
+	Amount           *big.Int "json:\"amount\""
+}
+
 // NewMsgCreateValidator creates a new MsgCreateValidator instance and does sanity checks
 // on the given arguments before populating the message.
 func NewMsgCreateValidator(args []interface{}, denom string, addrCdc address.Codec) (*stakingtypes.MsgCreateValidator, common.Address, error) {
@@ -366,6 +381,101 @@ func NewMsgCancelUnbondingDelegation(args []interface{}, denom string, addrCdc a
 	return msg, delegatorAddr, nil
 }
 
+// NewMsgDelegateBatch creates a new MsgDelegate instance for each of the validators
+// of a delegateBatch call and does sanity checks on the given arguments before
+// populating the messages.
+func NewMsgDelegateBatch(args []interface{}, denom string, addrCdc address.Codec) ([]*stakingtypes.MsgDelegate, common.Address, error) {
+	delegatorAddr, entries, err := checkBatchArgs(args)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	delegatorAddrStr, err := addrCdc.BytesToString(delegatorAddr.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to decode delegator address: %w", err)
+	}
+
+	msgs := make([]*stakingtypes.MsgDelegate, len(entries))
+	for i, entry := range entries {
+		msgs[i] = &stakingtypes.MsgDelegate{
+			DelegatorAddress: delegatorAddrStr,
+			ValidatorAddress: entry.ValidatorAddress,
+			Amount: sdk.Coin{
+				Denom:  denom,
+				Amount: math.NewIntFromBigInt(entry.Amount),
+			},
+		}
+	}
+
+	return msgs, delegatorAddr, nil
+}
+
+// NewMsgUndelegateBatch creates a new MsgUndelegate instance for each of the validators
+// of an undelegateBatch call and does sanity checks on the given arguments before
+// populating the messages.
+func NewMsgUndelegateBatch(args []interface{}, denom string, addrCdc address.Codec) ([]*stakingtypes.MsgUndelegate, common.Address, error) {
+	delegatorAddr, entries, err := checkBatchArgs(args)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	delegatorAddrStr, err := addrCdc.BytesToString(delegatorAddr.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to decode delegator address: %w", err)
+	}
+
+	msgs := make([]*stakingtypes.MsgUndelegate, len(entries))
+	for i, entry := range entries {
+		msgs[i] = &stakingtypes.MsgUndelegate{
+			DelegatorAddress: delegatorAddrStr,
+			ValidatorAddress: entry.ValidatorAddress,
+			Amount: sdk.Coin{
+				Denom:  denom,
+				Amount: math.NewIntFromBigInt(entry.Amount),
+			},
+		}
+	}
+
+	return msgs, delegatorAddr, nil
+}
+
+// NewMsgWithdrawDelegatorRewardBatch creates a new MsgWithdrawDelegatorReward instance for
+// each of the validators of a claimRewardsBatch call and does sanity checks on the given
+// arguments before populating the messages.
+func NewMsgWithdrawDelegatorRewardBatch(args []interface{}, addrCdc address.Codec) ([]*distributiontypes.MsgWithdrawDelegatorReward, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	delegatorAddr, ok := args[0].(common.Address)
+	if !ok || delegatorAddr == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	validatorAddresses, ok := args[1].([]string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "validatorAddresses", []string{}, args[1])
+	}
+	if len(validatorAddresses) == 0 {
+		return nil, common.Address{}, errors.New(ErrEmptyBatch)
+	}
+
+	delegatorAddrStr, err := addrCdc.BytesToString(delegatorAddr.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to decode delegator address: %w", err)
+	}
+
+	msgs := make([]*distributiontypes.MsgWithdrawDelegatorReward, len(validatorAddresses))
+	for i, validatorAddress := range validatorAddresses {
+		msgs[i] = &distributiontypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: delegatorAddrStr,
+			ValidatorAddress: validatorAddress,
+		}
+	}
+
+	return msgs, delegatorAddr, nil
+}
+
 // NewDelegationRequest creates a new QueryDelegationRequest instance and does sanity checks
 // on the given arguments before populating the request.
 func NewDelegationRequest(args []interface{}, addrCdc address.Codec) (*stakingtypes.QueryDelegationRequest, error) {
@@ -515,6 +625,82 @@ func NewRedelegationsRequest(method *abi.Method, args []interface{}, addrCdc add
 	}, nil
 }
 
+// NewDelegatorDelegationsRequest creates a new QueryDelegatorDelegationsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewDelegatorDelegationsRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*stakingtypes.QueryDelegatorDelegationsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input DelegatorDelegationsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to DelegatorDelegationsInput struct: %s", err)
+	}
+
+	if input.DelegatorAddress == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidDelegator, input.DelegatorAddress)
+	}
+
+	delegatorAddr, err := addrCdc.BytesToString(input.DelegatorAddress.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode delegator address: %w", err)
+	}
+
+	return &stakingtypes.QueryDelegatorDelegationsRequest{
+		DelegatorAddr: delegatorAddr,
+		Pagination:    &input.PageRequest,
+	}, nil
+}
+
+// NewDelegatorUnbondingDelegationsRequest creates a new QueryDelegatorUnbondingDelegationsRequest instance
+// and does sanity checks on the given arguments before populating the request.
+func NewDelegatorUnbondingDelegationsRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*stakingtypes.QueryDelegatorUnbondingDelegationsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input DelegatorUnbondingDelegationsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to DelegatorUnbondingDelegationsInput struct: %s", err)
+	}
+
+	if input.DelegatorAddress == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidDelegator, input.DelegatorAddress)
+	}
+
+	delegatorAddr, err := addrCdc.BytesToString(input.DelegatorAddress.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode delegator address: %w", err)
+	}
+
+	return &stakingtypes.QueryDelegatorUnbondingDelegationsRequest{
+		DelegatorAddr: delegatorAddr,
+		Pagination:    &input.PageRequest,
+	}, nil
+}
+
+// NewValidatorDelegationsRequest creates a new QueryValidatorDelegationsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewValidatorDelegationsRequest(method *abi.Method, args []interface{}) (*stakingtypes.QueryValidatorDelegationsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input ValidatorDelegationsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to ValidatorDelegationsInput struct: %s", err)
+	}
+
+	if _, err := sdk.ValAddressFromBech32(input.ValidatorAddress); err != nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidValidator, input.ValidatorAddress)
+	}
+
+	return &stakingtypes.QueryValidatorDelegationsRequest{
+		ValidatorAddr: input.ValidatorAddress,
+		Pagination:    &input.PageRequest,
+	}, nil
+}
+
 // RedelegationRequest is a struct that contains the information to pass into a redelegation query.
 type RedelegationRequest struct {
 	DelegatorAddress    sdk.AccAddress
@@ -867,6 +1053,115 @@ func NewUnbondingDelegationRequest(args []interface{}, addrCdc address.Codec) (*
 	}, nil
 }
 
+// DelegationResponse is a struct to represent a delegation between a delegator
+// and a validator together with its balance.
+type DelegationResponse struct {
+	DelegatorAddress string
+	ValidatorAddress string
+	Shares           *big.Int
+	Balance          cmn.Coin
+}
+
+// DelegatorDelegationsInput is a struct to represent the input information for
+// the delegatorDelegations query. Needed to unpack arguments into the PageRequest struct.
+type DelegatorDelegationsInput struct {
+	DelegatorAddress common.Address
+	PageRequest      query.PageRequest
+}
+
+// ValidatorDelegationsInput is a struct to represent the input information for
+// the validatorDelegations query. Needed to unpack arguments into the PageRequest struct.
+type ValidatorDelegationsInput struct {
+	ValidatorAddress string
+	PageRequest      query.PageRequest
+}
+
+// DelegationsOutput is a struct to represent the key information from
+// a delegatorDelegations or validatorDelegations response.
+type DelegationsOutput struct {
+	Delegations  []DelegationResponse
+	PageResponse query.PageResponse
+}
+
+// FromResponse populates the DelegationsOutput from the delegation responses and
+// pagination of a QueryDelegatorDelegationsResponse or QueryValidatorDelegationsResponse.
+func (do *DelegationsOutput) FromResponse(delegations stakingtypes.DelegationResponses, pagination *query.PageResponse) *DelegationsOutput {
+	do.Delegations = make([]DelegationResponse, len(delegations))
+	for i, d := range delegations {
+		do.Delegations[i] = DelegationResponse{
+			DelegatorAddress: d.Delegation.DelegatorAddress,
+			ValidatorAddress: d.Delegation.ValidatorAddress,
+			Shares:           d.Delegation.Shares.BigInt(),
+			Balance: cmn.Coin{
+				Denom:  d.Balance.Denom,
+				Amount: d.Balance.Amount.BigInt(),
+			},
+		}
+	}
+
+	if pagination != nil {
+		do.PageResponse.Total = pagination.Total
+		do.PageResponse.NextKey = pagination.NextKey
+	}
+
+	return do
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (do *DelegationsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(do.Delegations, do.PageResponse)
+}
+
+// DelegatorUnbondingDelegationsInput is a struct to represent the input information for
+// the delegatorUnbondingDelegations query. Needed to unpack arguments into the PageRequest struct.
+type DelegatorUnbondingDelegationsInput struct {
+	DelegatorAddress common.Address
+	PageRequest      query.PageRequest
+}
+
+// UnbondingDelegationsOutput is a struct to represent the key information from
+// a delegatorUnbondingDelegations response.
+type UnbondingDelegationsOutput struct {
+	UnbondingDelegations []UnbondingDelegationResponse
+	PageResponse         query.PageResponse
+}
+
+// FromResponse populates the UnbondingDelegationsOutput from a QueryDelegatorUnbondingDelegationsResponse.
+func (uo *UnbondingDelegationsOutput) FromResponse(res *stakingtypes.QueryDelegatorUnbondingDelegationsResponse) *UnbondingDelegationsOutput {
+	uo.UnbondingDelegations = make([]UnbondingDelegationResponse, len(res.UnbondingResponses))
+	for i, ubd := range res.UnbondingResponses {
+		entries := make([]UnbondingDelegationEntry, len(ubd.Entries))
+		for j, entry := range ubd.Entries {
+			entries[j] = UnbondingDelegationEntry{
+				UnbondingId:             entry.UnbondingId,
+				UnbondingOnHoldRefCount: entry.UnbondingOnHoldRefCount,
+				CreationHeight:          entry.CreationHeight,
+				CompletionTime:          entry.CompletionTime.UTC().Unix(),
+				InitialBalance:          entry.InitialBalance.BigInt(),
+				Balance:                 entry.Balance.BigInt(),
+			}
+		}
+
+		uo.UnbondingDelegations[i] = UnbondingDelegationResponse{
+			DelegatorAddress: ubd.DelegatorAddress,
+			ValidatorAddress: ubd.ValidatorAddress,
+			Entries:          entries,
+		}
+	}
+
+	if res.Pagination != nil {
+		uo.PageResponse.Total = res.Pagination.Total
+		uo.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return uo
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (uo *UnbondingDelegationsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(uo.UnbondingDelegations, uo.PageResponse)
+}
+
 // checkDelegationUndelegationArgs checks the arguments for the delegation and undelegation functions.
 func checkDelegationUndelegationArgs(args []interface{}) (common.Address, string, *big.Int, error) {
 	if len(args) != 3 {
@@ -891,6 +1186,34 @@ func checkDelegationUndelegationArgs(args []interface{}) (common.Address, string
 	return delegatorAddr, validatorAddress, amount, nil
 }
 
+// checkBatchArgs checks the arguments for the delegateBatch and undelegateBatch functions.
+func checkBatchArgs(args []interface{}) (common.Address, []ValidatorAmount, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	delegatorAddr, ok := args[0].(common.Address)
+	if !ok || delegatorAddr == (common.Address{}) {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	entries, ok := args[1].([]ValidatorAmount)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "validatorAmounts", []ValidatorAmount{}, args[1])
+	}
+	if len(entries) == 0 {
+		return common.Address{}, nil, errors.New(ErrEmptyBatch)
+	}
+
+	for _, entry := range entries {
+		if entry.Amount == nil {
+			return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidAmount, entry.Amount)
+		}
+	}
+
+	return delegatorAddr, entries, nil
+}
+
 // FormatConsensusPubkey format ConsensusPubkey into a base64 string
 func FormatConsensusPubkey(consensusPubkey *codectypes.Any) string {
 	ed25519pk, ok := consensusPubkey.GetCachedValue().(cryptotypes.PubKey)
@@ -11,6 +11,7 @@ import (
 	cmn "github.com/cosmos/evm/precompiles/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 )
 
@@ -645,3 +646,136 @@ func TestNewUnbondingDelegationRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMsgDelegateBatch(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	delegatorAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	entries := []ValidatorAmount{
+		{ValidatorAddress: validatorAddr, Amount: big.NewInt(1000000000)},
+		{ValidatorAddress: validatorAddr, Amount: big.NewInt(2000000000)},
+	}
+
+	expectedDelegatorAddr, err := addrCodec.BytesToString(delegatorAddr.Bytes())
+	require.NoError(t, err)
+
+	// the arguments are round-tripped through the ABI to make sure
+	// the decoded batch matches the ValidatorAmount type
+	abi, err := LoadABI()
+	require.NoError(t, err)
+	method := abi.Methods[DelegateBatchMethod]
+	packed, err := method.Inputs.Pack(delegatorAddr, entries)
+	require.NoError(t, err)
+	decodedArgs, err := method.Inputs.Unpack(packed)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: decodedArgs,
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			name:    "empty delegator address",
+			args:    []interface{}{common.Address{}, entries},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidDelegator, common.Address{}),
+		},
+		{
+			name:    "invalid batch type",
+			args:    []interface{}{delegatorAddr, []string{validatorAddr}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidType, "validatorAmounts", []ValidatorAmount{}, []string{validatorAddr}),
+		},
+		{
+			name:    "empty batch",
+			args:    []interface{}{delegatorAddr, []ValidatorAmount{}},
+			wantErr: true,
+			errMsg:  ErrEmptyBatch,
+		},
+		{
+			name:    "nil amount",
+			args:    []interface{}{delegatorAddr, []ValidatorAmount{{ValidatorAddress: validatorAddr}}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidAmount, nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msgs, returnAddr, err := NewMsgDelegateBatch(tt.args, denom, addrCodec)
+
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errMsg)
+				require.Nil(t, msgs)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, delegatorAddr, returnAddr)
+			require.Len(t, msgs, len(entries))
+			for i, msg := range msgs {
+				require.Equal(t, expectedDelegatorAddr, msg.DelegatorAddress)
+				require.Equal(t, entries[i].ValidatorAddress, msg.ValidatorAddress)
+				require.Equal(t, entries[i].Amount, msg.Amount.Amount.BigInt())
+				require.Equal(t, denom, msg.Amount.Denom)
+			}
+		})
+	}
+}
+
+func TestNewMsgWithdrawDelegatorRewardBatch(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	delegatorAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	expectedDelegatorAddr, err := addrCodec.BytesToString(delegatorAddr.Bytes())
+	require.NoError(t, err)
+
+	msgs, returnAddr, err := NewMsgWithdrawDelegatorRewardBatch([]interface{}{delegatorAddr, []string{validatorAddr}}, addrCodec)
+	require.NoError(t, err)
+	require.Equal(t, delegatorAddr, returnAddr)
+	require.Len(t, msgs, 1)
+	require.Equal(t, expectedDelegatorAddr, msgs[0].DelegatorAddress)
+	require.Equal(t, validatorAddr, msgs[0].ValidatorAddress)
+
+	_, _, err = NewMsgWithdrawDelegatorRewardBatch([]interface{}{delegatorAddr, []string{}}, addrCodec)
+	require.ErrorContains(t, err, ErrEmptyBatch)
+
+	_, _, err = NewMsgWithdrawDelegatorRewardBatch([]interface{}{common.Address{}, []string{validatorAddr}}, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidDelegator, common.Address{}))
+}
+
+func TestNewDelegatorDelegationsRequest(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	abi, err := LoadABI()
+	require.NoError(t, err)
+	method := abi.Methods[DelegatorDelegationsMethod]
+
+	delegatorAddr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	expectedDelegatorAddr, err := addrCodec.BytesToString(delegatorAddr.Bytes())
+	require.NoError(t, err)
+
+	pageRequest := query.PageRequest{Limit: 10, CountTotal: true}
+	req, err := NewDelegatorDelegationsRequest(&method, []interface{}{delegatorAddr, pageRequest}, addrCodec)
+	require.NoError(t, err)
+	require.Equal(t, expectedDelegatorAddr, req.DelegatorAddr)
+	require.Equal(t, uint64(10), req.Pagination.Limit)
+	require.True(t, req.Pagination.CountTotal)
+
+	_, err = NewDelegatorDelegationsRequest(&method, []interface{}{common.Address{}, pageRequest}, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidDelegator, common.Address{}))
+
+	_, err = NewDelegatorDelegationsRequest(&method, []interface{}{delegatorAddr}, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1))
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The ITokenFactory contract's address.
+address constant TOKENFACTORY_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080D;
+
+/// @dev The ITokenFactory contract's instance.
+ITokenFactory constant TOKENFACTORY_CONTRACT = ITokenFactory(
+    TOKENFACTORY_PRECOMPILE_ADDRESS
+);
+
+/// @author Evmos Team
+/// @title Token Factory Precompiled Contract
+/// @dev The interface through which solidity contracts create and administer
+/// native bank denoms of the form factory/{creator}/{subdenom}. Each created
+/// denom is registered as a token pair and exposed through its own ERC20 precompile.
+/// @custom:address 0x000000000000000000000000000000000000080D
+interface ITokenFactory {
+    /// @dev Emitted when a new denom is created.
+    /// @param creator The address of the account that created the denom
+    /// @param denom The full denomination of the new token
+    /// @param token The address of the ERC20 precompile of the new token
+    event CreateDenom(address indexed creator, string denom, address token);
+
+    /// @dev Emitted when new coins of a factory denom are minted.
+    /// @param admin The address of the denom admin
+    /// @param to The address of the account receiving the minted coins
+    /// @param denom The denomination of the minted coins
+    /// @param amount The amount of minted coins
+    event Mint(
+        address indexed admin,
+        address indexed to,
+        string denom,
+        uint256 amount
+    );
+
+    /// @dev Emitted when coins of a factory denom are burned.
+    /// @param admin The address of the denom admin
+    /// @param denom The denomination of the burned coins
+    /// @param amount The amount of burned coins
+    event Burn(address indexed admin, string denom, uint256 amount);
+
+    /// @dev Emitted when the admin of a factory denom is changed.
+    /// @param admin The address of the previous denom admin
+    /// @param newAdmin The address of the new denom admin, zero if renounced
+    /// @param denom The denomination whose admin changed
+    event ChangeAdmin(
+        address indexed admin,
+        address indexed newAdmin,
+        string denom
+    );
+
+    /// @dev Emitted when the bank metadata of a factory denom is set.
+    /// @param admin The address of the denom admin
+    /// @param denom The denomination whose metadata was set
+    /// @param name The new token name
+    /// @param symbol The new token symbol
+    /// @param decimals The new token decimals
+    event SetDenomMetadata(
+        address indexed admin,
+        string denom,
+        string name,
+        string symbol,
+        uint8 decimals
+    );
+
+    /// @dev Creates a new denom factory/{creator}/{subdenom} administered by the creator.
+    /// The denom creation fee, if any, is charged to the creator.
+    /// @param creator The address of the denom creator
+    /// @param subdenom The subdenom of the new token
+    /// @return denom The full denomination of the new token
+    /// @return token The address of the ERC20 precompile of the new token
+    function createDenom(
+        address creator,
+        string memory subdenom
+    ) external returns (string memory denom, address token);
+
+    /// @dev Mints new coins of a factory denom to the given account.
+    /// @param admin The address of the denom admin
+    /// @param denom The denomination to mint
+    /// @param to The address of the account receiving the minted coins
+    /// @param amount The amount of coins to mint
+    /// @return success Whether the coins were minted
+    function mint(
+        address admin,
+        string memory denom,
+        address to,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev Burns coins of a factory denom from the admin's balance.
+    /// @param admin The address of the denom admin
+    /// @param denom The denomination to burn
+    /// @param amount The amount of coins to burn
+    /// @return success Whether the coins were burned
+    function burn(
+        address admin,
+        string memory denom,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev Transfers the admin role of a factory denom. Setting the zero
+    /// address renounces the admin role permanently.
+    /// @param admin The address of the current denom admin
+    /// @param denom The denomination whose admin is changed
+    /// @param newAdmin The address of the new denom admin
+    /// @return success Whether the admin was changed
+    function changeAdmin(
+        address admin,
+        string memory denom,
+        address newAdmin
+    ) external returns (bool success);
+
+    /// @dev Sets the bank metadata of a factory denom. The name, symbol and
+    /// decimals are exposed by the ERC20 precompile of the token.
+    /// @param admin The address of the denom admin
+    /// @param denom The denomination whose metadata is set
+    /// @param name The token name
+    /// @param symbol The token symbol
+    /// @param decimals The token decimals
+    /// @return success Whether the metadata was set
+    function setDenomMetadata(
+        address admin,
+        string memory denom,
+        string memory name,
+        string memory symbol,
+        uint8 decimals
+    ) external returns (bool success);
+
+    /// @dev Queries the admin of a factory denom.
+    /// @param denom The denomination to query
+    /// @return admin The address of the denom admin, zero if renounced
+    function denomAdmin(
+        string memory denom
+    ) external view returns (address admin);
+
+    /// @dev Queries the denoms created by the given account.
+    /// @param creator The address of the denom creator
+    /// @param pageRequest The pagination request
+    /// @return denoms The denominations created by the account
+    /// @return pageResponse The pagination response
+    function denomsFromCreator(
+        address creator,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (string[] memory denoms, PageResponse memory pageResponse);
+
+    /// @dev Queries the fee charged for creating a new denom.
+    /// @return fee The denom creation fee
+    function denomCreationFee() external view returns (Coin[] memory fee);
+}
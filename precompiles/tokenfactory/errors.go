@@ -0,0 +1,20 @@
+package tokenfactory
+
+const (
+	// ErrInvalidCreator is raised when the creator address is not valid.
+	ErrInvalidCreator = "invalid creator address: %s"
+	// ErrInvalidAdmin is raised when the admin address is not valid.
+	ErrInvalidAdmin = "invalid admin address: %s"
+	// ErrInvalidReceiver is raised when the receiver address is not valid.
+	ErrInvalidReceiver = "invalid receiver address: %s"
+	// ErrInvalidDenom is raised when the denom is not valid.
+	ErrInvalidDenom = "invalid denom: %v"
+	// ErrInvalidSubdenom is raised when the subdenom is not valid.
+	ErrInvalidSubdenom = "invalid subdenom: %v"
+	// ErrInvalidAmount is raised when the amount is not valid.
+	ErrInvalidAmount = "invalid amount: %v"
+	// ErrInvalidDecimals is raised when the decimals are not valid.
+	ErrInvalidDecimals = "invalid decimals: %v"
+	// ErrNotEVMAddress is raised when an account address cannot be represented as an EVM address.
+	ErrNotEVMAddress = "account %s is not a 20-byte address"
+)
@@ -0,0 +1,197 @@
+package tokenfactory
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeCreateDenom defines the event type for the token factory CreateDenomMethod transaction.
+	EventTypeCreateDenom = "CreateDenom"
+	// EventTypeMint defines the event type for the token factory MintMethod transaction.
+	EventTypeMint = "Mint"
+	// EventTypeBurn defines the event type for the token factory BurnMethod transaction.
+	EventTypeBurn = "Burn"
+	// EventTypeChangeAdmin defines the event type for the token factory ChangeAdminMethod transaction.
+	EventTypeChangeAdmin = "ChangeAdmin"
+	// EventTypeSetDenomMetadata defines the event type for the token factory SetDenomMetadataMethod transaction.
+	EventTypeSetDenomMetadata = "SetDenomMetadata"
+)
+
+// EmitCreateDenomEvent creates a new event emitted on a CreateDenom transaction.
+func (p Precompile) EmitCreateDenomEvent(ctx sdk.Context, stateDB vm.StateDB, creator common.Address, denom string, token common.Address) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeCreateDenom]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(creator)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(denom, token)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitMintEvent creates a new event emitted on a Mint transaction.
+func (p Precompile) EmitMintEvent(ctx sdk.Context, stateDB vm.StateDB, admin, to common.Address, denom string, amount *big.Int) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeMint]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(admin)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(to)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(denom, amount)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitBurnEvent creates a new event emitted on a Burn transaction.
+func (p Precompile) EmitBurnEvent(ctx sdk.Context, stateDB vm.StateDB, admin common.Address, denom string, amount *big.Int) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeBurn]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(admin)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(denom, amount)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitChangeAdminEvent creates a new event emitted on a ChangeAdmin transaction.
+func (p Precompile) EmitChangeAdminEvent(ctx sdk.Context, stateDB vm.StateDB, admin, newAdmin common.Address, denom string) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeChangeAdmin]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(admin)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(newAdmin)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(denom)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitSetDenomMetadataEvent creates a new event emitted on a SetDenomMetadata transaction.
+func (p Precompile) EmitSetDenomMetadataEvent(ctx sdk.Context, stateDB vm.StateDB, admin common.Address, denom, name, symbol string, decimals uint8) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeSetDenomMetadata]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(admin)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2], event.Inputs[3], event.Inputs[4]}
+	packed, err := arguments.Pack(denom, name, symbol, decimals)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
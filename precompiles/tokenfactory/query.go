@@ -0,0 +1,81 @@
+package tokenfactory
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DenomAdminMethod defines the method name for the denom admin precompile request.
+	DenomAdminMethod = "denomAdmin"
+	// DenomsFromCreatorMethod defines the method name for the denoms from creator precompile request.
+	DenomsFromCreatorMethod = "denomsFromCreator"
+	// DenomCreationFeeMethod defines the method name for the denom creation fee precompile request.
+	DenomCreationFeeMethod = "denomCreationFee"
+)
+
+// DenomAdmin implements the query logic for getting the admin of a factory denom.
+// It returns the zero address if the admin role was renounced.
+func (p *Precompile) DenomAdmin(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseDenomAdminArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.tokenFactoryKeeper.DenomAuthorityMetadata(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var admin common.Address
+	if res.AuthorityMetadata.Admin != "" {
+		admin, err = hexFromBech32(p.addrCdc, res.AuthorityMetadata.Admin)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(admin)
+}
+
+// DenomsFromCreator implements the query logic for getting the denoms created by an account.
+func (p *Precompile) DenomsFromCreator(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseDenomsFromCreatorArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.tokenFactoryKeeper.DenomsFromCreator(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output := new(DenomsFromCreatorOutput).FromResponse(res)
+	return method.Outputs.Pack(output.Denoms, output.PageResponse)
+}
+
+// DenomCreationFee implements the query logic for getting the fee charged for creating a denom.
+func (p *Precompile) DenomCreationFee(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	_ []interface{},
+) ([]byte, error) {
+	params := p.tokenFactoryKeeper.GetParams(ctx)
+	return method.Outputs.Pack(cmn.NewCoinsResponse(params.DenomCreationFee))
+}
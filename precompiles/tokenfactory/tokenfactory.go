@@ -0,0 +1,167 @@
+package tokenfactory
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	tokenfactorykeeper "github.com/cosmos/evm/x/tokenfactory/keeper"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for the token factory.
+type Precompile struct {
+	cmn.Precompile
+	tokenFactoryKeeper tokenfactorykeeper.Keeper
+	addrCdc            address.Codec
+}
+
+// LoadABI loads the token factory ABI from the embedded abi.json file
+// for the token factory precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new token factory Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	tokenFactoryKeeper tokenfactorykeeper.Keeper,
+	addrCdc address.Codec,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		tokenFactoryKeeper: tokenFactoryKeeper,
+		addrCdc:            addrCdc,
+	}
+
+	// SetAddress defines the address of the token factory precompiled contract.
+	p.SetAddress(common.HexToAddress(evmtypes.TokenFactoryPrecompileAddress))
+
+	return p, nil
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the precompiled contract token factory methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	bz, err = p.run(evm, contract, readOnly)
+	if err != nil {
+		return cmn.ReturnRevertError(evm, err)
+	}
+
+	return bz, nil
+}
+
+func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start the balance change handler before executing the precompile.
+	p.GetBalanceHandler().BeforeBalanceChange(ctx)
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// token factory transactions
+	case CreateDenomMethod:
+		bz, err = p.CreateDenom(ctx, contract, stateDB, method, args)
+	case MintMethod:
+		bz, err = p.Mint(ctx, contract, stateDB, method, args)
+	case BurnMethod:
+		bz, err = p.Burn(ctx, contract, stateDB, method, args)
+	case ChangeAdminMethod:
+		bz, err = p.ChangeAdmin(ctx, contract, stateDB, method, args)
+	case SetDenomMetadataMethod:
+		bz, err = p.SetDenomMetadata(ctx, contract, stateDB, method, args)
+
+	// token factory queries
+	case DenomAdminMethod:
+		bz, err = p.DenomAdmin(ctx, method, contract, args)
+	case DenomsFromCreatorMethod:
+		bz, err = p.DenomsFromCreator(ctx, method, contract, args)
+	case DenomCreationFeeMethod:
+		bz, err = p.DenomCreationFee(ctx, method, contract, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost, nil, tracing.GasChangeCallPrecompiledContract) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	// Process the native balance changes after the method execution.
+	err = p.GetBalanceHandler().AfterBalanceChange(ctx, stateDB)
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case CreateDenomMethod, MintMethod, BurnMethod,
+		ChangeAdminMethod, SetDenomMetadataMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "tokenfactory")
+}
@@ -0,0 +1,180 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// CreateDenomMethod defines the ABI method name for the token factory CreateDenom transaction.
+	CreateDenomMethod = "createDenom"
+	// MintMethod defines the ABI method name for the token factory Mint transaction.
+	MintMethod = "mint"
+	// BurnMethod defines the ABI method name for the token factory Burn transaction.
+	BurnMethod = "burn"
+	// ChangeAdminMethod defines the ABI method name for the token factory ChangeAdmin transaction.
+	ChangeAdminMethod = "changeAdmin"
+	// SetDenomMetadataMethod defines the ABI method name for the token factory SetDenomMetadata transaction.
+	SetDenomMetadataMethod = "setDenomMetadata"
+)
+
+// CreateDenom defines a method to create a new factory denom administered by the creator.
+func (p *Precompile) CreateDenom(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, creatorHexAddr, err := NewMsgCreateDenom(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != creatorHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), creatorHexAddr.String())
+	}
+
+	res, err := p.tokenFactoryKeeper.CreateDenom(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	token := common.HexToAddress(res.Erc20Address)
+	if err = p.EmitCreateDenomEvent(ctx, stateDB, creatorHexAddr, res.NewTokenDenom, token); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.NewTokenDenom, token)
+}
+
+// Mint defines a method to mint coins of a factory denom to a given account.
+func (p *Precompile) Mint(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, adminHexAddr, err := NewMsgMint(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != adminHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), adminHexAddr.String())
+	}
+
+	if _, err = p.tokenFactoryKeeper.Mint(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	toHexAddr, err := hexFromBech32(p.addrCdc, msg.MintToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitMintEvent(ctx, stateDB, adminHexAddr, toHexAddr, msg.Amount.Denom, msg.Amount.Amount.BigInt()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Burn defines a method to burn coins of a factory denom from the admin's balance.
+func (p *Precompile) Burn(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, adminHexAddr, err := NewMsgBurn(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != adminHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), adminHexAddr.String())
+	}
+
+	if _, err = p.tokenFactoryKeeper.Burn(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitBurnEvent(ctx, stateDB, adminHexAddr, msg.Amount.Denom, msg.Amount.Amount.BigInt()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// ChangeAdmin defines a method to transfer or renounce the admin role of a factory denom.
+func (p *Precompile) ChangeAdmin(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, adminHexAddr, newAdminHexAddr, err := NewMsgChangeAdmin(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != adminHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), adminHexAddr.String())
+	}
+
+	if _, err = p.tokenFactoryKeeper.ChangeAdmin(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitChangeAdminEvent(ctx, stateDB, adminHexAddr, newAdminHexAddr, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// SetDenomMetadata defines a method to set the bank metadata of a factory denom.
+func (p *Precompile) SetDenomMetadata(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, adminHexAddr, err := NewMsgSetDenomMetadata(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != adminHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), adminHexAddr.String())
+	}
+
+	if _, err = p.tokenFactoryKeeper.SetDenomMetadata(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// the arguments were validated when building the message
+	name, symbol, decimals := args[2].(string), args[3].(string), args[4].(uint8)
+	if err = p.EmitSetDenomMetadataEvent(ctx, stateDB, adminHexAddr, msg.Metadata.Base, name, symbol, decimals); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,342 @@
+package tokenfactory
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/x/tokenfactory/types"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// EventCreateDenom defines the event data for the CreateDenom transaction.
+type EventCreateDenom struct {
+	Creator common.Address
+	Denom   string
+	Token   common.Address
+}
+
+// EventMint defines the event data for the Mint transaction.
+type EventMint struct {
+	Admin  common.Address
+	To     common.Address
+	Denom  string
+	Amount *big.Int
+}
+
+// EventBurn defines the event data for the Burn transaction.
+type EventBurn struct {
+	Admin  common.Address
+	Denom  string
+	Amount *big.Int
+}
+
+// EventChangeAdmin defines the event data for the ChangeAdmin transaction.
+type EventChangeAdmin struct {
+	Admin    common.Address
+	NewAdmin common.Address
+	Denom    string
+}
+
+// EventSetDenomMetadata defines the event data for the SetDenomMetadata transaction.
+type EventSetDenomMetadata struct {
+	Admin    common.Address
+	Denom    string
+	Name     string
+	Symbol   string
+	Decimals uint8
+}
+
+// DenomsFromCreatorInput defines the input for the DenomsFromCreator query.
+type DenomsFromCreatorInput struct {
+	Creator     common.Address
+	PageRequest query.PageRequest
+}
+
+// DenomsFromCreatorOutput defines the output for the DenomsFromCreator query.
+type DenomsFromCreatorOutput struct {
+	Denoms       []string           `abi:"denoms"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// NewMsgCreateDenom creates a new MsgCreateDenom instance and returns the
+// creator address in hex format.
+func NewMsgCreateDenom(args []interface{}, addrCdc address.Codec) (*types.MsgCreateDenom, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	creatorHexAddr, ok := args[0].(common.Address)
+	if !ok || creatorHexAddr == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidCreator, args[0])
+	}
+
+	subdenom, ok := args[1].(string)
+	if !ok || subdenom == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSubdenom, args[1])
+	}
+
+	creator, err := addrCdc.BytesToString(creatorHexAddr.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidCreator, err)
+	}
+
+	msg := types.NewMsgCreateDenom(creator, subdenom)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, creatorHexAddr, nil
+}
+
+// NewMsgMint creates a new MsgMint instance and returns the admin address
+// in hex format.
+func NewMsgMint(args []interface{}, addrCdc address.Codec) (*types.MsgMint, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	adminHexAddr, admin, err := parseAdmin(args[0], addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	denom, ok := args[1].(string)
+	if !ok || denom == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDenom, args[1])
+	}
+
+	toHexAddr, ok := args[2].(common.Address)
+	if !ok || toHexAddr == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidReceiver, args[2])
+	}
+
+	to, err := addrCdc.BytesToString(toHexAddr.Bytes())
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidReceiver, err)
+	}
+
+	amount, err := parseAmount(args[3])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := types.NewMsgMint(admin, sdk.NewCoin(denom, amount), to)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, adminHexAddr, nil
+}
+
+// NewMsgBurn creates a new MsgBurn instance and returns the admin address
+// in hex format.
+func NewMsgBurn(args []interface{}, addrCdc address.Codec) (*types.MsgBurn, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	adminHexAddr, admin, err := parseAdmin(args[0], addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	denom, ok := args[1].(string)
+	if !ok || denom == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDenom, args[1])
+	}
+
+	amount, err := parseAmount(args[2])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := types.NewMsgBurn(admin, sdk.NewCoin(denom, amount))
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, adminHexAddr, nil
+}
+
+// NewMsgChangeAdmin creates a new MsgChangeAdmin instance and returns the
+// admin and new admin addresses in hex format. A zero new admin address
+// renounces the admin role.
+func NewMsgChangeAdmin(args []interface{}, addrCdc address.Codec) (*types.MsgChangeAdmin, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	adminHexAddr, admin, err := parseAdmin(args[0], addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	denom, ok := args[1].(string)
+	if !ok || denom == "" {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidDenom, args[1])
+	}
+
+	newAdminHexAddr, ok := args[2].(common.Address)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidAdmin, args[2])
+	}
+
+	var newAdmin string
+	if newAdminHexAddr != (common.Address{}) {
+		newAdmin, err = addrCdc.BytesToString(newAdminHexAddr.Bytes())
+		if err != nil {
+			return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidAdmin, err)
+		}
+	}
+
+	msg := types.NewMsgChangeAdmin(admin, denom, newAdmin)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, adminHexAddr, newAdminHexAddr, nil
+}
+
+// NewMsgSetDenomMetadata creates a new MsgSetDenomMetadata instance and returns
+// the admin address in hex format.
+func NewMsgSetDenomMetadata(args []interface{}, addrCdc address.Codec) (*types.MsgSetDenomMetadata, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	adminHexAddr, admin, err := parseAdmin(args[0], addrCdc)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	denom, ok := args[1].(string)
+	if !ok || denom == "" {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDenom, args[1])
+	}
+
+	name, ok := args[2].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "name", "string", args[2])
+	}
+
+	symbol, ok := args[3].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "symbol", "string", args[3])
+	}
+
+	decimals, ok := args[4].(uint8)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDecimals, args[4])
+	}
+
+	msg := types.NewMsgSetDenomMetadata(admin, types.NewDenomMetadata(denom, name, symbol, uint32(decimals)))
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, adminHexAddr, nil
+}
+
+// ParseDenomAdminArgs parses the arguments for the DenomAdmin query.
+func ParseDenomAdminArgs(args []interface{}) (*types.QueryDenomAuthorityMetadataRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok || denom == "" {
+		return nil, fmt.Errorf(ErrInvalidDenom, args[0])
+	}
+
+	return &types.QueryDenomAuthorityMetadataRequest{
+		Denom: denom,
+	}, nil
+}
+
+// ParseDenomsFromCreatorArgs parses the arguments for the DenomsFromCreator query.
+func ParseDenomsFromCreatorArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*types.QueryDenomsFromCreatorRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input DenomsFromCreatorInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to DenomsFromCreatorInput: %s", err)
+	}
+
+	if input.Creator == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidCreator, input.Creator)
+	}
+
+	creator, err := addrCdc.BytesToString(input.Creator.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidCreator, err)
+	}
+
+	return &types.QueryDenomsFromCreatorRequest{
+		Creator:    creator,
+		Pagination: &input.PageRequest,
+	}, nil
+}
+
+// FromResponse populates the DenomsFromCreatorOutput from a QueryDenomsFromCreatorResponse.
+func (o *DenomsFromCreatorOutput) FromResponse(res *types.QueryDenomsFromCreatorResponse) *DenomsFromCreatorOutput {
+	o.Denoms = res.Denoms
+	if o.Denoms == nil {
+		o.Denoms = []string{}
+	}
+
+	if res.Pagination != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: res.Pagination.NextKey,
+			Total:   res.Pagination.Total,
+		}
+	}
+	return o
+}
+
+// parseAdmin parses a non-zero admin address and returns it in hex and bech32 formats.
+func parseAdmin(arg interface{}, addrCdc address.Codec) (common.Address, string, error) {
+	adminHexAddr, ok := arg.(common.Address)
+	if !ok || adminHexAddr == (common.Address{}) {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidAdmin, arg)
+	}
+
+	admin, err := addrCdc.BytesToString(adminHexAddr.Bytes())
+	if err != nil {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidAdmin, err)
+	}
+
+	return adminHexAddr, admin, nil
+}
+
+// parseAmount parses a positive token amount.
+func parseAmount(arg interface{}) (math.Int, error) {
+	amount, ok := arg.(*big.Int)
+	if !ok || amount == nil || amount.Sign() <= 0 {
+		return math.Int{}, fmt.Errorf(ErrInvalidAmount, arg)
+	}
+
+	return math.NewIntFromBigInt(amount), nil
+}
+
+// hexFromBech32 converts a bech32 account address to an EVM address.
+func hexFromBech32(addrCdc address.Codec, addr string) (common.Address, error) {
+	bz, err := addrCdc.StringToBytes(addr)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(bz) != common.AddressLength {
+		return common.Address{}, fmt.Errorf(ErrNotEVMAddress, addr)
+	}
+	return common.BytesToAddress(bz), nil
+}
@@ -0,0 +1,288 @@
+package tokenfactory
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+)
+
+func TestNewMsgCreateDenom(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	creator := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	creatorBech32, err := addrCodec.BytesToString(creator.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{creator, "bitcoin"},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			name:    "empty creator address",
+			args:    []interface{}{common.Address{}, "bitcoin"},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidCreator, common.Address{}),
+		},
+		{
+			name:    "empty subdenom",
+			args:    []interface{}{creator, ""},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidSubdenom, ""),
+		},
+		{
+			name:    "invalid subdenom",
+			args:    []interface{}{creator, "bit coin"},
+			wantErr: true,
+			errMsg:  "invalid denom",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, hexAddr, err := NewMsgCreateDenom(tc.args, addrCodec)
+			if tc.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, creator, hexAddr)
+			require.Equal(t, creatorBech32, msg.Sender)
+			require.Equal(t, "bitcoin", msg.Subdenom)
+		})
+	}
+}
+
+func TestNewMsgMint(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	admin := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	receiver := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	adminBech32, err := addrCodec.BytesToString(admin.Bytes())
+	require.NoError(t, err)
+	receiverBech32, err := addrCodec.BytesToString(receiver.Bytes())
+	require.NoError(t, err)
+	denom := "factory/" + adminBech32 + "/bitcoin"
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{admin, denom, receiver, big.NewInt(100)},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name:    "empty admin address",
+			args:    []interface{}{common.Address{}, denom, receiver, big.NewInt(100)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidAdmin, common.Address{}),
+		},
+		{
+			name:    "empty receiver address",
+			args:    []interface{}{admin, denom, common.Address{}, big.NewInt(100)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidReceiver, common.Address{}),
+		},
+		{
+			name:    "zero amount",
+			args:    []interface{}{admin, denom, receiver, big.NewInt(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidAmount, big.NewInt(0)),
+		},
+		{
+			name:    "not a factory denom",
+			args:    []interface{}{admin, "aatom", receiver, big.NewInt(100)},
+			wantErr: true,
+			errMsg:  "invalid token factory denom",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, hexAddr, err := NewMsgMint(tc.args, addrCodec)
+			if tc.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, admin, hexAddr)
+			require.Equal(t, adminBech32, msg.Sender)
+			require.Equal(t, receiverBech32, msg.MintToAddress)
+			require.Equal(t, denom, msg.Amount.Denom)
+			require.Equal(t, int64(100), msg.Amount.Amount.Int64())
+		})
+	}
+}
+
+func TestNewMsgChangeAdmin(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	admin := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	newAdmin := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	adminBech32, err := addrCodec.BytesToString(admin.Bytes())
+	require.NoError(t, err)
+	newAdminBech32, err := addrCodec.BytesToString(newAdmin.Bytes())
+	require.NoError(t, err)
+	denom := "factory/" + adminBech32 + "/bitcoin"
+
+	tests := []struct {
+		name        string
+		args        []interface{}
+		expNewAdmin string
+		wantErr     bool
+		errMsg      string
+	}{
+		{
+			name:        "valid",
+			args:        []interface{}{admin, denom, newAdmin},
+			expNewAdmin: newAdminBech32,
+		},
+		{
+			name:        "valid - renounce admin",
+			args:        []interface{}{admin, denom, common.Address{}},
+			expNewAdmin: "",
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			name:    "empty denom",
+			args:    []interface{}{admin, "", newAdmin},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidDenom, ""),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, hexAddr, _, err := NewMsgChangeAdmin(tc.args, addrCodec)
+			if tc.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, admin, hexAddr)
+			require.Equal(t, adminBech32, msg.Sender)
+			require.Equal(t, tc.expNewAdmin, msg.NewAdmin)
+		})
+	}
+}
+
+func TestNewMsgSetDenomMetadata(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	admin := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	adminBech32, err := addrCodec.BytesToString(admin.Bytes())
+	require.NoError(t, err)
+	denom := "factory/" + adminBech32 + "/bitcoin"
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{admin, denom, "Bitcoin", "BTC", uint8(8)},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			name:    "invalid decimals type",
+			args:    []interface{}{admin, denom, "Bitcoin", "BTC", uint32(8)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidDecimals, uint32(8)),
+		},
+		{
+			name:    "empty name",
+			args:    []interface{}{admin, denom, "", "BTC", uint8(8)},
+			wantErr: true,
+			errMsg:  "name field cannot be blank",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, hexAddr, err := NewMsgSetDenomMetadata(tc.args, addrCodec)
+			if tc.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, admin, hexAddr)
+			require.Equal(t, denom, msg.Metadata.Base)
+			require.Equal(t, "Bitcoin", msg.Metadata.Name)
+			require.Equal(t, "BTC", msg.Metadata.Symbol)
+			require.Equal(t, "btc", msg.Metadata.Display)
+		})
+	}
+}
+
+func TestParseDenomsFromCreatorArgs(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	abi, err := LoadABI()
+	require.NoError(t, err)
+	method := abi.Methods[DenomsFromCreatorMethod]
+
+	creator := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	creatorBech32, err := addrCodec.BytesToString(creator.Bytes())
+	require.NoError(t, err)
+	pageRequest := query.PageRequest{Limit: 10, CountTotal: true}
+
+	req, err := ParseDenomsFromCreatorArgs(&method, []interface{}{creator, pageRequest}, addrCodec)
+	require.NoError(t, err)
+	require.Equal(t, creatorBech32, req.Creator)
+	require.Equal(t, uint64(10), req.Pagination.Limit)
+	require.True(t, req.Pagination.CountTotal)
+
+	_, err = ParseDenomsFromCreatorArgs(&method, []interface{}{common.Address{}, pageRequest}, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(ErrInvalidCreator, common.Address{}))
+
+	_, err = ParseDenomsFromCreatorArgs(&method, []interface{}{creator}, addrCodec)
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1))
+}
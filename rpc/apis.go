@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/evm/rpc/backend"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/debug"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/dev"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/miner"
@@ -38,6 +39,12 @@ const (
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
 
+	// Local dev node namespaces
+
+	EvmNamespace     = "evm"
+	AnvilNamespace   = "anvil"
+	HardhatNamespace = "hardhat"
+
 	apiVersion = "1.0"
 )
 
@@ -188,3 +195,74 @@ func RegisterAPINamespace(ns string, creator APICreator) error {
 	apiCreators[ns] = creator
 	return nil
 }
+
+// DevNamespaces returns the JSON-RPC namespaces of the local dev node.
+func DevNamespaces() []string {
+	return []string{EvmNamespace, AnvilNamespace, HardhatNamespace}
+}
+
+// RegisterDevNamespaces registers the JSON-RPC namespaces of the local dev node
+// (see DevNamespaces) backed by the given controller.
+//
+// NOTE: the anvil and hardhat namespaces override eth_sendTransaction to send
+// the txs of impersonated accounts, so they must be enabled after the eth
+// namespace.
+func RegisterDevNamespaces(controller dev.Controller) error {
+	if err := RegisterAPINamespace(EvmNamespace, func(ctx *server.Context,
+		_ client.Context,
+		_ *rpcclient.WSClient,
+		_ bool,
+		_ types.EVMTxIndexer,
+	) []rpc.API {
+		return []rpc.API{
+			{
+				Namespace: EvmNamespace,
+				Version:   apiVersion,
+				Service:   dev.NewEvmAPI(ctx.Logger, controller),
+				Public:    true,
+			},
+		}
+	}); err != nil {
+		return err
+	}
+
+	for _, ns := range []string{AnvilNamespace, HardhatNamespace} {
+		if err := RegisterAPINamespace(ns, newDevStateAPICreator(ns, controller)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newDevStateAPICreator returns the API creator of the dev node state
+// manipulation namespace with the given prefix.
+func newDevStateAPICreator(ns string, controller dev.Controller) APICreator {
+	return func(ctx *server.Context,
+		clientCtx client.Context,
+		_ *rpcclient.WSClient,
+		allowUnprotectedTxs bool,
+		indexer types.EVMTxIndexer,
+	) []rpc.API {
+		evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+		ethAPI, err := dev.NewEthAPI(ctx.Logger, clientCtx, evmBackend, controller)
+		if err != nil {
+			panic(err)
+		}
+
+		return []rpc.API{
+			{
+				Namespace: ns,
+				Version:   apiVersion,
+				Service:   dev.NewAnvilAPI(ctx.Logger, controller),
+				Public:    true,
+			},
+			{
+				Namespace: EthNamespace,
+				Version:   apiVersion,
+				Service:   ethAPI,
+				Public:    true,
+			},
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"math"
 	"math/big"
 	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -16,6 +17,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -312,6 +314,7 @@ func (b *Backend) HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Heade
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFee)
+	ethHeader.Time = uint64(b.BlockTime(resBlock.Block.Header, blockRes).Unix()) //nolint:gosec // G115 // won't exceed uint64
 	return ethHeader, nil
 }
 
@@ -345,6 +348,7 @@ func (b *Backend) HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(*resHeader.Header, bloom, baseFee)
+	ethHeader.Time = uint64(b.BlockTime(*resHeader.Header, blockRes).Unix()) //nolint:gosec // G115 // won't exceed uint64
 	return ethHeader, nil
 }
 
@@ -364,6 +368,15 @@ func (b *Backend) BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.
 	return ethtypes.Bloom{}, errors.New("block bloom event is not found")
 }
 
+// BlockTime returns the block time seen by the EVM. It is the time of the
+// CometBFT header, unless the time of a local dev node was changed.
+func (b *Backend) BlockTime(header cmttypes.Header, blockRes *tmrpctypes.ResultBlockResults) time.Time {
+	if blockRes == nil {
+		return header.Time
+	}
+	return rpctypes.BlockTimeFromEvents(header, blockRes.FinalizeBlockEvents)
+}
+
 // RPCBlockFromTendermintBlock returns a JSON-RPC compatible Ethereum block from a
 // given Tendermint block and its block result.
 func (b *Backend) RPCBlockFromTendermintBlock(
@@ -457,6 +470,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		gasLimit, new(big.Int).SetUint64(gasUsed),
 		ethRPCTxs, bloom, validatorAddr, baseFee,
 	)
+	formattedBlock["timestamp"] = hexutil.Uint64(b.BlockTime(block.Header, blockRes).Unix()) //nolint:gosec // G115 // won't exceed uint64
 	return formattedBlock, nil
 }
 
@@ -500,6 +514,7 @@ func (b *Backend) EthBlockFromTendermintBlock(
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(block.Header, bloom, baseFee)
+	ethHeader.Time = uint64(b.BlockTime(block.Header, blockRes).Unix()) //nolint:gosec // G115 // won't exceed uint64
 	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
 
 	txs := make([]*ethtypes.Transaction, len(msgs))
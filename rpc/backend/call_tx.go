@@ -16,12 +16,14 @@ import (
 	"google.golang.org/grpc/status"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
 // Resend accepts an existing transaction and a new gas price and limit. It will remove
@@ -128,9 +130,7 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, err
 	}
 
-	baseDenom := evmtypes.GetEVMCoinDenom()
-
-	cosmosTx, err := ethereumTx.BuildTx(b.ClientCtx.TxConfig.NewTxBuilder(), baseDenom)
+	cosmosTx, err := b.buildCosmosTx(ethereumTx)
 	if err != nil {
 		b.Logger.Error("failed to build cosmos tx", "error", err.Error())
 		return common.Hash{}, err
@@ -158,6 +158,19 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 	return txHash, nil
 }
 
+// buildCosmosTx builds the cosmos tx from the ethereum msg, converting the fee
+// amount into the fee denom selected by the sender, if any.
+func (b *Backend) buildCosmosTx(msg *evmtypes.MsgEthereumTx) (authsigning.Tx, error) {
+	res, err := b.QueryClient.FeeMarket.AccountFeeDenom(b.Ctx, &feemarkettypes.QueryAccountFeeDenomRequest{
+		Address: sdk.AccAddress(msg.From).String(),
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to query the fee denom of the sender")
+	}
+
+	return msg.BuildTxWithFeeDenom(b.ClientCtx.TxConfig.NewTxBuilder(), evmtypes.GetEVMCoinDenom(), res.FeeDenom)
+}
+
 // SetTxDefaults populates tx message with default values in case they are not
 // provided on the args
 func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error) {
@@ -17,6 +17,36 @@ type FeeMarketQueryClient struct {
 	mock.Mock
 }
 
+// AccountFeeDenom provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) AccountFeeDenom(ctx context.Context, in *types.QueryAccountFeeDenomRequest, opts ...grpc.CallOption) (*types.QueryAccountFeeDenomResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryAccountFeeDenomResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAccountFeeDenomRequest, ...grpc.CallOption) *types.QueryAccountFeeDenomResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryAccountFeeDenomResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAccountFeeDenomRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BaseFee provides a mock function with given fields: ctx, in, opts
 func (_m *FeeMarketQueryClient) BaseFee(ctx context.Context, in *types.QueryBaseFeeRequest, opts ...grpc.CallOption) (*types.QueryBaseFeeResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -77,6 +107,36 @@ func (_m *FeeMarketQueryClient) BlockGas(ctx context.Context, in *types.QueryBlo
 	return r0, r1
 }
 
+// BurnedBaseFee provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) BurnedBaseFee(ctx context.Context, in *types.QueryBurnedBaseFeeRequest, opts ...grpc.CallOption) (*types.QueryBurnedBaseFeeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryBurnedBaseFeeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBurnedBaseFeeRequest, ...grpc.CallOption) *types.QueryBurnedBaseFeeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryBurnedBaseFeeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBurnedBaseFeeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *FeeMarketQueryClient) Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
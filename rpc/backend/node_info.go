@@ -1,8 +1,11 @@
 package backend
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -12,6 +15,7 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/keystore"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/testutil/constants"
@@ -186,18 +190,27 @@ func (b *Backend) SetEtherbase(etherbase common.Address) bool {
 }
 
 // ImportRawKey armors and encrypts a given raw hex encoded ECDSA key and stores it into the key directory.
+// The key can also be given as a Web3 Secret Storage (keystore V3) JSON, decrypted with the password.
 // The name of the key will have the format "personal_<length-keys>", where <length-keys> is the total number of
 // keys stored on the keyring.
 //
 // NOTE: The key will be both armored and encrypted using the same passphrase.
 func (b *Backend) ImportRawKey(privkey, password string) (common.Address, error) {
-	priv, err := crypto.HexToECDSA(privkey)
-	if err != nil {
-		return common.Address{}, err
+	var privKey *ethsecp256k1.PrivKey
+	if keystore.IsKeystoreJSON([]byte(privkey)) {
+		var err error
+		privKey, _, err = keystore.Decrypt([]byte(privkey), password)
+		if err != nil {
+			return common.Address{}, err
+		}
+	} else {
+		priv, err := crypto.HexToECDSA(privkey)
+		if err != nil {
+			return common.Address{}, err
+		}
+		privKey = &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(priv)}
 	}
 
-	privKey := &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(priv)}
-
 	addr := sdk.AccAddress(privKey.PubKey().Address().Bytes())
 	ethereumAddr := common.BytesToAddress(addr)
 
@@ -218,9 +231,72 @@ func (b *Backend) ImportRawKey(privkey, password string) (common.Address, error)
 
 	b.Logger.Info("key successfully imported", "name", privKeyName, "address", ethereumAddr.String())
 
+	if _, err := b.writeKeystoreFile(privKey, password); err != nil {
+		return common.Address{}, err
+	}
+
 	return ethereumAddr, nil
 }
 
+// WriteKeystore writes the key of the keyring as a Web3 Secret Storage
+// (keystore V3) JSON file, encrypted with the password, in the keystore
+// directory of the JSON-RPC config. It returns the path of the file, or an
+// empty path if the keystore directory is not configured.
+func (b *Backend) WriteKeystore(uid, password string) (string, error) {
+	if b.Cfg.JSONRPC.KeystoreDir == "" {
+		return "", nil
+	}
+
+	armor, err := b.ClientCtx.Keyring.ExportPrivKeyArmor(uid, password)
+	if err != nil {
+		return "", err
+	}
+
+	privKey, algo, err := sdkcrypto.UnarmorDecryptPrivKey(armor, password)
+	if err != nil {
+		return "", err
+	}
+
+	ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
+	if !ok || algo != ethsecp256k1.KeyType {
+		return "", fmt.Errorf("invalid key algorithm, got %s, expected %s", algo, ethsecp256k1.KeyType)
+	}
+
+	return b.writeKeystoreFile(ethPrivKey, password)
+}
+
+// writeKeystoreFile writes the key in the keystore directory, with the same
+// file name as geth. It is a no-op if the keystore directory is not configured.
+func (b *Backend) writeKeystoreFile(privKey *ethsecp256k1.PrivKey, password string) (string, error) {
+	dir := b.Cfg.JSONRPC.KeystoreDir
+	if dir == "" {
+		return "", nil
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(b.ClientCtx.HomeDir, dir)
+	}
+
+	keyJSON, err := keystore.Encrypt(privKey, password, keystore.KDFScrypt, false)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+
+	address := common.BytesToAddress(privKey.PubKey().Address())
+	now := time.Now().UTC()
+	name := fmt.Sprintf("UTC--%s--%s", now.Format("2006-01-02T15-04-05.000000000Z"), hex.EncodeToString(address.Bytes()))
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, keyJSON, 0o600); err != nil {
+		return "", err
+	}
+
+	b.Logger.Info("keystore file written", "address", address.String(), "path", path)
+	return path, nil
+}
+
 // ListAccounts will return a list of addresses for accounts this node manages.
 func (b *Backend) ListAccounts() ([]common.Address, error) {
 	addrs := []common.Address{}
@@ -72,10 +72,8 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 		return common.Hash{}, err
 	}
 
-	baseDenom := evmtypes.GetEVMCoinDenom()
-
 	// Assemble transaction from fields
-	tx, err := msg.BuildTx(b.ClientCtx.TxConfig.NewTxBuilder(), baseDenom)
+	tx, err := b.buildCosmosTx(msg)
 	if err != nil {
 		b.Logger.Error("build cosmos tx failed", "error", err.Error())
 		return common.Hash{}, err
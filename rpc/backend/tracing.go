@@ -97,11 +97,16 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		return nil, err
 	}
 
+	blockRes, err := b.RPCClient.BlockResults(b.Ctx, &blk.Block.Height)
+	if err != nil {
+		return nil, fmt.Errorf("block result not found for height %d", blk.Block.Height)
+	}
+
 	traceTxRequest := evmtypes.QueryTraceTxRequest{
 		Msg:             ethMessage,
 		Predecessors:    predecessors,
 		BlockNumber:     blk.Block.Height,
-		BlockTime:       blk.Block.Time,
+		BlockTime:       b.BlockTime(blk.Block.Header, blockRes),
 		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
 		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
@@ -187,11 +192,16 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		return nil, err
 	}
 
+	blockRes, err := b.RPCClient.BlockResults(b.Ctx, &block.Block.Height)
+	if err != nil {
+		return nil, fmt.Errorf("block result not found for height %d", block.Block.Height)
+	}
+
 	traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
 		Txs:             txsMessages,
 		TraceConfig:     config,
 		BlockNumber:     block.Block.Height,
-		BlockTime:       block.Block.Time,
+		BlockTime:       b.BlockTime(block.Block.Header, blockRes),
 		BlockHash:       common.Bytes2Hex(block.BlockID.Hash),
 		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
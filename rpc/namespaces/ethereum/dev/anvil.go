@@ -0,0 +1,71 @@
+package dev
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"cosmossdk.io/log"
+)
+
+// AnvilAPI is the set of state manipulation APIs of the local dev node. It is
+// served under both the anvil and hardhat prefixes.
+type AnvilAPI struct {
+	logger     log.Logger
+	controller Controller
+}
+
+// NewAnvilAPI creates an instance of the dev node state manipulation API.
+func NewAnvilAPI(logger log.Logger, controller Controller) *AnvilAPI {
+	return &AnvilAPI{
+		logger:     logger.With("api", "anvil"),
+		controller: controller,
+	}
+}
+
+// GetAutomine returns true if a block is produced for each new tx.
+func (api *AnvilAPI) GetAutomine() bool {
+	api.logger.Debug("anvil_getAutomine")
+	return api.controller.Automine()
+}
+
+// SetBalance sets the balance of the account in wei.
+func (api *AnvilAPI) SetBalance(ctx context.Context, address common.Address, balance hexutil.Big) error {
+	api.logger.Debug("anvil_setBalance", "address", address, "balance", balance)
+	return api.controller.SetBalance(ctx, address, balance.ToInt())
+}
+
+// SetCode sets the code of the account.
+func (api *AnvilAPI) SetCode(ctx context.Context, address common.Address, code hexutil.Bytes) error {
+	api.logger.Debug("anvil_setCode", "address", address)
+	return api.controller.SetCode(ctx, address, code)
+}
+
+// SetStorageAt sets the value of a storage slot of the account.
+func (api *AnvilAPI) SetStorageAt(ctx context.Context, address common.Address, slot, value common.Hash) (bool, error) {
+	api.logger.Debug("anvil_setStorageAt", "address", address, "slot", slot)
+	if err := api.controller.SetStorageAt(ctx, address, slot, value); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SetNonce sets the nonce of the account.
+func (api *AnvilAPI) SetNonce(ctx context.Context, address common.Address, nonce hexutil.Uint64) error {
+	api.logger.Debug("anvil_setNonce", "address", address, "nonce", nonce)
+	return api.controller.SetNonce(ctx, address, uint64(nonce))
+}
+
+// ImpersonateAccount allows eth_sendTransaction to send txs on behalf of the
+// account without its private key.
+func (api *AnvilAPI) ImpersonateAccount(address common.Address) {
+	api.logger.Debug("anvil_impersonateAccount", "address", address)
+	api.controller.ImpersonateAccount(address)
+}
+
+// StopImpersonatingAccount stops the impersonation of the account.
+func (api *AnvilAPI) StopImpersonatingAccount(address common.Address) {
+	api.logger.Debug("anvil_stopImpersonatingAccount", "address", address)
+	api.controller.StopImpersonatingAccount(address)
+}
@@ -0,0 +1,109 @@
+package dev
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/rpc/backend"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EthAPI overrides eth_sendTransaction to send the txs of impersonated
+// accounts. It must be registered after the eth namespace.
+type EthAPI struct {
+	logger     log.Logger
+	clientCtx  client.Context
+	backend    backend.EVMBackend
+	controller Controller
+	// signingKey is an ephemeral key used to sign the txs of impersonated
+	// accounts. The dev node takes the sender of these txs from the message
+	// instead of recovering it from the signature.
+	signingKey *ecdsa.PrivateKey
+}
+
+// NewEthAPI creates an instance of the dev node eth API.
+func NewEthAPI(logger log.Logger, clientCtx client.Context, backend backend.EVMBackend, controller Controller) (*EthAPI, error) {
+	signingKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return &EthAPI{
+		logger:     logger.With("api", "dev-eth"),
+		clientCtx:  clientCtx,
+		backend:    backend,
+		controller: controller,
+		signingKey: signingKey,
+	}, nil
+}
+
+// SendTransaction sends the tx of an impersonated account, or falls back to
+// the node keyring for other accounts.
+func (api *EthAPI) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
+	from := args.GetFrom()
+	if !api.controller.IsImpersonated(from) {
+		return api.backend.SendTransaction(args)
+	}
+
+	api.logger.Debug("eth_sendTransaction", "impersonated", from)
+
+	args, err := api.backend.SetTxDefaults(args)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	msg := args.ToTransaction()
+	signer := ethtypes.LatestSignerForChainID(api.backend.ChainConfig().ChainID)
+	ethTx, err := ethtypes.SignTx(msg.AsTransaction(), signer, api.signingKey)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	// NOTE: the From field is kept to the impersonated account
+	if err := msg.FromEthereumTx(ethTx); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return common.Hash{}, err
+	}
+
+	res, err := feemarkettypes.NewQueryClient(api.clientCtx).AccountFeeDenom(context.Background(), &feemarkettypes.QueryAccountFeeDenomRequest{
+		Address: sdk.AccAddress(msg.From).String(),
+	})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx, err := msg.BuildTxWithFeeDenom(api.clientCtx.TxConfig.NewTxBuilder(), evmtypes.GetEVMCoinDenom(), res.FeeDenom)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	txBytes, err := api.clientCtx.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	rsp, err := api.clientCtx.WithBroadcastMode(flags.BroadcastSync).BroadcastTx(txBytes)
+	if rsp != nil && rsp.Code != 0 {
+		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
+	}
+	if err != nil {
+		return ethTx.Hash(), err
+	}
+
+	return ethTx.Hash(), nil
+}
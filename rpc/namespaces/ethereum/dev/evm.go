@@ -0,0 +1,74 @@
+package dev
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"cosmossdk.io/log"
+)
+
+// EvmAPI is the evm prefixed set of APIs of the local dev node, compatible
+// with the Hardhat and Anvil ones.
+type EvmAPI struct {
+	logger     log.Logger
+	controller Controller
+}
+
+// NewEvmAPI creates an instance of the dev node evm API.
+func NewEvmAPI(logger log.Logger, controller Controller) *EvmAPI {
+	return &EvmAPI{
+		logger:     logger.With("api", "evm"),
+		controller: controller,
+	}
+}
+
+// Mine waits for the next block to be committed. The optional timestamp is
+// used as the time of the block.
+func (api *EvmAPI) Mine(ctx context.Context, timestamp *hexutil.Uint64) (string, error) {
+	api.logger.Debug("evm_mine", "timestamp", timestamp)
+	if err := api.controller.Mine(ctx, (*uint64)(timestamp)); err != nil {
+		return "", err
+	}
+	return "0x0", nil
+}
+
+// SetAutomine enables or disables the production of a block for each new tx.
+func (api *EvmAPI) SetAutomine(enabled bool) {
+	api.logger.Debug("evm_setAutomine", "enabled", enabled)
+	api.controller.SetAutomine(enabled)
+}
+
+// SetIntervalMining sets the interval between the blocks in milliseconds. A
+// zero interval disables interval mining.
+func (api *EvmAPI) SetIntervalMining(interval uint64) {
+	api.logger.Debug("evm_setIntervalMining", "interval", interval)
+	api.controller.SetIntervalMining(time.Duration(interval) * time.Millisecond) //#nosec G115 -- intervals won't exceed int64
+}
+
+// IncreaseTime increases the time of the following blocks by the given number
+// of seconds and returns the total time offset in seconds.
+func (api *EvmAPI) IncreaseTime(seconds int64) int64 {
+	api.logger.Debug("evm_increaseTime", "seconds", seconds)
+	return api.controller.IncreaseTime(seconds)
+}
+
+// SetNextBlockTimestamp sets the timestamp of the next block.
+func (api *EvmAPI) SetNextBlockTimestamp(timestamp hexutil.Uint64) error {
+	api.logger.Debug("evm_setNextBlockTimestamp", "timestamp", timestamp)
+	return api.controller.SetNextBlockTimestamp(uint64(timestamp))
+}
+
+// Snapshot records the current state and returns the snapshot identifier.
+func (api *EvmAPI) Snapshot() hexutil.Uint64 {
+	api.logger.Debug("evm_snapshot")
+	return hexutil.Uint64(api.controller.Snapshot())
+}
+
+// Revert restores the state recorded by the snapshot. It returns false if the
+// snapshot doesn't exist.
+func (api *EvmAPI) Revert(ctx context.Context, id hexutil.Uint64) (bool, error) {
+	api.logger.Debug("evm_revert", "id", id)
+	return api.controller.Revert(ctx, uint64(id))
+}
@@ -0,0 +1,31 @@
+package dev
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Controller defines the local dev node operations exposed by the dev RPC
+// namespaces.
+type Controller interface {
+	Mine(ctx context.Context, timestamp *uint64) error
+	SetAutomine(enabled bool)
+	Automine() bool
+	SetIntervalMining(interval time.Duration)
+	IncreaseTime(seconds int64) int64
+	SetNextBlockTimestamp(timestamp uint64) error
+	Snapshot() uint64
+	Revert(ctx context.Context, id uint64) (bool, error)
+
+	SetBalance(ctx context.Context, addr common.Address, balance *big.Int) error
+	SetCode(ctx context.Context, addr common.Address, code []byte) error
+	SetStorageAt(ctx context.Context, addr common.Address, slot, value common.Hash) error
+	SetNonce(ctx context.Context, addr common.Address, nonce uint64) error
+
+	ImpersonateAccount(addr common.Address)
+	StopImpersonatingAccount(addr common.Address)
+	IsImpersonated(addr common.Address) bool
+}
@@ -349,6 +349,8 @@ func (api *PublicFilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, er
 
 				// TODO: fetch bloom from events
 				header := types.EthHeaderFromTendermint(data.Block.Header, ethtypes.Bloom{}, baseFee)
+				header.Time = uint64(types.BlockTimeFromEvents(data.Block.Header, data.ResultFinalizeBlock.Events).Unix()) //nolint:gosec // G115 // won't exceed uint64
+
 				_ = notifier.Notify(rpcSub.ID, header) // #nosec G703
 			case <-rpcSub.Err():
 				headersSub.Unsubscribe(api.events)
@@ -49,7 +49,8 @@ func NewAPI(
 	}
 }
 
-// ImportRawKey armors and encrypts a given raw hex encoded ECDSA key and stores it into the key directory.
+// ImportRawKey armors and encrypts a given raw hex encoded ECDSA key, or Web3 Secret Storage (keystore V3) JSON
+// decrypted with the password, and stores it into the key directory.
 // The name of the key will have the format "personal_<length-keys>", where <length-keys> is the total number of
 // keys stored on the keyring.
 //
@@ -75,6 +76,7 @@ func (api *PrivateAccountAPI) LockAccount(address common.Address) bool {
 }
 
 // NewAccount will create a new account and returns the address for the new account.
+// If a keystore directory is configured, the key is also written there as a keystore JSON file.
 func (api *PrivateAccountAPI) NewAccount(password string) (common.Address, error) {
 	api.logger.Debug("personal_newAccount")
 
@@ -94,7 +96,15 @@ func (api *PrivateAccountAPI) NewAccount(password string) (common.Address, error
 	}
 	addr := common.BytesToAddress(pubKey.Address().Bytes())
 	api.logger.Info("Your new key was generated", "address", addr.String())
-	api.logger.Info("Please backup your key file!", "path", os.Getenv("HOME")+"/.evmos/"+name) // TODO: pass the correct binary
+
+	path, err := api.backend.WriteKeystore(name, password)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if path == "" {
+		path = os.Getenv("HOME") + "/.evmos/" + name // TODO: pass the correct binary
+	}
+	api.logger.Info("Please backup your key file!", "path", path)
 	api.logger.Info("Please remember your password!")
 	return addr, nil
 }
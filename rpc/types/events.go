@@ -127,7 +127,8 @@ func ParseTxResult(result *abci.ExecTxResult, tx sdk.Tx) (*ParsedTxs, error) {
 		p.Txs[0].GasUsed = gasUsed
 	}
 
-	// this could only happen if tx exceeds block gas limit
+	// this could only happen if tx exceeds block gas limit or if a batch of
+	// ethereum txs was reverted
 	if result.Code != 0 && tx != nil {
 		for i := 0; i < len(p.Txs); i++ {
 			p.Txs[i].Failed = true
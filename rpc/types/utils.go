@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -15,6 +16,7 @@ import (
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	"github.com/cosmos/evm/server/dev"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -33,6 +35,10 @@ const ExceedBlockGasLimitError = "out of gas in location: block gas meter; gasWa
 // note: the transfer amount cannot be set to 0, otherwise this problem will not be triggered
 const StateDBCommitError = "failed to commit stateDB"
 
+// BatchRevertedError defines the error message when one of the ethereum txs contained in a
+// cosmos tx fails. The fees of all the txs in the batch are deducted in the ante handler.
+const BatchRevertedError = "ethereum tx batch reverted"
+
 // RawTxToEthTx returns a evm MsgEthereum transaction from raw tx bytes.
 func RawTxToEthTx(clientCtx client.Context, txBz cmttypes.Tx) ([]*evmtypes.MsgEthereumTx, error) {
 	tx, err := clientCtx.TxConfig.TxDecoder()(txBz)
@@ -255,6 +261,29 @@ func BaseFeeFromEvents(events []abci.Event) *big.Int {
 	return nil
 }
 
+// BlockTimeFromEvents returns the block time seen by the EVM from the block
+// events. It is the time of the header, unless the time of a local dev node
+// was changed.
+func BlockTimeFromEvents(header cmttypes.Header, events []abci.Event) time.Time {
+	for _, event := range events {
+		if event.Type != dev.EventTypeBlockTime {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key == dev.AttributeKeyTimestamp {
+				blockTime, err := time.Parse(time.RFC3339Nano, attr.Value)
+				if err == nil {
+					return blockTime
+				}
+
+				return header.Time
+			}
+		}
+	}
+	return header.Time
+}
+
 // CheckTxFee is an internal function used to check whether the fee of
 // the given transaction is _reasonable_(under the minimum cap).
 func CheckTxFee(gasPrice *big.Int, gas uint64, minCap float64) error {
@@ -285,8 +314,13 @@ func TxStateDBCommitError(res *abci.ExecTxResult) bool {
 	return strings.Contains(res.Log, StateDBCommitError)
 }
 
+// TxBatchReverted returns true if the evm tx batch was reverted.
+func TxBatchReverted(res *abci.ExecTxResult) bool {
+	return strings.Contains(res.Log, BatchRevertedError)
+}
+
 // TxSucessOrExpectedFailure returns true if the transaction was successful
-// or if it failed with an ExceedBlockGasLimit error or TxStateDBCommitError error
+// or if it failed with an ExceedBlockGasLimit, TxStateDBCommitError or TxBatchReverted error
 func TxSucessOrExpectedFailure(res *abci.ExecTxResult) bool {
-	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxStateDBCommitError(res)
+	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxStateDBCommitError(res) || TxBatchReverted(res)
 }
@@ -63,6 +63,15 @@ const (
 	// DefaultMaxTxGasWanted is the default gas wanted for each eth tx returned in ante handler in check tx mode
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultEVMMempoolEnable is the default value for the parameter that defines if the EVM mempool is enabled
+	DefaultEVMMempoolEnable = false
+
+	// DefaultEVMMempoolPriceBump is the default minimum price bump percentage to replace a tx in the EVM mempool
+	DefaultEVMMempoolPriceBump uint64 = 10
+
+	// DefaultEVMMempoolAccountSlots is the default maximum number of txs held for a single account in the EVM mempool
+	DefaultEVMMempoolAccountSlots uint64 = 64
+
 	// DefaultEVMChainID is the default EVM Chain ID if one is not provided
 	DefaultEVMChainID = 262144
 
@@ -129,6 +138,19 @@ type EVMConfig struct {
 	EnablePreimageRecording bool `mapstructure:"cache-preimage"`
 	// EVMChainID defines the EIP-155 replay-protection chain ID.
 	EVMChainID uint64 `mapstructure:"evm-chain-id"`
+	// Mempool defines the configuration of the EVM app-side mempool.
+	Mempool MempoolConfig `mapstructure:"mempool"`
+}
+
+// MempoolConfig defines the configuration values of the EVM app-side mempool.
+type MempoolConfig struct {
+	// Enable defines if the EVM mempool is used instead of the default priority nonce mempool.
+	// It allows EVM txs with nonce gaps and replace-by-fee resubmissions.
+	Enable bool `mapstructure:"enable"`
+	// PriceBump is the minimum price bump percentage to replace a tx with the same sender and nonce.
+	PriceBump uint64 `mapstructure:"price-bump"`
+	// AccountSlots is the maximum number of pending and queued txs held for a single account.
+	AccountSlots uint64 `mapstructure:"account-slots"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -143,6 +165,9 @@ type JSONRPCConfig struct {
 	GasCap uint64 `mapstructure:"gas-cap"`
 	// AllowInsecureUnlock toggles if account unlocking is enabled when account-related RPCs are exposed by http.
 	AllowInsecureUnlock bool `mapstructure:"allow-insecure-unlock"`
+	// KeystoreDir defines the directory where the keys created or imported by the personal namespace are
+	// also written as Web3 Secret Storage (keystore V3) JSON files. Disabled if empty.
+	KeystoreDir string `mapstructure:"keystore-dir"`
 	// EVMTimeout is the global timeout for eth-call.
 	EVMTimeout time.Duration `mapstructure:"evm-timeout"`
 	// TxFeeCap is the global tx-fee cap for send transaction
@@ -190,6 +215,11 @@ func DefaultEVMConfig() *EVMConfig {
 		MaxTxGasWanted:          DefaultMaxTxGasWanted,
 		EVMChainID:              DefaultEVMChainID,
 		EnablePreimageRecording: DefaultEnablePreimageRecording,
+		Mempool: MempoolConfig{
+			Enable:       DefaultEVMMempoolEnable,
+			PriceBump:    DefaultEVMMempoolPriceBump,
+			AccountSlots: DefaultEVMMempoolAccountSlots,
+		},
 	}
 }
 
@@ -199,6 +229,10 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.Mempool.Enable && c.Mempool.AccountSlots == 0 {
+		return errors.New("EVM mempool account-slots cannot be 0")
+	}
+
 	return nil
 }
 
@@ -22,6 +22,19 @@ cache-preimage = {{ .EVM.EnablePreimageRecording }}
 # EVMChainID is the EIP-155 compatible replay protection chain ID. This is separate from the Cosmos chain ID.
 evm-chain-id = {{ .EVM.EVMChainID }}
 
+[evm.mempool]
+
+# Enable defines if the EVM app-side mempool is used. It keeps per-sender nonce ordered
+# queues, which allows EVM txs with nonce gaps and replace-by-fee resubmissions.
+# The max number of txs of the mempool is set by the 'mempool.max-txs' parameter.
+enable = {{ .EVM.Mempool.Enable }}
+
+# PriceBump is the minimum price bump percentage to replace a tx with the same sender and nonce.
+price-bump = {{ .EVM.Mempool.PriceBump }}
+
+# AccountSlots is the maximum number of pending and queued txs held for a single account.
+account-slots = {{ .EVM.Mempool.AccountSlots }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -47,6 +60,10 @@ gas-cap = {{ .JSONRPC.GasCap }}
 # Allow insecure account unlocking when account-related RPCs are exposed by http
 allow-insecure-unlock = {{ .JSONRPC.AllowInsecureUnlock }}
 
+# KeystoreDir is the directory where the keys created or imported by the personal namespace are also
+# written as Ethereum keystore JSON files, relative to the home directory if not absolute. Disabled if empty.
+keystore-dir = "{{ .JSONRPC.KeystoreDir }}"
+
 # EVMTimeout is the global timeout for eth_call. Default: 5s.
 evm-timeout = "{{ .JSONRPC.EVMTimeout }}"
 
@@ -0,0 +1,90 @@
+package dev
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	// EventTypeBlockTime is the type of the block event emitted with the block
+	// time seen by the application, when it differs from the time of the
+	// CometBFT header.
+	EventTypeBlockTime = "dev_block_time"
+	// AttributeKeyTimestamp is the attribute of the block time event with the
+	// time of the block, in the RFC3339 format with nanoseconds.
+	AttributeKeyTimestamp = "timestamp"
+)
+
+var _ servertypes.ABCI = &abciWrapper{}
+
+// abciWrapper overrides the block time seen by the application, produces the
+// blocks on demand and notifies the controller when a block is committed.
+type abciWrapper struct {
+	servertypes.ABCI
+
+	controller *Controller
+	height     int64
+}
+
+// NewABCIWrapper wraps the application so that it is driven by the dev node
+// controller.
+//
+// NOTE: PrepareProposal blocks until a block must be produced, so the ABCI
+// connections of CometBFT must not share a lock (see
+// proxy.NewConnSyncLocalClientCreator).
+func NewABCIWrapper(app servertypes.ABCI, controller *Controller) servertypes.ABCI {
+	return &abciWrapper{
+		ABCI:       app,
+		controller: controller,
+	}
+}
+
+// CheckTx notifies the controller of the new txs accepted by the mempool.
+func (w *abciWrapper) CheckTx(req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+	res, err := w.ABCI.CheckTx(req)
+	if err == nil && res.IsOK() && req.Type == abci.CheckTxType_New {
+		w.controller.NewTx()
+	}
+	return res, err
+}
+
+// PrepareProposal waits for the next block to be requested before preparing
+// it.
+func (w *abciWrapper) PrepareProposal(req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+	w.controller.WaitForBlock()
+	return w.ABCI.PrepareProposal(req)
+}
+
+// FinalizeBlock executes the block with the time of the dev node.
+func (w *abciWrapper) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	devReq := *req
+	devReq.Time = w.controller.BlockTime(req.Time)
+	w.height = req.Height
+
+	res, err := w.ABCI.FinalizeBlock(&devReq)
+	if err != nil || devReq.Time.Equal(req.Time) {
+		return res, err
+	}
+
+	res.Events = append(res.Events, abci.Event{
+		Type: EventTypeBlockTime,
+		Attributes: []abci.EventAttribute{
+			{Key: AttributeKeyTimestamp, Value: devReq.Time.Format(time.RFC3339Nano), Index: true},
+		},
+	})
+	return res, nil
+}
+
+// Commit commits the block and notifies the controller.
+func (w *abciWrapper) Commit() (*abci.ResponseCommit, error) {
+	res, err := w.ABCI.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	w.controller.Commit(w.height)
+	return res, nil
+}
@@ -0,0 +1,73 @@
+package dev_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/evm/server/dev"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+type mockABCI struct {
+	servertypes.ABCI
+
+	blockTime time.Time
+}
+
+func (m *mockABCI) CheckTx(*abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+	return &abci.ResponseCheckTx{}, nil
+}
+
+func (m *mockABCI) PrepareProposal(*abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+	return &abci.ResponsePrepareProposal{}, nil
+}
+
+func (m *mockABCI) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	m.blockTime = req.Time
+	return &abci.ResponseFinalizeBlock{}, nil
+}
+
+func (m *mockABCI) Commit() (*abci.ResponseCommit, error) {
+	return &abci.ResponseCommit{}, nil
+}
+
+func TestABCIWrapper(t *testing.T) {
+	controller, _, _ := newTestController()
+	app := &mockABCI{}
+	wrapper := dev.NewABCIWrapper(app, controller)
+	blockTime := time.Unix(1000, 0).UTC()
+
+	// the first block is proposed right away
+	_, err := wrapper.PrepareProposal(&abci.RequestPrepareProposal{Height: 1})
+	require.NoError(t, err)
+
+	// no event is emitted while the block time is the header time
+	res, err := wrapper.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Time: blockTime})
+	require.NoError(t, err)
+	require.Empty(t, res.Events)
+	_, err = wrapper.Commit()
+	require.NoError(t, err)
+
+	// a new tx requests the next block
+	_, err = wrapper.CheckTx(&abci.RequestCheckTx{Type: abci.CheckTxType_New})
+	require.NoError(t, err)
+	_, err = wrapper.PrepareProposal(&abci.RequestPrepareProposal{Height: 2})
+	require.NoError(t, err)
+
+	// the application time is emitted for the JSON-RPC server
+	controller.IncreaseTime(60)
+	res, err = wrapper.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 2, Time: blockTime.Add(time.Second)})
+	require.NoError(t, err)
+	require.Equal(t, blockTime.Add(61*time.Second), app.blockTime)
+	require.Equal(t, []abci.Event{{
+		Type: dev.EventTypeBlockTime,
+		Attributes: []abci.EventAttribute{
+			{Key: dev.AttributeKeyTimestamp, Value: "1970-01-01T00:17:41Z", Index: true},
+		},
+	}}, res.Events)
+}
@@ -0,0 +1,449 @@
+package dev
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Controller drives the state overrides of a local dev node.
+//
+// The overrides requested through the dev RPC namespaces are queued and
+// applied at the beginning of the next block, in the PreBlocker of the
+// application. The RPC calls return once the block that applied them is
+// committed, so the overrides are visible to the following requests.
+//
+// The blocks are produced on demand: the PrepareProposal of a block waits for
+// a new tx (automine), an override or evm_mine, or for the mining interval to
+// elapse if it's set.
+//
+// NOTE: the overrides are not part of the transactions of the block, so a dev
+// node can't be replayed from its block store. It must only be used for local
+// development with a single validator.
+type Controller struct {
+	evmKeeper EVMKeeper
+	cms       MultiStore
+	storeKeys []storetypes.StoreKey
+
+	mtx sync.Mutex
+	// pending are the operations applied in the next block, and applied the
+	// ones applied in the current block, waiting for the block to be committed.
+	pending []*operation
+	applied []*operation
+
+	height        int64
+	lastBlockTime time.Time
+	timeOffset    time.Duration
+	nextTimestamp *time.Time
+
+	snapshots      map[uint64]int64
+	lastSnapshotID uint64
+
+	impersonated map[common.Address]struct{}
+
+	// automine produces a block for each new tx, and interval produces a block
+	// at a fixed interval if it's not zero.
+	automine     bool
+	interval     time.Duration
+	newTxs       int
+	lastProposal time.Time
+	// wake notifies WaitForBlock that a block may have to be produced.
+	wake chan struct{}
+}
+
+// operation is a state override applied at the beginning of a block.
+type operation struct {
+	apply func(ctx sdk.Context) error
+	err   error
+	done  chan error
+}
+
+// NewController creates the controller of a dev node. The multistore and the
+// store keys are used to revert the state of the application to a snapshot.
+func NewController(evmKeeper EVMKeeper, cms MultiStore, storeKeys []storetypes.StoreKey) *Controller {
+	keys := make([]storetypes.StoreKey, len(storeKeys))
+	copy(keys, storeKeys)
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+
+	return &Controller{
+		evmKeeper:    evmKeeper,
+		cms:          cms,
+		storeKeys:    keys,
+		snapshots:    make(map[uint64]int64),
+		impersonated: make(map[common.Address]struct{}),
+		automine:     true,
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+// WaitForBlock waits until the next block must be produced. It must be called
+// by the PrepareProposal of the application. The first block after the node
+// starts is produced right away.
+func (c *Controller) WaitForBlock() {
+	for {
+		c.mtx.Lock()
+		ready := c.height == 0 || len(c.pending) > 0 || (c.automine && c.newTxs > 0)
+
+		var timer <-chan time.Time
+		if !ready && c.interval > 0 {
+			wait := time.Until(c.lastProposal.Add(c.interval))
+			if wait <= 0 {
+				ready = true
+			} else {
+				timer = time.After(wait)
+			}
+		}
+
+		if ready {
+			c.newTxs = 0
+			c.lastProposal = time.Now()
+			c.mtx.Unlock()
+			return
+		}
+		c.mtx.Unlock()
+
+		select {
+		case <-c.wake:
+		case <-timer:
+		}
+	}
+}
+
+// NewTx notifies the controller that a new tx was accepted by the mempool.
+func (c *Controller) NewTx() {
+	c.mtx.Lock()
+	c.newTxs++
+	c.mtx.Unlock()
+
+	c.notify()
+}
+
+// SetAutomine enables or disables the production of a block for each new tx.
+// When it's disabled, the txs are only included in the blocks produced by
+// evm_mine or by the mining interval.
+func (c *Controller) SetAutomine(enabled bool) {
+	c.mtx.Lock()
+	c.automine = enabled
+	c.mtx.Unlock()
+
+	c.notify()
+}
+
+// Automine returns true if a block is produced for each new tx.
+func (c *Controller) Automine() bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.automine
+}
+
+// SetIntervalMining sets the interval between the blocks produced without
+// any tx or operation. A zero interval disables interval mining.
+func (c *Controller) SetIntervalMining(interval time.Duration) {
+	c.mtx.Lock()
+	c.interval = interval
+	c.mtx.Unlock()
+
+	c.notify()
+}
+
+// notify wakes up WaitForBlock without blocking.
+func (c *Controller) notify() {
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+}
+
+// PreBlock applies the pending operations. It must be called by the
+// PreBlocker of the application. Each operation is applied in a cache context
+// and discarded if it fails, without failing the block.
+func (c *Controller) PreBlock(ctx sdk.Context) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for _, op := range c.pending {
+		cacheCtx, write := ctx.CacheContext()
+		if op.err = op.apply(cacheCtx); op.err == nil {
+			write()
+		}
+	}
+
+	c.applied = append(c.applied, c.pending...)
+	c.pending = nil
+}
+
+// BlockTime returns the block time seen by the application for the block
+// time set by CometBFT, according to the time offset of the dev node.
+//
+// NOTE: the offset only applies to the time seen by the application (e.g. the
+// block.timestamp of the EVM), the CometBFT block headers keep the time of
+// the validator. The application time is emitted in a block event (see
+// EventTypeBlockTime) for the JSON-RPC server to return it.
+func (c *Controller) BlockTime(blockTime time.Time) time.Time {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.nextTimestamp != nil {
+		c.timeOffset = c.nextTimestamp.Sub(blockTime)
+		c.nextTimestamp = nil
+	}
+
+	c.lastBlockTime = blockTime.Add(c.timeOffset)
+	return c.lastBlockTime
+}
+
+// Commit notifies the operations applied in the committed block.
+func (c *Controller) Commit(height int64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.height = height
+	for _, op := range c.applied {
+		op.done <- op.err
+	}
+	c.applied = nil
+}
+
+// Mine produces a block and waits for it to be committed. If a timestamp is
+// provided, it is used as the time of the block.
+func (c *Controller) Mine(ctx context.Context, timestamp *uint64) error {
+	if timestamp != nil {
+		if err := c.SetNextBlockTimestamp(*timestamp); err != nil {
+			return err
+		}
+	}
+
+	return c.enqueue(ctx, func(sdk.Context) error { return nil })
+}
+
+// IncreaseTime increases the time offset of the following blocks by the given
+// number of seconds and returns the total offset in seconds.
+func (c *Controller) IncreaseTime(seconds int64) int64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.timeOffset += time.Duration(seconds) * time.Second
+	return int64(c.timeOffset / time.Second)
+}
+
+// SetNextBlockTimestamp sets the time of the next block. The time of the
+// following blocks increases from it.
+func (c *Controller) SetNextBlockTimestamp(timestamp uint64) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	next := time.Unix(int64(timestamp), 0).UTC() //#nosec G115 -- timestamps won't exceed int64
+	if !next.After(c.lastBlockTime) {
+		return fmt.Errorf(
+			"timestamp %d is lower than or equal to the previous block timestamp %d",
+			timestamp, c.lastBlockTime.Unix(),
+		)
+	}
+
+	c.nextTimestamp = &next
+	return nil
+}
+
+// Snapshot records the state of the last committed block and returns the
+// identifier of the snapshot.
+func (c *Controller) Snapshot() uint64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	height := c.height
+	if height == 0 {
+		// no block was committed since the node started
+		height = c.cms.LastCommitID().Version
+	}
+
+	c.lastSnapshotID++
+	c.snapshots[c.lastSnapshotID] = height
+	return c.lastSnapshotID
+}
+
+// Revert restores the state recorded by the snapshot in the next block. The
+// snapshot and the ones taken after it are discarded. It returns false if the
+// snapshot doesn't exist.
+//
+// NOTE: the block height keeps increasing, only the application state is
+// reverted.
+func (c *Controller) Revert(ctx context.Context, id uint64) (bool, error) {
+	c.mtx.Lock()
+	height, found := c.snapshots[id]
+	if found {
+		for snapshotID := range c.snapshots {
+			if snapshotID >= id {
+				delete(c.snapshots, snapshotID)
+			}
+		}
+	}
+	c.mtx.Unlock()
+
+	if !found {
+		return false, nil
+	}
+
+	if err := c.enqueue(ctx, func(ctx sdk.Context) error {
+		return c.restore(ctx, height)
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SetBalance sets the balance of the account, in the 18 decimals
+// representation of the EVM coin.
+func (c *Controller) SetBalance(ctx context.Context, addr common.Address, balance *big.Int) error {
+	amount, overflow := uint256.FromBig(balance)
+	if balance.Sign() < 0 || overflow {
+		return fmt.Errorf("invalid balance %s", balance)
+	}
+
+	return c.enqueue(ctx, func(ctx sdk.Context) error {
+		return c.evmKeeper.SetBalance(ctx, addr, amount)
+	})
+}
+
+// SetCode sets the code of the account.
+func (c *Controller) SetCode(ctx context.Context, addr common.Address, code []byte) error {
+	return c.enqueue(ctx, func(ctx sdk.Context) error {
+		account := c.evmKeeper.GetAccountOrEmpty(ctx, addr)
+		account.CodeHash = evmtypes.EmptyCodeHash
+		if len(code) > 0 {
+			account.CodeHash = crypto.Keccak256(code)
+			c.evmKeeper.SetCode(ctx, account.CodeHash, code)
+		}
+		return c.evmKeeper.SetAccount(ctx, addr, account)
+	})
+}
+
+// SetStorageAt sets the value of the storage slot of the account.
+func (c *Controller) SetStorageAt(ctx context.Context, addr common.Address, slot, value common.Hash) error {
+	return c.enqueue(ctx, func(ctx sdk.Context) error {
+		if value == (common.Hash{}) {
+			c.evmKeeper.DeleteState(ctx, addr, slot)
+		} else {
+			c.evmKeeper.SetState(ctx, addr, slot, value.Bytes())
+		}
+		return nil
+	})
+}
+
+// SetNonce sets the nonce of the account.
+func (c *Controller) SetNonce(ctx context.Context, addr common.Address, nonce uint64) error {
+	return c.enqueue(ctx, func(ctx sdk.Context) error {
+		account := c.evmKeeper.GetAccountOrEmpty(ctx, addr)
+		account.Nonce = nonce
+		return c.evmKeeper.SetAccount(ctx, addr, account)
+	})
+}
+
+// ImpersonateAccount allows the transactions of the account to be sent
+// without its signature.
+func (c *Controller) ImpersonateAccount(addr common.Address) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.impersonated[addr] = struct{}{}
+}
+
+// StopImpersonatingAccount stops the impersonation of the account.
+func (c *Controller) StopImpersonatingAccount(addr common.Address) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	delete(c.impersonated, addr)
+}
+
+// IsImpersonated returns true if the account is impersonated.
+func (c *Controller) IsImpersonated(addr common.Address) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	_, found := c.impersonated[addr]
+	return found
+}
+
+// enqueue adds the operation to the pending operations and waits for the
+// block that applies it to be committed.
+func (c *Controller) enqueue(ctx context.Context, apply func(ctx sdk.Context) error) error {
+	op := &operation{
+		apply: apply,
+		done:  make(chan error, 1),
+	}
+
+	c.mtx.Lock()
+	c.pending = append(c.pending, op)
+	c.mtx.Unlock()
+	c.notify()
+
+	select {
+	case err := <-op.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// restore replaces the state of every store with its state at the given height.
+func (c *Controller) restore(ctx sdk.Context, height int64) error {
+	historical, err := c.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return fmt.Errorf("failed to load the state at height %d: %w", height, err)
+	}
+
+	for _, key := range c.storeKeys {
+		src := historical.GetKVStore(key)
+		dst := ctx.MultiStore().GetKVStore(key)
+		if src == nil || dst == nil {
+			return errors.New("store not found: " + key.Name())
+		}
+
+		if err := deleteAll(dst); err != nil {
+			return err
+		}
+
+		it := src.Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			dst.Set(bytes.Clone(it.Key()), bytes.Clone(it.Value()))
+		}
+		if err := it.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteAll removes all the entries of the store.
+func deleteAll(store storetypes.KVStore) error {
+	var keys [][]byte
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, bytes.Clone(it.Key()))
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return nil
+}
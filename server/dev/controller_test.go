@@ -0,0 +1,199 @@
+package dev_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/server/dev"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockEVMKeeper struct {
+	accounts map[common.Address]statedb.Account
+}
+
+func (k *mockEVMKeeper) GetAccountOrEmpty(_ sdk.Context, addr common.Address) statedb.Account {
+	if acc, found := k.accounts[addr]; found {
+		return acc
+	}
+	return *statedb.NewEmptyAccount()
+}
+
+func (k *mockEVMKeeper) SetAccount(_ sdk.Context, addr common.Address, account statedb.Account) error {
+	k.accounts[addr] = account
+	return nil
+}
+
+func (k *mockEVMKeeper) SetBalance(ctx sdk.Context, addr common.Address, amount *uint256.Int) error {
+	acc := k.GetAccountOrEmpty(ctx, addr)
+	acc.Balance = amount
+	k.accounts[addr] = acc
+	return nil
+}
+
+func (k *mockEVMKeeper) SetCode(_ sdk.Context, _, _ []byte)                                {}
+func (k *mockEVMKeeper) SetState(_ sdk.Context, _ common.Address, _ common.Hash, _ []byte) {}
+func (k *mockEVMKeeper) DeleteState(_ sdk.Context, _ common.Address, _ common.Hash)        {}
+
+type mockMultiStore struct {
+	version int64
+}
+
+func (m mockMultiStore) LastCommitID() storetypes.CommitID {
+	return storetypes.CommitID{Version: m.version}
+}
+
+func (m mockMultiStore) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	return nil, nil
+}
+
+func newTestController() (*dev.Controller, *mockEVMKeeper, sdk.Context) {
+	keeper := &mockEVMKeeper{accounts: make(map[common.Address]statedb.Account)}
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	return dev.NewController(keeper, mockMultiStore{version: 5}, []storetypes.StoreKey{key}), keeper, ctx
+}
+
+func TestControllerOperations(t *testing.T) {
+	controller, keeper, ctx := newTestController()
+	addr := common.HexToAddress("0x1")
+
+	done := make(chan error, 1)
+	go func() {
+		done <- controller.SetNonce(context.Background(), addr, 7)
+	}()
+
+	// the operation is applied by the next block and returns once it's committed
+	require.Eventually(t, func() bool {
+		controller.PreBlock(ctx)
+		return keeper.accounts[addr].Nonce == 7
+	}, time.Second, time.Millisecond)
+	require.Empty(t, done)
+
+	controller.Commit(1)
+	require.NoError(t, <-done)
+}
+
+func TestControllerOperationCanceled(t *testing.T) {
+	controller, _, _ := newTestController()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, controller.Mine(ctx, nil), context.Canceled)
+}
+
+func TestControllerBlockTime(t *testing.T) {
+	controller, _, _ := newTestController()
+	blockTime := time.Unix(1000, 0).UTC()
+
+	require.Equal(t, blockTime, controller.BlockTime(blockTime))
+
+	require.Equal(t, int64(60), controller.IncreaseTime(60))
+	require.Equal(t, int64(90), controller.IncreaseTime(30))
+	require.Equal(t, blockTime.Add(91*time.Second), controller.BlockTime(blockTime.Add(time.Second)))
+
+	// the next timestamp must be after the previous block
+	require.Error(t, controller.SetNextBlockTimestamp(1091))
+	require.NoError(t, controller.SetNextBlockTimestamp(5000))
+	require.Equal(t, time.Unix(5000, 0).UTC(), controller.BlockTime(blockTime.Add(2*time.Second)))
+
+	// the following blocks increase from the next timestamp
+	require.Equal(t, time.Unix(5001, 0).UTC(), controller.BlockTime(blockTime.Add(3*time.Second)))
+}
+
+func TestControllerSnapshots(t *testing.T) {
+	controller, _, _ := newTestController()
+
+	require.Equal(t, uint64(1), controller.Snapshot())
+	controller.Commit(6)
+	require.Equal(t, uint64(2), controller.Snapshot())
+
+	found, err := controller.Revert(context.Background(), 3)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestControllerImpersonation(t *testing.T) {
+	controller, _, _ := newTestController()
+	addr := common.HexToAddress("0x1")
+
+	require.False(t, controller.IsImpersonated(addr))
+	controller.ImpersonateAccount(addr)
+	require.True(t, controller.IsImpersonated(addr))
+	controller.StopImpersonatingAccount(addr)
+	require.False(t, controller.IsImpersonated(addr))
+}
+
+// waitForBlock returns a channel closed once WaitForBlock returns.
+func waitForBlock(controller *dev.Controller) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		controller.WaitForBlock()
+		close(done)
+	}()
+	return done
+}
+
+func TestControllerWaitForBlock(t *testing.T) {
+	controller, _, ctx := newTestController()
+
+	// the first block after the node starts is produced right away
+	<-waitForBlock(controller)
+	controller.Commit(1)
+
+	// automine: a block is produced for each new tx
+	done := waitForBlock(controller)
+	select {
+	case <-done:
+		t.Fatal("block produced without any tx")
+	case <-time.After(50 * time.Millisecond):
+	}
+	controller.NewTx()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("block not produced for the new tx")
+	}
+
+	// manual mining: the txs wait for evm_mine
+	controller.SetAutomine(false)
+	require.False(t, controller.Automine())
+	controller.NewTx()
+	done = waitForBlock(controller)
+	select {
+	case <-done:
+		t.Fatal("block produced without evm_mine")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	mined := make(chan error, 1)
+	go func() {
+		mined <- controller.Mine(context.Background(), nil)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("block not produced by evm_mine")
+	}
+	controller.PreBlock(ctx)
+	controller.Commit(2)
+	require.NoError(t, <-mined)
+
+	// interval mining: the blocks are produced without any tx
+	controller.SetIntervalMining(10 * time.Millisecond)
+	select {
+	case <-waitForBlock(controller):
+	case <-time.After(time.Second):
+		t.Fatal("block not produced by the mining interval")
+	}
+}
@@ -0,0 +1,40 @@
+package dev
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	storetypes "cosmossdk.io/store/types"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Application defines an application that can be started in dev mode.
+type Application interface {
+	servertypes.Application
+
+	// DevController returns the controller of the dev node, or nil if the
+	// application was not created in dev mode.
+	DevController() *Controller
+}
+
+// EVMKeeper defines the expected EVM keeper interface used by the dev node
+// to override the EVM state.
+type EVMKeeper interface {
+	GetAccountOrEmpty(ctx sdk.Context, addr common.Address) statedb.Account
+	SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error
+	SetBalance(ctx sdk.Context, addr common.Address, amount *uint256.Int) error
+	SetCode(ctx sdk.Context, codeHash, code []byte)
+	SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte)
+	DeleteState(ctx sdk.Context, addr common.Address, key common.Hash)
+}
+
+// MultiStore defines the expected multistore interface used by the dev node
+// to read the state of previous heights.
+type MultiStore interface {
+	LastCommitID() storetypes.CommitID
+	CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error)
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/client/genesis"
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	flagAllocFormat = "format"
+
+	allocFormatAlloc = "alloc"
+	allocFormatDump  = "dump"
+)
+
+// NewExportEthAllocCmd creates a new Cobra command to export the EVM accounts
+// of the application state as a geth genesis alloc or state dump.
+func NewExportEthAllocCmd(appExporter types.AppExporter, coinInfo evmtypes.EvmCoinInfo, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-eth-alloc",
+		Short: "Export the EVM accounts as a geth genesis alloc or state dump",
+		Long: fmt.Sprintf(`Export the balances, nonces, code and storage of the EVM accounts at the given
+height, in a format compatible with geth:
+		- alloc: the alloc of a geth genesis file.
+		- dump: a geth state dump, as produced by 'geth dump'. The state and storage roots are not included.
+
+The balances are converted from the %d decimals of the %s denom to the 18 decimals representation of the EVM coin.
+`, coinInfo.Decimals, coinInfo.Denom),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			format, _ := cmd.Flags().GetString(flagAllocFormat)
+			if format != allocFormatAlloc && format != allocFormatDump {
+				return fmt.Errorf("unknown format, expect: %s|%s, got: %s", allocFormatAlloc, allocFormatDump, format)
+			}
+
+			if _, err := os.Stat(config.GenesisFile()); os.IsNotExist(err) {
+				return err
+			}
+
+			db, err := cosmosevmserverconfig.OpenDB(serverCtx.Viper, config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+
+			modulesToExport := []string{authtypes.ModuleName, banktypes.ModuleName, evmtypes.ModuleName}
+			if coinInfo.Decimals != evmtypes.EighteenDecimals {
+				modulesToExport = append(modulesToExport, precisebanktypes.ModuleName)
+			}
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			exported, err := appExporter(serverCtx.Logger, db, nil, height, false, nil, serverCtx.Viper, modulesToExport)
+			if err != nil {
+				return fmt.Errorf("error exporting state: %w", err)
+			}
+
+			var appState map[string]json.RawMessage
+			if err := json.Unmarshal(exported.AppState, &appState); err != nil {
+				return fmt.Errorf("failed to unmarshal application state: %w", err)
+			}
+
+			alloc, err := genesis.ExportAlloc(clientCtx.Codec, appState, coinInfo)
+			if err != nil {
+				return err
+			}
+
+			var out []byte
+			if format == allocFormatDump {
+				out, err = json.MarshalIndent(genesis.DumpFromAlloc(alloc), "", "  ")
+			} else {
+				out, err = json.MarshalIndent(alloc, "", "  ")
+			}
+			if err != nil {
+				return err
+			}
+
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDocument == "" {
+				_, err := fmt.Fprintln(cmd.OutOrStdout(), string(out))
+				return err
+			}
+
+			return os.WriteFile(outputDocument, out, 0o600)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().String(flagAllocFormat, allocFormatAlloc, fmt.Sprintf("Output format (%s|%s)", allocFormatAlloc, allocFormatDump))
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Exported accounts are written to the given file instead of STDOUT")
+
+	return cmd
+}
@@ -19,6 +19,13 @@ const (
 	AppDBBackend = "app-db-backend"
 )
 
+// Local development node flags
+const (
+	// Dev starts a single validator local node with the dev cheat RPC namespaces.
+	Dev          = "dev"
+	DevBlockTime = "dev.block-time"
+)
+
 // GRPC-related flags.
 const (
 	GRPCOnly       = "grpc-only"
@@ -42,6 +49,7 @@ const (
 	JSONWsAddress              = "json-rpc.ws-address"
 	JSONRPCGasCap              = "json-rpc.gas-cap"
 	JSONRPCAllowInsecureUnlock = "json-rpc.allow-insecure-unlock"
+	JSONRPCKeystoreDir         = "json-rpc.keystore-dir"
 	JSONRPCEVMTimeout          = "json-rpc.evm-timeout"
 	JSONRPCTxFeeCap            = "json-rpc.txfee-cap"
 	JSONRPCFilterCap           = "json-rpc.filter-cap"
@@ -65,6 +73,9 @@ const (
 	EVMMaxTxGasWanted          = "evm.max-tx-gas-wanted"
 	EVMEnablePreimageRecording = "evm.cache-preimage"
 	EVMChainID                 = "evm.evm-chain-id"
+	EVMMempoolEnable           = "evm.mempool.enable"
+	EVMMempoolPriceBump        = "evm.mempool.price-bump"
+	EVMMempoolAccountSlots     = "evm.mempool.account-slots"
 )
 
 // TLS flags
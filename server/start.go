@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -9,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime/pprof"
+	"slices"
 	"time"
 
 	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
@@ -30,8 +32,10 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/rpc"
 	ethdebug "github.com/cosmos/evm/rpc/namespaces/ethereum/debug"
 	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+	"github.com/cosmos/evm/server/dev"
 	srvflags "github.com/cosmos/evm/server/flags"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 
@@ -98,6 +102,13 @@ will not be able to commit subsequent blocks.
 
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
+
+For local contract development, the '--dev' flag starts a single validator node that produces blocks
+on demand, for new txs and evm_mine, or every '--dev.block-time' if it is set. It enables the 'evm',
+'anvil' and 'hardhat' JSON-RPC namespaces, which provide Hardhat/Anvil-style cheat methods (evm_mine,
+evm_increaseTime, evm_snapshot, anvil_setBalance, anvil_impersonateAccount, ...). All historical states
+are kept to support evm_revert. The state overrides are not part of the blocks, so a dev node must never
+be used on a public network.
 `,
 		PreRunE: func(cmd *cobra.Command, _ []string) error {
 			serverCtx := server.GetServerContextFromCmd(cmd)
@@ -167,6 +178,8 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint(server.FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(server.FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune CometBFT blocks")
 	cmd.Flags().String(srvflags.AppDBBackend, "", "The type of database for application and snapshots databases")
+	cmd.Flags().Bool(srvflags.Dev, false, "Start a local dev node with Hardhat/Anvil-style cheat JSON-RPC namespaces (unsafe - local development only)")
+	cmd.Flags().Duration(srvflags.DevBlockTime, 0, "The interval between the blocks of the local dev node (if 0, the blocks are only produced for new txs and evm_mine)")
 
 	cmd.Flags().Bool(srvflags.GRPCOnly, false, "Start the node in gRPC query only mode without CometBFT process")
 	cmd.Flags().Bool(srvflags.GRPCEnable, cosmosevmserverconfig.DefaultGRPCEnable, "Define if the gRPC server should be enabled")
@@ -183,6 +196,7 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().String(srvflags.JSONWsAddress, cosmosevmserverconfig.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
 	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, cosmosevmserverconfig.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is aatom (0=infinite)")                         //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCAllowInsecureUnlock, cosmosevmserverconfig.DefaultJSONRPCAllowInsecureUnlock, "Allow insecure account unlocking when account-related RPCs are exposed by http") //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCKeystoreDir, "", "Sets the directory where the keys created or imported by the personal namespace are also written as keystore JSON files")                   //nolint:lll
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, cosmosevmserverconfig.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 evmos)")                    //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, cosmosevmserverconfig.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
 	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, cosmosevmserverconfig.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
@@ -199,6 +213,9 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
 	cmd.Flags().Bool(srvflags.EVMEnablePreimageRecording, cosmosevmserverconfig.DefaultEnablePreimageRecording, "Enables tracking of SHA3 preimages in the EVM (not implemented yet)")                      //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMChainID, cosmosevmserverconfig.DefaultEVMChainID, "the EIP-155 compatible replay protection chain ID")
+	cmd.Flags().Bool(srvflags.EVMMempoolEnable, cosmosevmserverconfig.DefaultEVMMempoolEnable, "Enables the EVM mempool, which allows EVM txs with nonce gaps and replace-by-fee resubmissions") //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, cosmosevmserverconfig.DefaultEVMMempoolPriceBump, "the minimum price bump percentage to replace a tx in the EVM mempool")
+	cmd.Flags().Uint64(srvflags.EVMMempoolAccountSlots, cosmosevmserverconfig.DefaultEVMMempoolAccountSlots, "the maximum number of txs held for a single account in the EVM mempool") //nolint:lll
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -287,6 +304,14 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 	logger := svrCtx.Logger
 	g, ctx := getCtx(svrCtx, true)
 
+	devMode := svrCtx.Viper.GetBool(srvflags.Dev)
+	if devMode {
+		if svrCtx.Viper.GetBool(srvflags.GRPCOnly) {
+			return errors.New("dev mode can't be used in gRPC only mode")
+		}
+		configureDevMode(svrCtx)
+	}
+
 	if cpuProfile := svrCtx.Viper.GetString(srvflags.CPUProfile); cpuProfile != "" {
 		fp, err := ethdebug.ExpandHome(cpuProfile)
 		if err != nil {
@@ -345,6 +370,20 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	app := opts.AppCreator(svrCtx.Logger, db, traceWriter, svrCtx.Viper)
 
+	var (
+		abciApp       types.ABCI = app
+		devController *dev.Controller
+	)
+	if devMode {
+		devApp, ok := app.(dev.Application)
+		if !ok || devApp.DevController() == nil {
+			return errors.New("the application doesn't support dev mode")
+		}
+
+		devController = devApp.DevController()
+		abciApp = dev.NewABCIWrapper(app, devController)
+	}
+
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
 		logger.Error("failed load or gen node key", "error", err.Error())
@@ -365,12 +404,19 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 	} else {
 		logger.Info("starting node with ABCI CometBFT in-process")
 
-		cmtApp := server.NewCometABCIWrapper(app)
+		cmtApp := server.NewCometABCIWrapper(abciApp)
+		clientCreator := proxy.NewLocalClientCreator(cmtApp)
+		if devMode {
+			// the dev node blocks in PrepareProposal until a block is
+			// requested, which must not block the mempool connection
+			clientCreator = proxy.NewConnSyncLocalClientCreator(cmtApp)
+		}
+
 		tmNode, err = node.NewNode(
 			cfg,
 			pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
 			nodeKey,
-			proxy.NewLocalClientCreator(cmtApp),
+			clientCreator,
 			genDocProvider,
 			cmtcfg.DefaultDBProvider,
 			node.DefaultMetricsProvider(cfg.Instrumentation),
@@ -391,6 +437,12 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 				_ = tmNode.Stop()
 			}
 		}()
+
+		if devMode {
+			if err := checkDevValidators(ctx, tmNode); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Add the tx service to the gRPC router. We only need to register this
@@ -458,6 +510,13 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		defer apiSrv.Close()
 	}
 
+	if devMode && config.JSONRPC.Enable {
+		if err := rpc.RegisterDevNamespaces(devController); err != nil {
+			return err
+		}
+		config.JSONRPC.API = appendDevNamespaces(config.JSONRPC.API)
+	}
+
 	clientCtx, httpSrv, httpSrvDone, err := startJSONRPCServer(svrCtx, clientCtx, g, config, genDocProvider, cfg.RPC.ListenAddress, idxer)
 	if httpSrv != nil {
 		defer func() {
@@ -488,6 +547,62 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 	return g.Wait()
 }
 
+// devTimeoutPropose is the propose timeout of a dev node. If no block is
+// requested for longer, CometBFT moves to the next round without a block.
+const devTimeoutPropose = 24 * time.Hour
+
+// configureDevMode sets the CometBFT and app options of a local dev node.
+func configureDevMode(svrCtx *server.Context) {
+	svrCtx.Logger.Info("starting local dev node; the state can be overridden through the dev JSON-RPC namespaces")
+
+	// NOTE: CometBFT keeps producing blocks even with create_empty_blocks
+	// disabled, because the app hash changes on every block. Instead, the next
+	// block is proposed right away and the dev controller holds its
+	// PrepareProposal until a new tx, evm_mine or the mining interval, so the
+	// propose timeout must be longer than the wait.
+	cfg := svrCtx.Config
+	cfg.Consensus.CreateEmptyBlocks = true
+	cfg.Consensus.SkipTimeoutCommit = true
+	cfg.Consensus.TimeoutCommit = 0
+	cfg.Consensus.TimeoutPropose = devTimeoutPropose
+
+	// the proposals are taken from the app-side mempool, which includes the
+	// txs received while PrepareProposal waits
+	svrCtx.Viper.Set(srvflags.EVMMempoolEnable, true)
+	if svrCtx.Viper.GetInt(server.FlagMempoolMaxTxs) < 0 {
+		svrCtx.Viper.Set(server.FlagMempoolMaxTxs, 0)
+	}
+
+	// all the historical states are kept to revert to snapshots
+	svrCtx.Viper.Set(server.FlagPruning, pruningtypes.PruningOptionNothing)
+}
+
+// checkDevValidators returns an error if the dev node is not the only
+// validator of the chain.
+func checkDevValidators(ctx context.Context, tmNode *node.Node) error {
+	res, err := local.New(tmNode).Validators(ctx, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if res.Total != 1 {
+		return fmt.Errorf("dev mode requires a single validator, got %d", res.Total)
+	}
+	return nil
+}
+
+// appendDevNamespaces appends the dev JSON-RPC namespaces to the enabled
+// ones. They are appended last as they override eth_sendTransaction.
+func appendDevNamespaces(namespaces []string) []string {
+	enabled := make([]string, 0, len(namespaces)+len(rpc.DevNamespaces()))
+	for _, ns := range namespaces {
+		if !slices.Contains(rpc.DevNamespaces(), ns) {
+			enabled = append(enabled, ns)
+		}
+	}
+	return append(enabled, rpc.DevNamespaces()...)
+}
+
 // OpenIndexerDB opens the custom eth indexer db, using the same db backend as the main app
 func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
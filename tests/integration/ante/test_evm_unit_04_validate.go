@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/evm/ante/evm"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/math"
@@ -224,12 +225,18 @@ func (s *EvmUnitAnteTestSuite) TestCheckTxFee() {
 	// amount represents 1 token in the 18 decimals representation.
 	amount := math.NewInt(1e18)
 	gasLimit := uint64(1e6)
+	// 1 unit of the EVM denom is worth 0.5uusdc
+	feeDenom := feemarkettypes.NewFeeDenom("uusdc", math.LegacyNewDecWithPrec(5, 1))
 
 	testCases := []struct {
 		name       string
 		txFee      *big.Int
 		txGasLimit uint64
-		expError   error
+		feeDenom   *feemarkettypes.FeeDenom
+		// feeInfoInFeeDenom is true if the AuthInfo fee amount is converted
+		// into the fee denom
+		feeInfoInFeeDenom bool
+		expError          error
 	}{
 		{
 			name:       "pass",
@@ -243,6 +250,29 @@ func (s *EvmUnitAnteTestSuite) TestCheckTxFee() {
 			txGasLimit: gasLimit,
 			expError:   errortypes.ErrInvalidRequest,
 		},
+		{
+			name:              "pass: fees paid in the fee denom",
+			txFee:             big.NewInt(amount.Int64()),
+			txGasLimit:        gasLimit,
+			feeDenom:          &feeDenom,
+			feeInfoInFeeDenom: true,
+			expError:          nil,
+		},
+		{
+			name:              "fail: not enough tx fees in the fee denom",
+			txFee:             big.NewInt(amount.Int64() + 1),
+			txGasLimit:        gasLimit,
+			feeDenom:          &feeDenom,
+			feeInfoInFeeDenom: true,
+			expError:          errortypes.ErrInvalidRequest,
+		},
+		{
+			name:       "fail: tx fees in the EVM denom instead of the fee denom",
+			txFee:      big.NewInt(amount.Int64()),
+			txGasLimit: gasLimit,
+			feeDenom:   &feeDenom,
+			expError:   errortypes.ErrInvalidRequest,
+		},
 	}
 
 	for _, chainID := range []testconstants.ChainID{
@@ -262,6 +292,9 @@ func (s *EvmUnitAnteTestSuite) TestCheckTxFee() {
 				evmExtendedDenom := evmtypes.GetEVMCoinExtendedDenom()
 
 				coins := sdktypes.Coins{sdktypes.Coin{Denom: evmExtendedDenom, Amount: amount}}
+				if tc.feeInfoInFeeDenom {
+					coins = sdktypes.Coins{evmtypes.ConvertAmountFrom18DecimalsToFeeDenom(amount.BigInt(), *tc.feeDenom, true)}
+				}
 
 				// This struct should hold values in the original representation
 				txFeeInfo := &tx.Fee{
@@ -270,7 +303,7 @@ func (s *EvmUnitAnteTestSuite) TestCheckTxFee() {
 				}
 
 				// Function under test
-				err := evm.CheckTxFee(txFeeInfo, tc.txFee, tc.txGasLimit)
+				err := evm.CheckTxFee(txFeeInfo, tc.txFee, tc.txGasLimit, tc.feeDenom)
 
 				if tc.expError != nil {
 					s.Require().Error(err)
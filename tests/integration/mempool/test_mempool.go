@@ -0,0 +1,130 @@
+package mempool
+
+import (
+	"math/big"
+
+	"github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// TestInsertCosmosTxAfterAnte inserts Cosmos txs in the mempool the way
+// CheckTx does, i.e. with the state updated by the ante handler, which already
+// incremented the sequence of the signer.
+func (s *MempoolTestSuite) TestInsertCosmosTxAfterAnte() {
+	app := s.network.App
+	mp := mempool.NewMempool(mempool.DefaultConfig(), app.GetAccountKeeper(), app.GetEVMKeeper(), nil)
+
+	sender := s.keyring.GetKey(0)
+	committedCtx := s.network.GetContext()
+	sequence, err := app.GetAccountKeeper().GetSequence(committedCtx, sender.AccAddr)
+	s.Require().NoError(err)
+
+	gasPrice := sdkmath.NewIntFromBigInt(app.GetEVMKeeper().GetBaseFee(committedCtx)).MulRaw(2)
+	checkCtx, _ := committedCtx.WithIsCheckTx(true).CacheContext()
+
+	const txCount = 2
+	for i := 0; i < txCount; i++ {
+		cosmosTx, err := tx.PrepareCosmosTx(checkCtx, app, tx.CosmosTxArgs{
+			TxCfg:    app.GetTxConfig(),
+			Priv:     sender.Priv,
+			ChainID:  s.network.GetChainID(),
+			Gas:      200_000,
+			GasPrice: &gasPrice,
+			Msgs: []sdk.Msg{
+				banktypes.NewMsgSend(sender.AccAddr, s.keyring.GetAccAddr(1), sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 1))),
+			},
+		})
+		s.Require().NoError(err)
+
+		checkCtx, err = app.GetAnteHandler()(checkCtx, cosmosTx, false)
+		s.Require().NoError(err)
+
+		// the sequence has been incremented by the ante handler
+		checkSequence, err := app.GetAccountKeeper().GetSequence(checkCtx, sender.AccAddr)
+		s.Require().NoError(err)
+		s.Require().Equal(sequence+uint64(i)+1, checkSequence)
+
+		s.Require().NoError(mp.Insert(checkCtx, cosmosTx))
+	}
+	s.Require().Equal(txCount, mp.CountTx())
+
+	// the txs are selected in order from the committed sequence
+	var selected []sdkmempool.SignerData
+	mp.SelectBy(committedCtx, nil, func(tx sdk.Tx) bool {
+		signers, err := sdkmempool.NewDefaultSignerExtractionAdapter().GetSigners(tx)
+		s.Require().NoError(err)
+		selected = append(selected, signers...)
+		return true
+	})
+	s.Require().Equal([]sdkmempool.SignerData{
+		sdkmempool.NewSignerData(sender.AccAddr, sequence),
+		sdkmempool.NewSignerData(sender.AccAddr, sequence+1),
+	}, selected)
+}
+
+// TestInsertEthTxAfterCosmosTx inserts an EVM tx after a pending Cosmos tx of
+// the same sender, the way CheckTx does. The EVM tx must not drop the Cosmos
+// tx, which is not committed yet although its sequence was incremented in the
+// CheckTx state.
+func (s *MempoolTestSuite) TestInsertEthTxAfterCosmosTx() {
+	app := s.network.App
+	mp := mempool.NewMempool(mempool.DefaultConfig(), app.GetAccountKeeper(), app.GetEVMKeeper(), nil)
+	txFactory := factory.New(s.network, grpc.NewIntegrationHandler(s.network))
+
+	sender := s.keyring.GetKey(0)
+	committedCtx := s.network.GetContext()
+	sequence, err := app.GetAccountKeeper().GetSequence(committedCtx, sender.AccAddr)
+	s.Require().NoError(err)
+
+	gasPrice := sdkmath.NewIntFromBigInt(app.GetEVMKeeper().GetBaseFee(committedCtx)).MulRaw(2)
+	checkCtx, _ := committedCtx.WithIsCheckTx(true).CacheContext()
+
+	cosmosTx, err := tx.PrepareCosmosTx(checkCtx, app, tx.CosmosTxArgs{
+		TxCfg:    app.GetTxConfig(),
+		Priv:     sender.Priv,
+		ChainID:  s.network.GetChainID(),
+		Gas:      200_000,
+		GasPrice: &gasPrice,
+		Msgs: []sdk.Msg{
+			banktypes.NewMsgSend(sender.AccAddr, s.keyring.GetAccAddr(1), sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 1))),
+		},
+	})
+	s.Require().NoError(err)
+	checkCtx, err = app.GetAnteHandler()(checkCtx, cosmosTx, false)
+	s.Require().NoError(err)
+	s.Require().NoError(mp.Insert(checkCtx, cosmosTx))
+
+	receiver := s.keyring.GetAddr(1)
+	ethTx, err := txFactory.GenerateSignedEthTx(sender.Priv, evmtypes.EvmTxArgs{
+		Nonce:    sequence + 1,
+		To:       &receiver,
+		Amount:   big.NewInt(1),
+		GasPrice: gasPrice.BigInt(),
+	})
+	s.Require().NoError(err)
+	// with the EVM mempool, the ante handler doesn't increment the sequence
+	// of EVM txs during CheckTx (see MonoDecorator.WithMempoolNonceGaps)
+	s.Require().NoError(mp.Insert(checkCtx, ethTx))
+	s.Require().Equal(2, mp.CountTx())
+
+	// pruning against the committed state keeps both txs
+	mp.PruneCommitted(committedCtx)
+	s.Require().Equal(2, mp.CountTx())
+
+	// both txs are selected in order from the committed sequence
+	var selected []sdk.Tx
+	mp.SelectBy(committedCtx, nil, func(tx sdk.Tx) bool {
+		selected = append(selected, tx)
+		return true
+	})
+	s.Require().Equal([]sdk.Tx{cosmosTx, ethTx}, selected)
+}
@@ -0,0 +1,35 @@
+package mempool
+
+import (
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+)
+
+type MempoolTestSuite struct {
+	suite.Suite
+
+	create  network.CreateEvmApp
+	options []network.ConfigOption
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+}
+
+func NewMempoolTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *MempoolTestSuite {
+	return &MempoolTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+func (s *MempoolTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	}
+	options = append(options, s.options...)
+
+	s.network = network.NewUnitTestNetwork(s.create, options...)
+	s.keyring = keyring
+}
@@ -0,0 +1,63 @@
+package authz
+
+import (
+	"github.com/cosmos/evm/precompiles/authz"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *PrecompileTestSuite) TestGrants() {
+	method := s.precompile.Methods[authz.GrantsMethod]
+
+	s.SetupTest()
+	s.grantSend(s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+	bz, err := s.precompile.Grants(ctx, &method, contract, []interface{}{
+		s.keyring.GetAddr(0), s.keyring.GetAddr(1), "", query.PageRequest{CountTotal: true},
+	})
+	s.Require().NoError(err)
+
+	var out authz.GrantsOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, bz))
+	s.Require().Len(out.Grants, 1)
+	s.Require().Equal(s.keyring.GetAddr(0), out.Grants[0].Granter)
+	s.Require().Equal(s.keyring.GetAddr(1), out.Grants[0].Grantee)
+	s.Require().Equal("/cosmos.authz.v1beta1.GenericAuthorization", out.Grants[0].AuthorizationType)
+	s.Require().Equal(sendMsgTypeURL, out.Grants[0].MsgTypeUrl)
+	s.Require().Contains(out.Grants[0].Authorization, sendMsgTypeURL)
+	s.Require().NotZero(out.Grants[0].Expiration)
+	s.Require().Equal(uint64(1), out.PageResponse.Total)
+
+	_, err = s.precompile.Grants(ctx, &method, contract, []interface{}{
+		s.keyring.GetAddr(1), s.keyring.GetAddr(0), sendMsgTypeURL, query.PageRequest{},
+	})
+	s.Require().ErrorContains(err, "authorization not found")
+}
+
+func (s *PrecompileTestSuite) TestGranterGranteeGrants() {
+	s.SetupTest()
+	s.grantSend(s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+	s.grantSend(s.keyring.GetAddr(0), s.keyring.GetAddr(2))
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	method := s.precompile.Methods[authz.GranterGrantsMethod]
+	bz, err := s.precompile.GranterGrants(ctx, &method, contract, []interface{}{s.keyring.GetAddr(0), query.PageRequest{}})
+	s.Require().NoError(err)
+
+	var out authz.GrantsOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, authz.GranterGrantsMethod, bz))
+	s.Require().Len(out.Grants, 2)
+
+	method = s.precompile.Methods[authz.GranteeGrantsMethod]
+	bz, err = s.precompile.GranteeGrants(ctx, &method, contract, []interface{}{s.keyring.GetAddr(2), query.PageRequest{}})
+	s.Require().NoError(err)
+
+	out = authz.GrantsOutput{}
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, authz.GranteeGrantsMethod, bz))
+	s.Require().Len(out.Grants, 1)
+	s.Require().Equal(s.keyring.GetAddr(0), out.Grants[0].Granter)
+	s.Require().Equal(s.keyring.GetAddr(2), out.Grants[0].Grantee)
+}
@@ -0,0 +1,262 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var sendMsgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func (s *PrecompileTestSuite) TestGrantGeneric() {
+	var (
+		stDB   *statedb.StateDB
+		method = s.precompile.Methods[authz.GrantGenericMethod]
+	)
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{utiltx.GenerateAddress(), s.keyring.GetAddr(1), sendMsgTypeURL, int64(0)}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - granter is grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(0), sendMsgTypeURL, int64(0)}
+			},
+			func() {},
+			true,
+			authztypes.ErrGranteeIsGranter.Error(),
+		},
+		{
+			"fail - disabled msg type",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), int64(0)}
+			},
+			func() {},
+			true,
+			"found disabled msg type",
+		},
+		{
+			"success - generic authorization granted",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, int64(0)}
+			},
+			func() {
+				authorization, expiration := s.network.App.GetAuthzKeeper().GetAuthorization(
+					s.network.GetContext(), s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgTypeURL,
+				)
+				s.Require().Equal(authztypes.NewGenericAuthorization(sendMsgTypeURL), authorization)
+				s.Require().Nil(expiration)
+
+				s.Require().Len(stDB.Logs(), 1)
+				var event authz.EventGrant
+				err := cmn.UnpackLog(s.precompile.ABI, &event, authz.EventTypeGrant, *stDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), event.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), event.Grantee)
+				s.Require().Equal(sendMsgTypeURL, event.MsgTypeUrl)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			stDB = s.network.GetStateDB()
+			res, err := s.precompile.GrantGeneric(ctx, contract, stDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	method := s.precompile.Methods[authz.RevokeMethod]
+
+	s.SetupTest()
+	s.grantSend(s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(1), s.precompile.Address(), 200000)
+	_, err := s.precompile.Revoke(ctx, contract, s.network.GetStateDB(), &method, []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL})
+	s.Require().ErrorContains(err, "does not match the requester address")
+
+	contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+	res, err := s.precompile.Revoke(ctx, contract, s.network.GetStateDB(), &method, []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL})
+	s.Require().NoError(err)
+	s.Require().Equal(cmn.TrueValue, res)
+
+	authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(
+		s.network.GetContext(), s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgTypeURL,
+	)
+	s.Require().Nil(authorization)
+
+	_, err = s.precompile.Revoke(ctx, contract, s.network.GetStateDB(), &method, []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL})
+	s.Require().ErrorContains(err, "authorization not found")
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	var (
+		stDB   *statedb.StateDB
+		method = s.precompile.Methods[authz.ExecMethod]
+	)
+	amount := math.NewInt(100)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - no messages",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), []string{}}
+			},
+			func() {},
+			true,
+			"invalid messages",
+		},
+		{
+			"fail - msg.sender address does not match the grantee address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), []string{s.sendMsgJSON(amount)}}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - no authorization",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), []string{s.sendMsgJSON(amount)}}
+			},
+			func() {},
+			true,
+			"authorization not found",
+		},
+		{
+			"fail - disabled nested msg",
+			func() []interface{} {
+				s.grantSend(s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+				msgJSON, err := s.network.App.AppCodec().MarshalInterfaceJSON(&evmtypes.MsgEthereumTx{From: s.keyring.GetAddr(0).Bytes()})
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(1), []string{string(msgJSON)}}
+			},
+			func() {},
+			true,
+			"found disabled msg type",
+		},
+		{
+			"success - send on behalf of the granter",
+			func() []interface{} {
+				s.grantSend(s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+				return []interface{}{s.keyring.GetAddr(1), []string{s.sendMsgJSON(amount)}}
+			},
+			func() {
+				balance := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(2), s.network.GetBaseDenom())
+				prefunded := network.PrefundedAccountInitialBalance
+				s.Require().Equal(prefunded.Add(amount), balance.Amount)
+
+				s.Require().Len(stDB.Logs(), 1)
+				var event authz.EventExec
+				err := cmn.UnpackLog(s.precompile.ABI, &event, authz.EventTypeExec, *stDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(1), event.Grantee)
+				s.Require().Equal([]string{sendMsgTypeURL}, event.MsgTypeUrls)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(1), s.precompile.Address(), 200000)
+
+			stDB = s.network.GetStateDB()
+			_, err := s.precompile.Exec(ctx, contract, stDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+// grantSend grants a generic authorization for MsgSend from the granter to the grantee.
+func (s *PrecompileTestSuite) grantSend(granter, grantee common.Address) {
+	expiration := s.network.GetContext().BlockTime().Add(time.Hour)
+	err := s.network.App.GetAuthzKeeper().SaveGrant(
+		s.network.GetContext(),
+		grantee.Bytes(),
+		granter.Bytes(),
+		authztypes.NewGenericAuthorization(sendMsgTypeURL),
+		&expiration,
+	)
+	s.Require().NoError(err)
+}
+
+// sendMsgJSON returns the protoJSON encoded MsgSend from the first to the third account.
+func (s *PrecompileTestSuite) sendMsgJSON(amount math.Int) string {
+	msg := banktypes.NewMsgSend(
+		s.keyring.GetAccAddr(0),
+		s.keyring.GetAccAddr(2),
+		sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), amount)),
+	)
+	bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+	s.Require().NoError(err)
+	return string(bz)
+}
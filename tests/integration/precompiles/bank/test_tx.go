@@ -0,0 +1,186 @@
+package bank
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/bank"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	cosmosevmutiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func (s *PrecompileTestSuite) TestSend() {
+	var (
+		stDB     *statedb.StateDB
+		receiver common.Address
+		method   = s.precompile.Methods[bank.SendMethod]
+	)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{receiver}
+			},
+			func() {},
+			false,
+			"invalid number of arguments",
+		},
+		{
+			"fail - empty receiver address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}}
+			},
+			func() {},
+			false,
+			"invalid receiver address",
+		},
+		{
+			"fail - no coins",
+			func() []interface{} {
+				return []interface{}{receiver, []cmn.Coin{}}
+			},
+			func() {},
+			false,
+			"no coins provided",
+		},
+		{
+			"fail - zero amount",
+			func() []interface{} {
+				return []interface{}{receiver, []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(0)}}}
+			},
+			func() {},
+			false,
+			"invalid coins",
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				amount := network.PrefundedAccountInitialBalance.AddRaw(1).BigInt()
+				return []interface{}{receiver, []cmn.Coin{{Denom: s.tokenDenom, Amount: amount}}}
+			},
+			func() {},
+			false,
+			"insufficient funds",
+		},
+		{
+			"fail - receiver is a blocked address",
+			func() []interface{} {
+				blocked := common.BytesToAddress(authtypes.NewModuleAddress(distrtypes.ModuleName))
+				return []interface{}{blocked, []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}}
+			},
+			func() {},
+			false,
+			"is not allowed to receive funds",
+		},
+		{
+			"fail - send disabled for denom",
+			func() []interface{} {
+				bankKeeper := s.network.App.GetBankKeeper()
+				bankKeeper.SetSendEnabled(s.network.GetContext(), s.tokenDenom, false)
+				return []interface{}{receiver, []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}}
+			},
+			func() {},
+			false,
+			banktypes.ErrSendDisabled.Error(),
+		},
+		{
+			"pass - send multiple coins",
+			func() []interface{} {
+				return []interface{}{receiver, []cmn.Coin{
+					{Denom: s.tokenDenom, Amount: big.NewInt(100)},
+					{Denom: s.bondDenom, Amount: big.NewInt(200)},
+				}}
+			},
+			func() {
+				ctx := s.network.GetContext()
+				bankKeeper := s.network.App.GetBankKeeper()
+				s.Require().Equal(math.NewInt(100), bankKeeper.GetBalance(ctx, receiver.Bytes(), s.tokenDenom).Amount)
+				s.Require().Equal(math.NewInt(200), bankKeeper.GetBalance(ctx, receiver.Bytes(), s.bondDenom).Amount)
+
+				s.Require().Len(stDB.Logs(), 2)
+				var event bank.EventTransfer
+				err := cmn.UnpackLog(s.precompile.ABI, &event, bank.EventTypeTransfer, *stDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), event.From)
+				s.Require().Equal(receiver, event.To)
+				s.Require().Equal(s.bondDenom, event.Denom)
+				s.Require().Equal(big.NewInt(200), event.Amount)
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			receiver = cosmosevmutiltx.GenerateAddress()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			stDB = s.network.GetStateDB()
+			bz, err := s.precompile.Send(ctx, contract, stDB, &method, args)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+				tc.postCheck()
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMultiSend() {
+	method := s.precompile.Methods[bank.MultiSendMethod]
+
+	s.SetupTest()
+	receiverA := cosmosevmutiltx.GenerateAddress()
+	receiverB := cosmosevmutiltx.GenerateAddress()
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+	stDB := s.network.GetStateDB()
+
+	// an empty list of outputs is rejected
+	_, err := s.precompile.MultiSend(ctx, contract, stDB, &method, []interface{}{[]bank.Output{}})
+	s.Require().ErrorContains(err, "no outputs provided")
+
+	bz, err := s.precompile.MultiSend(ctx, contract, stDB, &method, []interface{}{[]bank.Output{
+		{To: receiverA, Amount: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(100)}}},
+		{To: receiverB, Amount: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(300)}}},
+	}})
+	s.Require().NoError(err)
+	s.Require().Equal(cmn.TrueValue, bz)
+
+	bankKeeper := s.network.App.GetBankKeeper()
+	s.Require().Equal(math.NewInt(100), bankKeeper.GetBalance(ctx, receiverA.Bytes(), s.tokenDenom).Amount)
+	s.Require().Equal(math.NewInt(300), bankKeeper.GetBalance(ctx, receiverB.Bytes(), s.tokenDenom).Amount)
+	s.Require().Len(stDB.Logs(), 2)
+
+	// multiSend fails if one of the outputs cannot be sent
+	blocked := common.BytesToAddress(authtypes.NewModuleAddress(distrtypes.ModuleName))
+	_, err = s.precompile.MultiSend(ctx, contract, stDB, &method, []interface{}{[]bank.Output{
+		{To: receiverA, Amount: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(100)}}},
+		{To: blocked, Amount: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(100)}}},
+	}})
+	s.Require().ErrorContains(err, "is not allowed to receive funds")
+}
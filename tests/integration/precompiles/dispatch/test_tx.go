@@ -0,0 +1,170 @@
+package dispatch
+
+import (
+	"fmt"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/dispatch"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var sendMsgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func (s *PrecompileTestSuite) TestDispatch() {
+	var (
+		stDB   *statedb.StateDB
+		method = s.precompile.Methods[dispatch.DispatchMethod]
+		amount = int64(1000)
+	)
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(res []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - msg type not allowed",
+			func() []interface{} {
+				s.setAllowedDispatchMsgs()
+				return []interface{}{sendMsgTypeURL, s.encodeSend(math.NewInt(amount))}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(dispatch.ErrMsgNotAllowed, sendMsgTypeURL),
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				s.setAllowedDispatchMsgs(sendMsgTypeURL)
+				balance := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(0), s.network.GetBaseDenom())
+				return []interface{}{sendMsgTypeURL, s.encodeSend(balance.Amount.AddRaw(1))}
+			},
+			func([]byte) {},
+			true,
+			"insufficient funds",
+		},
+		{
+			"success - send with the caller as signer",
+			func() []interface{} {
+				s.setAllowedDispatchMsgs(sendMsgTypeURL)
+				return []interface{}{sendMsgTypeURL, s.encodeSend(math.NewInt(amount))}
+			},
+			func(res []byte) {
+				var out []byte
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, dispatch.DispatchMethod, res))
+				var resp banktypes.MsgSendResponse
+				s.Require().NoError(s.network.App.AppCodec().Unmarshal(out, &resp))
+
+				s.Require().Len(stDB.Logs(), 1)
+				var event dispatch.EventDispatch
+				err := cmn.UnpackLog(s.precompile.ABI, &event, dispatch.EventTypeDispatch, *stDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), event.Sender)
+				s.Require().Equal(sendMsgTypeURL, event.TypeUrl)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			recipient := s.keyring.GetAccAddr(2)
+			prevBalance := s.network.App.GetBankKeeper().GetBalance(ctx, recipient, s.network.GetBaseDenom())
+
+			stDB = s.network.GetStateDB()
+			res, err := s.precompile.Dispatch(ctx, contract, stDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, recipient, s.network.GetBaseDenom())
+				s.Require().Equal(prevBalance.Amount.AddRaw(amount), balance.Amount)
+				tc.postCheck(res)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDispatchJSON() {
+	method := s.precompile.Methods[dispatch.DispatchJSONMethod]
+
+	s.SetupTest()
+	s.setAllowedDispatchMsgs(sendMsgTypeURL)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	recipient := s.keyring.GetAccAddr(2)
+	prevBalance := s.network.App.GetBankKeeper().GetBalance(ctx, recipient, s.network.GetBaseDenom())
+
+	// the from address is set to the caller
+	msgJSON := fmt.Sprintf(
+		`{"from_address":%q,"to_address":%q,"amount":[{"denom":%q,"amount":"1000"}]}`,
+		s.keyring.GetAccAddr(1).String(), recipient.String(), s.network.GetBaseDenom(),
+	)
+	_, err := s.precompile.DispatchJSON(ctx, contract, s.network.GetStateDB(), &method, []interface{}{sendMsgTypeURL, msgJSON})
+	s.Require().NoError(err)
+
+	balance := s.network.App.GetBankKeeper().GetBalance(ctx, recipient, s.network.GetBaseDenom())
+	s.Require().Equal(prevBalance.Amount.AddRaw(1000), balance.Amount)
+
+	_, err = s.precompile.DispatchJSON(ctx, contract, s.network.GetStateDB(), &method, []interface{}{sendMsgTypeURL, "{"})
+	s.Require().ErrorContains(err, "invalid msg value")
+}
+
+func (s *PrecompileTestSuite) TestAllowedMessages() {
+	method := s.precompile.Methods[dispatch.AllowedMessagesMethod]
+
+	s.SetupTest()
+	s.setAllowedDispatchMsgs(sendMsgTypeURL)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+	bz, err := s.precompile.AllowedMessages(ctx, &method, contract, []interface{}{})
+	s.Require().NoError(err)
+
+	var out []string
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, dispatch.AllowedMessagesMethod, bz))
+	s.Require().Equal([]string{sendMsgTypeURL}, out)
+}
+
+// setAllowedDispatchMsgs sets the dispatch allow-list of the EVM module parameters.
+func (s *PrecompileTestSuite) setAllowedDispatchMsgs(typeURLs ...string) {
+	params := s.network.App.GetEVMKeeper().GetParams(s.network.GetContext())
+	params.AllowedDispatchMsgs = typeURLs
+	s.Require().NoError(s.network.App.GetEVMKeeper().SetParams(s.network.GetContext(), params))
+}
+
+// encodeSend returns a proto-encoded MsgSend of the given amount of the base denom to
+// the third keyring account, with a from address that is not the caller.
+func (s *PrecompileTestSuite) encodeSend(amount math.Int) []byte {
+	msg := &banktypes.MsgSend{
+		FromAddress: s.keyring.GetAccAddr(1).String(),
+		ToAddress:   s.keyring.GetAccAddr(2).String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), amount)),
+	}
+	bz, err := s.network.App.AppCodec().Marshal(msg)
+	s.Require().NoError(err)
+	return bz
+}
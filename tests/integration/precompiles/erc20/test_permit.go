@@ -0,0 +1,386 @@
+package erc20
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/cosmos/evm/precompiles/erc20"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+var (
+	permitTypes = []apitypes.Type{
+		{Name: "owner", Type: "address"},
+		{Name: "spender", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	}
+	authorizationTypes = []apitypes.Type{
+		{Name: "from", Type: "address"},
+		{Name: "to", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "validAfter", Type: "uint256"},
+		{Name: "validBefore", Type: "uint256"},
+		{Name: "nonce", Type: "bytes32"},
+	}
+	cancelAuthorizationTypes = []apitypes.Type{
+		{Name: "authorizer", Type: "address"},
+		{Name: "nonce", Type: "bytes32"},
+	}
+)
+
+// signTypedData signs the EIP-712 typed data of the given message for the ERC20
+// precompile domain and returns the v, r and s values of the signature.
+func (s *PrecompileTestSuite) signTypedData(
+	key *ecdsa.PrivateKey,
+	primaryType string,
+	fields []apitypes.Type,
+	message apitypes.TypedDataMessage,
+) (uint8, [32]byte, [32]byte) {
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			primaryType: fields,
+		},
+		PrimaryType: primaryType,
+		Domain: apitypes.TypedDataDomain{
+			// NOTE: the xmpl token has no metadata, so the domain name falls back to the denom
+			Name:              s.tokenDenom,
+			Version:           erc20.DomainVersion,
+			ChainId:           math.NewHexOrDecimal256(evmtypes.GetEthChainConfig().ChainID.Int64()),
+			VerifyingContract: s.precompile.Address().Hex(),
+		},
+		Message: message,
+	}
+
+	digest, _, err := apitypes.TypedDataAndHash(typedData)
+	s.Require().NoError(err, "failed to hash typed data")
+
+	sig, err := crypto.Sign(digest, key)
+	s.Require().NoError(err, "failed to sign typed data")
+
+	var r, ss [32]byte
+	copy(r[:], sig[:32])
+	copy(ss[:], sig[32:64])
+	return sig[64] + 27, r, ss
+}
+
+// fundWithXMPL mints XMPL coins to the given address.
+func (s *PrecompileTestSuite) fundWithXMPL(addr common.Address) {
+	err := s.network.App.GetBankKeeper().MintCoins(s.network.GetContext(), erc20types.ModuleName, XMPLCoin)
+	s.Require().NoError(err, "failed to mint coins")
+	err = s.network.App.GetBankKeeper().SendCoinsFromModuleToAccount(s.network.GetContext(), erc20types.ModuleName, addr.Bytes(), XMPLCoin)
+	s.Require().NoError(err, "failed to send coins from module to account")
+}
+
+func (s *PrecompileTestSuite) TestDomainSeparator() {
+	method := s.precompile.Methods[erc20.DomainSeparatorMethod]
+	ctx := s.network.GetContext()
+
+	domain := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+		},
+		Domain: apitypes.TypedDataDomain{
+			Name:              s.tokenDenom,
+			Version:           erc20.DomainVersion,
+			ChainId:           math.NewHexOrDecimal256(evmtypes.GetEthChainConfig().ChainID.Int64()),
+			VerifyingContract: s.precompile.Address().Hex(),
+		},
+	}
+	expSeparator, err := domain.HashStruct("EIP712Domain", domain.Domain.Map())
+	s.Require().NoError(err)
+
+	bz, err := s.precompile.DomainSeparator(ctx, nil, nil, &method, []interface{}{})
+	s.requireOut(bz, err, method, true, "", [32]byte(expSeparator))
+}
+
+func (s *PrecompileTestSuite) TestPermit() {
+	method := s.precompile.Methods[erc20.PermitMethod]
+	noncesMethod := s.precompile.Methods[erc20.NoncesMethod]
+
+	ownerAddr, ownerPriv := utiltx.NewAddrKey()
+	ownerKey, err := ownerPriv.ToECDSA()
+	s.Require().NoError(err)
+	_, otherPriv := utiltx.NewAddrKey()
+	otherKey, err := otherPriv.ToECDSA()
+	s.Require().NoError(err)
+
+	spender := s.keyring.GetAddr(1)
+	value := big.NewInt(100)
+
+	permitArgs := func(key *ecdsa.PrivateKey, nonce int64, deadline *big.Int) []interface{} {
+		v, r, ss := s.signTypedData(key, "Permit", permitTypes, apitypes.TypedDataMessage{
+			"owner":    ownerAddr.Hex(),
+			"spender":  spender.Hex(),
+			"value":    value,
+			"nonce":    big.NewInt(nonce),
+			"deadline": deadline,
+		})
+		return []interface{}{ownerAddr, spender, value, deadline, v, r, ss}
+	}
+
+	testcases := []struct {
+		name        string
+		malleate    func(deadline *big.Int) []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:        "fail - invalid number of arguments",
+			malleate:    func(*big.Int) []interface{} { return []interface{}{ownerAddr, spender} },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name: "fail - expired deadline",
+			malleate: func(deadline *big.Int) []interface{} {
+				return permitArgs(ownerKey, 0, new(big.Int).Sub(deadline, big.NewInt(2*3600)))
+			},
+			errContains: erc20.ErrPermitExpired.Error(),
+		},
+		{
+			name: "fail - signed by another key",
+			malleate: func(deadline *big.Int) []interface{} {
+				return permitArgs(otherKey, 0, deadline)
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "fail - invalid nonce",
+			malleate: func(deadline *big.Int) []interface{} {
+				return permitArgs(ownerKey, 1, deadline)
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "pass",
+			malleate: func(deadline *big.Int) []interface{} {
+				return permitArgs(ownerKey, 0, deadline)
+			},
+			expPass: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			ctx := s.network.GetContext()
+			deadline := big.NewInt(ctx.BlockTime().Add(time.Hour).Unix())
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 0)
+
+			_, err := s.precompile.Permit(ctx, contract, stateDB, &method, tc.malleate(deadline))
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.requireAllowance(s.precompile.Address(), ownerAddr, spender, value)
+
+			bz, err := s.precompile.Nonces(ctx, contract, stateDB, &noncesMethod, []interface{}{ownerAddr})
+			s.requireOut(bz, err, noncesMethod, true, "", big.NewInt(1))
+
+			// the same signature can't be replayed
+			_, err = s.precompile.Permit(ctx, contract, stateDB, &method, tc.malleate(deadline))
+			s.Require().ErrorContains(err, erc20.ErrPermitInvalidSignature.Error())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestTransferWithAuthorization() {
+	method := s.precompile.Methods[erc20.TransferWithAuthorizationMethod]
+	receiveMethod := s.precompile.Methods[erc20.ReceiveWithAuthorizationMethod]
+	stateMethod := s.precompile.Methods[erc20.AuthorizationStateMethod]
+
+	fromAddr, fromPriv := utiltx.NewAddrKey()
+	fromKey, err := fromPriv.ToECDSA()
+	s.Require().NoError(err)
+
+	value := big.NewInt(100)
+	nonce := [32]byte(crypto.Keccak256Hash([]byte("nonce")))
+
+	authorizationArgs := func(primaryType string, validAfter, validBefore *big.Int) []interface{} {
+		v, r, ss := s.signTypedData(fromKey, primaryType, authorizationTypes, apitypes.TypedDataMessage{
+			"from":        fromAddr.Hex(),
+			"to":          toAddr.Hex(),
+			"value":       value,
+			"validAfter":  validAfter,
+			"validBefore": validBefore,
+			"nonce":       hexutil.Encode(nonce[:]),
+		})
+		return []interface{}{fromAddr, toAddr, value, validAfter, validBefore, nonce, v, r, ss}
+	}
+
+	testcases := []struct {
+		name        string
+		method      string
+		caller      common.Address
+		malleate    func(now int64) []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:   "fail - not yet valid",
+			method: erc20.TransferWithAuthorizationMethod,
+			malleate: func(now int64) []interface{} {
+				return authorizationArgs("TransferWithAuthorization", big.NewInt(now+60), big.NewInt(now+3600))
+			},
+			errContains: erc20.ErrAuthorizationNotYetValid.Error(),
+		},
+		{
+			name:   "fail - expired",
+			method: erc20.TransferWithAuthorizationMethod,
+			malleate: func(now int64) []interface{} {
+				return authorizationArgs("TransferWithAuthorization", big.NewInt(0), big.NewInt(now))
+			},
+			errContains: erc20.ErrAuthorizationExpired.Error(),
+		},
+		{
+			name:   "fail - signed for receiveWithAuthorization",
+			method: erc20.TransferWithAuthorizationMethod,
+			malleate: func(now int64) []interface{} {
+				return authorizationArgs("ReceiveWithAuthorization", big.NewInt(0), big.NewInt(now+3600))
+			},
+			errContains: erc20.ErrAuthorizationInvalidSignature.Error(),
+		},
+		{
+			name:   "fail - receiveWithAuthorization caller is not the payee",
+			method: erc20.ReceiveWithAuthorizationMethod,
+			caller: s.keyring.GetAddr(0),
+			malleate: func(now int64) []interface{} {
+				return authorizationArgs("ReceiveWithAuthorization", big.NewInt(0), big.NewInt(now+3600))
+			},
+			errContains: erc20.ErrCallerNotPayee.Error(),
+		},
+		{
+			name:   "pass - transferWithAuthorization",
+			method: erc20.TransferWithAuthorizationMethod,
+			caller: s.keyring.GetAddr(0),
+			malleate: func(now int64) []interface{} {
+				return authorizationArgs("TransferWithAuthorization", big.NewInt(0), big.NewInt(now+3600))
+			},
+			expPass: true,
+		},
+		{
+			name:   "pass - receiveWithAuthorization",
+			method: erc20.ReceiveWithAuthorizationMethod,
+			caller: toAddr,
+			malleate: func(now int64) []interface{} {
+				return authorizationArgs("ReceiveWithAuthorization", big.NewInt(0), big.NewInt(now+3600))
+			},
+			expPass: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.fundWithXMPL(fromAddr)
+			stateDB := s.network.GetStateDB()
+			ctx := s.network.GetContext()
+			now := ctx.BlockTime().Unix()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, tc.caller, s.precompile.Address(), 0)
+
+			args := tc.malleate(now)
+			call := s.precompile.TransferWithAuthorization
+			callMethod := method
+			if tc.method == erc20.ReceiveWithAuthorizationMethod {
+				call = s.precompile.ReceiveWithAuthorization
+				callMethod = receiveMethod
+			}
+
+			_, err := call(ctx, contract, stateDB, &callMethod, args)
+			if !tc.expPass {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			balance := s.network.App.GetBankKeeper().GetBalance(ctx, toAddr.Bytes(), tokenDenom)
+			s.Require().Equal(value, balance.Amount.BigInt())
+
+			bz, err := s.precompile.AuthorizationState(ctx, contract, stateDB, &stateMethod, []interface{}{fromAddr, nonce})
+			s.requireOut(bz, err, stateMethod, true, "", true)
+
+			// the same authorization can't be used twice
+			_, err = call(ctx, contract, stateDB, &callMethod, args)
+			s.Require().ErrorContains(err, erc20.ErrAuthorizationUsed.Error())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCancelAuthorization() {
+	method := s.precompile.Methods[erc20.CancelAuthorizationMethod]
+	transferMethod := s.precompile.Methods[erc20.TransferWithAuthorizationMethod]
+
+	authorizerAddr, authorizerPriv := utiltx.NewAddrKey()
+	authorizerKey, err := authorizerPriv.ToECDSA()
+	s.Require().NoError(err)
+
+	s.fundWithXMPL(authorizerAddr)
+	stateDB := s.network.GetStateDB()
+	ctx := s.network.GetContext()
+	now := ctx.BlockTime().Unix()
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 0)
+
+	nonce := [32]byte(crypto.Keccak256Hash([]byte("nonce")))
+	v, r, ss := s.signTypedData(authorizerKey, "CancelAuthorization", cancelAuthorizationTypes, apitypes.TypedDataMessage{
+		"authorizer": authorizerAddr.Hex(),
+		"nonce":      hexutil.Encode(nonce[:]),
+	})
+
+	// a cancellation signed for another nonce is rejected
+	otherNonce := [32]byte(crypto.Keccak256Hash([]byte("other")))
+	_, err = s.precompile.CancelAuthorization(ctx, contract, stateDB, &method, []interface{}{authorizerAddr, otherNonce, v, r, ss})
+	s.Require().ErrorContains(err, erc20.ErrAuthorizationInvalidSignature.Error())
+
+	_, err = s.precompile.CancelAuthorization(ctx, contract, stateDB, &method, []interface{}{authorizerAddr, nonce, v, r, ss})
+	s.Require().NoError(err)
+	s.Require().True(s.network.App.GetErc20Keeper().IsAuthorizationUsed(ctx, s.precompile.Address(), authorizerAddr, nonce))
+
+	// a canceled authorization can't be used
+	validBefore := big.NewInt(now + 3600)
+	tv, tr, ts := s.signTypedData(authorizerKey, "TransferWithAuthorization", authorizationTypes, apitypes.TypedDataMessage{
+		"from":        authorizerAddr.Hex(),
+		"to":          toAddr.Hex(),
+		"value":       big.NewInt(1),
+		"validAfter":  big.NewInt(0),
+		"validBefore": validBefore,
+		"nonce":       hexutil.Encode(nonce[:]),
+	})
+	_, err = s.precompile.TransferWithAuthorization(ctx, contract, stateDB, &transferMethod, []interface{}{
+		authorizerAddr, toAddr, big.NewInt(1), big.NewInt(0), validBefore, nonce, tv, tr, ts,
+	})
+	s.Require().ErrorContains(err, erc20.ErrAuthorizationUsed.Error())
+
+	balance := s.network.App.GetBankKeeper().GetBalance(ctx, toAddr.Bytes(), tokenDenom)
+	s.Require().True(balance.IsZero())
+
+	// a cancellation can't be replayed
+	_, err = s.precompile.CancelAuthorization(ctx, contract, stateDB, &method, []interface{}{authorizerAddr, nonce, v, r, ss})
+	s.Require().ErrorContains(err, erc20.ErrAuthorizationUsed.Error())
+}
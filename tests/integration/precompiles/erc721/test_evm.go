@@ -0,0 +1,106 @@
+package erc721
+
+import (
+	"math/big"
+
+	"github.com/cosmos/evm/precompiles/erc721"
+	"github.com/cosmos/evm/precompiles/testutil"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/erc721/keeper/testdata"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// TestEVMCalls checks that the ERC-721 precompile of a registered class is
+// available to the EVM transactions.
+func (s *PrecompileTestSuite) TestEVMCalls() {
+	var (
+		sender    = s.keyring.GetKey(0)
+		failCheck testutil.LogCheckArgs
+		passCheck testutil.LogCheckArgs
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func() (evmtypes.EvmTxArgs, testutiltypes.CallArgs, testutil.LogCheckArgs)
+		postCheck func()
+	}{
+		{
+			"pass - transferFrom",
+			func() (evmtypes.EvmTxArgs, testutiltypes.CallArgs, testutil.LogCheckArgs) {
+				return evmtypes.EvmTxArgs{}, testutiltypes.CallArgs{
+					MethodName: erc721.TransferFromMethod,
+					Args:       []interface{}{sender.Addr, s.keyring.GetAddr(1), big.NewInt(1)},
+				}, passCheck.WithExpEvents(erc721.EventTypeTransfer)
+			},
+			func() {
+				s.Require().Equal(s.keyring.GetAddr(1), s.ownerOf("1"))
+			},
+		},
+		{
+			"pass - safeTransferFrom to an EOA",
+			func() (evmtypes.EvmTxArgs, testutiltypes.CallArgs, testutil.LogCheckArgs) {
+				return evmtypes.EvmTxArgs{}, testutiltypes.CallArgs{
+					MethodName: erc721.SafeTransferFromMethod,
+					Args:       []interface{}{sender.Addr, s.keyring.GetAddr(1), big.NewInt(1)},
+				}, passCheck.WithExpEvents(erc721.EventTypeTransfer)
+			},
+			func() {
+				s.Require().Equal(s.keyring.GetAddr(1), s.ownerOf("1"))
+			},
+		},
+		{
+			"fail - safeTransferFrom to a contract that is not a receiver",
+			func() (evmtypes.EvmTxArgs, testutiltypes.CallArgs, testutil.LogCheckArgs) {
+				erc721Contract, err := testdata.LoadERC721Contract()
+				s.Require().NoError(err)
+				receiver, err := s.factory.DeployContract(
+					sender.Priv,
+					evmtypes.EvmTxArgs{},
+					testutiltypes.ContractDeploymentData{Contract: erc721Contract},
+				)
+				s.Require().NoError(err)
+				s.Require().NoError(s.network.NextBlock())
+
+				return evmtypes.EvmTxArgs{}, testutiltypes.CallArgs{
+					MethodName: erc721.SafeTransferFromMethod,
+					Args:       []interface{}{sender.Addr, receiver, big.NewInt(1)},
+				}, failCheck.WithErrContains(erc721.ErrNonERC721Receiver.Error())
+			},
+			func() {
+				s.Require().Equal(sender.Addr, s.ownerOf("1"))
+			},
+		},
+		{
+			"fail - call with value",
+			func() (evmtypes.EvmTxArgs, testutiltypes.CallArgs, testutil.LogCheckArgs) {
+				return evmtypes.EvmTxArgs{Amount: big.NewInt(1)}, testutiltypes.CallArgs{
+					MethodName: erc721.TransferFromMethod,
+					Args:       []interface{}{sender.Addr, s.keyring.GetAddr(1), big.NewInt(1)},
+				}, failCheck.WithErrContains("cannot receive funds")
+			},
+			func() {
+				s.Require().Equal(sender.Addr, s.ownerOf("1"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			sender = s.keyring.GetKey(0)
+			failCheck = testutil.LogCheckArgs{ABIEvents: s.precompile.Events}
+			passCheck = failCheck.WithExpPass(true)
+
+			txArgs, callArgs, logCheck := tc.malleate()
+			precompileAddr := s.precompile.Address()
+			txArgs.To = &precompileAddr
+			callArgs.ContractABI = s.precompile.ABI
+
+			_, _, err := s.factory.CallContractAndCheckLogs(sender.Priv, txArgs, callArgs, logCheck)
+			s.Require().NoError(err)
+			s.Require().NoError(s.network.NextBlock())
+
+			tc.postCheck()
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package erc721
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/erc721"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	"cosmossdk.io/x/nft"
+)
+
+// queryTestCase is a test case for the ERC-721 precompile queries.
+type queryTestCase struct {
+	name        string
+	malleate    func() []interface{}
+	expOutput   func() interface{}
+	errContains string
+}
+
+// runQueryTestCases calls the given query method of the precompile for each
+// test case and checks the unpacked output.
+func (s *PrecompileTestSuite) runQueryTestCases(methodName string, testCases []queryTestCase) {
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			method := s.precompile.Methods[methodName]
+			args := tc.malleate()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 0)
+			stateDB := s.network.GetStateDB()
+
+			var (
+				bz  []byte
+				err error
+			)
+			switch methodName {
+			case erc721.NameMethod:
+				bz, err = s.precompile.Name(ctx, contract, stateDB, &method, args)
+			case erc721.SymbolMethod:
+				bz, err = s.precompile.Symbol(ctx, contract, stateDB, &method, args)
+			case erc721.TokenURIMethod:
+				bz, err = s.precompile.TokenURI(ctx, contract, stateDB, &method, args)
+			case erc721.BalanceOfMethod:
+				bz, err = s.precompile.BalanceOf(ctx, contract, stateDB, &method, args)
+			case erc721.OwnerOfMethod:
+				bz, err = s.precompile.OwnerOf(ctx, contract, stateDB, &method, args)
+			case erc721.GetApprovedMethod:
+				bz, err = s.precompile.GetApproved(ctx, contract, stateDB, &method, args)
+			case erc721.IsApprovedForAllMethod:
+				bz, err = s.precompile.IsApprovedForAll(ctx, contract, stateDB, &method, args)
+			case erc721.SupportsInterfaceMethod:
+				bz, err = s.precompile.SupportsInterface(ctx, contract, stateDB, &method, args)
+			default:
+				s.FailNow("unexpected method", methodName)
+			}
+
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err, "failed to unpack output")
+			s.Require().Len(out, 1)
+			if expInt, ok := tc.expOutput().(*big.Int); ok {
+				s.Require().Equal(expInt.String(), out[0].(*big.Int).String())
+				return
+			}
+			s.Require().Equal(tc.expOutput(), out[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestName() {
+	s.runQueryTestCases(erc721.NameMethod, []queryTestCase{
+		{
+			"pass - class name",
+			func() []interface{} { return []interface{}{} },
+			func() interface{} { return "Example NFT" },
+			"",
+		},
+		{
+			"pass - class id if the class has no name",
+			func() []interface{} {
+				err := s.network.App.GetNFTKeeper().UpdateClass(s.network.GetContext(), nft.Class{Id: classID})
+				s.Require().NoError(err)
+				return []interface{}{}
+			},
+			func() interface{} { return classID },
+			"",
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestSymbol() {
+	s.runQueryTestCases(erc721.SymbolMethod, []queryTestCase{
+		{
+			"pass",
+			func() []interface{} { return []interface{}{} },
+			func() interface{} { return "XNFT" },
+			"",
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestTokenURI() {
+	s.runQueryTestCases(erc721.TokenURIMethod, []queryTestCase{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} { return []interface{}{} },
+			nil,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - nonexistent token",
+			func() []interface{} { return []interface{}{big.NewInt(3)} },
+			nil,
+			fmt.Sprintf(erc721.ErrNonexistentToken, big.NewInt(3)),
+		},
+		{
+			"pass",
+			func() []interface{} { return []interface{}{big.NewInt(1)} },
+			func() interface{} { return tokenURI },
+			"",
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestBalanceOf() {
+	s.runQueryTestCases(erc721.BalanceOfMethod, []queryTestCase{
+		{
+			"fail - zero address",
+			func() []interface{} { return []interface{}{common.Address{}} },
+			nil,
+			erc721.ErrInvalidOwner.Error(),
+		},
+		{
+			"pass - owner of the tokens",
+			func() []interface{} { return []interface{}{s.keyring.GetAddr(0)} },
+			func() interface{} { return big.NewInt(2) },
+			"",
+		},
+		{
+			"pass - account without tokens",
+			func() []interface{} { return []interface{}{s.keyring.GetAddr(1)} },
+			func() interface{} { return big.NewInt(0) },
+			"",
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestOwnerOf() {
+	s.runQueryTestCases(erc721.OwnerOfMethod, []queryTestCase{
+		{
+			"fail - nonexistent token",
+			func() []interface{} { return []interface{}{big.NewInt(3)} },
+			nil,
+			fmt.Sprintf(erc721.ErrNonexistentToken, big.NewInt(3)),
+		},
+		{
+			"pass",
+			func() []interface{} { return []interface{}{big.NewInt(1)} },
+			func() interface{} { return s.keyring.GetAddr(0) },
+			"",
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestGetApproved() {
+	s.runQueryTestCases(erc721.GetApprovedMethod, []queryTestCase{
+		{
+			"fail - nonexistent token",
+			func() []interface{} { return []interface{}{big.NewInt(3)} },
+			nil,
+			fmt.Sprintf(erc721.ErrNonexistentToken, big.NewInt(3)),
+		},
+		{
+			"pass - no approval",
+			func() []interface{} { return []interface{}{big.NewInt(1)} },
+			func() interface{} { return common.Address{} },
+			"",
+		},
+		{
+			"pass - approved account",
+			func() []interface{} {
+				s.network.App.GetErc721Keeper().SetTokenApproval(
+					s.network.GetContext(), s.precompile.Address(), big.NewInt(1), s.keyring.GetAddr(0), s.keyring.GetAddr(1),
+				)
+				return []interface{}{big.NewInt(1)}
+			},
+			func() interface{} { return s.keyring.GetAddr(1) },
+			"",
+		},
+		{
+			"pass - approval granted by a previous owner",
+			func() []interface{} {
+				s.network.App.GetErc721Keeper().SetTokenApproval(
+					s.network.GetContext(), s.precompile.Address(), big.NewInt(1), s.keyring.GetAddr(2), s.keyring.GetAddr(1),
+				)
+				return []interface{}{big.NewInt(1)}
+			},
+			func() interface{} { return common.Address{} },
+			"",
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestIsApprovedForAll() {
+	s.runQueryTestCases(erc721.IsApprovedForAllMethod, []queryTestCase{
+		{
+			"pass - not approved",
+			func() []interface{} { return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)} },
+			func() interface{} { return false },
+			"",
+		},
+		{
+			"pass - approved",
+			func() []interface{} {
+				s.network.App.GetErc721Keeper().SetApprovalForAll(
+					s.network.GetContext(), s.precompile.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), true,
+				)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func() interface{} { return true },
+			"",
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestSupportsInterface() {
+	s.runQueryTestCases(erc721.SupportsInterfaceMethod, []queryTestCase{
+		{
+			"pass - ERC-165",
+			func() []interface{} { return []interface{}{[4]byte{0x01, 0xff, 0xc9, 0xa7}} },
+			func() interface{} { return true },
+			"",
+		},
+		{
+			"pass - ERC-721",
+			func() []interface{} { return []interface{}{[4]byte{0x80, 0xac, 0x58, 0xcd}} },
+			func() interface{} { return true },
+			"",
+		},
+		{
+			"pass - ERC-721 metadata",
+			func() []interface{} { return []interface{}{[4]byte{0x5b, 0x5e, 0x13, 0x9f}} },
+			func() interface{} { return true },
+			"",
+		},
+		{
+			"pass - unsupported interface",
+			func() []interface{} { return []interface{}{[4]byte{0xff, 0xff, 0xff, 0xff}} },
+			func() interface{} { return false },
+			"",
+		},
+	})
+}
@@ -0,0 +1,85 @@
+package erc721
+
+import (
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/precompiles/erc721"
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	erc721types "github.com/cosmos/evm/x/erc721/types"
+
+	"cosmossdk.io/x/nft"
+)
+
+const (
+	// classID is the x/nft class represented by the ERC-721 precompile.
+	classID = "xmplnft"
+	// tokenURI is the uri of the tokens minted on the class.
+	tokenURI = "ipfs://xmpl"
+)
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for
+// ERC-721 precompile unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	create      network.CreateEvmApp
+	options     []network.ConfigOption
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	precompile *erc721.Precompile
+}
+
+func NewPrecompileTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *PrecompileTestSuite {
+	return &PrecompileTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(3)
+
+	// Create the class with the tokens 1 and 2 owned by the first account and
+	// register it with an ERC-721 precompile on genesis, so that the
+	// precompile is also available to the EVM transactions.
+	pair := erc721types.NewTokenPairForClass(classID)
+	erc721Genesis := erc721types.DefaultGenesisState()
+	erc721Genesis.TokenPairs = []erc721types.TokenPair{pair}
+	nftGenesis := &nft.GenesisState{
+		Classes: []*nft.Class{{Id: classID, Name: "Example NFT", Symbol: "XNFT"}},
+		Entries: []*nft.Entry{{
+			Owner: keyring.GetAccAddr(0).String(),
+			Nfts: []*nft.NFT{
+				{ClassId: classID, Id: "1", Uri: tokenURI},
+				{ClassId: classID, Id: "2", Uri: tokenURI},
+			},
+		}},
+	}
+
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(network.CustomGenesisState{
+			nft.ModuleName:         nftGenesis,
+			erc721types.ModuleName: erc721Genesis,
+		}),
+	}
+	options = append(options, s.options...)
+	integrationNetwork := network.NewUnitTestNetwork(s.create, options...)
+	grpcHandler := grpc.NewIntegrationHandler(integrationNetwork)
+	txFactory := factory.New(integrationNetwork, grpcHandler)
+
+	s.factory = txFactory
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+	s.network = integrationNetwork
+
+	precompile, err := erc721.NewPrecompile(pair, integrationNetwork.App.GetNFTKeeper(), integrationNetwork.App.GetErc721Keeper())
+	s.Require().NoError(err, "failed to create the ERC-721 precompile")
+	s.precompile = precompile
+}
@@ -0,0 +1,294 @@
+package erc721
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/erc721"
+	"github.com/cosmos/evm/precompiles/testutil"
+)
+
+// txTestCase is a test case for the ERC-721 precompile transactions.
+type txTestCase struct {
+	name string
+	// malleate returns the caller and the arguments of the transaction
+	malleate    func() (common.Address, []interface{})
+	postCheck   func()
+	errContains func() string
+}
+
+// runTxTestCases calls the given transaction method of the precompile for each
+// test case.
+func (s *PrecompileTestSuite) runTxTestCases(methodName string, testCases []txTestCase) {
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			method := s.precompile.Methods[methodName]
+			caller, args := tc.malleate()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), caller, s.precompile.Address(), 0)
+			stateDB := s.network.GetStateDB()
+
+			var err error
+			switch methodName {
+			case erc721.TransferFromMethod:
+				_, err = s.precompile.TransferFrom(ctx, contract, stateDB, &method, args)
+			case erc721.SafeTransferFromMethod, erc721.SafeTransferFromWithDataMethod:
+				// the receivers are EOAs so that the EVM is not used
+				_, err = s.precompile.SafeTransferFrom(ctx, nil, contract, stateDB, &method, args)
+			case erc721.ApproveMethod:
+				_, err = s.precompile.Approve(ctx, contract, stateDB, &method, args)
+			case erc721.SetApprovalForAllMethod:
+				_, err = s.precompile.SetApprovalForAll(ctx, contract, stateDB, &method, args)
+			default:
+				s.FailNow("unexpected method", methodName)
+			}
+
+			if tc.errContains != nil {
+				s.Require().ErrorContains(err, tc.errContains())
+				return
+			}
+			s.Require().NoError(err)
+			tc.postCheck()
+		})
+	}
+}
+
+// ownerOf returns the owner of the given token of the class.
+func (s *PrecompileTestSuite) ownerOf(tokenID string) common.Address {
+	return common.BytesToAddress(s.network.App.GetNFTKeeper().GetOwner(s.network.GetContext(), classID, tokenID))
+}
+
+// transferTestCases returns the test cases shared by the transferFrom and
+// safeTransferFrom methods.
+func (s *PrecompileTestSuite) transferTestCases() []txTestCase {
+	return []txTestCase{
+		{
+			"fail - zero receiver",
+			func() (common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), []interface{}{s.keyring.GetAddr(0), common.Address{}, big.NewInt(1)}
+			},
+			nil,
+			func() string { return fmt.Sprintf(erc721.ErrInvalidReceiver, common.Address{}) },
+		},
+		{
+			"fail - nonexistent token",
+			func() (common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(3)}
+			},
+			nil,
+			func() string { return fmt.Sprintf(erc721.ErrNonexistentToken, big.NewInt(3)) },
+		},
+		{
+			"fail - transfer from incorrect owner",
+			func() (common.Address, []interface{}) {
+				return s.keyring.GetAddr(1), []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2), big.NewInt(1)}
+			},
+			nil,
+			func() string { return fmt.Sprintf(erc721.ErrIncorrectOwner, s.keyring.GetAddr(1)) },
+		},
+		{
+			"fail - caller not approved",
+			func() (common.Address, []interface{}) {
+				return s.keyring.GetAddr(1), []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(1)}
+			},
+			nil,
+			func() string { return fmt.Sprintf(erc721.ErrInsufficientApproval, s.keyring.GetAddr(1)) },
+		},
+		{
+			"fail - token approval granted by a previous owner",
+			func() (common.Address, []interface{}) {
+				s.network.App.GetErc721Keeper().SetTokenApproval(
+					s.network.GetContext(), s.precompile.Address(), big.NewInt(1), s.keyring.GetAddr(2), s.keyring.GetAddr(1),
+				)
+				return s.keyring.GetAddr(1), []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(1)}
+			},
+			nil,
+			func() string { return fmt.Sprintf(erc721.ErrInsufficientApproval, s.keyring.GetAddr(1)) },
+		},
+		{
+			"pass - owner",
+			func() (common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(1)}
+			},
+			func() {
+				s.Require().Equal(s.keyring.GetAddr(1), s.ownerOf("1"))
+				s.Require().Equal(s.keyring.GetAddr(0), s.ownerOf("2"))
+			},
+			nil,
+		},
+		{
+			"pass - approved spender",
+			func() (common.Address, []interface{}) {
+				s.network.App.GetErc721Keeper().SetTokenApproval(
+					s.network.GetContext(), s.precompile.Address(), big.NewInt(1), s.keyring.GetAddr(0), s.keyring.GetAddr(1),
+				)
+				return s.keyring.GetAddr(1), []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(2), big.NewInt(1)}
+			},
+			func() {
+				s.Require().Equal(s.keyring.GetAddr(2), s.ownerOf("1"))
+
+				approved := s.network.App.GetErc721Keeper().GetTokenApproval(
+					s.network.GetContext(), s.precompile.Address(), big.NewInt(1), s.keyring.GetAddr(2),
+				)
+				s.Require().Equal(common.Address{}, approved, "expected the approval to be cleared")
+			},
+			nil,
+		},
+		{
+			"pass - operator",
+			func() (common.Address, []interface{}) {
+				s.network.App.GetErc721Keeper().SetApprovalForAll(
+					s.network.GetContext(), s.precompile.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), true,
+				)
+				return s.keyring.GetAddr(1), []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(2), big.NewInt(2)}
+			},
+			func() {
+				s.Require().Equal(s.keyring.GetAddr(2), s.ownerOf("2"))
+			},
+			nil,
+		},
+	}
+}
+
+func (s *PrecompileTestSuite) TestTransferFrom() {
+	s.runTxTestCases(erc721.TransferFromMethod, s.transferTestCases())
+}
+
+func (s *PrecompileTestSuite) TestSafeTransferFrom() {
+	s.runTxTestCases(erc721.SafeTransferFromMethod, s.transferTestCases())
+
+	testCases := s.transferTestCases()
+	for i, tc := range testCases {
+		malleate := tc.malleate
+		testCases[i].malleate = func() (common.Address, []interface{}) {
+			caller, args := malleate()
+			return caller, append(args, []byte("data"))
+		}
+	}
+	s.runTxTestCases(erc721.SafeTransferFromWithDataMethod, testCases)
+}
+
+func (s *PrecompileTestSuite) TestApprove() {
+	s.runTxTestCases(erc721.ApproveMethod, []txTestCase{
+		{
+			"fail - nonexistent token",
+			func() (common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), []interface{}{s.keyring.GetAddr(1), big.NewInt(3)}
+			},
+			nil,
+			func() string { return fmt.Sprintf(erc721.ErrNonexistentToken, big.NewInt(3)) },
+		},
+		{
+			"fail - approval to current owner",
+			func() (common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), []interface{}{s.keyring.GetAddr(0), big.NewInt(1)}
+			},
+			nil,
+			func() string { return erc721.ErrApprovalToCurrentOwner.Error() },
+		},
+		{
+			"fail - caller not owner nor operator",
+			func() (common.Address, []interface{}) {
+				return s.keyring.GetAddr(1), []interface{}{s.keyring.GetAddr(1), big.NewInt(1)}
+			},
+			nil,
+			func() string { return fmt.Sprintf(erc721.ErrInsufficientApproval, s.keyring.GetAddr(1)) },
+		},
+		{
+			"pass - owner",
+			func() (common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), []interface{}{s.keyring.GetAddr(1), big.NewInt(1)}
+			},
+			func() {
+				approved := s.network.App.GetErc721Keeper().GetTokenApproval(
+					s.network.GetContext(), s.precompile.Address(), big.NewInt(1), s.keyring.GetAddr(0),
+				)
+				s.Require().Equal(s.keyring.GetAddr(1), approved)
+			},
+			nil,
+		},
+		{
+			"pass - operator",
+			func() (common.Address, []interface{}) {
+				s.network.App.GetErc721Keeper().SetApprovalForAll(
+					s.network.GetContext(), s.precompile.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), true,
+				)
+				return s.keyring.GetAddr(1), []interface{}{s.keyring.GetAddr(2), big.NewInt(1)}
+			},
+			func() {
+				approved := s.network.App.GetErc721Keeper().GetTokenApproval(
+					s.network.GetContext(), s.precompile.Address(), big.NewInt(1), s.keyring.GetAddr(0),
+				)
+				s.Require().Equal(s.keyring.GetAddr(2), approved)
+			},
+			nil,
+		},
+		{
+			"pass - zero address clears the approval",
+			func() (common.Address, []interface{}) {
+				s.network.App.GetErc721Keeper().SetTokenApproval(
+					s.network.GetContext(), s.precompile.Address(), big.NewInt(1), s.keyring.GetAddr(0), s.keyring.GetAddr(1),
+				)
+				return s.keyring.GetAddr(0), []interface{}{common.Address{}, big.NewInt(1)}
+			},
+			func() {
+				approved := s.network.App.GetErc721Keeper().GetTokenApproval(
+					s.network.GetContext(), s.precompile.Address(), big.NewInt(1), s.keyring.GetAddr(0),
+				)
+				s.Require().Equal(common.Address{}, approved)
+			},
+			nil,
+		},
+	})
+}
+
+func (s *PrecompileTestSuite) TestSetApprovalForAll() {
+	s.runTxTestCases(erc721.SetApprovalForAllMethod, []txTestCase{
+		{
+			"fail - caller as operator",
+			func() (common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), []interface{}{s.keyring.GetAddr(0), true}
+			},
+			nil,
+			func() string { return fmt.Sprintf(erc721.ErrInvalidOperator, s.keyring.GetAddr(0)) },
+		},
+		{
+			"fail - zero operator",
+			func() (common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), []interface{}{common.Address{}, true}
+			},
+			nil,
+			func() string { return fmt.Sprintf(erc721.ErrInvalidOperator, common.Address{}) },
+		},
+		{
+			"pass - approve",
+			func() (common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), []interface{}{s.keyring.GetAddr(1), true}
+			},
+			func() {
+				s.Require().True(s.network.App.GetErc721Keeper().IsApprovedForAll(
+					s.network.GetContext(), s.precompile.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1),
+				))
+			},
+			nil,
+		},
+		{
+			"pass - revoke",
+			func() (common.Address, []interface{}) {
+				s.network.App.GetErc721Keeper().SetApprovalForAll(
+					s.network.GetContext(), s.precompile.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), true,
+				)
+				return s.keyring.GetAddr(0), []interface{}{s.keyring.GetAddr(1), false}
+			},
+			func() {
+				s.Require().False(s.network.App.GetErc721Keeper().IsApprovedForAll(
+					s.network.GetContext(), s.precompile.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1),
+				))
+			},
+			nil,
+		},
+	})
+}
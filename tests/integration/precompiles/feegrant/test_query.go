@@ -0,0 +1,62 @@
+package feegrant
+
+import (
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+
+	s.SetupTest()
+	s.grantBasicAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+	bz, err := s.precompile.Allowance(ctx, &method, contract, []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)})
+	s.Require().NoError(err)
+
+	var out feegrant.AllowanceOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz))
+	s.Require().Equal(s.keyring.GetAddr(0), out.Allowance.Granter)
+	s.Require().Equal(s.keyring.GetAddr(1), out.Allowance.Grantee)
+	s.Require().Equal("/cosmos.feegrant.v1beta1.BasicAllowance", out.Allowance.AllowanceType)
+	s.Require().Empty(out.Allowance.SpendLimit)
+	s.Require().Zero(out.Allowance.Expiration)
+
+	_, err = s.precompile.Allowance(ctx, &method, contract, []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(0)})
+	s.Require().Error(err)
+}
+
+func (s *PrecompileTestSuite) TestAllowancesAndAllowancesByGranter() {
+	s.SetupTest()
+	s.grantBasicAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+	s.grantBasicAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(2))
+	s.grantBasicAllowance(s.keyring.GetAddr(2), s.keyring.GetAddr(1))
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	method := s.precompile.Methods[feegrant.AllowancesMethod]
+	bz, err := s.precompile.Allowances(ctx, &method, contract, []interface{}{s.keyring.GetAddr(1), query.PageRequest{CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out feegrant.AllowancesOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, bz))
+	s.Require().Len(out.Allowances, 2)
+	s.Require().Equal(uint64(2), out.PageResponse.Total)
+	for _, allowance := range out.Allowances {
+		s.Require().Equal(s.keyring.GetAddr(1), allowance.Grantee)
+	}
+
+	method = s.precompile.Methods[feegrant.AllowancesByGranterMethod]
+	bz, err = s.precompile.AllowancesByGranter(ctx, &method, contract, []interface{}{s.keyring.GetAddr(0), query.PageRequest{}})
+	s.Require().NoError(err)
+
+	out = feegrant.AllowancesOutput{}
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesByGranterMethod, bz))
+	s.Require().Len(out.Allowances, 2)
+	for _, allowance := range out.Allowances {
+		s.Require().Equal(s.keyring.GetAddr(0), allowance.Granter)
+	}
+}
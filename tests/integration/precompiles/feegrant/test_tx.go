@@ -0,0 +1,225 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	feegranttypes "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var sendMsgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func (s *PrecompileTestSuite) TestGrantBasicAllowance() {
+	var (
+		stDB   *statedb.StateDB
+		method = s.precompile.Methods[feegrant.GrantBasicAllowanceMethod]
+	)
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{utiltx.GenerateAddress(), s.keyring.GetAddr(1), s.spendLimit(1000), int64(0)}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - granter is grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(0), s.spendLimit(1000), int64(0)}
+			},
+			func() {},
+			true,
+			"cannot self-grant fee authorization",
+		},
+		{
+			"fail - allowance already exists",
+			func() []interface{} {
+				s.grantBasicAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), s.spendLimit(1000), int64(0)}
+			},
+			func() {},
+			true,
+			"fee allowance already exists",
+		},
+		{
+			"success - basic allowance granted",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), s.spendLimit(1000), int64(0)}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(
+					s.network.GetContext(), s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1),
+				)
+				s.Require().NoError(err)
+				basic, ok := allowance.(*feegranttypes.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 1000)), basic.SpendLimit)
+				s.Require().Nil(basic.Expiration)
+
+				s.Require().Len(stDB.Logs(), 1)
+				var event feegrant.EventGrantAllowance
+				err = cmn.UnpackLog(s.precompile.ABI, &event, feegrant.EventTypeGrantAllowance, *stDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), event.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), event.Grantee)
+				s.Require().Equal("/cosmos.feegrant.v1beta1.BasicAllowance", event.AllowanceType)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			stDB = s.network.GetStateDB()
+			res, err := s.precompile.GrantBasicAllowance(ctx, contract, stDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantPeriodicAllowance() {
+	method := s.precompile.Methods[feegrant.GrantPeriodicAllowanceMethod]
+
+	s.SetupTest()
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	// the period spend limit must be in the denominations of the spend limit
+	_, err := s.precompile.GrantPeriodicAllowance(ctx, contract, s.network.GetStateDB(), &method, []interface{}{
+		s.keyring.GetAddr(0), s.keyring.GetAddr(1), s.spendLimit(1000), int64(0), int64(3600), []cmn.Coin{{Denom: "other", Amount: big.NewInt(100)}},
+	})
+	s.Require().ErrorContains(err, "period spend limit has different currency than basic spend limit")
+
+	res, err := s.precompile.GrantPeriodicAllowance(ctx, contract, s.network.GetStateDB(), &method, []interface{}{
+		s.keyring.GetAddr(0), s.keyring.GetAddr(1), s.spendLimit(1000), int64(0), int64(3600), s.spendLimit(100),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(cmn.TrueValue, res)
+
+	allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(
+		s.network.GetContext(), s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1),
+	)
+	s.Require().NoError(err)
+	periodic, ok := allowance.(*feegranttypes.PeriodicAllowance)
+	s.Require().True(ok)
+	s.Require().Equal(time.Hour, periodic.Period)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 100)), periodic.PeriodSpendLimit)
+	s.Require().Equal(ctx.BlockTime().Add(time.Hour), periodic.PeriodReset)
+}
+
+func (s *PrecompileTestSuite) TestGrantAllowedMsgAllowance() {
+	method := s.precompile.Methods[feegrant.GrantAllowedMsgAllowanceMethod]
+
+	s.SetupTest()
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	stDB := s.network.GetStateDB()
+	res, err := s.precompile.GrantAllowedMsgAllowance(ctx, contract, stDB, &method, []interface{}{
+		s.keyring.GetAddr(0), s.keyring.GetAddr(1), s.spendLimit(1000), int64(0), int64(0), []cmn.Coin{}, []string{sendMsgTypeURL},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(cmn.TrueValue, res)
+
+	allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(
+		s.network.GetContext(), s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1),
+	)
+	s.Require().NoError(err)
+	allowedMsg, ok := allowance.(*feegranttypes.AllowedMsgAllowance)
+	s.Require().True(ok)
+	s.Require().Equal([]string{sendMsgTypeURL}, allowedMsg.AllowedMessages)
+
+	s.Require().Len(stDB.Logs(), 1)
+	var event feegrant.EventGrantAllowance
+	err = cmn.UnpackLog(s.precompile.ABI, &event, feegrant.EventTypeGrantAllowance, *stDB.Logs()[0])
+	s.Require().NoError(err)
+	s.Require().Equal("/cosmos.feegrant.v1beta1.AllowedMsgAllowance", event.AllowanceType)
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+
+	s.SetupTest()
+	s.grantBasicAllowance(s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(1), s.precompile.Address(), 200000)
+	_, err := s.precompile.RevokeAllowance(ctx, contract, s.network.GetStateDB(), &method, []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)})
+	s.Require().ErrorContains(err, "does not match the requester address")
+
+	stDB := s.network.GetStateDB()
+	contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+	res, err := s.precompile.RevokeAllowance(ctx, contract, stDB, &method, []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)})
+	s.Require().NoError(err)
+	s.Require().Equal(cmn.TrueValue, res)
+
+	_, err = s.network.App.GetFeeGrantKeeper().GetAllowance(
+		s.network.GetContext(), s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1),
+	)
+	s.Require().ErrorContains(err, "fee-grant not found")
+
+	s.Require().Len(stDB.Logs(), 1)
+	var event feegrant.EventRevokeAllowance
+	err = cmn.UnpackLog(s.precompile.ABI, &event, feegrant.EventTypeRevokeAllowance, *stDB.Logs()[0])
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(0), event.Granter)
+	s.Require().Equal(s.keyring.GetAddr(1), event.Grantee)
+
+	_, err = s.precompile.RevokeAllowance(ctx, contract, s.network.GetStateDB(), &method, []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)})
+	s.Require().ErrorContains(err, "fee-grant not found")
+}
+
+// grantBasicAllowance grants a basic allowance without limit from the granter to the grantee.
+func (s *PrecompileTestSuite) grantBasicAllowance(granter, grantee common.Address) {
+	err := s.network.App.GetFeeGrantKeeper().GrantAllowance(
+		s.network.GetContext(),
+		granter.Bytes(),
+		grantee.Bytes(),
+		&feegranttypes.BasicAllowance{},
+	)
+	s.Require().NoError(err)
+}
+
+// spendLimit returns the given amount of the base denom as a Coin array.
+func (s *PrecompileTestSuite) spendLimit(amount int64) []cmn.Coin {
+	return []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(amount)}}
+}
@@ -0,0 +1,127 @@
+package group
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/precompiles/group"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	grouptypes "github.com/cosmos/cosmos-sdk/x/group"
+)
+
+func (s *PrecompileTestSuite) TestGetGroupInfo() {
+	s.SetupTest()
+	groupID, _ := s.createGroupWithPolicy()
+
+	method := s.precompile.Methods[group.GetGroupInfoMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	bz, err := s.precompile.GetGroupInfo(ctx, &method, contract, []interface{}{groupID})
+	s.Require().NoError(err)
+
+	var out group.GroupInfoOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, group.GetGroupInfoMethod, bz))
+	s.Require().Equal(groupID, out.GroupInfo.Id)
+	s.Require().Equal(s.keyring.GetAccAddr(0).String(), out.GroupInfo.Admin)
+	s.Require().Equal("group metadata", out.GroupInfo.Metadata)
+	s.Require().Equal("2", out.GroupInfo.TotalWeight)
+	s.Require().Equal(uint64(ctx.BlockTime().Unix()), out.GroupInfo.CreatedAt) //nolint:gosec // G115
+
+	_, err = s.precompile.GetGroupInfo(ctx, &method, contract, []interface{}{groupID + 1})
+	s.Require().Error(err)
+}
+
+func (s *PrecompileTestSuite) TestGetGroupMembers() {
+	s.SetupTest()
+	groupID, _ := s.createGroupWithPolicy()
+
+	method := s.precompile.Methods[group.GetGroupMembersMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	bz, err := s.precompile.GetGroupMembers(ctx, &method, contract, []interface{}{groupID, query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out group.GroupMembersOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, group.GetGroupMembersMethod, bz))
+	s.Require().Len(out.Members, 1)
+	s.Require().Equal(uint64(2), out.PageResponse.Total)
+	s.Require().NotEmpty(out.PageResponse.NextKey)
+	s.Require().Equal(groupID, out.Members[0].GroupId)
+	s.Require().Contains([]interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}, out.Members[0].Member)
+	s.Require().Equal("1", out.Members[0].Weight)
+}
+
+func (s *PrecompileTestSuite) TestGetGroupPolicyInfo() {
+	s.SetupTest()
+	groupID, policyAddr := s.createGroupWithPolicy()
+
+	method := s.precompile.Methods[group.GetGroupPolicyInfoMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	bz, err := s.precompile.GetGroupPolicyInfo(ctx, &method, contract, []interface{}{policyAddr})
+	s.Require().NoError(err)
+
+	var out group.GroupPolicyInfoOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, group.GetGroupPolicyInfoMethod, bz))
+	s.Require().Equal(policyAddr, out.GroupPolicyInfo.GroupPolicy)
+	s.Require().Equal(groupID, out.GroupPolicyInfo.GroupId)
+	s.Require().Equal(s.keyring.GetAccAddr(0).String(), out.GroupPolicyInfo.Admin)
+	s.Require().Equal("policy metadata", out.GroupPolicyInfo.Metadata)
+	s.Require().Equal(defaultDecisionPolicy, out.GroupPolicyInfo.DecisionPolicy)
+
+	_, err = s.precompile.GetGroupPolicyInfo(ctx, &method, contract, []interface{}{""})
+	s.Require().ErrorContains(err, fmt.Sprintf(group.ErrInvalidGroupPolicy, ""))
+}
+
+func (s *PrecompileTestSuite) TestGetProposalAndVotes() {
+	s.SetupTest()
+	_, policyAddr := s.createGroupWithPolicy()
+	proposalID := s.submitProposal(0, policyAddr, s.keyring.GetAccAddr(2), 1)
+
+	voteMethod := s.precompile.Methods[group.VoteMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(1), s.precompile.Address(), 200000)
+	_, err := s.precompile.Vote(ctx, contract, s.network.GetStateDB(), &voteMethod, []interface{}{s.keyring.GetAddr(1), proposalID, uint8(grouptypes.VOTE_OPTION_NO), "vote metadata", uint8(grouptypes.Exec_EXEC_UNSPECIFIED)})
+	s.Require().NoError(err)
+
+	// proposal
+	method := s.precompile.Methods[group.GetProposalMethod]
+	bz, err := s.precompile.GetProposal(ctx, &method, contract, []interface{}{proposalID})
+	s.Require().NoError(err)
+
+	var proposalOut group.ProposalOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&proposalOut, group.GetProposalMethod, bz))
+	s.Require().Equal(proposalID, proposalOut.Proposal.Id)
+	s.Require().Equal(policyAddr, proposalOut.Proposal.GroupPolicy)
+	s.Require().Equal(uint8(grouptypes.PROPOSAL_STATUS_SUBMITTED), proposalOut.Proposal.Status)
+	s.Require().Equal(uint8(grouptypes.PROPOSAL_EXECUTOR_RESULT_NOT_RUN), proposalOut.Proposal.ExecutorResult)
+	s.Require().Len(proposalOut.Proposal.Proposers, 1)
+	s.Require().Equal(s.keyring.GetAddr(0), proposalOut.Proposal.Proposers[0])
+	s.Require().Equal([]string{"/cosmos.bank.v1beta1.MsgSend"}, proposalOut.Proposal.Messages)
+	s.Require().Equal("send", proposalOut.Proposal.Title)
+	s.Require().Equal("send funds", proposalOut.Proposal.Summary)
+
+	// tally
+	method = s.precompile.Methods[group.GetTallyResultMethod]
+	bz, err = s.precompile.GetTallyResult(ctx, &method, contract, []interface{}{proposalID})
+	s.Require().NoError(err)
+
+	var tallyOut group.TallyResultOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&tallyOut, group.GetTallyResultMethod, bz))
+	s.Require().Equal(group.TallyResultData{Yes: "0", Abstain: "0", No: "1", NoWithVeto: "0"}, tallyOut.TallyResult)
+
+	// vote
+	method = s.precompile.Methods[group.GetVoteMethod]
+	bz, err = s.precompile.GetVote(ctx, &method, contract, []interface{}{proposalID, s.keyring.GetAddr(1)})
+	s.Require().NoError(err)
+
+	var voteOut group.VoteOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&voteOut, group.GetVoteMethod, bz))
+	s.Require().Equal(proposalID, voteOut.Vote.ProposalId)
+	s.Require().Equal(s.keyring.GetAddr(1), voteOut.Vote.Voter)
+	s.Require().Equal(uint8(grouptypes.VOTE_OPTION_NO), voteOut.Vote.Option)
+	s.Require().Equal("vote metadata", voteOut.Vote.Metadata)
+
+	_, err = s.precompile.GetVote(ctx, &method, contract, []interface{}{proposalID, s.keyring.GetAddr(2)})
+	s.Require().Error(err)
+}
@@ -0,0 +1,334 @@
+package group
+
+import (
+	"fmt"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/group"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	grouptypes "github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// defaultDecisionPolicy is a threshold decision policy of one vote, with a one hour
+// voting period and no minimum execution period.
+var defaultDecisionPolicy = group.DecisionPolicy{
+	PolicyType:   group.DecisionPolicyTypeThreshold,
+	Value:        "1",
+	VotingPeriod: 3600,
+}
+
+func (s *PrecompileTestSuite) TestCreateGroup() {
+	var (
+		stDB   *statedb.StateDB
+		method = s.precompile.Methods[group.CreateGroupMethod]
+	)
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(res []byte)
+		expError    bool
+		errContains func() string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			func() string { return fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0) },
+		},
+		{
+			"fail - admin is not the caller",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.members(), ""}
+			},
+			func([]byte) {},
+			true,
+			// the keyring is created by SetupTest, so the addresses are read
+			// once the case is set up
+			func() string {
+				return fmt.Sprintf(cmn.ErrRequesterIsNotMsgSender, s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+			},
+		},
+		{
+			"fail - invalid member weight",
+			func() []interface{} {
+				members := []group.MemberRequest{{Member: s.keyring.GetAddr(0), Weight: "-1"}}
+				return []interface{}{s.keyring.GetAddr(0), members, ""}
+			},
+			func([]byte) {},
+			true,
+			func() string { return "weight" },
+		},
+		{
+			"success - create group",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.members(), "metadata"}
+			},
+			func(res []byte) {
+				var groupID uint64
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&groupID, group.CreateGroupMethod, res))
+				s.Require().Equal(uint64(1), groupID)
+
+				info, err := s.network.App.GetGroupKeeper().GroupInfo(s.network.GetContext(), &grouptypes.QueryGroupInfoRequest{GroupId: groupID})
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAccAddr(0).String(), info.Info.Admin)
+				s.Require().Equal("2", info.Info.TotalWeight)
+
+				s.Require().Len(stDB.Logs(), 1)
+				var event group.EventCreateGroup
+				err = cmn.UnpackLog(s.precompile.ABI, &event, group.EventTypeCreateGroup, *stDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), event.Admin)
+				s.Require().Equal(groupID, event.GroupId)
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			stDB = s.network.GetStateDB()
+			res, err := s.precompile.CreateGroup(ctx, contract, stDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains())
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(res)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestUpdateGroupMembers() {
+	s.SetupTest()
+	groupID, _ := s.createGroupWithPolicy()
+
+	method := s.precompile.Methods[group.UpdateGroupMembersMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	// remove the second member
+	updates := []group.MemberRequest{{Member: s.keyring.GetAddr(1), Weight: "0"}}
+	stDB := s.network.GetStateDB()
+	_, err := s.precompile.UpdateGroupMembers(ctx, contract, stDB, &method, []interface{}{s.keyring.GetAddr(0), groupID, updates})
+	s.Require().NoError(err)
+
+	info, err := s.network.App.GetGroupKeeper().GroupInfo(ctx, &grouptypes.QueryGroupInfoRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Equal("1", info.Info.TotalWeight)
+
+	s.Require().Len(stDB.Logs(), 1)
+	var event group.EventUpdateGroupMembers
+	err = cmn.UnpackLog(s.precompile.ABI, &event, group.EventTypeUpdateGroupMembers, *stDB.Logs()[0])
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(0), event.Admin)
+	s.Require().Equal(groupID, event.GroupId)
+}
+
+func (s *PrecompileTestSuite) TestCreateGroupPolicy() {
+	s.SetupTest()
+	groupID, _ := s.createGroupWithPolicy()
+
+	method := s.precompile.Methods[group.CreateGroupPolicyMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	invalidPolicy := defaultDecisionPolicy
+	invalidPolicy.PolicyType = 3
+	_, err := s.precompile.CreateGroupPolicy(ctx, contract, s.network.GetStateDB(), &method, []interface{}{s.keyring.GetAddr(0), groupID, "", invalidPolicy})
+	s.Require().ErrorContains(err, fmt.Sprintf(group.ErrInvalidDecisionPolicy, 3))
+
+	percentagePolicy := group.DecisionPolicy{
+		PolicyType:   group.DecisionPolicyTypePercentage,
+		Value:        "0.5",
+		VotingPeriod: 3600,
+	}
+	stDB := s.network.GetStateDB()
+	res, err := s.precompile.CreateGroupPolicy(ctx, contract, stDB, &method, []interface{}{s.keyring.GetAddr(0), groupID, "", percentagePolicy})
+	s.Require().NoError(err)
+
+	var policyAddr string
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&policyAddr, group.CreateGroupPolicyMethod, res))
+
+	s.Require().Len(stDB.Logs(), 1)
+	var event group.EventCreateGroupPolicy
+	err = cmn.UnpackLog(s.precompile.ABI, &event, group.EventTypeCreateGroupPolicy, *stDB.Logs()[0])
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(0), event.Admin)
+	s.Require().Equal(groupID, event.GroupId)
+	s.Require().Equal(policyAddr, event.GroupPolicy)
+}
+
+func (s *PrecompileTestSuite) TestProposalLifecycle() {
+	s.SetupTest()
+	_, policyAddr := s.createGroupWithPolicy()
+
+	// fund the group policy account
+	amount := int64(1000)
+	policyAccAddr := sdk.MustAccAddressFromBech32(policyAddr)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), amount))
+	err := s.network.App.GetBankKeeper().SendCoins(s.network.GetContext(), s.keyring.GetAccAddr(0), policyAccAddr, coins)
+	s.Require().NoError(err)
+
+	recipient := s.keyring.GetAccAddr(2)
+	prevBalance := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), recipient, s.network.GetBaseDenom())
+
+	// submit a proposal to send the funds of the group policy
+	proposalID := s.submitProposal(0, policyAddr, recipient, amount)
+
+	// the proposal is not run before it is accepted
+	execMethod := s.precompile.Methods[group.ExecMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+	res, err := s.precompile.Exec(ctx, contract, s.network.GetStateDB(), &execMethod, []interface{}{s.keyring.GetAddr(0), proposalID})
+	s.Require().NoError(err)
+
+	var result uint8
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&result, group.ExecMethod, res))
+	s.Require().Equal(uint8(grouptypes.PROPOSAL_EXECUTOR_RESULT_NOT_RUN), result)
+
+	// vote with the second member
+	voteMethod := s.precompile.Methods[group.VoteMethod]
+	contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(1), s.precompile.Address(), 200000)
+
+	_, err = s.precompile.Vote(ctx, contract, s.network.GetStateDB(), &voteMethod, []interface{}{s.keyring.GetAddr(0), proposalID, uint8(grouptypes.VOTE_OPTION_YES), "", uint8(grouptypes.Exec_EXEC_UNSPECIFIED)})
+	s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrRequesterIsNotMsgSender, s.keyring.GetAddr(1), s.keyring.GetAddr(0)))
+
+	stDB := s.network.GetStateDB()
+	_, err = s.precompile.Vote(ctx, contract, stDB, &voteMethod, []interface{}{s.keyring.GetAddr(1), proposalID, uint8(grouptypes.VOTE_OPTION_YES), "", uint8(grouptypes.Exec_EXEC_UNSPECIFIED)})
+	s.Require().NoError(err)
+
+	s.Require().Len(stDB.Logs(), 1)
+	var voteEvent group.EventVote
+	err = cmn.UnpackLog(s.precompile.ABI, &voteEvent, group.EventTypeVote, *stDB.Logs()[0])
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(1), voteEvent.Voter)
+	s.Require().Equal(proposalID, voteEvent.ProposalId)
+	s.Require().Equal(uint8(grouptypes.VOTE_OPTION_YES), voteEvent.Option)
+
+	// execute the accepted proposal
+	contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+	stDB = s.network.GetStateDB()
+	res, err = s.precompile.Exec(ctx, contract, stDB, &execMethod, []interface{}{s.keyring.GetAddr(0), proposalID})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&result, group.ExecMethod, res))
+	s.Require().Equal(uint8(grouptypes.PROPOSAL_EXECUTOR_RESULT_SUCCESS), result)
+
+	s.Require().Len(stDB.Logs(), 1)
+	var execEvent group.EventExec
+	err = cmn.UnpackLog(s.precompile.ABI, &execEvent, group.EventTypeExec, *stDB.Logs()[0])
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(0), execEvent.Executor)
+	s.Require().Equal(proposalID, execEvent.ProposalId)
+	s.Require().Equal(result, execEvent.Result)
+
+	balance := s.network.App.GetBankKeeper().GetBalance(ctx, recipient, s.network.GetBaseDenom())
+	s.Require().Equal(prevBalance.Amount.AddRaw(amount), balance.Amount)
+}
+
+func (s *PrecompileTestSuite) TestWithdrawProposal() {
+	s.SetupTest()
+	_, policyAddr := s.createGroupWithPolicy()
+	proposalID := s.submitProposal(0, policyAddr, s.keyring.GetAccAddr(2), 1)
+
+	method := s.precompile.Methods[group.WithdrawProposalMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	stDB := s.network.GetStateDB()
+	_, err := s.precompile.WithdrawProposal(ctx, contract, stDB, &method, []interface{}{s.keyring.GetAddr(0), proposalID})
+	s.Require().NoError(err)
+
+	proposal, err := s.network.App.GetGroupKeeper().Proposal(ctx, &grouptypes.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(grouptypes.PROPOSAL_STATUS_WITHDRAWN, proposal.Proposal.Status)
+
+	s.Require().Len(stDB.Logs(), 1)
+	var event group.EventWithdrawProposal
+	err = cmn.UnpackLog(s.precompile.ABI, &event, group.EventTypeWithdrawProposal, *stDB.Logs()[0])
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(0), event.Proposer)
+	s.Require().Equal(proposalID, event.ProposalId)
+}
+
+// members returns the member requests of a group made of the first two keyring accounts.
+func (s *PrecompileTestSuite) members() []group.MemberRequest {
+	return []group.MemberRequest{
+		{Member: s.keyring.GetAddr(0), Weight: "1"},
+		{Member: s.keyring.GetAddr(1), Weight: "1"},
+	}
+}
+
+// createGroupWithPolicy creates a group of the first two keyring accounts, administered by
+// the first one, with a threshold group policy of one vote.
+func (s *PrecompileTestSuite) createGroupWithPolicy() (uint64, string) {
+	method := s.precompile.Methods[group.CreateGroupWithPolicyMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	stDB := s.network.GetStateDB()
+	res, err := s.precompile.CreateGroupWithPolicy(ctx, contract, stDB, &method, []interface{}{
+		s.keyring.GetAddr(0), s.members(), "group metadata", "policy metadata", false, defaultDecisionPolicy,
+	})
+	s.Require().NoError(err)
+
+	out, err := method.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	s.Require().Len(out, 2)
+	groupID, ok := out[0].(uint64)
+	s.Require().True(ok)
+	policyAddr, ok := out[1].(string)
+	s.Require().True(ok)
+
+	// a group and a group policy creation events are emitted
+	s.Require().Len(stDB.Logs(), 2)
+	var event group.EventCreateGroupPolicy
+	err = cmn.UnpackLog(s.precompile.ABI, &event, group.EventTypeCreateGroupPolicy, *stDB.Logs()[1])
+	s.Require().NoError(err)
+	s.Require().Equal(policyAddr, event.GroupPolicy)
+
+	return groupID, policyAddr
+}
+
+// submitProposal submits a group proposal from the keyring account at the given index,
+// to send the given amount of the base denom from the group policy to the recipient.
+func (s *PrecompileTestSuite) submitProposal(proposer int, policyAddr string, recipient sdk.AccAddress, amount int64) uint64 {
+	method := s.precompile.Methods[group.SubmitProposalMethod]
+	proposerAddr := s.keyring.GetAddr(proposer)
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), proposerAddr, s.precompile.Address(), 200000)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: policyAddr,
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), amount)),
+	}
+	msgJSON, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+	s.Require().NoError(err)
+	proposal := fmt.Sprintf(`{"messages":[%s],"metadata":"","title":"send","summary":"send funds"}`, msgJSON)
+
+	stDB := s.network.GetStateDB()
+	res, err := s.precompile.SubmitProposal(ctx, contract, stDB, &method, []interface{}{
+		proposerAddr, policyAddr, []byte(proposal), uint8(grouptypes.Exec_EXEC_UNSPECIFIED),
+	})
+	s.Require().NoError(err)
+
+	var proposalID uint64
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&proposalID, group.SubmitProposalMethod, res))
+
+	s.Require().Len(stDB.Logs(), 1)
+	var event group.EventSubmitProposal
+	err = cmn.UnpackLog(s.precompile.ABI, &event, group.EventTypeSubmitProposal, *stDB.Logs()[0])
+	s.Require().NoError(err)
+	s.Require().Equal(proposerAddr, event.Proposer)
+	s.Require().Equal(proposalID, event.ProposalId)
+
+	return proposalID
+}
@@ -701,3 +701,185 @@ func (s *PrecompileTestSuite) TestRedelegations() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestDelegatorDelegations() {
+	method := s.precompile.Methods[staking.DelegatorDelegationsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(out staking.DelegationsOutput)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(staking.DelegationsOutput) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - empty delegator address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			func(staking.DelegationsOutput) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidDelegator, common.Address{}),
+		},
+		{
+			"success - no delegations",
+			func() []interface{} {
+				addr, _ := testutiltx.NewAddrKey()
+				return []interface{}{addr, query.PageRequest{CountTotal: true}}
+			},
+			func(out staking.DelegationsOutput) {
+				s.Require().Empty(out.Delegations)
+				s.Require().Equal(uint64(0), out.PageResponse.Total)
+			},
+			false,
+			"",
+		},
+		{
+			"success - paginated delegations",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			func(out staking.DelegationsOutput) {
+				s.Require().Len(out.Delegations, 1)
+				s.Require().Equal(uint64(len(s.network.GetValidators())), out.PageResponse.Total)
+				s.Require().NotEmpty(out.PageResponse.NextKey)
+				s.Require().Equal(s.keyring.GetAccAddr(0).String(), out.Delegations[0].DelegatorAddress)
+				s.Require().Equal(s.bondDenom, out.Delegations[0].Balance.Denom)
+				s.Require().Equal(big.NewInt(1e18), out.Delegations[0].Balance.Amount)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			contract := vm.NewContract(s.keyring.GetAddr(0), s.precompile.Address(), uint256.NewInt(0), 100000, nil)
+
+			bz, err := s.precompile.DelegatorDelegations(s.network.GetContext(), &method, contract, tc.malleate())
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var out staking.DelegationsOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, staking.DelegatorDelegationsMethod, bz))
+			tc.postCheck(out)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDelegatorUnbondingDelegations() {
+	method := s.precompile.Methods[staking.DelegatorUnbondingDelegationsMethod]
+
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	contract := vm.NewContract(s.keyring.GetAddr(0), s.precompile.Address(), uint256.NewInt(0), 100000, nil)
+
+	// undelegate from the first two validators
+	for _, validator := range s.network.GetValidators()[:2] {
+		valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+		s.Require().NoError(err)
+		_, _, err = s.network.App.GetStakingKeeper().Undelegate(ctx, s.keyring.GetAccAddr(0), valAddr, math.LegacyNewDec(1))
+		s.Require().NoError(err)
+	}
+
+	bz, err := s.precompile.DelegatorUnbondingDelegations(ctx, &method, contract, []interface{}{s.keyring.GetAddr(0), query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out staking.UnbondingDelegationsOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, staking.DelegatorUnbondingDelegationsMethod, bz))
+	s.Require().Len(out.UnbondingDelegations, 1)
+	s.Require().Equal(uint64(2), out.PageResponse.Total)
+	s.Require().NotEmpty(out.PageResponse.NextKey)
+	s.Require().Equal(s.keyring.GetAccAddr(0).String(), out.UnbondingDelegations[0].DelegatorAddress)
+	s.Require().Len(out.UnbondingDelegations[0].Entries, 1)
+	s.Require().Equal(ctx.BlockHeight(), out.UnbondingDelegations[0].Entries[0].CreationHeight)
+	s.Require().Equal(big.NewInt(1e18), out.UnbondingDelegations[0].Entries[0].Balance)
+
+	// next page
+	bz, err = s.precompile.DelegatorUnbondingDelegations(ctx, &method, contract, []interface{}{s.keyring.GetAddr(0), query.PageRequest{Key: out.PageResponse.NextKey}})
+	s.Require().NoError(err)
+
+	var next staking.UnbondingDelegationsOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&next, staking.DelegatorUnbondingDelegationsMethod, bz))
+	s.Require().Len(next.UnbondingDelegations, 1)
+	s.Require().Empty(next.PageResponse.NextKey)
+	s.Require().NotEqual(out.UnbondingDelegations[0].ValidatorAddress, next.UnbondingDelegations[0].ValidatorAddress)
+}
+
+func (s *PrecompileTestSuite) TestValidatorDelegations() {
+	method := s.precompile.Methods[staking.ValidatorDelegationsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(operatorAddress string) []interface{}
+		postCheck   func(out staking.DelegationsOutput)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(string) []interface{} {
+				return []interface{}{}
+			},
+			func(staking.DelegationsOutput) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid validator address",
+			func(string) []interface{} {
+				return []interface{}{"invalid", query.PageRequest{}}
+			},
+			func(staking.DelegationsOutput) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidValidator, "invalid"),
+		},
+		{
+			"success - paginated delegations",
+			func(operatorAddress string) []interface{} {
+				return []interface{}{operatorAddress, query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			func(out staking.DelegationsOutput) {
+				// only the first account delegates at genesis
+				s.Require().Len(out.Delegations, 1)
+				s.Require().Equal(uint64(1), out.PageResponse.Total)
+				s.Require().Empty(out.PageResponse.NextKey)
+				s.Require().Equal(s.keyring.GetAccAddr(0).String(), out.Delegations[0].DelegatorAddress)
+				s.Require().Equal(s.network.GetValidators()[0].OperatorAddress, out.Delegations[0].ValidatorAddress)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			contract := vm.NewContract(s.keyring.GetAddr(0), s.precompile.Address(), uint256.NewInt(0), 100000, nil)
+
+			bz, err := s.precompile.ValidatorDelegations(s.network.GetContext(), &method, contract, tc.malleate(s.network.GetValidators()[0].OperatorAddress))
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var out staking.DelegationsOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, staking.ValidatorDelegationsMethod, bz))
+			tc.postCheck(out)
+		})
+	}
+}
@@ -55,11 +55,31 @@ func (s *PrecompileTestSuite) TestIsTransaction() {
 			s.precompile.Methods[staking.CancelUnbondingDelegationMethod],
 			true,
 		},
+		{
+			staking.DelegateBatchMethod,
+			s.precompile.Methods[staking.DelegateBatchMethod],
+			true,
+		},
+		{
+			staking.UndelegateBatchMethod,
+			s.precompile.Methods[staking.UndelegateBatchMethod],
+			true,
+		},
+		{
+			staking.ClaimRewardsBatchMethod,
+			s.precompile.Methods[staking.ClaimRewardsBatchMethod],
+			true,
+		},
 		{
 			staking.DelegationMethod,
 			s.precompile.Methods[staking.DelegationMethod],
 			false,
 		},
+		{
+			staking.DelegatorDelegationsMethod,
+			s.precompile.Methods[staking.DelegatorDelegationsMethod],
+			false,
+		},
 		{
 			"invalid",
 			abi.Method{},
@@ -2,6 +2,7 @@ package staking
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -19,6 +20,8 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 func (s *PrecompileTestSuite) TestCreateValidator() {
@@ -1322,3 +1325,320 @@ func (s *PrecompileTestSuite) TestCancelUnbondingDelegation() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestDelegateBatch() {
+	var (
+		ctx  sdk.Context
+		stDB *statedb.StateDB
+	)
+	method := s.precompile.Methods[staking.DelegateBatchMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(delegator testkeyring.Key, operatorAddresses []string) []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(testkeyring.Key, []string) []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - different origin than delegator",
+			func(_ testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{
+					cosmosevmutiltx.GenerateAddress(),
+					[]staking.ValidatorAmount{{ValidatorAddress: operatorAddresses[0], Amount: big.NewInt(1e18)}},
+				}
+			},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - empty batch",
+			func(delegator testkeyring.Key, _ []string) []interface{} {
+				return []interface{}{delegator.Addr, []staking.ValidatorAmount{}}
+			},
+			true,
+			staking.ErrEmptyBatch,
+		},
+		{
+			"fail - invalid validator address in batch",
+			func(delegator testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{
+					delegator.Addr,
+					[]staking.ValidatorAmount{
+						{ValidatorAddress: operatorAddresses[0], Amount: big.NewInt(1e18)},
+						{ValidatorAddress: "invalid", Amount: big.NewInt(1e18)},
+					},
+				}
+			},
+			true,
+			"batch entry for validator invalid failed",
+		},
+		{
+			"success - delegate to multiple validators",
+			func(delegator testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{
+					delegator.Addr,
+					[]staking.ValidatorAmount{
+						{ValidatorAddress: operatorAddresses[0], Amount: big.NewInt(1e18)},
+						{ValidatorAddress: operatorAddresses[1], Amount: big.NewInt(2e18)},
+					},
+				}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stDB = s.network.GetStateDB()
+
+			delegator := s.keyring.GetKey(0)
+			operatorAddresses := []string{
+				s.network.GetValidators()[0].OperatorAddress,
+				s.network.GetValidators()[1].OperatorAddress,
+			}
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, delegator.Addr, s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.DelegateBatch(ctx, contract, stDB, &method, tc.malleate(delegator, operatorAddresses))
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			for i, operatorAddress := range operatorAddresses {
+				valAddr, valErr := sdk.ValAddressFromBech32(operatorAddress)
+				s.Require().NoError(valErr)
+				validator, valErr := s.network.App.GetStakingKeeper().GetValidator(ctx, valAddr)
+				s.Require().NoError(valErr)
+
+				// each genesis share is backed by 1e18 tokens
+				expShares := math.LegacyNewDec(int64(i + 1))
+				s.Require().Equal(s.network.GetValidators()[i].DelegatorShares.Add(expShares), validator.DelegatorShares)
+			}
+
+			s.Require().NoError(err)
+			success, err := s.precompile.Unpack(staking.DelegateBatchMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, success[0])
+
+			// one Delegate event is emitted per validator
+			logs := stDB.Logs()
+			s.Require().Len(logs, 2)
+			event := s.precompile.Events[staking.EventTypeDelegate]
+			for _, log := range logs {
+				s.Require().Equal(s.precompile.Address(), log.Address)
+				s.Require().Equal(event.ID, log.Topics[0])
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestUndelegateBatch() {
+	method := s.precompile.Methods[staking.UndelegateBatchMethod]
+
+	// errContains returns the expected error for the validators of the network
+	// created by SetupTest
+	testCases := []struct {
+		name        string
+		malleate    func(delegator testkeyring.Key, operatorAddresses []string) []interface{}
+		expError    bool
+		errContains func(operatorAddresses []string) string
+	}{
+		{
+			"fail - invalid delegator address",
+			func(_ testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{
+					common.Address{},
+					[]staking.ValidatorAmount{{ValidatorAddress: operatorAddresses[0], Amount: big.NewInt(1)}},
+				}
+			},
+			true,
+			func([]string) string { return fmt.Sprintf(cmn.ErrInvalidDelegator, common.Address{}) },
+		},
+		{
+			"fail - invalid batch type",
+			func(delegator testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{delegator.Addr, operatorAddresses}
+			},
+			true,
+			func([]string) string { return "invalid type for validatorAmounts" },
+		},
+		{
+			"fail - undelegation exceeds the delegation",
+			func(delegator testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{
+					delegator.Addr,
+					[]staking.ValidatorAmount{
+						{ValidatorAddress: operatorAddresses[0], Amount: big.NewInt(1e18)},
+						{ValidatorAddress: operatorAddresses[1], Amount: big.NewInt(3e18)},
+					},
+				}
+			},
+			true,
+			func(operatorAddresses []string) string {
+				return fmt.Errorf(staking.ErrBatchEntryFailed, operatorAddresses[1], errors.New("invalid shares amount")).Error()
+			},
+		},
+		{
+			"success - undelegate from multiple validators",
+			func(delegator testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{
+					delegator.Addr,
+					[]staking.ValidatorAmount{
+						{ValidatorAddress: operatorAddresses[0], Amount: big.NewInt(1e18)},
+						{ValidatorAddress: operatorAddresses[1], Amount: big.NewInt(1e18)},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			stDB := s.network.GetStateDB()
+
+			delegator := s.keyring.GetKey(0)
+			operatorAddresses := []string{
+				s.network.GetValidators()[0].OperatorAddress,
+				s.network.GetValidators()[1].OperatorAddress,
+			}
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, delegator.Addr, s.precompile.Address(), 200000)
+			bz, err := s.precompile.UndelegateBatch(ctx, contract, stDB, &method, tc.malleate(delegator, operatorAddresses))
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains(operatorAddresses))
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			undelegations, err := s.network.App.GetStakingKeeper().GetAllUnbondingDelegations(ctx, delegator.AccAddr)
+			s.Require().NoError(err)
+			s.Require().Len(undelegations, 2)
+
+			var out []int64
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, staking.UndelegateBatchMethod, bz))
+			params, err := s.network.App.GetStakingKeeper().GetParams(ctx)
+			s.Require().NoError(err)
+			expCompletionTime := ctx.BlockTime().Add(params.UnbondingTime).UTC().Unix()
+			s.Require().Equal([]int64{expCompletionTime, expCompletionTime}, out)
+
+			event := s.precompile.Events[staking.EventTypeUnbond]
+			s.Require().Len(stDB.Logs(), 2)
+			for _, log := range stDB.Logs() {
+				s.Require().Equal(event.ID, log.Topics[0])
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestClaimRewardsBatch() {
+	method := s.precompile.Methods[staking.ClaimRewardsBatchMethod]
+	rewards := math.NewInt(1e18)
+
+	testCases := []struct {
+		name        string
+		malleate    func(delegator testkeyring.Key, operatorAddresses []string) []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(testkeyring.Key, []string) []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - empty batch",
+			func(delegator testkeyring.Key, _ []string) []interface{} {
+				return []interface{}{delegator.Addr, []string{}}
+			},
+			true,
+			staking.ErrEmptyBatch,
+		},
+		{
+			"fail - no delegation to one of the validators",
+			func(delegator testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{delegator.Addr, append(operatorAddresses, sdk.ValAddress(cosmosevmutiltx.GenerateAddress().Bytes()).String())}
+			},
+			true,
+			"batch entry for validator",
+		},
+		{
+			"success - claim rewards from multiple validators",
+			func(delegator testkeyring.Key, operatorAddresses []string) []interface{} {
+				return []interface{}{delegator.Addr, operatorAddresses}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			stDB := s.network.GetStateDB()
+
+			delegator := s.keyring.GetKey(0)
+			validators := s.network.GetValidators()[:2]
+			operatorAddresses := make([]string, len(validators))
+			for i, validator := range validators {
+				operatorAddresses[i] = validator.OperatorAddress
+
+				// fund the distribution module and allocate rewards to the validator
+				coins := sdk.NewCoins(sdk.NewCoin(s.bondDenom, rewards))
+				s.Require().NoError(s.network.App.GetBankKeeper().MintCoins(ctx, minttypes.ModuleName, coins))
+				s.Require().NoError(s.network.App.GetBankKeeper().SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, coins))
+				s.Require().NoError(s.network.App.GetDistrKeeper().AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(coins...)))
+			}
+
+			balanceBefore := s.network.App.GetBankKeeper().GetBalance(ctx, delegator.AccAddr, s.bondDenom)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, delegator.Addr, s.precompile.Address(), 200000)
+			bz, err := s.precompile.ClaimRewardsBatch(ctx, contract, stDB, &method, tc.malleate(delegator, operatorAddresses))
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var out []cmn.Coin
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, staking.ClaimRewardsBatchMethod, bz))
+			s.Require().Len(out, 1)
+			s.Require().Equal(s.bondDenom, out[0].Denom)
+			s.Require().Positive(out[0].Amount.Sign())
+
+			balanceAfter := s.network.App.GetBankKeeper().GetBalance(ctx, delegator.AccAddr, s.bondDenom)
+			s.Require().Equal(balanceBefore.Amount.Add(math.NewIntFromBigInt(out[0].Amount)), balanceAfter.Amount)
+
+			event := s.precompile.Events[staking.EventTypeWithdrawDelegatorReward]
+			s.Require().Len(stDB.Logs(), 2)
+			for _, log := range stDB.Logs() {
+				s.Require().Equal(event.ID, log.Topics[0])
+			}
+		})
+	}
+}
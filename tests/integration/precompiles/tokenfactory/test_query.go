@@ -0,0 +1,75 @@
+package tokenfactory
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/precompiles/tokenfactory"
+	tokenfactorytypes "github.com/cosmos/evm/x/tokenfactory/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *PrecompileTestSuite) TestDenomAdmin() {
+	s.SetupTest()
+	denom := s.createDenom(0, subdenom)
+
+	method := s.precompile.Methods[tokenfactory.DenomAdminMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	bz, err := s.precompile.DenomAdmin(ctx, &method, contract, []interface{}{denom})
+	s.Require().NoError(err)
+
+	var admin common.Address
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&admin, tokenfactory.DenomAdminMethod, bz))
+	s.Require().Equal(s.keyring.GetAddr(0), admin)
+
+	_, err = s.precompile.DenomAdmin(ctx, &method, contract, []interface{}{s.factoryDenom(1, subdenom)})
+	s.Require().Error(err)
+}
+
+func (s *PrecompileTestSuite) TestDenomsFromCreator() {
+	s.SetupTest()
+	s.createDenom(0, "alpha")
+	s.createDenom(0, "beta")
+	s.createDenom(1, "gamma")
+
+	method := s.precompile.Methods[tokenfactory.DenomsFromCreatorMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	bz, err := s.precompile.DenomsFromCreator(ctx, &method, contract, []interface{}{s.keyring.GetAddr(0), query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out tokenfactory.DenomsFromCreatorOutput
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, tokenfactory.DenomsFromCreatorMethod, bz))
+	s.Require().Equal([]string{s.factoryDenom(0, "alpha")}, out.Denoms)
+	s.Require().Equal(uint64(2), out.PageResponse.Total)
+	s.Require().NotEmpty(out.PageResponse.NextKey)
+
+	bz, err = s.precompile.DenomsFromCreator(ctx, &method, contract, []interface{}{s.keyring.GetAddr(0), query.PageRequest{Key: out.PageResponse.NextKey}})
+	s.Require().NoError(err)
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, tokenfactory.DenomsFromCreatorMethod, bz))
+	s.Require().Equal([]string{s.factoryDenom(0, "beta")}, out.Denoms)
+	s.Require().Empty(out.PageResponse.NextKey)
+}
+
+func (s *PrecompileTestSuite) TestDenomCreationFee() {
+	s.SetupTest()
+	fee := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1000)))
+	err := s.network.App.GetTokenFactoryKeeper().SetParams(s.network.GetContext(), tokenfactorytypes.NewParams(fee))
+	s.Require().NoError(err)
+
+	method := s.precompile.Methods[tokenfactory.DenomCreationFeeMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	bz, err := s.precompile.DenomCreationFee(ctx, &method, contract, []interface{}{})
+	s.Require().NoError(err)
+
+	var out []cmn.Coin
+	s.Require().NoError(s.precompile.UnpackIntoInterface(&out, tokenfactory.DenomCreationFeeMethod, bz))
+	s.Require().Equal(cmn.NewCoinsResponse(fee), out)
+}
@@ -0,0 +1,372 @@
+package tokenfactory
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/precompiles/tokenfactory"
+	tokenfactorytypes "github.com/cosmos/evm/x/tokenfactory/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const subdenom = "bitcoin"
+
+func (s *PrecompileTestSuite) TestCreateDenom() {
+	var (
+		stDB          *statedb.StateDB
+		communityPool math.LegacyDec
+		method        = s.precompile.Methods[tokenfactory.CreateDenomMethod]
+	)
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(res []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - creator is not the caller",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), subdenom}
+			},
+			func([]byte) {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - denom already exists",
+			func() []interface{} {
+				s.createDenom(0, subdenom)
+				return []interface{}{s.keyring.GetAddr(0), subdenom}
+			},
+			func([]byte) {},
+			true,
+			tokenfactorytypes.ErrDenomExists.Error(),
+		},
+		{
+			"fail - insufficient funds for the creation fee",
+			func() []interface{} {
+				fee := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewIntWithDecimal(1, 30)))
+				err := s.network.App.GetTokenFactoryKeeper().SetParams(s.network.GetContext(), tokenfactorytypes.NewParams(fee))
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(0), subdenom}
+			},
+			func([]byte) {},
+			true,
+			"failed to pay the denom creation fee",
+		},
+		{
+			"success - create denom",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), subdenom}
+			},
+			func(res []byte) {
+				ctx := s.network.GetContext()
+				expDenom := s.factoryDenom(0, subdenom)
+				expToken := tokenfactorytypes.GetERC20Address(expDenom)
+
+				out, err := s.precompile.Unpack(tokenfactory.CreateDenomMethod, res)
+				s.Require().NoError(err)
+				s.Require().Equal(expDenom, out[0])
+				s.Require().Equal(expToken, out[1])
+
+				authority, found := s.network.App.GetTokenFactoryKeeper().GetAuthorityMetadata(ctx, expDenom)
+				s.Require().True(found)
+				s.Require().Equal(s.keyring.GetAccAddr(0).String(), authority.Admin)
+
+				// the denom is registered as a token pair with an active ERC20 precompile
+				erc20Keeper := s.network.App.GetErc20Keeper()
+				s.Require().True(erc20Keeper.IsDenomRegistered(ctx, expDenom))
+				_, found, err = erc20Keeper.GetERC20PrecompileInstance(ctx, expToken)
+				s.Require().NoError(err)
+				s.Require().True(found)
+
+				s.Require().Len(stDB.Logs(), 1)
+				var event tokenfactory.EventCreateDenom
+				err = cmn.UnpackLog(s.precompile.ABI, &event, tokenfactory.EventTypeCreateDenom, *stDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), event.Creator)
+				s.Require().Equal(expDenom, event.Denom)
+				s.Require().Equal(expToken, event.Token)
+			},
+			false,
+			"",
+		},
+		{
+			"success - create denom with creation fee",
+			func() []interface{} {
+				fee := sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), math.NewInt(1000)))
+				err := s.network.App.GetTokenFactoryKeeper().SetParams(s.network.GetContext(), tokenfactorytypes.NewParams(fee))
+				s.Require().NoError(err)
+
+				pool, err := s.network.App.GetDistrKeeper().FeePool.Get(s.network.GetContext())
+				s.Require().NoError(err)
+				communityPool = pool.CommunityPool.AmountOf(s.network.GetBaseDenom())
+				return []interface{}{s.keyring.GetAddr(0), subdenom}
+			},
+			func([]byte) {
+				pool, err := s.network.App.GetDistrKeeper().FeePool.Get(s.network.GetContext())
+				s.Require().NoError(err)
+				s.Require().Equal(
+					communityPool.Add(math.LegacyNewDec(1000)),
+					pool.CommunityPool.AmountOf(s.network.GetBaseDenom()),
+				)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			stDB = s.network.GetStateDB()
+			res, err := s.precompile.CreateDenom(ctx, contract, stDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(res)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMint() {
+	var (
+		stDB   *statedb.StateDB
+		denom  string
+		method = s.precompile.Methods[tokenfactory.MintMethod]
+	)
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - admin is not the caller",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), denom, s.keyring.GetAddr(1), big.NewInt(100)}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - caller is not the denom admin",
+			func() []interface{} {
+				denom = s.createDenom(1, subdenom)
+				return []interface{}{s.keyring.GetAddr(0), denom, s.keyring.GetAddr(0), big.NewInt(100)}
+			},
+			func() {},
+			true,
+			tokenfactorytypes.ErrUnauthorized.Error(),
+		},
+		{
+			"fail - mint to a module account",
+			func() []interface{} {
+				govAddr := common.BytesToAddress(authtypes.NewModuleAddress(govtypes.ModuleName))
+				return []interface{}{s.keyring.GetAddr(0), denom, govAddr, big.NewInt(100)}
+			},
+			func() {},
+			true,
+			"is not allowed to receive funds",
+		},
+		{
+			"success - mint to another account",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), denom, s.keyring.GetAddr(1), big.NewInt(100)}
+			},
+			func() {
+				balance := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(1), denom)
+				s.Require().Equal(int64(100), balance.Amount.Int64())
+
+				s.Require().Len(stDB.Logs(), 1)
+				var event tokenfactory.EventMint
+				err := cmn.UnpackLog(s.precompile.ABI, &event, tokenfactory.EventTypeMint, *stDB.Logs()[0])
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), event.Admin)
+				s.Require().Equal(s.keyring.GetAddr(1), event.To)
+				s.Require().Equal(denom, event.Denom)
+				s.Require().Equal(big.NewInt(100), event.Amount)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			denom = s.createDenom(0, subdenom)
+
+			args := tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			stDB = s.network.GetStateDB()
+			_, err := s.precompile.Mint(ctx, contract, stDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestBurn() {
+	s.SetupTest()
+	denom := s.createDenom(0, subdenom)
+	s.mint(0, denom, 0, 100)
+
+	method := s.precompile.Methods[tokenfactory.BurnMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	stDB := s.network.GetStateDB()
+	_, err := s.precompile.Burn(ctx, contract, stDB, &method, []interface{}{s.keyring.GetAddr(0), denom, big.NewInt(101)})
+	s.Require().ErrorContains(err, "insufficient funds")
+
+	_, err = s.precompile.Burn(ctx, contract, stDB, &method, []interface{}{s.keyring.GetAddr(0), denom, big.NewInt(40)})
+	s.Require().NoError(err)
+
+	bankKeeper := s.network.App.GetBankKeeper()
+	s.Require().Equal(int64(60), bankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), denom).Amount.Int64())
+	s.Require().Equal(int64(60), bankKeeper.GetSupply(ctx, denom).Amount.Int64())
+
+	s.Require().Len(stDB.Logs(), 1)
+	var event tokenfactory.EventBurn
+	err = cmn.UnpackLog(s.precompile.ABI, &event, tokenfactory.EventTypeBurn, *stDB.Logs()[0])
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(0), event.Admin)
+	s.Require().Equal(denom, event.Denom)
+	s.Require().Equal(big.NewInt(40), event.Amount)
+}
+
+func (s *PrecompileTestSuite) TestChangeAdmin() {
+	s.SetupTest()
+	denom := s.createDenom(0, subdenom)
+
+	method := s.precompile.Methods[tokenfactory.ChangeAdminMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	stDB := s.network.GetStateDB()
+	_, err := s.precompile.ChangeAdmin(ctx, contract, stDB, &method, []interface{}{s.keyring.GetAddr(0), denom, s.keyring.GetAddr(1)})
+	s.Require().NoError(err)
+
+	authority, found := s.network.App.GetTokenFactoryKeeper().GetAuthorityMetadata(ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(s.keyring.GetAccAddr(1).String(), authority.Admin)
+
+	s.Require().Len(stDB.Logs(), 1)
+	var event tokenfactory.EventChangeAdmin
+	err = cmn.UnpackLog(s.precompile.ABI, &event, tokenfactory.EventTypeChangeAdmin, *stDB.Logs()[0])
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(0), event.Admin)
+	s.Require().Equal(s.keyring.GetAddr(1), event.NewAdmin)
+	s.Require().Equal(denom, event.Denom)
+
+	// the previous admin can no longer administer the denom
+	_, err = s.precompile.ChangeAdmin(ctx, contract, stDB, &method, []interface{}{s.keyring.GetAddr(0), denom, s.keyring.GetAddr(0)})
+	s.Require().ErrorContains(err, tokenfactorytypes.ErrUnauthorized.Error())
+
+	// the new admin renounces the admin role
+	contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(1), s.precompile.Address(), 200000)
+	_, err = s.precompile.ChangeAdmin(ctx, contract, stDB, &method, []interface{}{s.keyring.GetAddr(1), denom, common.Address{}})
+	s.Require().NoError(err)
+
+	authority, found = s.network.App.GetTokenFactoryKeeper().GetAuthorityMetadata(ctx, denom)
+	s.Require().True(found)
+	s.Require().Empty(authority.Admin)
+
+	mintMethod := s.precompile.Methods[tokenfactory.MintMethod]
+	_, err = s.precompile.Mint(ctx, contract, stDB, &mintMethod, []interface{}{s.keyring.GetAddr(1), denom, s.keyring.GetAddr(1), big.NewInt(1)})
+	s.Require().ErrorContains(err, tokenfactorytypes.ErrUnauthorized.Error())
+}
+
+func (s *PrecompileTestSuite) TestSetDenomMetadata() {
+	s.SetupTest()
+	denom := s.createDenom(0, subdenom)
+
+	method := s.precompile.Methods[tokenfactory.SetDenomMetadataMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(1), s.precompile.Address(), 200000)
+
+	stDB := s.network.GetStateDB()
+	_, err := s.precompile.SetDenomMetadata(ctx, contract, stDB, &method, []interface{}{s.keyring.GetAddr(1), denom, "Bitcoin", "BTC", uint8(8)})
+	s.Require().ErrorContains(err, tokenfactorytypes.ErrUnauthorized.Error())
+
+	contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+	_, err = s.precompile.SetDenomMetadata(ctx, contract, stDB, &method, []interface{}{s.keyring.GetAddr(0), denom, "Bitcoin", "BTC", uint8(8)})
+	s.Require().NoError(err)
+
+	metadata, found := s.network.App.GetBankKeeper().GetDenomMetaData(ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal("Bitcoin", metadata.Name)
+	s.Require().Equal("BTC", metadata.Symbol)
+	s.Require().Equal("btc", metadata.Display)
+	s.Require().Len(metadata.DenomUnits, 2)
+	s.Require().Equal(uint32(8), metadata.DenomUnits[1].Exponent)
+
+	s.Require().Len(stDB.Logs(), 1)
+	var event tokenfactory.EventSetDenomMetadata
+	err = cmn.UnpackLog(s.precompile.ABI, &event, tokenfactory.EventTypeSetDenomMetadata, *stDB.Logs()[0])
+	s.Require().NoError(err)
+	s.Require().Equal(s.keyring.GetAddr(0), event.Admin)
+	s.Require().Equal(denom, event.Denom)
+	s.Require().Equal("Bitcoin", event.Name)
+	s.Require().Equal("BTC", event.Symbol)
+	s.Require().Equal(uint8(8), event.Decimals)
+}
+
+// factoryDenom returns the factory denom created by the given keyring account.
+func (s *PrecompileTestSuite) factoryDenom(creator int, subdenom string) string {
+	denom, err := tokenfactorytypes.GetTokenDenom(s.keyring.GetAccAddr(creator).String(), subdenom)
+	s.Require().NoError(err)
+	return denom
+}
+
+// createDenom creates a factory denom administered by the given keyring account.
+func (s *PrecompileTestSuite) createDenom(creator int, subdenom string) string {
+	denom, _, err := s.network.App.GetTokenFactoryKeeper().CreateFactoryDenom(s.network.GetContext(), s.keyring.GetAccAddr(creator), subdenom)
+	s.Require().NoError(err)
+	return denom
+}
+
+// mint mints coins of a factory denom from its admin to the given keyring account.
+func (s *PrecompileTestSuite) mint(admin int, denom string, to int, amount int64) {
+	tfKeeper := s.network.App.GetTokenFactoryKeeper()
+	msg := tokenfactorytypes.NewMsgMint(
+		s.keyring.GetAccAddr(admin).String(),
+		sdk.NewCoin(denom, math.NewInt(amount)),
+		s.keyring.GetAccAddr(to).String(),
+	)
+	_, err := tfKeeper.Mint(s.network.GetContext(), msg)
+	s.Require().NoError(err)
+}
@@ -3,6 +3,7 @@ package backend
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -1310,6 +1311,60 @@ func (s *TestSuite) TestHeaderByNumber() {
 	}
 }
 
+func (s *TestSuite) TestBlockTime() {
+	height := int64(1)
+	devBlockTime := time.Unix(5000, 0).UTC()
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expTime      func(resBlock *cmtrpctypes.ResultBlock) time.Time
+	}{
+		{
+			"header time",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockResults(client, height)
+				s.Require().NoError(err)
+			},
+			func(resBlock *cmtrpctypes.ResultBlock) time.Time {
+				return resBlock.Block.Time
+			},
+		},
+		{
+			"dev node block time",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockResultsWithBlockTime(client, height, devBlockTime)
+				s.Require().NoError(err)
+			},
+			func(*cmtrpctypes.ResultBlock) time.Time {
+				return devBlockTime
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+
+			client := s.backend.ClientCtx.Client.(*mocks.Client)
+			resBlock, err := RegisterBlock(client, height, nil)
+			s.Require().NoError(err)
+			tc.registerMock()
+			RegisterBaseFee(s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient), math.NewInt(1))
+			expTime := uint64(tc.expTime(resBlock).Unix()) //nolint:gosec // G115 // won't exceed uint64
+
+			header, err := s.backend.HeaderByNumber(ethrpc.BlockNumber(height))
+			s.Require().NoError(err)
+			s.Require().Equal(expTime, header.Time)
+
+			block, err := s.backend.EthBlockByNumber(ethrpc.BlockNumber(height))
+			s.Require().NoError(err)
+			s.Require().Equal(expTime, block.Time())
+		})
+	}
+}
+
 func (s *TestSuite) TestHeaderByHash() {
 	var expResultHeader *cmtrpctypes.ResultHeader
 
@@ -16,6 +16,7 @@ import (
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/testutil/constants"
 	utiltx "github.com/cosmos/evm/testutil/tx"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/math"
@@ -365,7 +366,9 @@ func (s *TestSuite) TestSendRawTransaction() {
 				txBytes, _ := s.backend.ClientCtx.TxConfig.TxEncoder()(cosmosTx)
 
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				s.backend.AllowUnprotectedTxs = true
+				RegisterAccountFeeDenom(feeMarketClient, 1, ethTx.From, nil)
 				RegisterBroadcastTxError(client, txBytes)
 			},
 			func() []byte {
@@ -383,7 +386,27 @@ func (s *TestSuite) TestSendRawTransaction() {
 				txBytes, _ := s.backend.ClientCtx.TxConfig.TxEncoder()(cosmosTx)
 
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				s.backend.AllowUnprotectedTxs = true
+				RegisterAccountFeeDenom(feeMarketClient, 1, ethTx.From, nil)
+				RegisterBroadcastTx(client, txBytes)
+			},
+			func() []byte { return rlpEncodedBz },
+			common.HexToHash(ethTx.Hash),
+			"",
+			true,
+		},
+		{
+			"pass - Gets the transaction hash of an eth transaction paying the fees in the fee denom of the sender",
+			func() {
+				feeDenom := feemarkettypes.NewFeeDenom("uusdc", math.LegacyNewDecWithPrec(5, 1))
+				cosmosTx, _ := ethTx.BuildTxWithFeeDenom(s.backend.ClientCtx.TxConfig.NewTxBuilder(), evmDenom, &feeDenom)
+				txBytes, _ := s.backend.ClientCtx.TxConfig.TxEncoder()(cosmosTx)
+
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				s.backend.AllowUnprotectedTxs = true
+				RegisterAccountFeeDenom(feeMarketClient, 1, ethTx.From, &feeDenom)
 				RegisterBroadcastTx(client, txBytes)
 			},
 			func() []byte { return rlpEncodedBz },
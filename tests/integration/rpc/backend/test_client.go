@@ -3,6 +3,7 @@ package backend
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/mock"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	rpc "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/dev"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -223,6 +225,27 @@ func RegisterBlockResults(
 	return res, nil
 }
 
+// RegisterBlockResultsWithBlockTime registers the block results of a dev node
+// block whose time was changed.
+func RegisterBlockResultsWithBlockTime(client *mocks.Client, height int64, blockTime time.Time) (*cmtrpctypes.ResultBlockResults, error) {
+	res := &cmtrpctypes.ResultBlockResults{
+		Height:     height,
+		TxsResults: []*abci.ExecTxResult{{Code: 0, GasUsed: 0}},
+		FinalizeBlockEvents: []abci.Event{{
+			Type: dev.EventTypeBlockTime,
+			Attributes: []abci.EventAttribute{{
+				Key:   dev.AttributeKeyTimestamp,
+				Value: blockTime.Format(time.RFC3339Nano),
+				Index: true,
+			}},
+		}},
+	}
+
+	client.On("BlockResults", rpc.ContextWithHeight(height), mock.AnythingOfType("*int64")).
+		Return(res, nil)
+	return res, nil
+}
+
 func RegisterBlockResultsError(client *mocks.Client, height int64) {
 	client.On("BlockResults", rpc.ContextWithHeight(height), mock.AnythingOfType("*int64")).
 		Return(nil, errortypes.ErrInvalidRequest)
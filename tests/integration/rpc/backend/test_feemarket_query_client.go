@@ -5,6 +5,7 @@ import (
 	rpc "github.com/cosmos/evm/rpc/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -20,3 +21,9 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// AccountFeeDenom
+func RegisterAccountFeeDenom(feeMarketClient *mocks.FeeMarketQueryClient, height int64, addr sdk.AccAddress, feeDenom *feemarkettypes.FeeDenom) {
+	feeMarketClient.On("AccountFeeDenom", rpc.ContextWithHeight(height), &feemarkettypes.QueryAccountFeeDenomRequest{Address: addr.String()}).
+		Return(&feemarkettypes.QueryAccountFeeDenomResponse{FeeDenom: feeDenom}, nil)
+}
@@ -3,6 +3,8 @@ package backend
 import (
 	"fmt"
 	"math/big"
+	"os"
+	"path/filepath"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -12,6 +14,7 @@ import (
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/keystore"
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	"github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/testutil/constants"
@@ -320,6 +323,8 @@ func (s *TestSuite) TestImportRawKey() {
 	priv, _ := ethsecp256k1.GenerateKey()
 	privHex := common.Bytes2Hex(priv.Bytes())
 	pubAddr := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	keyJSON, err := keystore.Encrypt(priv, "password", keystore.KDFPBKDF2, true)
+	s.Require().NoError(err)
 
 	testCases := []struct {
 		name         string
@@ -345,6 +350,22 @@ func (s *TestSuite) TestImportRawKey() {
 			pubAddr,
 			true,
 		},
+		{
+			"fail - keystore with wrong password",
+			func() {},
+			string(keyJSON),
+			"wrong",
+			common.Address{},
+			false,
+		},
+		{
+			"pass - keystore returning correct address",
+			func() {},
+			string(keyJSON),
+			"password",
+			pubAddr,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -362,3 +383,26 @@ func (s *TestSuite) TestImportRawKey() {
 		})
 	}
 }
+
+func (s *TestSuite) TestImportRawKeyKeystoreDir() {
+	s.SetupTest()
+	s.backend.Cfg.JSONRPC.KeystoreDir = s.T().TempDir()
+
+	priv, _ := ethsecp256k1.GenerateKey()
+	pubAddr := common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	addr, err := s.backend.ImportRawKey(common.Bytes2Hex(priv.Bytes()), "password")
+	s.Require().NoError(err)
+	s.Require().Equal(pubAddr, addr)
+
+	files, err := os.ReadDir(s.backend.Cfg.JSONRPC.KeystoreDir)
+	s.Require().NoError(err)
+	s.Require().Len(files, 1)
+
+	keyJSON, err := os.ReadFile(filepath.Join(s.backend.Cfg.JSONRPC.KeystoreDir, files[0].Name()))
+	s.Require().NoError(err)
+	decrypted, decryptedAddr, err := keystore.Decrypt(keyJSON, "password")
+	s.Require().NoError(err)
+	s.Require().True(priv.Equals(decrypted))
+	s.Require().Equal(pubAddr, decryptedAddr)
+}
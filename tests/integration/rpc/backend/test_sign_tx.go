@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	utiltx "github.com/cosmos/evm/testutil/tx"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/math"
@@ -100,7 +101,7 @@ func (s *TestSuite) TestSendTransaction() {
 		{
 			"fail - Cannot broadcast transaction",
 			func() {
-				client, txBytes := broadcastTx(s, priv, baseFee, callArgsDefault)
+				client, txBytes := broadcastTx(s, priv, baseFee, callArgsDefault, nil)
 				RegisterBroadcastTxError(client, txBytes)
 			},
 			callArgsDefault,
@@ -110,7 +111,18 @@ func (s *TestSuite) TestSendTransaction() {
 		{
 			"pass - Return the transaction hash",
 			func() {
-				client, txBytes := broadcastTx(s, priv, baseFee, callArgsDefault)
+				client, txBytes := broadcastTx(s, priv, baseFee, callArgsDefault, nil)
+				RegisterBroadcastTx(client, txBytes)
+			},
+			callArgsDefault,
+			hash,
+			true,
+		},
+		{
+			"pass - Return the transaction hash of a tx paying the fees in the fee denom of the sender",
+			func() {
+				feeDenom := feemarkettypes.NewFeeDenom("uusdc", math.LegacyNewDecWithPrec(5, 1))
+				client, txBytes := broadcastTx(s, priv, baseFee, callArgsDefault, &feeDenom)
 				RegisterBroadcastTx(client, txBytes)
 			},
 			callArgsDefault,
@@ -241,9 +253,10 @@ func (s *TestSuite) TestSignTypedData() {
 	}
 }
 
-func broadcastTx(suite *TestSuite, priv *ethsecp256k1.PrivKey, baseFee math.Int, callArgsDefault evmtypes.TransactionArgs) (client *mocks.Client, txBytes []byte) {
+func broadcastTx(suite *TestSuite, priv *ethsecp256k1.PrivKey, baseFee math.Int, callArgsDefault evmtypes.TransactionArgs, feeDenom *feemarkettypes.FeeDenom) (client *mocks.Client, txBytes []byte) {
 	var header metadata.MD
 	QueryClient := suite.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+	feeMarketClient := suite.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 	client = suite.backend.ClientCtx.Client.(*mocks.Client)
 	armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
 	_ = suite.backend.ClientCtx.Keyring.ImportPrivKey("test_key", armor, "")
@@ -257,8 +270,9 @@ func broadcastTx(suite *TestSuite, priv *ethsecp256k1.PrivKey, baseFee math.Int,
 	msg := callArgsDefault.ToTransaction()
 	err = msg.Sign(ethSigner, suite.backend.ClientCtx.Keyring)
 	suite.Require().NoError(err)
+	RegisterAccountFeeDenom(feeMarketClient, 1, msg.From, feeDenom)
 	baseDenom := evmtypes.GetEVMCoinDenom()
-	tx, _ := msg.BuildTx(suite.backend.ClientCtx.TxConfig.NewTxBuilder(), baseDenom)
+	tx, _ := msg.BuildTxWithFeeDenom(suite.backend.ClientCtx.TxConfig.NewTxBuilder(), baseDenom, feeDenom)
 	txEncoder := suite.backend.ClientCtx.TxConfig.TxEncoder()
 	txBytes, _ = txEncoder(tx)
 	return client, txBytes
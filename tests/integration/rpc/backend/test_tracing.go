@@ -119,6 +119,8 @@ func (s *TestSuite) TestTraceTransaction() {
 				s.Require().NoError(err)
 				RegisterTraceTransactionWithPredecessors(QueryClient, msgEthereumTx, []*evmtypes.MsgEthereumTx{msgEthereumTx})
 				RegisterConsensusParams(client, height)
+				_, err = RegisterBlockResults(client, height)
+				s.Require().NoError(err)
 			},
 			&types.Block{Header: types.Header{Height: 1, ChainID: ChainID.ChainID}, Data: types.Data{Txs: []types.Tx{txBz, txBz2}}},
 			[]*abci.ExecTxResult{
@@ -164,6 +166,8 @@ func (s *TestSuite) TestTraceTransaction() {
 				s.Require().NoError(err)
 				RegisterTraceTransaction(QueryClient, msgEthereumTx)
 				RegisterConsensusParams(client, height)
+				_, err = RegisterBlockResults(client, height)
+				s.Require().NoError(err)
 			},
 			&types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}},
 			[]*abci.ExecTxResult{
@@ -240,6 +244,8 @@ func (s *TestSuite) TestTraceBlock() {
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				RegisterTraceBlock(QueryClient, []*evmtypes.MsgEthereumTx{msgEthTx})
 				RegisterConsensusParams(client, 1)
+				_, err := RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
 			},
 			[]*evmtypes.TxTraceResult{},
 			&resBlockFilled,
@@ -0,0 +1,140 @@
+package erc20
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/erc20/keeper"
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setupConvertedERC20Pair registers an external ERC20 token pair and converts
+// part of the minted ERC20 tokens into coins.
+func (s *KeeperTestSuite) setupConvertedERC20Pair(mint, convert int64) common.Address {
+	contractAddr, err := s.setupRegisterERC20Pair(contractMinterBurner)
+	s.Require().NoError(err)
+
+	_, err = s.MintERC20Token(contractAddr, s.keyring.GetAddr(0), big.NewInt(mint))
+	s.Require().NoError(err)
+
+	msg := types.NewMsgConvertERC20(math.NewInt(convert), s.keyring.GetAccAddr(0), contractAddr, s.keyring.GetAddr(0))
+	_, err = s.network.App.GetErc20Keeper().ConvertERC20(s.network.GetContext(), msg)
+	s.Require().NoError(err)
+
+	return contractAddr
+}
+
+func (s *KeeperTestSuite) TestEndBlock() {
+	var contractAddr common.Address
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expEnabled bool
+	}{
+		{
+			"pass - supply in parity",
+			func() {},
+			true,
+		},
+		{
+			"pass - tokens sent to the module account",
+			func() {
+				_, err := s.network.App.GetEVMKeeper().CallEVM(
+					s.network.GetContext(), contracts.ERC20MinterBurnerDecimalsContract.ABI,
+					s.keyring.GetAddr(0), contractAddr, true, nil,
+					"transfer", types.ModuleAddress, big.NewInt(10),
+				)
+				s.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"pass - disabled on coins not backed by escrowed tokens",
+			func() {
+				ctx := s.network.GetContext()
+				coins := sdk.NewCoins(sdk.NewInt64Coin(types.CreateDenom(contractAddr.String()), 10))
+				err := s.network.App.GetBankKeeper().MintCoins(ctx, types.ModuleName, coins)
+				s.Require().NoError(err)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contractAddr = s.setupConvertedERC20Pair(100, 10)
+			tc.malleate()
+
+			ctx := s.network.GetContext()
+			err := s.network.App.GetErc20Keeper().EndBlock(ctx)
+			s.Require().NoError(err)
+
+			id := s.network.App.GetErc20Keeper().GetTokenPairID(ctx, contractAddr.String())
+			pair, found := s.network.App.GetErc20Keeper().GetTokenPair(ctx, id)
+			s.Require().True(found)
+			s.Require().Equal(tc.expEnabled, pair.Enabled)
+
+			var emitted bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeSupplyParityBroken {
+					emitted = true
+				}
+			}
+			s.Require().Equal(!tc.expEnabled, emitted)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEndBlockRoundRobin() {
+	s.SetupTest()
+	contractAddr := s.setupConvertedERC20Pair(100, 10)
+	ctx := s.network.GetContext()
+	erc20Keeper := s.network.App.GetErc20Keeper()
+
+	// register more pairs than the checks of a single block. Their contracts
+	// don't exist, so the supply can't be queried and they are kept enabled.
+	fakePairs := make([]types.TokenPair, keeper.MaxSupplyChecksPerBlock)
+	for i := range fakePairs {
+		fakePairs[i] = types.NewTokenPair(utiltx.GenerateAddress(), fmt.Sprintf("fake%d", i), types.OWNER_EXTERNAL)
+		erc20Keeper.SetToken(ctx, fakePairs[i])
+	}
+
+	// break the parity of the converted pair
+	coins := sdk.NewCoins(sdk.NewInt64Coin(types.CreateDenom(contractAddr.String()), 10))
+	s.Require().NoError(s.network.App.GetBankKeeper().MintCoins(ctx, types.ModuleName, coins))
+
+	// every pair is checked within two blocks
+	for range 2 {
+		s.Require().NoError(erc20Keeper.EndBlock(ctx))
+	}
+
+	id := erc20Keeper.GetTokenPairID(ctx, contractAddr.String())
+	pair, found := erc20Keeper.GetTokenPair(ctx, id)
+	s.Require().True(found)
+	s.Require().False(pair.Enabled, "expected the broken pair to be disabled")
+
+	for _, fakePair := range fakePairs {
+		pair, found := erc20Keeper.GetTokenPair(ctx, fakePair.GetID())
+		s.Require().True(found)
+		s.Require().True(pair.Enabled)
+	}
+
+	var broken int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSupplyParityBroken {
+			broken++
+		}
+	}
+	s.Require().Equal(1, broken)
+}
@@ -3,11 +3,15 @@ package erc20
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/cosmos/evm/testutil/config"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	utiltx "github.com/cosmos/evm/testutil/tx"
 	"github.com/cosmos/evm/x/erc20/types"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
@@ -171,6 +175,73 @@ func (s *KeeperTestSuite) TestTokenPair() {
 	}
 }
 
+func (s *KeeperTestSuite) TestTokenPairSupply() {
+	var (
+		ctx          sdk.Context
+		contractAddr common.Address
+		req          *types.QueryTokenPairSupplyRequest
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expPass     bool
+		expERC20Amt int64
+		expCoinAmt  int64
+	}{
+		{
+			"fail - token pair not found",
+			func() {
+				req = &types.QueryTokenPairSupplyRequest{Token: utiltx.GenerateAddress().Hex()}
+			},
+			false,
+			0,
+			0,
+		},
+		{
+			"pass - supply in parity",
+			func() {
+				req = &types.QueryTokenPairSupplyRequest{Token: contractAddr.Hex()}
+			},
+			true,
+			10,
+			10,
+		},
+		{
+			"pass - coins not backed by escrowed tokens",
+			func() {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(types.CreateDenom(contractAddr.String()), 5))
+				err := s.network.App.GetBankKeeper().MintCoins(ctx, types.ModuleName, coins)
+				s.Require().NoError(err)
+				req = &types.QueryTokenPairSupplyRequest{Token: types.CreateDenom(contractAddr.String())}
+			},
+			true,
+			10,
+			15,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			s.SetupTest() // reset
+			contractAddr = s.setupConvertedERC20Pair(100, 10)
+			ctx = s.network.GetContext()
+
+			tc.malleate()
+
+			res, err := s.network.App.GetErc20Keeper().TokenPairSupply(ctx, req)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(contractAddr.Hex(), res.TokenPair.Erc20Address)
+				s.Require().Equal(math.NewInt(tc.expERC20Amt).String(), res.Erc20Amount.String())
+				s.Require().Equal(math.NewInt(tc.expCoinAmt).String(), res.CoinAmount.String())
+				s.Require().Equal(math.NewInt(tc.expERC20Amt-tc.expCoinAmt).String(), res.Delta.String())
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryParams() {
 	s.SetupTest()
 	ctx := s.network.GetContext()
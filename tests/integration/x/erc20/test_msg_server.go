@@ -556,6 +556,8 @@ func (s *KeeperTestSuite) TestConvertNativeERC20ToEVMERC20() {
 				mockBankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("failed to unescrow")).AnyTimes()
 				mockBankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false).AnyTimes()
 				mockBankKeeper.EXPECT().GetBalance(gomock.Any(), gomock.Any(), gomock.Any()).Return(sdk.Coin{Denom: "coin", Amount: math.OneInt()}).AnyTimes()
+				// supply parity checks of the end blocker
+				mockBankKeeper.EXPECT().GetSupply(gomock.Any(), gomock.Any()).Return(sdk.Coin{Denom: "coin", Amount: math.ZeroInt()}).AnyTimes()
 			},
 			contractMinterBurner,
 			false,
@@ -581,6 +583,8 @@ func (s *KeeperTestSuite) TestConvertNativeERC20ToEVMERC20() {
 				mockBankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				mockBankKeeper.EXPECT().BurnCoins(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("failed to burn")).AnyTimes()
 				mockBankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false)
+				// supply parity checks of the end blocker
+				mockBankKeeper.EXPECT().GetSupply(gomock.Any(), gomock.Any()).Return(sdk.Coin{Denom: "coin", Amount: math.ZeroInt()}).AnyTimes()
 			},
 			contractMinterBurner,
 			false,
@@ -2,6 +2,7 @@ package erc20
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/mock"
@@ -328,3 +329,302 @@ func (s *KeeperTestSuite) TestToggleConverision() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateCoinMetadata() {
+	var (
+		contractAddr common.Address
+		metadata     banktypes.Metadata
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		errContains string
+	}{
+		{
+			"fail - token pair not registered",
+			func() {
+				metadata.Base = cosmosTokenBase
+			},
+			types.ErrTokenPairNotFound.Error(),
+		},
+		{
+			"fail - invalid metadata",
+			func() {
+				metadata.Display = "unknown"
+			},
+			"invalid metadata",
+		},
+		{
+			"pass",
+			func() {},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			var err error
+			contractAddr, err = s.setupRegisterERC20Pair(contractMinterBurner)
+			s.Require().NoError(err)
+
+			ctx := s.network.GetContext()
+			var found bool
+			metadata, found = s.network.App.GetBankKeeper().GetDenomMetaData(ctx, types.CreateDenom(contractAddr.String()))
+			s.Require().True(found)
+			metadata.Name = "Updated Coin Token"
+			metadata.Description = "updated description"
+
+			tc.malleate()
+
+			_, err = s.network.App.GetErc20Keeper().UpdateCoinMetadata(ctx, &types.MsgUpdateCoinMetadata{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Metadata:  metadata,
+			})
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			updated, found := s.network.App.GetBankKeeper().GetDenomMetaData(ctx, metadata.Base)
+			s.Require().True(found)
+			s.Require().Equal(metadata, updated)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMigrateTokenPair() {
+	var (
+		ctx     sdk.Context
+		oldPair types.TokenPair
+		token   string
+		newAddr common.Address
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		errContains string
+	}{
+		{
+			"fail - token pair not registered",
+			func() {
+				token = cosmosTokenBase
+			},
+			types.ErrTokenPairNotFound.Error(),
+		},
+		{
+			"fail - external ERC20 token pair",
+			func() {
+				contractAddr, err := s.setupRegisterERC20Pair(contractMinterBurner)
+				s.Require().NoError(err)
+				ctx = s.network.GetContext()
+				token = contractAddr.Hex()
+			},
+			"only native coin token pairs can be migrated",
+		},
+		{
+			"fail - new address already registered",
+			func() {
+				contractAddr, err := s.setupRegisterERC20Pair(contractMinterBurner)
+				s.Require().NoError(err)
+				ctx = s.network.GetContext()
+				newAddr = contractAddr
+			},
+			types.ErrTokenPairAlreadyExists.Error(),
+		},
+		{
+			"fail - new address is a static precompile",
+			func() {
+				newAddr = common.BytesToAddress([]byte{0x01})
+			},
+			"is a static precompile",
+		},
+		{
+			"fail - new address has code",
+			func() {
+				contractAddr, err := s.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				s.Require().NoError(err)
+				s.Require().NoError(s.network.NextBlock())
+				ctx = s.network.GetContext()
+				newAddr = contractAddr
+			},
+			"already has code",
+		},
+		{
+			"pass",
+			func() {},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			erc20Keeper := s.network.App.GetErc20Keeper()
+
+			id := erc20Keeper.GetTokenPairID(ctx, s.network.GetBaseDenom())
+			var found bool
+			oldPair, found = erc20Keeper.GetTokenPair(ctx, id)
+			s.Require().True(found)
+			oldAddr := oldPair.GetERC20Contract()
+			token = oldPair.Denom
+			newAddr = common.HexToAddress("0x205CF44075E77A3543abC690437F3b2819bc450a")
+
+			owner, spender := s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+			s.Require().NoError(erc20Keeper.UnsafeSetAllowance(ctx, oldAddr, owner, spender, big.NewInt(100)))
+
+			tc.malleate()
+
+			_, err := erc20Keeper.MigrateTokenPair(ctx, &types.MsgMigrateTokenPair{
+				Authority:       authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Token:           token,
+				NewErc20Address: newAddr.Hex(),
+			})
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the coin is paired with the new precompile
+			id = erc20Keeper.GetTokenPairID(ctx, oldPair.Denom)
+			newPair, found := erc20Keeper.GetTokenPair(ctx, id)
+			s.Require().True(found)
+			s.Require().Equal(newAddr, newPair.GetERC20Contract())
+			s.Require().Equal(oldPair.Enabled, newPair.Enabled)
+			s.Require().True(newPair.IsNativeCoin())
+			s.Require().False(erc20Keeper.IsERC20Registered(ctx, oldAddr))
+
+			// the precompile params point to the new address
+			params := erc20Keeper.GetParams(ctx)
+			s.Require().True(params.IsNativePrecompile(newAddr))
+			s.Require().False(params.IsNativePrecompile(oldAddr))
+
+			_, found, err = erc20Keeper.GetERC20PrecompileInstance(ctx, newAddr)
+			s.Require().NoError(err)
+			s.Require().True(found, "expected the precompile on the new address")
+			_, found, err = erc20Keeper.GetERC20PrecompileInstance(ctx, oldAddr)
+			s.Require().NoError(err)
+			s.Require().False(found, "expected no precompile on the old address")
+
+			// the allowances are moved to the new precompile
+			allowance, err := erc20Keeper.GetAllowance(ctx, newAddr, owner, spender)
+			s.Require().NoError(err)
+			s.Require().Equal(big.NewInt(100), allowance)
+			allowance, err = erc20Keeper.GetAllowance(ctx, oldAddr, owner, spender)
+			s.Require().NoError(err)
+			s.Require().Zero(allowance.Sign())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestDeregisterTokenPair() {
+	var (
+		ctx          sdk.Context
+		contractAddr common.Address
+		token        string
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		errContains string
+		// expBalances are the ERC20 balances of the keyring accounts after
+		// the deregistration
+		expBalances []int64
+	}{
+		{
+			"fail - token pair not registered",
+			func() {
+				token = cosmosTokenBase
+			},
+			types.ErrTokenPairNotFound.Error(),
+			nil,
+		},
+		{
+			"fail - native coin token pair",
+			func() {
+				token = s.network.GetBaseDenom()
+			},
+			"only external ERC20 token pairs can be deregistered",
+			nil,
+		},
+		{
+			"fail - coins escrowed on IBC channels",
+			func() {
+				s.network.App.GetTransferKeeper().SetTotalEscrowForDenom(ctx, sdk.NewInt64Coin(token, 1))
+			},
+			types.ErrInvalidIBC.Error(),
+			nil,
+		},
+		{
+			"fail - coins not backed by escrowed tokens",
+			func() {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(token, 5))
+				s.Require().NoError(s.network.App.GetBankKeeper().MintCoins(ctx, types.ModuleName, coins))
+				s.Require().NoError(s.network.App.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, types.ModuleName, s.keyring.GetAccAddr(1), coins))
+			},
+			types.ErrSupplyParity.Error(),
+			nil,
+		},
+		{
+			"fail - coins held by a module account",
+			func() {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(token, 3))
+				s.Require().NoError(s.network.App.GetBankKeeper().SendCoinsFromAccountToModule(ctx, s.keyring.GetAccAddr(0), authtypes.FeeCollectorName, coins))
+			},
+			types.ErrModuleAccountHolder.Error(),
+			nil,
+		},
+		{
+			"pass - coins returned to the holders",
+			func() {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(token, 4))
+				s.Require().NoError(s.network.App.GetBankKeeper().SendCoins(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), coins))
+			},
+			"",
+			[]int64{96, 4},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			contractAddr = s.setupConvertedERC20Pair(100, 10)
+			ctx = s.network.GetContext()
+			token = types.CreateDenom(contractAddr.String())
+			denom := token
+
+			tc.malleate()
+
+			_, err := s.network.App.GetErc20Keeper().DeregisterTokenPair(ctx, &types.MsgDeregisterTokenPair{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Token:     token,
+			})
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().False(s.network.App.GetErc20Keeper().IsERC20Registered(ctx, contractAddr))
+			s.Require().False(s.network.App.GetErc20Keeper().IsDenomRegistered(ctx, denom))
+
+			erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			for i, expBalance := range tc.expBalances {
+				balance := s.network.App.GetErc20Keeper().BalanceOf(ctx, erc20, contractAddr, s.keyring.GetAddr(i))
+				s.Require().Equal(big.NewInt(expBalance).String(), balance.String())
+			}
+
+			// all the coins have been burned and the escrowed tokens returned
+			supply := s.network.App.GetBankKeeper().GetSupply(ctx, denom)
+			s.Require().True(supply.IsZero())
+			escrowed := s.network.App.GetErc20Keeper().BalanceOf(ctx, erc20, contractAddr, types.ModuleAddress)
+			s.Require().Zero(escrowed.Sign())
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package erc721
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc721/keeper/testdata"
+	"github.com/cosmos/evm/x/erc721/types"
+
+	"cosmossdk.io/x/nft"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (s *KeeperTestSuite) TestConvertERC721() {
+	var pair types.TokenPair
+
+	testCases := []struct {
+		name        string
+		malleate    func() *types.MsgConvertERC721
+		errContains string
+	}{
+		{
+			"fail - module disabled",
+			func() *types.MsgConvertERC721 {
+				params := s.network.App.GetErc721Keeper().GetParams(s.network.GetContext())
+				params.EnableErc721 = false
+				s.Require().NoError(s.network.App.GetErc721Keeper().SetParams(s.network.GetContext(), params))
+
+				return types.NewMsgConvertERC721([]string{"1"}, s.keyring.GetAccAddr(1), pair.GetERC721Contract(), s.keyring.GetAddr(0))
+			},
+			types.ErrERC721Disabled.Error(),
+		},
+		{
+			"fail - pair not registered",
+			func() *types.MsgConvertERC721 {
+				return types.NewMsgConvertERC721([]string{"1"}, s.keyring.GetAccAddr(1), common.Address{0x01}, s.keyring.GetAddr(0))
+			},
+			types.ErrTokenPairNotFound.Error(),
+		},
+		{
+			"fail - pair disabled",
+			func() *types.MsgConvertERC721 {
+				pair.Enabled = false
+				s.network.App.GetErc721Keeper().SetTokenPair(s.network.GetContext(), pair)
+
+				return types.NewMsgConvertERC721([]string{"1"}, s.keyring.GetAccAddr(1), pair.GetERC721Contract(), s.keyring.GetAddr(0))
+			},
+			types.ErrERC721TokenPairDisabled.Error(),
+		},
+		{
+			"fail - class pair",
+			func() *types.MsgConvertERC721 {
+				classPair := s.SetupClassPair("nativeclass", "1")
+
+				return types.NewMsgConvertERC721([]string{"1"}, s.keyring.GetAccAddr(1), classPair.GetERC721Contract(), s.keyring.GetAddr(0))
+			},
+			types.ErrNativeConversionDisabled.Error(),
+		},
+		{
+			"fail - token not owned by the sender",
+			func() *types.MsgConvertERC721 {
+				return types.NewMsgConvertERC721([]string{"1"}, s.keyring.GetAccAddr(0), pair.GetERC721Contract(), s.keyring.GetAddr(1))
+			},
+			"failed to escrow token 1",
+		},
+		{
+			"fail - nonexistent token",
+			func() *types.MsgConvertERC721 {
+				return types.NewMsgConvertERC721([]string{"3"}, s.keyring.GetAccAddr(1), pair.GetERC721Contract(), s.keyring.GetAddr(0))
+			},
+			"failed to escrow token 3",
+		},
+		{
+			"pass",
+			func() *types.MsgConvertERC721 {
+				return types.NewMsgConvertERC721([]string{"1", "2"}, s.keyring.GetAccAddr(1), pair.GetERC721Contract(), s.keyring.GetAddr(0))
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			pair = s.SetupERC721Pair(1, 2)
+
+			msg := tc.malleate()
+			ctx := s.network.GetContext()
+			_, err := s.network.App.GetErc721Keeper().ConvertERC721(ctx, msg)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			nftKeeper := s.network.App.GetNFTKeeper()
+			for _, id := range msg.TokenIds {
+				owner := nftKeeper.GetOwner(ctx, pair.ClassId, id)
+				s.Require().Equal(s.keyring.GetAccAddr(1), owner, "expected the receiver to own the NFT")
+
+				token, found := nftKeeper.GetNFT(ctx, pair.ClassId, id)
+				s.Require().True(found)
+				s.Require().Equal(testdata.ERC721TokenURI, token.Uri)
+
+				tokenID, ok := new(big.Int).SetString(id, 10)
+				s.Require().True(ok)
+				erc721Owner, err := s.network.App.GetErc721Keeper().OwnerOf(ctx, pair.GetERC721Contract(), tokenID)
+				s.Require().NoError(err)
+				s.Require().Equal(types.ModuleAddress, erc721Owner, "expected the ERC721 token to be escrowed")
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestConvertNFT() {
+	var pair types.TokenPair
+
+	testCases := []struct {
+		name        string
+		malleate    func() *types.MsgConvertNFT
+		errContains string
+	}{
+		{
+			"fail - module disabled",
+			func() *types.MsgConvertNFT {
+				params := s.network.App.GetErc721Keeper().GetParams(s.network.GetContext())
+				params.EnableErc721 = false
+				s.Require().NoError(s.network.App.GetErc721Keeper().SetParams(s.network.GetContext(), params))
+
+				return types.NewMsgConvertNFT(pair.ClassId, []string{"1"}, s.keyring.GetAddr(1), s.keyring.GetAccAddr(0))
+			},
+			types.ErrERC721Disabled.Error(),
+		},
+		{
+			"fail - class pair",
+			func() *types.MsgConvertNFT {
+				classPair := s.SetupClassPair("nativeclass", "1")
+
+				return types.NewMsgConvertNFT(classPair.ClassId, []string{"1"}, s.keyring.GetAddr(1), s.keyring.GetAccAddr(0))
+			},
+			types.ErrNativeConversionDisabled.Error(),
+		},
+		{
+			"fail - NFT not owned by the sender",
+			func() *types.MsgConvertNFT {
+				return types.NewMsgConvertNFT(pair.ClassId, []string{"1"}, s.keyring.GetAddr(0), s.keyring.GetAccAddr(1))
+			},
+			types.ErrUnauthorized.Error(),
+		},
+		{
+			"fail - nonexistent NFT",
+			func() *types.MsgConvertNFT {
+				return types.NewMsgConvertNFT(pair.ClassId, []string{"3"}, s.keyring.GetAddr(1), s.keyring.GetAccAddr(0))
+			},
+			types.ErrUnauthorized.Error(),
+		},
+		{
+			"pass",
+			func() *types.MsgConvertNFT {
+				return types.NewMsgConvertNFT(pair.ClassId, []string{"1", "2"}, s.keyring.GetAddr(1), s.keyring.GetAccAddr(0))
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			pair = s.SetupERC721Pair(1, 2)
+
+			// convert the ERC721 tokens so that the sender owns the NFTs
+			ctx := s.network.GetContext()
+			_, err := s.network.App.GetErc721Keeper().ConvertERC721(
+				ctx,
+				types.NewMsgConvertERC721([]string{"1", "2"}, s.keyring.GetAccAddr(0), pair.GetERC721Contract(), s.keyring.GetAddr(0)),
+			)
+			s.Require().NoError(err)
+
+			msg := tc.malleate()
+			_, err = s.network.App.GetErc721Keeper().ConvertNFT(ctx, msg)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			for _, id := range msg.TokenIds {
+				s.Require().False(s.network.App.GetNFTKeeper().HasNFT(ctx, pair.ClassId, id), "expected the NFT to be burned")
+
+				tokenID, ok := new(big.Int).SetString(id, 10)
+				s.Require().True(ok)
+				owner, err := s.network.App.GetErc721Keeper().OwnerOf(ctx, pair.GetERC721Contract(), tokenID)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(1), owner, "expected the receiver to own the ERC721 token")
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRegisterERC721() {
+	s.SetupTest()
+	pair := s.SetupERC721Pair()
+
+	ctx := s.network.GetContext()
+	s.Require().True(pair.Enabled)
+	s.Require().True(pair.IsNativeERC721())
+	s.Require().Equal(types.CreateClassID(pair.GetERC721Contract()), pair.ClassId)
+
+	class, found := s.network.App.GetNFTKeeper().GetClass(ctx, pair.ClassId)
+	s.Require().True(found)
+	s.Require().Equal(nft.Class{
+		Id:          pair.ClassId,
+		Name:        testdata.ERC721Name,
+		Symbol:      testdata.ERC721Symbol,
+		Description: "Cosmos NFT class representation of " + pair.Erc721Address,
+	}, class)
+
+	// the contract cannot be registered twice
+	_, err := s.network.App.GetErc721Keeper().RegisterERC721(ctx, &types.MsgRegisterERC721{
+		Authority:       authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Erc721Addresses: []string{pair.Erc721Address},
+	})
+	s.Require().ErrorContains(err, types.ErrTokenPairAlreadyExists.Error())
+}
@@ -0,0 +1,53 @@
+package erc721
+
+import (
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/testutil/keyring"
+	"github.com/cosmos/evm/x/erc721/keeper/testdata"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	create  network.CreateEvmApp
+	options []network.ConfigOption
+	network *network.UnitTestNetwork
+	handler grpc.Handler
+	keyring keyring.Keyring
+	factory factory.TxFactory
+
+	erc721Contract evmtypes.CompiledContract
+}
+
+func NewKeeperTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *KeeperTestSuite {
+	return &KeeperTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	keys := keyring.New(2)
+
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keys.GetAllAccAddrs()...),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+	gh := grpc.NewIntegrationHandler(nw)
+	tf := factory.New(nw, gh)
+
+	erc721Contract, err := testdata.LoadERC721Contract()
+	s.Require().NoError(err, "failed to load the ERC721 contract")
+
+	s.network = nw
+	s.factory = tf
+	s.handler = gh
+	s.keyring = keys
+	s.erc721Contract = erc721Contract
+}
@@ -0,0 +1,96 @@
+package erc721
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/erc721/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/x/nft"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// DeployERC721 deploys the test ERC721 contract.
+func (s *KeeperTestSuite) DeployERC721() (common.Address, error) {
+	addr, err := s.factory.DeployContract(
+		s.keyring.GetPrivKey(0),
+		evmtypes.EvmTxArgs{},
+		testutiltypes.ContractDeploymentData{
+			Contract: s.erc721Contract,
+		},
+	)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return addr, s.network.NextBlock()
+}
+
+// MintERC721 mints the given token of the test ERC721 contract to the given
+// address.
+func (s *KeeperTestSuite) MintERC721(contract, to common.Address, tokenID int64) error {
+	_, err := s.factory.ExecuteContractCall(
+		s.keyring.GetPrivKey(0),
+		evmtypes.EvmTxArgs{
+			To: &contract,
+		},
+		testutiltypes.CallArgs{
+			ContractABI: s.erc721Contract.ABI,
+			MethodName:  "mint",
+			Args:        []interface{}{to, big.NewInt(tokenID)},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.network.NextBlock()
+}
+
+// SetupERC721Pair deploys the test ERC721 contract, mints the given tokens to
+// the first keyring account and registers the contract on x/erc721.
+func (s *KeeperTestSuite) SetupERC721Pair(tokenIDs ...int64) types.TokenPair {
+	contract, err := s.DeployERC721()
+	s.Require().NoError(err, "failed to deploy the ERC721 contract")
+
+	for _, id := range tokenIDs {
+		s.Require().NoError(s.MintERC721(contract, s.keyring.GetAddr(0), id), "failed to mint token %d", id)
+	}
+
+	_, err = s.network.App.GetErc721Keeper().RegisterERC721(s.network.GetContext(), &types.MsgRegisterERC721{
+		Authority:       authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Erc721Addresses: []string{contract.Hex()},
+	})
+	s.Require().NoError(err, "failed to register the ERC721 contract")
+
+	pair, err := s.network.App.GetErc721Keeper().GetERC721TokenPair(s.network.GetContext(), contract)
+	s.Require().NoError(err)
+	return pair
+}
+
+// SetupClassPair creates a x/nft class, mints the given tokens to the first
+// keyring account and registers the class on x/erc721.
+func (s *KeeperTestSuite) SetupClassPair(classID string, tokenIDs ...string) types.TokenPair {
+	ctx := s.network.GetContext()
+	nftKeeper := s.network.App.GetNFTKeeper()
+
+	s.Require().NoError(nftKeeper.SaveClass(ctx, nft.Class{Id: classID, Name: "Native NFT", Symbol: "NNFT"}))
+	for _, id := range tokenIDs {
+		s.Require().NoError(nftKeeper.Mint(ctx, nft.NFT{ClassId: classID, Id: id}, s.keyring.GetAccAddr(0)))
+	}
+
+	_, err := s.network.App.GetErc721Keeper().RegisterClass(ctx, &types.MsgRegisterClass{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		ClassIds:  []string{classID},
+	})
+	s.Require().NoError(err, "failed to register the class")
+
+	pair, err := s.network.App.GetErc721Keeper().GetClassTokenPair(ctx, classID)
+	s.Require().NoError(err)
+	return pair
+}
@@ -0,0 +1,219 @@
+package feemarket
+
+import (
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (s *KeeperTestSuite) TestCalculateBaseFeeAlgorithm() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+
+	testCases := []struct {
+		name                 string
+		algorithm            types.BaseFeeAlgorithm
+		state                types.BaseFeeState
+		parentBlockGasWanted uint64
+		expFee               func(params types.Params) math.LegacyDec
+	}{
+		{
+			"EIP-1559 - parent block wanted more gas than its target",
+			types.BaseFeeAlgorithmEIP1559,
+			types.DefaultBaseFeeState(),
+			100,
+			func(params types.Params) math.LegacyDec {
+				return params.BaseFee.Add(params.BaseFee.QuoInt64(8))
+			},
+		},
+		{
+			"AIMD - parent block wanted more gas than its target",
+			types.BaseFeeAlgorithmAIMD,
+			types.BaseFeeState{LearningRate: math.LegacyNewDecWithPrec(2, 1)},
+			100,
+			func(params types.Params) math.LegacyDec {
+				return params.BaseFee.Mul(math.LegacyNewDecWithPrec(12, 1))
+			},
+		},
+		{
+			"AIMD - parent block wanted less gas than its target",
+			types.BaseFeeAlgorithmAIMD,
+			types.BaseFeeState{LearningRate: math.LegacyNewDecWithPrec(2, 1)},
+			25,
+			func(params types.Params) math.LegacyDec {
+				return params.BaseFee.Mul(math.LegacyNewDecWithPrec(9, 1))
+			},
+		},
+		{
+			"exponential - no excess gas",
+			types.BaseFeeAlgorithmExponential,
+			types.BaseFeeState{},
+			100,
+			func(params types.Params) math.LegacyDec {
+				return params.ExponentialParams.MinBaseFee
+			},
+		},
+		{
+			"exponential - excess gas",
+			types.BaseFeeAlgorithmExponential,
+			types.BaseFeeState{ExcessGas: 400},
+			100,
+			func(params types.Params) math.LegacyDec {
+				// an excess gas equal to the update fraction (gas target * 8)
+				return types.ExponentialCalculator{}.CalculateBaseFee(params, types.BaseFeeState{ExcessGas: 400}, params.BaseFee, 100, math.NewInt(100))
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			fmk := nw.App.GetFeeMarketKeeper()
+
+			params := fmk.GetParams(ctx)
+			params.BaseFeeAlgorithm = tc.algorithm
+			params.MinGasPrice = math.LegacyZeroDec()
+			s.Require().NoError(fmk.SetParams(ctx, params))
+			fmk.SetBaseFeeState(ctx, tc.state)
+
+			ctx = ctx.WithBlockHeight(1)
+			fmk.SetBlockGasWanted(ctx, tc.parentBlockGasWanted)
+
+			// Set next block target/gasLimit through Consensus Param MaxGas
+			blockParams := tmproto.BlockParams{
+				MaxGas:   100,
+				MaxBytes: 10,
+			}
+			ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{Block: &blockParams})
+
+			fee := fmk.CalculateBaseFee(ctx)
+			s.Require().Equal(tc.expFee(params), fee)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEndBlockBaseFeeState() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+
+	testCases := []struct {
+		name      string
+		algorithm types.BaseFeeAlgorithm
+		expState  func(params types.Params) types.BaseFeeState
+	}{
+		{
+			"EIP-1559 - state unchanged",
+			types.BaseFeeAlgorithmEIP1559,
+			func(types.Params) types.BaseFeeState {
+				return types.DefaultBaseFeeState()
+			},
+		},
+		{
+			"AIMD - gas added to the window and learning rate increased",
+			types.BaseFeeAlgorithmAIMD,
+			func(params types.Params) types.BaseFeeState {
+				return types.BaseFeeState{
+					LearningRate:   params.AIMDParams.MinLearningRate.Add(params.AIMDParams.Alpha),
+					BlockGasWindow: []uint64{2500000},
+				}
+			},
+		},
+		{
+			"exponential - gas above target added to the excess gas",
+			types.BaseFeeAlgorithmExponential,
+			func(types.Params) types.BaseFeeState {
+				state := types.DefaultBaseFeeState()
+				state.ExcessGas = 1500000
+				return state
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			fmk := nw.App.GetFeeMarketKeeper()
+
+			params := fmk.GetParams(ctx)
+			params.BaseFeeAlgorithm = tc.algorithm
+			s.Require().NoError(fmk.SetParams(ctx, params))
+			fmk.SetBaseFeeState(ctx, types.DefaultBaseFeeState())
+
+			// gas limit of 2000000, i.e. a gas target of 1000000
+			blockParams := tmproto.BlockParams{
+				MaxGas:   2000000,
+				MaxBytes: 10,
+			}
+			ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{Block: &blockParams})
+			ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(uint64(1000000000)))
+			fmk.SetTransientBlockGasWanted(ctx, 5000000)
+
+			s.Require().NoError(fmk.EndBlock(ctx))
+			s.Require().Equal(tc.expState(params), fmk.GetBaseFeeState(ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateParamsBaseFeeAlgorithm() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+
+	state := types.BaseFeeState{
+		LearningRate:   math.LegacyNewDecWithPrec(2, 1),
+		BlockGasWindow: []uint64{100, 200},
+		ExcessGas:      300,
+	}
+
+	testCases := []struct {
+		name      string
+		algorithm types.BaseFeeAlgorithm
+		expState  types.BaseFeeState
+	}{
+		{
+			"same algorithm - state kept",
+			types.BaseFeeAlgorithmEIP1559,
+			state,
+		},
+		{
+			"algorithm changed - state reset",
+			types.BaseFeeAlgorithmAIMD,
+			types.DefaultBaseFeeState(),
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			fmk := nw.App.GetFeeMarketKeeper()
+			fmk.SetBaseFeeState(ctx, state)
+
+			params := fmk.GetParams(ctx)
+			params.BaseFeeAlgorithm = tc.algorithm
+			_, err := fmk.UpdateParams(ctx, &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    params,
+			})
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.algorithm, fmk.GetParams(ctx).BaseFeeAlgorithm)
+			s.Require().Equal(tc.expState, fmk.GetBaseFeeState(ctx))
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package feemarket
+
+import (
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	"github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (s *KeeperTestSuite) TestSplitBaseFee() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+
+	baseFee := sdkmath.LegacyNewDec(1_000_000_000)
+	gasUsed := uint64(21_000)
+	baseFeeAmt := baseFee.MulInt64(int64(gasUsed)).TruncateInt()
+
+	testCases := []struct {
+		name             string
+		split            types.BaseFeeSplit
+		gasUsed          uint64
+		feeCollectorAmt  sdkmath.Int
+		expBurned        sdkmath.Int
+		expCommunityPool sdkmath.Int
+	}{
+		{
+			"no-op - default split",
+			types.DefaultBaseFeeSplit,
+			gasUsed,
+			baseFeeAmt,
+			sdkmath.ZeroInt(),
+			sdkmath.ZeroInt(),
+		},
+		{
+			"no-op - no gas used",
+			types.NewBaseFeeSplit(sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.LegacyNewDecWithPrec(25, 2), sdkmath.LegacyNewDecWithPrec(25, 2)),
+			0,
+			baseFeeAmt,
+			sdkmath.ZeroInt(),
+			sdkmath.ZeroInt(),
+		},
+		{
+			"pass - base fee burned and sent to the community pool",
+			types.NewBaseFeeSplit(sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.LegacyNewDecWithPrec(25, 2), sdkmath.LegacyNewDecWithPrec(25, 2)),
+			gasUsed,
+			baseFeeAmt.MulRaw(2),
+			baseFeeAmt.QuoRaw(2),
+			baseFeeAmt.QuoRaw(4),
+		},
+		{
+			"pass - whole base fee burned",
+			types.NewBaseFeeSplit(sdkmath.LegacyOneDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()),
+			gasUsed,
+			baseFeeAmt,
+			baseFeeAmt,
+			sdkmath.ZeroInt(),
+		},
+		{
+			"pass - split capped to the fee collector balance",
+			types.NewBaseFeeSplit(sdkmath.LegacyOneDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()),
+			gasUsed,
+			baseFeeAmt.QuoRaw(3),
+			baseFeeAmt.QuoRaw(3),
+			sdkmath.ZeroInt(),
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			fmk := nw.App.GetFeeMarketKeeper()
+			bk := nw.App.GetBankKeeper()
+			denom := evmtypes.GetEVMCoinDenom()
+
+			params := fmk.GetParams(ctx)
+			params.BaseFee = baseFee
+			params.BaseFeeSplit = tc.split
+			s.Require().NoError(fmk.SetParams(ctx, params))
+
+			// reset the fee collector balance to the fees of the block
+			feeCollector := nw.App.GetAccountKeeper().GetModuleAddress(authtypes.FeeCollectorName)
+			balance := bk.GetBalance(ctx, feeCollector, denom)
+			if balance.IsPositive() {
+				s.Require().NoError(bk.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, evmtypes.ModuleName, sdk.Coins{balance}))
+				s.Require().NoError(bk.BurnCoins(ctx, evmtypes.ModuleName, sdk.Coins{balance}))
+			}
+			fees := sdk.Coins{sdk.NewCoin(denom, tc.feeCollectorAmt)}
+			s.Require().NoError(bk.MintCoins(ctx, evmtypes.ModuleName, fees))
+			s.Require().NoError(bk.SendCoinsFromModuleToModule(ctx, evmtypes.ModuleName, authtypes.FeeCollectorName, fees))
+
+			fmk.AddTransientEVMGasUsed(ctx, tc.gasUsed)
+
+			supplyBefore := bk.GetSupply(ctx, denom)
+			feePoolBefore, err := nw.App.GetDistrKeeper().FeePool.Get(ctx)
+			s.Require().NoError(err)
+
+			err = fmk.SplitBaseFee(ctx)
+			s.Require().NoError(err)
+
+			supplyAfter := bk.GetSupply(ctx, denom)
+			s.Require().Equal(tc.expBurned.String(), supplyBefore.Amount.Sub(supplyAfter.Amount).String(), "unexpected burned amount")
+			s.Require().Equal(tc.expBurned.String(), fmk.GetBurnedBaseFee(ctx).String())
+
+			feePoolAfter, err := nw.App.GetDistrKeeper().FeePool.Get(ctx)
+			s.Require().NoError(err)
+			communityPoolDiff := feePoolAfter.CommunityPool.AmountOf(denom).Sub(feePoolBefore.CommunityPool.AmountOf(denom))
+			s.Require().Equal(tc.expCommunityPool.String(), communityPoolDiff.TruncateInt().String(), "unexpected community pool amount")
+
+			expFeeCollectorAmt := tc.feeCollectorAmt.Sub(tc.expBurned).Sub(tc.expCommunityPool)
+			s.Require().Equal(expFeeCollectorAmt.String(), bk.GetBalance(ctx, feeCollector, denom).Amount.String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSplitBaseFeeEndBlock() {
+	keyring := testkeyring.New(2)
+	feemarketGenesis := types.DefaultGenesisState()
+	feemarketGenesis.Params.BaseFeeSplit = types.NewBaseFeeSplit(sdkmath.LegacyOneDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec())
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(network.CustomGenesisState{types.ModuleName: feemarketGenesis}),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+	txFactory := factory.New(nw, grpc.NewIntegrationHandler(nw))
+
+	receiver := keyring.GetAddr(1)
+	res, err := txFactory.ExecuteEthTx(keyring.GetPrivKey(0), evmtypes.EvmTxArgs{
+		To:     &receiver,
+		Amount: sdkmath.NewInt(1000).BigInt(),
+	})
+	s.Require().NoError(err)
+	s.Require().True(res.IsOK(), "transaction should have succeeded", res.GetLog())
+	s.Require().NoError(nw.NextBlock())
+
+	// the whole base fee of the transaction has been burned
+	ctx := nw.GetContext()
+	burned := nw.App.GetFeeMarketKeeper().GetBurnedBaseFee(ctx)
+	s.Require().True(burned.IsPositive(), "expected base fee to be burned")
+
+	qc := nw.GetFeeMarketClient()
+	qRes, err := qc.BurnedBaseFee(ctx.Context(), &types.QueryBurnedBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), burned), qRes.BurnedBaseFee)
+}
+
+func (s *KeeperTestSuite) TestSplitBaseFeeEndBlockWithFeeDenom() {
+	const altDenom = "uusdc"
+	feeDenom := types.NewFeeDenom(altDenom, sdkmath.LegacyNewDecWithPrec(5, 1))
+
+	keyring := testkeyring.New(2)
+	sender := keyring.GetKey(0)
+	feemarketGenesis := types.DefaultGenesisState()
+	feemarketGenesis.Params.BaseFeeSplit = types.NewBaseFeeSplit(sdkmath.LegacyOneDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec())
+	feemarketGenesis.Params.FeeDenoms = []types.FeeDenom{feeDenom}
+	feemarketGenesis.AccountFeeDenoms = []types.AccountFeeDenom{{Address: sender.AccAddr.String(), Denom: altDenom}}
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithOtherDenoms([]string{altDenom}),
+		network.WithCustomGenesis(network.CustomGenesisState{types.ModuleName: feemarketGenesis}),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+	txFactory := factory.New(nw, grpc.NewIntegrationHandler(nw))
+
+	receiver := keyring.GetAddr(1)
+	msg, err := txFactory.GenerateSignedMsgEthereumTx(sender.Priv, evmtypes.EvmTxArgs{
+		To:     &receiver,
+		Amount: sdkmath.NewInt(1000).BigInt(),
+	})
+	s.Require().NoError(err)
+	tx, err := msg.BuildTxWithFeeDenom(nw.App.GetTxConfig().NewTxBuilder(), evmtypes.GetEVMCoinDenom(), &feeDenom)
+	s.Require().NoError(err)
+	txBytes, err := nw.App.GetTxConfig().TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	feeCollector := nw.App.GetAccountKeeper().GetModuleAddress(authtypes.FeeCollectorName)
+	balanceBefore := nw.App.GetBankKeeper().GetBalance(nw.GetContext(), feeCollector, altDenom)
+
+	blockRes, err := nw.NextBlockWithTxs(txBytes)
+	s.Require().NoError(err)
+	s.Require().Len(blockRes.TxResults, 1)
+	s.Require().True(blockRes.TxResults[0].IsOK(), "transaction should have succeeded", blockRes.TxResults[0].GetLog())
+
+	// the fees paid in the fee denom are not split and stay in the fee
+	// collector
+	ctx := nw.GetContext()
+	s.Require().True(nw.App.GetFeeMarketKeeper().GetBurnedBaseFee(ctx).IsZero(), "expected no base fee to be burned")
+	balanceAfter := nw.App.GetBankKeeper().GetBalance(ctx, feeCollector, altDenom)
+	s.Require().True(balanceAfter.Amount.GT(balanceBefore.Amount), "expected the fees to stay in the fee collector")
+}
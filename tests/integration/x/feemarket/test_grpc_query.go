@@ -132,3 +132,73 @@ func (s *KeeperTestSuite) TestQueryBlockGas() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQueryAccountFeeDenom() {
+	var (
+		nw     *network.UnitTestNetwork
+		ctx    sdk.Context
+		req    *types.QueryAccountFeeDenomRequest
+		expRes *types.QueryAccountFeeDenomResponse
+	)
+	feeDenom := types.NewFeeDenom("uusdc", sdkmath.LegacyNewDecWithPrec(5, 1))
+	addr := sdk.AccAddress([]byte("fee_denom_account___"))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - invalid address",
+			func() {
+				req = &types.QueryAccountFeeDenomRequest{Address: "invalid"}
+			},
+			false,
+		},
+		{
+			"pass - fees paid in the EVM denom",
+			func() {
+				expRes = &types.QueryAccountFeeDenomResponse{}
+			},
+			true,
+		},
+		{
+			"pass - fees paid in the fee denom",
+			func() {
+				nw.App.GetFeeMarketKeeper().SetAccountFeeDenom(ctx, addr, feeDenom.Denom)
+				expRes = &types.QueryAccountFeeDenomResponse{FeeDenom: &feeDenom}
+			},
+			true,
+		},
+		{
+			"pass - fee denom no longer accepted",
+			func() {
+				nw.App.GetFeeMarketKeeper().SetAccountFeeDenom(ctx, addr, "uother")
+				expRes = &types.QueryAccountFeeDenomResponse{}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+
+			params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+			params.FeeDenoms = []types.FeeDenom{feeDenom}
+			s.Require().NoError(nw.App.GetFeeMarketKeeper().SetParams(ctx, params))
+			req = &types.QueryAccountFeeDenomRequest{Address: addr.String()}
+
+			tc.malleate()
+
+			res, err := nw.App.GetFeeMarketKeeper().AccountFeeDenom(ctx, req)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(expRes, res)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
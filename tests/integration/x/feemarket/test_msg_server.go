@@ -4,6 +4,8 @@ import (
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	"github.com/cosmos/evm/x/feemarket/types"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -50,3 +52,64 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSetFeeDenom() {
+	const feeDenom = "uusdc"
+
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+	sender := sdk.AccAddress([]byte("fee_denom_sender____"))
+
+	testCases := []struct {
+		name        string
+		request     *types.MsgSetFeeDenom
+		expFeeDenom string
+		expectErr   bool
+	}{
+		{
+			name:      "fail - invalid sender",
+			request:   &types.MsgSetFeeDenom{Sender: "foobar", FeeDenom: feeDenom},
+			expectErr: true,
+		},
+		{
+			name:      "fail - fee denom not accepted",
+			request:   types.NewMsgSetFeeDenom(sender, "uother"),
+			expectErr: true,
+		},
+		{
+			name:        "pass - set the fee denom",
+			request:     types.NewMsgSetFeeDenom(sender, feeDenom),
+			expFeeDenom: feeDenom,
+		},
+		{
+			name:    "pass - reset to the EVM denom",
+			request: types.NewMsgSetFeeDenom(sender, ""),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+
+			params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+			params.FeeDenoms = []types.FeeDenom{types.NewFeeDenom(feeDenom, math.LegacyOneDec())}
+			s.Require().NoError(nw.App.GetFeeMarketKeeper().SetParams(ctx, params))
+			nw.App.GetFeeMarketKeeper().SetAccountFeeDenom(ctx, sender, feeDenom)
+
+			_, err := nw.App.GetFeeMarketKeeper().SetFeeDenom(ctx, tc.request)
+			if tc.expectErr {
+				s.Error(err)
+				return
+			}
+			s.NoError(err)
+
+			accFeeDenom, found := nw.App.GetFeeMarketKeeper().GetAccountFeeDenom(ctx, sender)
+			s.Require().Equal(tc.expFeeDenom != "", found)
+			s.Require().Equal(tc.expFeeDenom, accFeeDenom.Denom)
+		})
+	}
+}
@@ -2,20 +2,209 @@ package callbacks
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/cosmos/evm/contracts"
 	"github.com/cosmos/evm/testutil/keyring"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/ibc/callbacks/testutil"
 	"github.com/cosmos/evm/x/ibc/callbacks/types"
+	nfttransfertypes "github.com/cosmos/evm/x/ibc/nfttransfer/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	cbtypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+func (s *KeeperTestSuite) TestSendPacket() {
+	var (
+		contract  common.Address
+		ctx       sdk.Context
+		senderKey keyring.Key
+		calldata  string
+		gasLimit  uint64
+		// expCounter is true if the contract is the counter contract, whose
+		// counter is incremented by the send callback
+		expCounter bool
+	)
+	channelID := "channel-0"
+
+	deploy := func(data testutiltypes.ContractDeploymentData) common.Address {
+		addr, err := s.factory.DeployContract(senderKey.Priv, evmtypes.EvmTxArgs{}, data)
+		s.Require().NoError(err)
+		s.Require().NoError(s.network.NextBlock())
+		ctx = s.network.GetContext()
+		s.network.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceSend(ctx, transfertypes.PortID, channelID, 2)
+		return addr
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {
+				counterContract, err := testutil.LoadCounterWithCallbacksContract()
+				s.Require().NoError(err)
+				contract = deploy(testutiltypes.ContractDeploymentData{Contract: counterContract})
+			},
+			nil,
+		},
+		{
+			"send sequence of the channel not found",
+			func() {
+				channelID = "channel-1"
+			},
+			channeltypes.ErrSequenceSendNotFound,
+		},
+		{
+			"contract code does not exist",
+			func() {
+				contract = common.HexToAddress("0x1234567890abcdef1234567890abcdef12345678")
+			},
+			types.ErrCallbackFailed,
+		},
+		{
+			"source callback contains calldata",
+			func() {
+				counterContract, err := testutil.LoadCounterWithCallbacksContract()
+				s.Require().NoError(err)
+				contract = deploy(testutiltypes.ContractDeploymentData{Contract: counterContract})
+				calldata = "deadbeef"
+			},
+			types.ErrInvalidCalldata,
+		},
+		{
+			"success - contract does not support the ICallbacks interface",
+			func() {
+				contract = deploy(testutiltypes.ContractDeploymentData{
+					Contract:        contracts.ERC20MinterBurnerDecimalsContract,
+					ConstructorArgs: []interface{}{"coin", "token", uint8(18)},
+				})
+				expCounter = false
+			},
+			nil,
+		},
+		{
+			"success - contract does not implement ERC-165",
+			func() {
+				// the runtime code reverts every call without data
+				contract = deploy(testutiltypes.ContractDeploymentData{
+					Contract: evmtypes.CompiledContract{Bin: common.FromHex("6004600c60003960046000f3600080fd")},
+				})
+				expCounter = false
+			},
+			nil,
+		},
+		{
+			"contract rejects the packet",
+			func() {
+				// the runtime code returns true from supportsInterface and reverts every
+				// other call with one byte of data
+				contract = deploy(testutiltypes.ContractDeploymentData{
+					Contract: evmtypes.CompiledContract{Bin: common.FromHex("601f600c600039601f6000f360003560e01c6301ffc9a71460145760016000fd5b600160005260206000f3")},
+				})
+			},
+			types.ErrCallbackFailed,
+		},
+		{
+			"contract rejects the packet with a bare revert",
+			func() {
+				// the runtime code returns true from supportsInterface and reverts every
+				// other call without data, as revert() does
+				contract = deploy(testutiltypes.ContractDeploymentData{
+					Contract: evmtypes.CompiledContract{Bin: common.FromHex("601e600c600039601e6000f360003560e01c6301ffc9a714601357600080fd5b600160005260206000f3")},
+				})
+			},
+			types.ErrCallbackFailed,
+		},
+		{
+			"callback gas limit too low for the ERC-165 query",
+			func() {
+				counterContract, err := testutil.LoadCounterWithCallbacksContract()
+				s.Require().NoError(err)
+				contract = deploy(testutiltypes.ContractDeploymentData{Contract: counterContract})
+				// below the intrinsic gas of the supportsInterface call
+				gasLimit = 20_000
+			},
+			types.ErrCallbackFailed,
+		},
+		{
+			"callback runs out of gas",
+			func() {
+				counterContract, err := testutil.LoadCounterWithCallbacksContract()
+				s.Require().NoError(err)
+				contract = deploy(testutiltypes.ContractDeploymentData{Contract: counterContract})
+				gasLimit = 30_000
+			},
+			types.ErrCallbackFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			ctx = s.network.GetContext()
+			channelID = "channel-0"
+			calldata = ""
+			gasLimit = 1_000_000
+			expCounter = true
+			senderKey = s.keyring.GetKey(0)
+			s.network.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceSend(ctx, transfertypes.PortID, channelID, 2)
+
+			tc.malleate()
+
+			memo := fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, contract.Hex())
+			if calldata != "" {
+				memo = fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "%s"}}`, contract.Hex(), calldata)
+			}
+			transferData := transfertypes.NewFungibleTokenPacketData(
+				"uatom",
+				"100",
+				senderKey.AccAddr.String(),
+				"receiver",
+				memo,
+			)
+
+			// the callbacks middleware limits the gas of the callback
+			cachedCtx := ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+			err := s.network.App.GetCallbackKeeper().IBCSendPacketCallback(
+				cachedCtx, transfertypes.PortID, channelID, clienttypes.ZeroHeight(), 10000000, transferData.GetBytes(),
+				contract.Hex(), senderKey.AccAddr.String(), transfertypes.V1,
+			)
+			if tc.expErr != nil {
+				s.Require().ErrorContains(err, tc.expErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotZero(cachedCtx.GasMeter().GasConsumed())
+			if !expCounter {
+				return
+			}
+
+			counterContract, err := testutil.LoadCounterWithCallbacksContract()
+			s.Require().NoError(err)
+			res, err := s.network.App.GetEVMKeeper().CallEVM(ctx, counterContract.ABI, senderKey.Addr, contract, false, nil, "getCounter")
+			s.Require().NoError(err)
+
+			var counter *big.Int
+			s.Require().NoError(counterContract.ABI.UnpackIntoInterface(&counter, "getCounter", res.Ret))
+			s.Require().Equal(big.NewInt(1), counter)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestOnRecvPacket() {
 	var (
 		contract     common.Address
@@ -61,6 +250,46 @@ func (s *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			cbtypes.ErrInvalidCallbackData,
 		},
+		{
+			"packet sent to an application without packet adapter",
+			func() {
+				packet.DestinationPort = "unsupported"
+			},
+			types.ErrUnsupportedApplication,
+		},
+		{
+			"application does not support destination callbacks",
+			func() {
+				packet.DestinationPort = icatypes.ControllerPortPrefix + senderKey.AccAddr.String()
+				packet.Data = icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: []byte("data"),
+					Memo: fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, contract.Hex()),
+				}.GetBytes()
+			},
+			types.ErrUnsupportedCallback,
+		},
+		{
+			"packet data is nft-transfer but the class is not registered",
+			func() {
+				counterContract, err := testutil.LoadCounterWithCallbacksContract()
+				s.Require().NoError(err)
+				contract, err = s.factory.DeployContract(senderKey.Priv, evmtypes.EvmTxArgs{}, testutiltypes.ContractDeploymentData{Contract: counterContract})
+				s.Require().NoError(err)
+				s.Require().NoError(s.network.NextBlock())
+				ctx = s.network.GetContext()
+
+				packet.SourcePort = nfttransfertypes.PortID
+				packet.DestinationPort = nfttransfertypes.PortID
+				packet.Data = nfttransfertypes.NewNonFungibleTokenPacketData(
+					"class", "", "", []string{"1"}, nil, nil,
+					senderKey.AccAddr.String(),
+					receiver,
+					fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, contract.Hex()),
+				).GetBytes()
+			},
+			types.ErrTokenPairNotFound,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -148,6 +377,27 @@ func (s *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			},
 			types.ErrInvalidCalldata,
 		},
+		{
+			"packet sent from an application without packet adapter",
+			func() {
+				packet.SourcePort = "unsupported"
+			},
+			types.ErrUnsupportedApplication,
+		},
+		{
+			"packet data is nft-transfer",
+			func() {
+				packet.SourcePort = nfttransfertypes.PortID
+				packet.DestinationPort = nfttransfertypes.PortID
+				packet.Data = nfttransfertypes.NewNonFungibleTokenPacketData(
+					"class", "", "", []string{"1"}, nil, nil,
+					senderKey.AccAddr.String(),
+					receiver,
+					fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, contract.Hex()),
+				).GetBytes()
+			},
+			types.ErrCallbackFailed,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -262,7 +262,7 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsRemainder() {
 			moduleAddr,
 			types.ExtendedCoinDenom(),
 		)
-		remainderBefore := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext())
+		remainderBefore := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext(), types.ExtendedCoinDenom())
 
 		// ----------------------------------------
 		// Burn
@@ -275,7 +275,7 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsRemainder() {
 
 		// ----------------------------------------
 		// Checks
-		remainderAfter := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext())
+		remainderAfter := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext(), types.ExtendedCoinDenom())
 		balAfter := s.network.App.GetPreciseBankKeeper().GetBalance(
 			s.network.GetContext(),
 			moduleAddr,
@@ -353,7 +353,7 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsSpreadRemainder() {
 			addr,
 			types.ExtendedCoinDenom(),
 		)
-		remainderBefore := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext())
+		remainderBefore := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext(), types.ExtendedCoinDenom())
 
 		// ----------------------------------------
 		// Send & Burn
@@ -374,7 +374,7 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsSpreadRemainder() {
 
 		// ----------------------------------------
 		// Checks
-		remainderAfter := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext())
+		remainderAfter := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext(), types.ExtendedCoinDenom())
 		balAfter := s.network.App.GetPreciseBankKeeper().GetBalance(
 			s.network.GetContext(),
 			addr,
@@ -503,7 +503,7 @@ func (s *KeeperIntegrationTestSuite) TestBurnCoinsRandomValueMultiDecimals() {
 			s.Equal(burnerBal.BigInt().Cmp(big.NewInt(0)), 0, "burner balance mismatch (expected: %s, actual: %s)", big.NewInt(0), burnerBal)
 
 			// Check remainder
-			remainder := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext())
+			remainder := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext(), types.ExtendedCoinDenom())
 			s.Equal(remainder.BigInt().Cmp(big.NewInt(0)), 0, "remainder should be zero (expected: %s, actual: %s)", big.NewInt(0), remainder)
 		})
 	}
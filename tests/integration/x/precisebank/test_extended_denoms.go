@@ -0,0 +1,144 @@
+package precisebank
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (s *KeeperIntegrationTestSuite) TestUpdateParams() {
+	fooDenom := types.NewExtendedDenom("ufoo", "afoo", 6)
+
+	testCases := []struct {
+		name    string
+		request *types.MsgUpdateParams
+		expErr  string
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgUpdateParams{Authority: "foobar"},
+			"invalid authority",
+		},
+		{
+			"fail - invalid params",
+			&types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.NewParams([]types.ExtendedDenom{types.NewExtendedDenom("ufoo", "ufoo", 6)}),
+			},
+			"invalid extended denom ufoo",
+		},
+		{
+			"pass - add extended denom",
+			&types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.NewParams([]types.ExtendedDenom{fooDenom}),
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			_, err := s.network.App.GetPreciseBankKeeper().UpdateParams(s.network.GetContext(), tc.request)
+			if tc.expErr != "" {
+				s.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			res, err := s.network.GetPreciseBankClient().Params(context.Background(), &types.QueryParamsRequest{})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.request.Params.ExtendedDenoms, res.Params.ExtendedDenoms)
+		})
+	}
+}
+
+func (s *KeeperIntegrationTestSuite) TestExtendedDenomMintSendBurn() {
+	fooDenom := types.NewExtendedDenom("ufoo", "afoo", 6)
+	cf := fooDenom.ConversionFactor()
+
+	pbk := s.network.App.GetPreciseBankKeeper()
+	bk := s.network.App.GetBankKeeper()
+
+	// Before being registered, afoo is a regular x/bank denom
+	evmModuleAddr := s.network.App.GetAccountKeeper().GetModuleAddress(evmtypes.ModuleName)
+	s.Require().NoError(pbk.MintCoins(s.network.GetContext(), evmtypes.ModuleName, cs(c("afoo", 1))))
+	s.Require().Equal(c("afoo", 1), bk.GetBalance(s.network.GetContext(), evmModuleAddr, "afoo"))
+	s.Require().NoError(pbk.BurnCoins(s.network.GetContext(), evmtypes.ModuleName, cs(c("afoo", 1))))
+
+	s.Require().NoError(pbk.SetParams(s.network.GetContext(), types.NewParams([]types.ExtendedDenom{fooDenom})))
+
+	sender := sdk.AccAddress([]byte("sender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+	reserveAddr := s.network.App.GetAccountKeeper().GetModuleAddress(types.ModuleName)
+
+	// 2 ufoo and 500 afoo, alongside the EVM extended denom
+	mintAmt := cs(
+		ci("afoo", cf.MulRaw(2).AddRaw(500)),
+		ci(types.ExtendedCoinDenom(), types.ConversionFactor().AddRaw(100)),
+	)
+	s.Require().NoError(pbk.MintCoins(s.network.GetContext(), evmtypes.ModuleName, mintAmt))
+	s.Require().NoError(pbk.SendCoinsFromModuleToAccount(s.network.GetContext(), evmtypes.ModuleName, sender, mintAmt))
+
+	s.Require().Equal(sdkmath.NewInt(2), bk.GetBalance(s.network.GetContext(), sender, "ufoo").Amount)
+	s.Require().Equal(sdkmath.NewInt(500), pbk.GetFractionalBalance(s.network.GetContext(), sender, "afoo"))
+	s.Require().Equal(sdkmath.NewInt(100), pbk.GetFractionalBalance(s.network.GetContext(), sender, types.ExtendedCoinDenom()))
+	s.Require().Equal(cf.SubRaw(500), pbk.GetRemainderAmount(s.network.GetContext(), "afoo"))
+	s.Require().Equal(sdkmath.OneInt(), bk.GetBalance(s.network.GetContext(), reserveAddr, "ufoo").Amount)
+
+	// Send an amount that requires a borrow from the integer balance
+	sendAmt := cs(ci("afoo", cf.AddRaw(600)))
+	s.Require().NoError(pbk.SendCoins(s.network.GetContext(), sender, recipient, sendAmt))
+
+	s.Require().Equal(ci("afoo", cf.SubRaw(100)), pbk.GetBalance(s.network.GetContext(), sender, "afoo"))
+	s.Require().Equal(ci("afoo", cf.AddRaw(600)), pbk.GetBalance(s.network.GetContext(), recipient, "afoo"))
+	s.Require().Equal(ci(types.ExtendedCoinDenom(), types.ConversionFactor().AddRaw(100)), pbk.GetBalance(s.network.GetContext(), sender, types.ExtendedCoinDenom()))
+
+	// Sending more than the balance returns an error in the extended denom
+	err := pbk.SendCoins(s.network.GetContext(), sender, recipient, cs(ci("afoo", cf)))
+	s.Require().ErrorContains(err, fmt.Sprintf("spendable balance %s is smaller than %s", ci("afoo", cf.SubRaw(100)), ci("afoo", cf)))
+
+	// Query the fractional balance and remainder of the extended denom
+	fracRes, err := s.network.GetPreciseBankClient().FractionalBalance(
+		context.Background(),
+		&types.QueryFractionalBalanceRequest{Address: recipient.String(), Denom: "afoo"},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(c("afoo", 600), fracRes.FractionalBalance)
+
+	remainderRes, err := s.network.GetPreciseBankClient().Remainder(
+		context.Background(),
+		&types.QueryRemainderRequest{Denom: "afoo"},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(ci("afoo", cf.SubRaw(500)), remainderRes.Remainder)
+
+	_, err = s.network.GetPreciseBankClient().Remainder(
+		context.Background(),
+		&types.QueryRemainderRequest{Denom: "ufoo"},
+	)
+	s.Require().ErrorContains(err, "ufoo is not an extended denom")
+
+	// Burning the remaining fractional amounts leaves the reserve fully backing
+	// the remainder
+	burnAmt := cs(ci("afoo", cf.SubRaw(100)))
+	s.Require().NoError(pbk.SendCoinsFromAccountToModule(s.network.GetContext(), sender, evmtypes.ModuleName, burnAmt))
+	s.Require().NoError(pbk.BurnCoins(s.network.GetContext(), evmtypes.ModuleName, burnAmt))
+
+	s.Require().True(pbk.GetBalance(s.network.GetContext(), sender, "afoo").IsZero())
+	totalFractional := pbk.GetTotalSumFractionalBalances(s.network.GetContext(), "afoo")
+	remainder := pbk.GetRemainderAmount(s.network.GetContext(), "afoo")
+	reserve := bk.GetBalance(s.network.GetContext(), reserveAddr, "ufoo").Amount
+	s.Require().Equal(reserve.Mul(cf), totalFractional.Add(remainder), "reserve should back fractional balances and remainder")
+}
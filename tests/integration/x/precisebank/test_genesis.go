@@ -186,7 +186,7 @@ func (s *GenesisTestSuite) TestInitGenesis() {
 			// Verify balances are set in state, get full list of balances in
 			// state to ensure they are set AND no extra balances are set
 			var bals []types.FractionalBalance
-			s.network.App.GetPreciseBankKeeper().IterateFractionalBalances(s.network.GetContext(), func(addr sdk.AccAddress, bal sdkmath.Int) bool {
+			s.network.App.GetPreciseBankKeeper().IterateFractionalBalances(s.network.GetContext(), types.ExtendedCoinDenom(), func(addr sdk.AccAddress, bal sdkmath.Int) bool {
 				bals = append(bals, types.NewFractionalBalance(addr.String(), bal))
 
 				return false
@@ -194,7 +194,7 @@ func (s *GenesisTestSuite) TestInitGenesis() {
 
 			s.Require().ElementsMatch(tc.genesisState.Balances, bals, "balances should be set in state")
 
-			remainder := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext())
+			remainder := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext(), types.ExtendedCoinDenom())
 			s.Require().Equal(tc.genesisState.Remainder, remainder, "remainder should be set in state")
 		})
 	}
@@ -125,7 +125,7 @@ func (s *KeeperIntegrationTestSuite) TestMintBurnSendCoinsRandomValueMultiDecima
 
 			// Check remainder
 			expectedRemainder := burnAmount.Sub(mintAmount).Mod(types.ConversionFactor())
-			actualRemainder := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext())
+			actualRemainder := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext(), types.ExtendedCoinDenom())
 			s.Require().Equal(expectedRemainder.BigInt().Cmp(actualRemainder.BigInt()), 0, "Remainder mismatch (expected: %s, actual: %s)", expectedRemainder, actualRemainder)
 		})
 	}
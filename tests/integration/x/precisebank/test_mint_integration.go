@@ -425,7 +425,7 @@ func (s *KeeperIntegrationTestSuite) TestMintCoinsRandomValueMultiDecimals() {
 			s.Equal(minterBal.BigInt().Cmp(targetBalance.BigInt()), 0, "minter balance mismatch (expected: %s, actual: %s)", targetBalance, minterBal)
 
 			// Check remainder
-			remainder := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext())
+			remainder := s.network.App.GetPreciseBankKeeper().GetRemainderAmount(s.network.GetContext(), types.ExtendedCoinDenom())
 			s.Equal(remainder.BigInt().Cmp(big.NewInt(0)), 0, "remainder should be zero (expected: %s, actual: %s)", big.NewInt(0), remainder)
 		})
 	}
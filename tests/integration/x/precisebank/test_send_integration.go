@@ -792,7 +792,7 @@ func (s *KeeperIntegrationTestSuite) TestSendCoinsRandomValueMultiDecimals() {
 			// Check recipient balance
 			recipientBal := s.GetAllBalances(recipient)
 			intReceived := recipientBal.AmountOf(types.ExtendedCoinDenom()).Quo(types.ConversionFactor())
-			fracReceived := s.network.App.GetPreciseBankKeeper().GetFractionalBalance(s.network.GetContext(), recipient)
+			fracReceived := s.network.App.GetPreciseBankKeeper().GetFractionalBalance(s.network.GetContext(), recipient, types.ExtendedCoinDenom())
 
 			expectedInt := totalSent.Quo(types.ConversionFactor())
 			expectedFrac := totalSent.Mod(types.ConversionFactor())
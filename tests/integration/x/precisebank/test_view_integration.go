@@ -75,7 +75,7 @@ func (s *KeeperIntegrationTestSuite) TestKeeperSpendableCoin() {
 			s.MintToAccount(addr, tt.giveBankBal)
 
 			// Set fractional balance in store before query
-			s.network.App.GetPreciseBankKeeper().SetFractionalBalance(s.network.GetContext(), addr, tt.giveFractionalBal)
+			s.network.App.GetPreciseBankKeeper().SetFractionalBalance(s.network.GetContext(), addr, types.ExtendedCoinDenom(), tt.giveFractionalBal)
 
 			// Add some locked coins
 			acc := s.network.App.GetAccountKeeper().GetAccount(s.network.GetContext(), addr)
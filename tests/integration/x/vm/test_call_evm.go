@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/cosmos/evm/contracts"
 	testconstants "github.com/cosmos/evm/testutil/constants"
@@ -148,3 +150,28 @@ func (s *KeeperTestSuite) TestCallEVMWithData() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestCallEVMWithDataRevert() {
+	// init code deploying a contract that always reverts with 0xdeadbeef
+	initCode := common.FromHex("6010600c60003960106000f363deadbeef60e01b60005260046000fd")
+
+	for _, commit := range []bool{false, true} {
+		s.Run(fmt.Sprintf("commit %t", commit), func() {
+			s.SetupTest() // reset
+
+			ctx := s.Network.GetContext()
+			evmKeeper := s.Network.App.GetEVMKeeper()
+			nonce, err := s.Network.App.GetAccountKeeper().GetSequence(ctx, types.ModuleAddress.Bytes())
+			s.Require().NoError(err)
+			_, err = evmKeeper.CallEVMWithData(ctx, types.ModuleAddress, nil, initCode, true, nil)
+			s.Require().NoError(err)
+			contract := crypto.CreateAddress(types.ModuleAddress, nonce)
+
+			res, err := evmKeeper.CallEVMWithData(ctx, types.ModuleAddress, &contract, []byte{}, commit, nil)
+			s.Require().ErrorIs(err, evmtypes.ErrVMExecution)
+			s.Require().NotNil(res)
+			s.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+			s.Require().Equal(common.FromHex("deadbeef"), res.Ret)
+		})
+	}
+}
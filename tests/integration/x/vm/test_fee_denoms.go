@@ -0,0 +1,132 @@
+package vm
+
+import (
+	"math/big"
+
+	basefactory "github.com/cosmos/evm/testutil/integration/base/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestEthereumTxWithFeeDenom() {
+	const altDenom = "uusdc"
+	// 1 unit of the EVM denom is worth 0.5uusdc
+	feeDenom := feemarkettypes.NewFeeDenom(altDenom, sdkmath.LegacyNewDecWithPrec(5, 1))
+
+	testCases := []struct {
+		name string
+		// accFeeDenom is the fee denom selected by the sender
+		accFeeDenom string
+		// feeInfoInFeeDenom is true if the AuthInfo fee amount is converted
+		// into the fee denom
+		feeInfoInFeeDenom bool
+		expErr            string
+	}{
+		{
+			"fail - AuthInfo fee amount in the EVM denom",
+			altDenom,
+			false,
+			"invalid AuthInfo Fee Amount",
+		},
+		{
+			"fail - AuthInfo fee amount in the fee denom not selected by the sender",
+			"",
+			true,
+			"invalid AuthInfo Fee Amount",
+		},
+		{
+			"pass - fees paid and refunded in the fee denom",
+			altDenom,
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			keyring := testkeyring.New(2)
+			feemarketGenesis := feemarkettypes.DefaultGenesisState()
+			feemarketGenesis.Params.FeeDenoms = []feemarkettypes.FeeDenom{feeDenom}
+			unitNetwork := network.NewUnitTestNetwork(
+				s.Create,
+				network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+				network.WithOtherDenoms([]string{altDenom}),
+				network.WithCustomGenesis(network.CustomGenesisState{feemarkettypes.ModuleName: feemarketGenesis}),
+			)
+			txFactory := factory.New(unitNetwork, grpc.NewIntegrationHandler(unitNetwork))
+
+			sender := keyring.GetKey(0)
+			recipient := keyring.GetAddr(1)
+			value := big.NewInt(100)
+
+			// the fee denom is selected by the sender through a signed Cosmos tx
+			if tc.accFeeDenom != "" {
+				res, err := txFactory.CommitCosmosTx(sender.Priv, basefactory.CosmosTxArgs{
+					Msgs: []sdk.Msg{feemarkettypes.NewMsgSetFeeDenom(sender.AccAddr, tc.accFeeDenom)},
+				})
+				s.Require().NoError(err)
+				s.Require().True(res.IsOK(), res.Log)
+			}
+
+			// Use an even gas price so that the converted fees are exact
+			gasPrice := new(big.Int).Mul(unitNetwork.App.GetEVMKeeper().GetBaseFee(unitNetwork.GetContext()), big.NewInt(2))
+			msg, err := txFactory.GenerateSignedMsgEthereumTx(sender.Priv, types.EvmTxArgs{
+				To:       &recipient,
+				Amount:   value,
+				GasPrice: gasPrice,
+				GasLimit: 100_000,
+			})
+			s.Require().NoError(err)
+
+			var feeInfoDenom *feemarkettypes.FeeDenom
+			if tc.feeInfoInFeeDenom {
+				feeInfoDenom = &feeDenom
+			}
+			tx, err := msg.BuildTxWithFeeDenom(unitNetwork.App.GetTxConfig().NewTxBuilder(), types.GetEVMCoinDenom(), feeInfoDenom)
+			s.Require().NoError(err)
+			txBytes, err := unitNetwork.App.GetTxConfig().TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			ctx := unitNetwork.GetContext()
+			evmBalanceBefore := unitNetwork.App.GetEVMKeeper().GetBalance(ctx, sender.Addr).ToBig()
+			altBalanceBefore := unitNetwork.App.GetBankKeeper().GetBalance(ctx, sender.AccAddr, altDenom).Amount
+
+			blockRes, err := unitNetwork.NextBlockWithTxs(txBytes)
+			s.Require().NoError(err)
+			s.Require().Len(blockRes.TxResults, 1)
+			txRes := blockRes.TxResults[0]
+
+			if tc.expErr != "" {
+				s.Require().False(txRes.IsOK())
+				s.Require().Contains(txRes.Log, tc.expErr)
+				return
+			}
+			s.Require().True(txRes.IsOK(), txRes.Log)
+
+			res, err := txFactory.GetEvmTransactionResponseFromTxResult(*txRes)
+			s.Require().NoError(err)
+			s.Require().False(res.Failed(), res.VmError)
+
+			ctx = unitNetwork.GetContext()
+			evmBalanceAfter := unitNetwork.App.GetEVMKeeper().GetBalance(ctx, sender.Addr).ToBig()
+			altBalanceAfter := unitNetwork.App.GetBankKeeper().GetBalance(ctx, sender.AccAddr, altDenom).Amount
+
+			// Only the value is paid in the EVM denom
+			s.Require().Equal(value, new(big.Int).Sub(evmBalanceBefore, evmBalanceAfter))
+
+			// The fees of the gas used are paid in the fee denom, the leftover
+			// gas being refunded
+			fees := new(big.Int).Mul(new(big.Int).SetUint64(res.GasUsed), gasPrice)
+			expFees := types.ConvertAmountFrom18DecimalsToFeeDenom(fees, feeDenom, true)
+			s.Require().Equal(expFees.Amount, altBalanceBefore.Sub(altBalanceAfter))
+		})
+	}
+}
@@ -6,7 +6,11 @@ import (
 	"github.com/cosmos/evm/testutil/integration/evm/utils"
 	"github.com/cosmos/evm/x/vm/types"
 
+	sdkmath "cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -79,6 +83,157 @@ func (s *KeeperTestSuite) TestEthereumTx() {
 	s.EnableFeemarket = false
 }
 
+func (s *KeeperTestSuite) TestEthereumTxBatch() {
+	s.EnableFeemarket = true
+	defer func() { s.EnableFeemarket = false }()
+	s.SetupTest()
+	testCases := []struct {
+		name        string
+		msgCount    uint64
+		expectedErr error
+	}{
+		{
+			"success - failed tx in a single message cosmos tx is not reverted",
+			1,
+			nil,
+		},
+		{
+			"fail - failed tx in a batch reverts the batch",
+			2,
+			types.ErrBatchReverted,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// contract creation executing the INVALID opcode
+			args := types.EvmTxArgs{
+				GasLimit: 100000,
+				Input:    []byte{0xfe},
+			}
+			tx, err := s.Factory.GenerateSignedEthTx(s.Keyring.GetPrivKey(0), args)
+			s.Require().NoError(err)
+			msg := tx.GetMsgs()[0].(*types.MsgEthereumTx)
+
+			ctx := s.Network.GetContext()
+			s.Network.App.GetEVMKeeper().SetTxMsgCountTransient(ctx, tc.msgCount)
+
+			// Function to be tested
+			res, err := s.Network.App.GetEVMKeeper().EthereumTx(ctx, msg)
+			if tc.expectedErr != nil {
+				s.Require().Error(err)
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+			} else {
+				s.Require().NoError(err)
+				s.Require().True(res.Failed())
+			}
+
+			err = s.Network.NextBlock()
+			s.Require().NoError(err)
+		})
+	}
+}
+
+// TestEthereumTxBatchDelivery delivers cosmos txs containing multiple ethereum
+// txs through the ante handler and the EVM, and checks that a failed message
+// reverts the state changes of the whole batch.
+func (s *KeeperTestSuite) TestEthereumTxBatchDelivery() {
+	s.EnableFeemarket = true
+	defer func() { s.EnableFeemarket = false }()
+
+	value := big.NewInt(1000)
+	testCases := []struct {
+		name       string
+		failLast   bool
+		expPass    bool
+		expBalance *big.Int
+	}{
+		{
+			"pass - all the ethereum txs of the batch are applied",
+			false,
+			true,
+			new(big.Int).Mul(value, big.NewInt(2)),
+		},
+		{
+			"fail - a failed ethereum tx reverts the whole batch",
+			true,
+			false,
+			big.NewInt(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			sender := s.Keyring.GetKey(0)
+			recipient := s.Keyring.GetAddr(1)
+
+			ctx := s.Network.GetContext()
+			nonce := s.Network.App.GetEVMKeeper().GetNonce(ctx, sender.Addr)
+			recipientBalanceBefore := s.Network.App.GetEVMKeeper().GetBalance(ctx, recipient).ToBig()
+			gasPrice := new(big.Int).Mul(s.Network.App.GetEVMKeeper().GetBaseFee(ctx), big.NewInt(2))
+
+			argsList := []types.EvmTxArgs{
+				{Nonce: nonce, To: &recipient, Amount: value, GasPrice: gasPrice, GasLimit: 21_000},
+				{Nonce: nonce + 1, To: &recipient, Amount: value, GasPrice: gasPrice, GasLimit: 21_000},
+			}
+			if tc.failLast {
+				// contract creation executing the INVALID opcode
+				argsList[1] = types.EvmTxArgs{Nonce: nonce + 1, Input: []byte{0xfe}, GasPrice: gasPrice, GasLimit: 100_000}
+			}
+
+			txConfig := s.Network.App.GetTxConfig()
+			builder, ok := txConfig.NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+			s.Require().True(ok)
+			option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionsEthereumTx{})
+			s.Require().NoError(err)
+			builder.SetExtensionOptions(option)
+
+			msgs := make([]sdktypes.Msg, 0, len(argsList))
+			fees := sdktypes.Coins{}
+			gasLimit := uint64(0)
+			for i, args := range argsList {
+				msg, err := s.Factory.GenerateSignedMsgEthereumTx(sender.Priv, args)
+				s.Require().NoError(err)
+				s.Require().Equal(nonce+uint64(i), msg.AsTransaction().Nonce())
+				msgs = append(msgs, &msg)
+				fees = fees.Add(sdktypes.NewCoin(types.GetEVMCoinDenom(), sdkmath.NewIntFromBigInt(msg.GetFee())))
+				gasLimit += msg.GetGas()
+			}
+			s.Require().NoError(builder.SetMsgs(msgs...))
+			builder.SetFeeAmount(types.ConvertCoinsDenomToExtendedDenom(fees))
+			builder.SetGasLimit(gasLimit)
+
+			txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+			s.Require().NoError(err)
+
+			blockRes, err := s.Network.NextBlockWithTxs(txBytes)
+			s.Require().NoError(err)
+			s.Require().Len(blockRes.TxResults, 1)
+			txRes := blockRes.TxResults[0]
+
+			if tc.expPass {
+				s.Require().True(txRes.IsOK(), txRes.Log)
+
+				var txData sdktypes.TxMsgData
+				s.Require().NoError(s.Network.App.AppCodec().Unmarshal(txRes.Data, &txData))
+				s.Require().Len(txData.MsgResponses, len(argsList))
+			} else {
+				s.Require().False(txRes.IsOK())
+				s.Require().Contains(txRes.Log, types.ErrBatchReverted.Error())
+			}
+
+			ctx = s.Network.GetContext()
+			recipientBalanceAfter := s.Network.App.GetEVMKeeper().GetBalance(ctx, recipient).ToBig()
+			s.Require().Equal(tc.expBalance.String(), new(big.Int).Sub(recipientBalanceAfter, recipientBalanceBefore).String())
+
+			// the nonces are incremented by the ante handler, whose state
+			// changes are kept when the batch is reverted
+			s.Require().Equal(nonce+uint64(len(argsList)), s.Network.App.GetEVMKeeper().GetNonce(ctx, sender.Addr))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestUpdateParams() {
 	s.SetupTest()
 	testCases := []struct {
@@ -12,12 +12,14 @@ import (
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
+	erc721types "github.com/cosmos/evm/x/erc721/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/gogoproto/proto"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -54,6 +56,8 @@ type defaultGenesisParams struct {
 var genesisSetupFunctions = map[string]genSetupFn{
 	evmtypes.ModuleName:       genStateSetter[*evmtypes.GenesisState](evmtypes.ModuleName),
 	erc20types.ModuleName:     genStateSetter[*erc20types.GenesisState](erc20types.ModuleName),
+	erc721types.ModuleName:    genStateSetter[*erc721types.GenesisState](erc721types.ModuleName),
+	nft.ModuleName:            genStateSetter[*nft.GenesisState](nft.ModuleName),
 	govtypes.ModuleName:       genStateSetter[*govtypesv1.GenesisState](govtypes.ModuleName),
 	feemarkettypes.ModuleName: genStateSetter[*feemarkettypes.GenesisState](feemarkettypes.ModuleName),
 	distrtypes.ModuleName:     genStateSetter[*distrtypes.GenesisState](distrtypes.ModuleName),
@@ -24,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
+		GetTokenPairSupplyCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -98,6 +99,38 @@ func GetTokenPairCmd() *cobra.Command {
 	return cmd
 }
 
+// GetTokenPairSupplyCmd queries the supply of a registered token pair
+func GetTokenPairSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-pair-supply TOKEN",
+		Short: "Get the supply held on both sides of a registered token pair",
+		Long:  "Get the supply held on both sides of a registered token pair and their difference",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTokenPairSupplyRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.TokenPairSupply(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParamsCmd queries erc20 module params
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSupplyChecksPerBlock is the maximum number of token pairs whose supply
+// parity is checked on each EndBlock.
+const MaxSupplyChecksPerBlock = 10
+
+// EndBlock disables the conversions of the enabled token pairs whose supply
+// parity is broken, e.g. by malicious or fee-on-transfer ERC20 contracts. The
+// conversions can be enabled again through governance once the pair is fixed.
+//
+// The pairs are checked in a round robin, starting after the last pair visited
+// on the previous block, so that at most MaxSupplyChecksPerBlock EVM queries
+// are executed per block. Native coin pairs backed by precompiles read their
+// supply from the bank module and are always in parity, so they are skipped.
+func (k Keeper) EndBlock(ctx sdk.Context) error {
+	// Gas costs are handled within msg handler so costs should be ignored.
+	// The EVM queries are bounded by the supply query gas cap.
+	infCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	params := k.GetParams(infCtx)
+	var pairs []types.TokenPair
+	lastID := k.iterateTokenPairsFromCursor(infCtx, func(pair types.TokenPair) (stop bool) {
+		if !pair.Enabled || isPrecompilePair(params, pair) {
+			return false
+		}
+
+		pairs = append(pairs, pair)
+		return len(pairs) == MaxSupplyChecksPerBlock
+	})
+	k.setSupplyCheckCursor(infCtx, lastID)
+
+	for _, pair := range pairs {
+		err := k.checkSupplyParity(infCtx, pair)
+		switch {
+		case err == nil:
+			continue
+		case !errors.Is(err, types.ErrSupplyParity):
+			// the supply can't be queried, e.g. on selfdestructed contracts, which
+			// already fail on conversions
+			k.Logger(ctx).Debug(
+				"failed to check token pair supply parity",
+				"contract", pair.Erc20Address,
+				"error", err.Error(),
+			)
+			continue
+		}
+
+		pair.Enabled = false
+		k.SetTokenPair(infCtx, pair)
+
+		k.Logger(ctx).Error(
+			"disabled token pair conversion",
+			"denom", pair.Denom,
+			"contract", pair.Erc20Address,
+			"error", err.Error(),
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSupplyParityBroken,
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// iterateTokenPairsFromCursor iterates over the stored token pairs once,
+// starting after the supply check cursor and wrapping around to the first
+// pair. It returns the id of the last visited pair.
+func (k Keeper) iterateTokenPairsFromCursor(ctx sdk.Context, cb func(tokenPair types.TokenPair) (stop bool)) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	cursor := k.getSupplyCheckCursor(ctx)
+
+	var (
+		start  []byte
+		lastID = cursor
+	)
+	if cursor != nil {
+		// the cursor pair was already visited on the previous block
+		start = append(append([]byte{}, cursor...), 0x00)
+	}
+
+	ranges := [][2][]byte{{start, nil}}
+	if start != nil {
+		ranges = append(ranges, [2][]byte{nil, start})
+	}
+
+	for _, r := range ranges {
+		iterator := store.Iterator(r[0], r[1])
+		stop := false
+		for ; iterator.Valid() && !stop; iterator.Next() {
+			var tokenPair types.TokenPair
+			k.cdc.MustUnmarshal(iterator.Value(), &tokenPair)
+
+			lastID = append([]byte{}, iterator.Key()...)
+			stop = cb(tokenPair)
+		}
+		iterator.Close()
+
+		if stop {
+			break
+		}
+	}
+
+	return lastID
+}
+
+// getSupplyCheckCursor returns the id of the last token pair visited by the
+// EndBlock supply checks.
+func (k Keeper) getSupplyCheckCursor(ctx sdk.Context) []byte {
+	return ctx.KVStore(k.storeKey).Get(types.KeySupplyCheckCursor)
+}
+
+// setSupplyCheckCursor stores the id of the last token pair visited by the
+// EndBlock supply checks.
+func (k Keeper) setSupplyCheckCursor(ctx sdk.Context, id []byte) {
+	store := ctx.KVStore(k.storeKey)
+	if len(id) == 0 {
+		store.Delete(types.KeySupplyCheckCursor)
+		return
+	}
+	store.Set(types.KeySupplyCheckCursor, id)
+}
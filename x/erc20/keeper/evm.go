@@ -152,3 +152,27 @@ func (k Keeper) monitorApprovalEvent(res *evmtypes.MsgEthereumTxResponse) error
 
 	return nil
 }
+
+// TotalSupply queries the total supply of a given ERC20 contract
+func (k Keeper) TotalSupply(
+	ctx sdk.Context,
+	abi abi.ABI,
+	contract common.Address,
+) *big.Int {
+	res, err := k.evmKeeper.CallEVM(ctx, abi, types.ModuleAddress, contract, false, nil, "totalSupply")
+	if err != nil {
+		return nil
+	}
+
+	unpacked, err := abi.Unpack("totalSupply", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil
+	}
+
+	supply, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil
+	}
+
+	return supply
+}
@@ -78,6 +78,32 @@ func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (
 	return &types.QueryTokenPairResponse{TokenPair: pair}, nil
 }
 
+// TokenPairSupply returns the amounts held on both sides of a registered token
+// pair and their difference
+func (k Keeper) TokenPairSupply(c context.Context, req *types.QueryTokenPairSupplyRequest) (*types.QueryTokenPairSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	res, err := k.TokenPair(c, &types.QueryTokenPairRequest{Token: req.Token})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	erc20Amount, coinAmount, err := k.GetTokenPairSupply(ctx, res.TokenPair)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenPairSupplyResponse{
+		TokenPair:   res.TokenPair,
+		Erc20Amount: erc20Amount,
+		CoinAmount:  coinAmount,
+		Delta:       erc20Amount.Sub(coinAmount),
+	}, nil
+}
+
 // Params returns the params of the erc20 module
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the erc20 module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) { //nolint:staticcheck
+	ir.RegisterRoute(types.ModuleName, "token-pair-supply", TokenPairSupplyInvariant(k))
+}
+
+// AllInvariants runs all invariants of the erc20 module.
+func AllInvariants(k Keeper) sdk.Invariant { //nolint:staticcheck
+	return func(ctx sdk.Context) (string, bool) {
+		return TokenPairSupplyInvariant(k)(ctx)
+	}
+}
+
+// TokenPairSupplyInvariant checks that the circulating side of every enabled
+// token pair is fully backed by the other side. Pairs disabled by the EndBlock
+// circuit breaker and pairs whose supply can't be queried are skipped.
+func TokenPairSupplyInvariant(k Keeper) sdk.Invariant { //nolint:staticcheck
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		for _, pair := range k.GetTokenPairs(ctx) {
+			if !pair.Enabled {
+				continue
+			}
+
+			if err := k.checkSupplyParity(ctx, pair); errors.Is(err, types.ErrSupplyParity) {
+				broken++
+				msg += fmt.Sprintf("\t%s (%s): %s\n", pair.Denom, pair.Erc20Address, err)
+			}
+		}
+
+		return sdk.FormatInvariant( //nolint:staticcheck
+			types.ModuleName, "token-pair-supply",
+			fmt.Sprintf("amount of token pairs with broken supply parity: %d\n%s", broken, msg),
+		), broken != 0
+	}
+}
@@ -386,6 +386,93 @@ func (k *Keeper) ToggleConversion(goCtx context.Context, req *types.MsgToggleCon
 	return &types.MsgToggleConversionResponse{}, nil
 }
 
+// UpdateCoinMetadata implements the gRPC MsgServer interface. After a successful
+// governance vote it overrides the bank metadata of the Cosmos coin of a registered
+// token pair.
+func (k *Keeper) UpdateCoinMetadata(goCtx context.Context, req *types.MsgUpdateCoinMetadata) (*types.MsgUpdateCoinMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.updateCoinMetadata(ctx, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateCoinMetadata,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgUpdateCoinMetadataResponse{}, nil
+}
+
+// MigrateTokenPair implements the gRPC MsgServer interface. After a successful
+// governance vote it moves a native Cosmos coin token pair to a new ERC20
+// precompile address.
+func (k *Keeper) MigrateTokenPair(goCtx context.Context, req *types.MsgMigrateTokenPair) (*types.MsgMigrateTokenPairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Check if the conversion is globally enabled
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("token pair migration is currently disabled by governance")
+	}
+
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	oldPair, newPair, err := k.migrateTokenPair(ctx, req.Token, common.HexToAddress(req.NewErc20Address))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMigrateTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, newPair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, oldPair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyNewERC20Token, newPair.Erc20Address),
+		),
+	)
+
+	return &types.MsgMigrateTokenPairResponse{}, nil
+}
+
+// DeregisterTokenPair implements the gRPC MsgServer interface. After a successful
+// governance vote it returns the escrowed tokens of an external ERC20 token pair
+// to the holders of its Cosmos coin and removes the token pair.
+func (k *Keeper) DeregisterTokenPair(goCtx context.Context, req *types.MsgDeregisterTokenPair) (*types.MsgDeregisterTokenPairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Check if the conversion is globally enabled
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("token pair deregistration is currently disabled by governance")
+	}
+
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.deregisterTokenPair(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgDeregisterTokenPairResponse{}, nil
+}
+
 // validateAuthority is a helper function to validate that the provided authority
 // is the keeper's authority address
 func (k *Keeper) validateAuthority(authority string) error {
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NOTE: Nonces and used authorizations are not removed when a token pair is deleted
+// so that signatures can't be replayed if the token pair is registered again.
+
+// GetNonce returns the current EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address.
+func (k Keeper) GetNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNonce)
+	bz := store.Get(types.NonceKey(erc20, owner))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// UseNonce returns the current EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address and increments it.
+func (k Keeper) UseNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+) uint64 {
+	nonce := k.GetNonce(ctx, erc20, owner)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNonce)
+	store.Set(types.NonceKey(erc20, owner), sdk.Uint64ToBigEndian(nonce+1))
+
+	return nonce
+}
+
+// IsAuthorizationUsed returns true if the EIP-3009 authorization with the given
+// nonce of the authorizer was already used or canceled on the given erc20
+// precompile address.
+func (k Keeper) IsAuthorizationUsed(
+	ctx sdk.Context,
+	erc20 common.Address,
+	authorizer common.Address,
+	nonce common.Hash,
+) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAuthorization)
+	return store.Has(types.AuthorizationKey(erc20, authorizer, nonce))
+}
+
+// SetAuthorizationUsed marks the EIP-3009 authorization with the given nonce of
+// the authorizer as used on the given erc20 precompile address.
+func (k Keeper) SetAuthorizationUsed(
+	ctx sdk.Context,
+	erc20 common.Address,
+	authorizer common.Address,
+	nonce common.Hash,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAuthorization)
+	store.Set(types.AuthorizationKey(erc20, authorizer, nonce), []byte{1})
+}
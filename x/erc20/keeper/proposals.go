@@ -5,6 +5,7 @@ package keeper
 import (
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/erc20/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -133,3 +134,219 @@ func (k Keeper) toggleConversion(
 	k.SetTokenPair(ctx, pair)
 	return pair, nil
 }
+
+// getRegisteredTokenPair returns the token pair registered for the given token,
+// which can be either the hex address of the ERC20 or the Cosmos denomination.
+func (k Keeper) getRegisteredTokenPair(ctx sdk.Context, token string) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+	return pair, nil
+}
+
+// updateCoinMetadata overrides the bank metadata of the Cosmos coin of a
+// registered token pair
+func (k Keeper) updateCoinMetadata(
+	ctx sdk.Context,
+	metadata banktypes.Metadata,
+) (types.TokenPair, error) {
+	pair, err := k.getRegisteredTokenPair(ctx, metadata.Base)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return types.TokenPair{}, errorsmod.Wrapf(err, "invalid metadata for coin %s", metadata.Base)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return pair, nil
+}
+
+// migrateTokenPair moves the native Cosmos coin token pair of the given token
+// to a new ERC20 precompile address. The balances are kept on the bank module,
+// so only the precompile registration and the ERC20 allowances are moved.
+func (k Keeper) migrateTokenPair(
+	ctx sdk.Context,
+	token string,
+	newContract common.Address,
+) (oldPair, newPair types.TokenPair, err error) {
+	oldPair, err = k.getRegisteredTokenPair(ctx, token)
+	if err != nil {
+		return types.TokenPair{}, types.TokenPair{}, err
+	}
+
+	if !oldPair.IsNativeCoin() {
+		return types.TokenPair{}, types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrUndefinedOwner, "only native coin token pairs can be migrated: %s", oldPair.Erc20Address,
+		)
+	}
+
+	if k.IsERC20Registered(ctx, newContract) {
+		return types.TokenPair{}, types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "token ERC20 contract already registered: %s", newContract,
+		)
+	}
+
+	evmParams := k.evmKeeper.GetParams(ctx)
+	if k.evmKeeper.IsAvailableStaticPrecompile(&evmParams, newContract) {
+		return types.TokenPair{}, types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrInternalTokenPair, "address %s is a static precompile", newContract,
+		)
+	}
+
+	if acc := k.evmKeeper.GetAccountWithoutBalance(ctx, newContract); acc != nil && acc.IsContract() {
+		return types.TokenPair{}, types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrInternalTokenPair, "address %s already has code", newContract,
+		)
+	}
+
+	oldContract := oldPair.GetERC20Contract()
+	params := k.GetParams(ctx)
+	switch {
+	case params.IsNativePrecompile(oldContract):
+		params.NativePrecompiles = replacePrecompile(params.NativePrecompiles, oldContract, newContract)
+	case params.IsDynamicPrecompile(oldContract):
+		params.DynamicPrecompiles = replacePrecompile(params.DynamicPrecompiles, oldContract, newContract)
+	default:
+		return types.TokenPair{}, types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrInternalTokenPair, "token pair ERC20 is not a precompile: %s", oldContract,
+		)
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return types.TokenPair{}, types.TokenPair{}, err
+	}
+
+	// keep the allowances of the old precompile, they are removed together
+	// with the old token pair
+	var allowances []types.Allowance
+	k.IterateAllowances(ctx, func(allowance types.Allowance) (stop bool) {
+		if common.HexToAddress(allowance.Erc20Address) == oldContract {
+			allowances = append(allowances, allowance)
+		}
+		return false
+	})
+
+	k.DeleteTokenPair(ctx, oldPair)
+
+	newPair = types.NewTokenPair(newContract, oldPair.Denom, types.OWNER_MODULE)
+	newPair.Enabled = oldPair.Enabled
+	k.SetToken(ctx, newPair)
+
+	for _, allowance := range allowances {
+		owner := common.HexToAddress(allowance.Owner)
+		spender := common.HexToAddress(allowance.Spender)
+		if err := k.UnsafeSetAllowance(ctx, newContract, owner, spender, allowance.Value.BigInt()); err != nil {
+			return types.TokenPair{}, types.TokenPair{}, err
+		}
+	}
+
+	if err := k.UnRegisterERC20CodeHash(ctx, oldContract); err != nil {
+		return types.TokenPair{}, types.TokenPair{}, err
+	}
+
+	if err := k.RegisterERC20CodeHash(ctx, newContract); err != nil {
+		return types.TokenPair{}, types.TokenPair{}, err
+	}
+
+	return oldPair, newPair, nil
+}
+
+// deregisterTokenPair removes the token pair of an external ERC20 contract.
+// The escrowed ERC20 tokens are first returned to the holders of the Cosmos
+// coins and the coins are burned, so the escrow must back the coin supply
+// before and after the deregistration.
+//
+// Module accounts track their balances on their own state and can't use the
+// returned ERC20 tokens, so the deregistration is rejected while they hold
+// any of the coins.
+func (k Keeper) deregisterTokenPair(
+	ctx sdk.Context,
+	token string,
+) (types.TokenPair, error) {
+	pair, err := k.getRegisteredTokenPair(ctx, token)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if !pair.IsNativeERC20() {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairOwnedByModule, "only external ERC20 token pairs can be deregistered: %s", pair.Erc20Address,
+		)
+	}
+
+	if err := k.checkSupplyParity(ctx, pair); err != nil {
+		return types.TokenPair{}, errorsmod.Wrap(err, "before deregistration")
+	}
+
+	// coins sent to other chains can't be returned to their owners
+	if escrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, pair.Denom); escrow.IsPositive() {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrInvalidIBC, "%s escrowed on IBC channels", escrow,
+		)
+	}
+
+	owners, err := k.getDenomOwners(ctx, pair.Denom)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	holders := make([]sdk.AccAddress, len(owners))
+	for i, owner := range owners {
+		holders[i], err = k.accountKeeper.AddressCodec().StringToBytes(owner.Address)
+		if err != nil {
+			return types.TokenPair{}, err
+		}
+
+		if types.IsModuleAccount(k.accountKeeper.GetAccount(ctx, holders[i])) {
+			return types.TokenPair{}, errorsmod.Wrapf(
+				types.ErrModuleAccountHolder, "%s held by %s", owner.Balance, owner.Address,
+			)
+		}
+	}
+
+	for i, owner := range owners {
+		if err := k.ConvertCoinNativeERC20(ctx, pair, owner.Balance.Amount, common.BytesToAddress(holders[i]), holders[i]); err != nil {
+			return types.TokenPair{}, errorsmod.Wrapf(err, "failed to return ERC20 tokens to %s", owner.Address)
+		}
+	}
+
+	if err := k.checkSupplyParity(ctx, pair); err != nil {
+		return types.TokenPair{}, errorsmod.Wrap(err, "after deregistration")
+	}
+
+	if supply := k.bankKeeper.GetSupply(ctx, pair.Denom); supply.IsPositive() {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrSupplyParity, "%s left after deregistration", supply,
+		)
+	}
+
+	k.DeleteTokenPair(ctx, pair)
+	return pair, nil
+}
+
+// replacePrecompile replaces the old address by the new one on the given
+// precompiles and sorts the result.
+func replacePrecompile(precompiles []string, oldAddr, newAddr common.Address) []string {
+	updated := make([]string, 0, len(precompiles))
+	for _, precompile := range precompiles {
+		if common.HexToAddress(precompile) == oldAddr {
+			precompile = newAddr.Hex()
+		}
+		updated = append(updated, precompile)
+	}
+
+	utils.SortSlice(updated)
+	return updated
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/x/erc20/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// supplyQueryGasCap is the gas limit of the ERC20 queries of the supply
+// checks. The standard balanceOf and totalSupply methods only need a few
+// thousand gas, so the limit bounds the cost of malicious contracts.
+const supplyQueryGasCap = 100_000
+
+// GetTokenPairSupply returns the amounts held on both sides of a token pair:
+//   - external ERC20: the ERC20 balance escrowed on the module account and the
+//     bank supply of the coin
+//   - native Cosmos coin: the ERC20 total supply and the coins backing it,
+//     which is the bank supply for precompiles and the coins escrowed on the
+//     module account for deployed contracts
+func (k Keeper) GetTokenPairSupply(ctx sdk.Context, pair types.TokenPair) (erc20Amount, coinAmount math.Int, err error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+
+	switch {
+	case pair.IsNativeERC20():
+		escrowed := k.queryUint256(ctx, erc20, contract, "balanceOf", types.ModuleAddress)
+		if escrowed == nil {
+			return math.Int{}, math.Int{}, errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+		}
+		return math.NewIntFromBigInt(escrowed), k.bankKeeper.GetSupply(ctx, pair.Denom).Amount, nil
+	case pair.IsNativeCoin():
+		supply := k.queryUint256(ctx, erc20, contract, "totalSupply")
+		if supply == nil {
+			return math.Int{}, math.Int{}, errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve total supply")
+		}
+
+		if isPrecompilePair(k.GetParams(ctx), pair) {
+			return math.NewIntFromBigInt(supply), k.bankKeeper.GetSupply(ctx, pair.Denom).Amount, nil
+		}
+		return math.NewIntFromBigInt(supply), k.bankKeeper.GetBalance(ctx, types.ModuleAddress.Bytes(), pair.Denom).Amount, nil
+	default:
+		return math.Int{}, math.Int{}, types.ErrUndefinedOwner
+	}
+}
+
+// queryUint256 calls a view method of an ERC20 contract that returns a single
+// uint256, with the supply query gas cap. It returns nil if the call fails.
+func (k Keeper) queryUint256(
+	ctx sdk.Context,
+	abi abi.ABI,
+	contract common.Address,
+	method string,
+	args ...interface{},
+) *big.Int {
+	res, err := k.evmKeeper.CallEVM(ctx, abi, types.ModuleAddress, contract, false, big.NewInt(supplyQueryGasCap), method, args...)
+	if err != nil {
+		return nil
+	}
+
+	unpacked, err := abi.Unpack(method, res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil
+	}
+
+	value, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil
+	}
+	return value
+}
+
+// isPrecompilePair returns true if the ERC20 of a native Cosmos coin token pair
+// is a precompile, which reads the balances and supply from the bank module.
+func isPrecompilePair(params types.Params, pair types.TokenPair) bool {
+	contract := pair.GetERC20Contract()
+	return pair.IsNativeCoin() && (params.IsNativePrecompile(contract) || params.IsDynamicPrecompile(contract))
+}
+
+// checkSupplyParity returns an error if the circulating side of a token pair
+// is not fully backed by the other side. Tokens sent directly to the module
+// account only over-collateralize the pair, so they don't break the parity.
+func (k Keeper) checkSupplyParity(ctx sdk.Context, pair types.TokenPair) error {
+	erc20Amount, coinAmount, err := k.GetTokenPairSupply(ctx, pair)
+	if err != nil {
+		return err
+	}
+
+	backed := erc20Amount.GTE(coinAmount)
+	if pair.IsNativeCoin() {
+		backed = coinAmount.GTE(erc20Amount)
+	}
+
+	if !backed {
+		return errorsmod.Wrapf(
+			types.ErrSupplyParity,
+			"%s: ERC20 amount %s, coin amount %s", pair.Erc20Address, erc20Amount, coinAmount,
+		)
+	}
+	return nil
+}
+
+// getDenomOwners returns all the accounts that hold a balance of the given
+// denomination.
+func (k Keeper) getDenomOwners(ctx sdk.Context, denom string) ([]*banktypes.DenomOwner, error) {
+	var (
+		owners  []*banktypes.DenomOwner
+		nextKey []byte
+	)
+
+	for {
+		res, err := k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
+			Denom:      denom,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		owners = append(owners, res.DenomOwners...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return owners, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
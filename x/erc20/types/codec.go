@@ -29,6 +29,9 @@ const (
 	updateParams     = "cosmos/evm/erc20/MsgUpdateParams"
 	registerERC20    = "cosmos/evm/erc20/MsgRegisterERC20"
 	toggleConversion = "cosmos/evm/erc20/MsgToggleConversion"
+	updateCoinMeta   = "cosmos/evm/erc20/MsgUpdateCoinMetadata"
+	migrateTokenPair = "cosmos/evm/erc20/MsgMigrateTokenPair"
+	deregisterPair   = "cosmos/evm/erc20/MsgDeregisterTokenPair"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -46,6 +49,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgRegisterERC20{},
 		&MsgToggleConversion{},
+		&MsgUpdateCoinMetadata{},
+		&MsgMigrateTokenPair{},
+		&MsgDeregisterTokenPair{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -66,4 +72,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
 	cdc.RegisterConcrete(&MsgRegisterERC20{}, registerERC20, nil)
 	cdc.RegisterConcrete(&MsgToggleConversion{}, toggleConversion, nil)
+	cdc.RegisterConcrete(&MsgUpdateCoinMetadata{}, updateCoinMeta, nil)
+	cdc.RegisterConcrete(&MsgMigrateTokenPair{}, migrateTokenPair, nil)
+	cdc.RegisterConcrete(&MsgDeregisterTokenPair{}, deregisterPair, nil)
 }
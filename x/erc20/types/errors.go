@@ -24,4 +24,6 @@ var (
 	ErrAllowanceNotFound        = errorsmod.Register(ModuleName, 17, "allowance not found")
 	ErrInvalidAllowance         = errorsmod.Register(ModuleName, 18, "invalid allowance")
 	ErrNegativeToken            = errorsmod.Register(ModuleName, 19, "token amount is negative")
+	ErrSupplyParity             = errorsmod.Register(ModuleName, 20, "token pair supply parity broken")
+	ErrModuleAccountHolder      = errorsmod.Register(ModuleName, 21, "coins held by a module account")
 )
@@ -12,11 +12,17 @@ const (
 	EventTypeRegisterERC20          = "register_erc20"
 	EventTypeToggleTokenConversion  = "toggle_token_conversion" // #nosec
 	EventTypeRegisterERC20Extension = "register_erc20_extension"
+	EventTypeUpdateCoinMetadata     = "update_coin_metadata"
+	EventTypeMigrateTokenPair       = "migrate_token_pair"
+	EventTypeDeregisterTokenPair    = "deregister_token_pair"
+	EventTypeSupplyParityBroken     = "supply_parity_broken"
 
 	AttributeCoinSourceChannel = "source_channel"
 	AttributeKeyCosmosCoin     = "cosmos_coin"
 	AttributeKeyERC20Token     = "erc20_token" // #nosec
 	AttributeKeyReceiver       = "receiver"
+	AttributeKeyNewERC20Token  = "new_erc20_token" // #nosec
+	AttributeKeyError          = "error"
 )
 
 // LogTransfer Event type for Transfer(address from, address to, uint256 value)
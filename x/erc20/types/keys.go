@@ -32,6 +32,9 @@ const (
 	prefixTokenPairByDenom
 	prefixSTRv2Addresses
 	prefixAllowance
+	prefixNonce
+	prefixAuthorization
+	prefixSupplyCheckCursor
 )
 
 // KVStore key prefixes
@@ -41,6 +44,9 @@ var (
 	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
 	KeyPrefixSTRv2Addresses   = []byte{prefixSTRv2Addresses}
 	KeyPrefixAllowance        = []byte{prefixAllowance}
+	KeyPrefixNonce            = []byte{prefixNonce}
+	KeyPrefixAuthorization    = []byte{prefixAuthorization}
+	KeySupplyCheckCursor      = []byte{prefixSupplyCheckCursor}
 )
 
 func AllowanceKey(
@@ -50,3 +56,22 @@ func AllowanceKey(
 ) []byte {
 	return append(append(erc20.Bytes(), owner.Bytes()...), spender.Bytes()...)
 }
+
+// NonceKey returns the key of the EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address.
+func NonceKey(
+	erc20 common.Address,
+	owner common.Address,
+) []byte {
+	return append(erc20.Bytes(), owner.Bytes()...)
+}
+
+// AuthorizationKey returns the key of the EIP-3009 authorization with the given
+// nonce of the given authorizer on the given erc20 precompile address.
+func AuthorizationKey(
+	erc20 common.Address,
+	authorizer common.Address,
+	nonce common.Hash,
+) []byte {
+	return append(append(erc20.Bytes(), authorizer.Bytes()...), nonce.Bytes()...)
+}
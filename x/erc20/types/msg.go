@@ -1,10 +1,13 @@
 package types
 
 import (
+	"strings"
+
 	"github.com/ethereum/go-ethereum/common"
 	protov2 "google.golang.org/protobuf/proto"
 
 	erc20api "github.com/cosmos/evm/api/cosmos/evm/erc20/v1"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -19,11 +22,17 @@ var (
 	_ sdk.Msg              = &MsgUpdateParams{}
 	_ sdk.Msg              = &MsgRegisterERC20{}
 	_ sdk.Msg              = &MsgToggleConversion{}
+	_ sdk.Msg              = &MsgUpdateCoinMetadata{}
+	_ sdk.Msg              = &MsgMigrateTokenPair{}
+	_ sdk.Msg              = &MsgDeregisterTokenPair{}
 	_ sdk.HasValidateBasic = &MsgConvertERC20{}
 	_ sdk.HasValidateBasic = &MsgConvertCoin{}
 	_ sdk.HasValidateBasic = &MsgUpdateParams{}
 	_ sdk.HasValidateBasic = &MsgRegisterERC20{}
 	_ sdk.HasValidateBasic = &MsgToggleConversion{}
+	_ sdk.HasValidateBasic = &MsgUpdateCoinMetadata{}
+	_ sdk.HasValidateBasic = &MsgMigrateTokenPair{}
+	_ sdk.HasValidateBasic = &MsgDeregisterTokenPair{}
 )
 
 const (
@@ -122,6 +131,43 @@ func (m *MsgToggleConversion) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateCoinMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return m.Metadata.Validate()
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgMigrateTokenPair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if strings.TrimSpace(m.Token) == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "token cannot be blank")
+	}
+
+	if err := cosmosevmtypes.ValidateNonZeroAddress(m.NewErc20Address); err != nil {
+		return errorsmod.Wrapf(err, "invalid new ERC20 address %s", m.NewErc20Address)
+	}
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDeregisterTokenPair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if strings.TrimSpace(m.Token) == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "token cannot be blank")
+	}
+	return nil
+}
+
 // Route should return the name of the module
 func (msg MsgConvertCoin) Route() string { return RouterKey }
 
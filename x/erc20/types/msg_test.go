@@ -13,6 +13,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -279,3 +280,136 @@ func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgUpdateCoinMetadataValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	metadata := banktypes.Metadata{
+		Description: "test coin",
+		Base:        "acoin",
+		Display:     "coin",
+		Name:        "Coin",
+		Symbol:      "COIN",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "acoin", Exponent: 0},
+			{Denom: "coin", Exponent: 18},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateCoinMetadata
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgUpdateCoinMetadata{Authority: "invalid", Metadata: metadata},
+			false,
+		},
+		{
+			"fail - invalid metadata",
+			&types.MsgUpdateCoinMetadata{Authority: authority, Metadata: banktypes.Metadata{Base: "acoin"}},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgUpdateCoinMetadata{Authority: authority, Metadata: metadata},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgMigrateTokenPairValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	newAddress := utiltx.GenerateAddress().String()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgMigrateTokenPair
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgMigrateTokenPair{Authority: "invalid", Token: "acoin", NewErc20Address: newAddress},
+			false,
+		},
+		{
+			"fail - empty token",
+			&types.MsgMigrateTokenPair{Authority: authority, Token: " ", NewErc20Address: newAddress},
+			false,
+		},
+		{
+			"fail - invalid new ERC20 address",
+			&types.MsgMigrateTokenPair{Authority: authority, Token: "acoin", NewErc20Address: "0xinvalid"},
+			false,
+		},
+		{
+			"fail - zero new ERC20 address",
+			&types.MsgMigrateTokenPair{Authority: authority, Token: "acoin", NewErc20Address: common.Address{}.String()},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgMigrateTokenPair{Authority: authority, Token: "acoin", NewErc20Address: newAddress},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgDeregisterTokenPairValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgDeregisterTokenPair
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgDeregisterTokenPair{Authority: "invalid", Token: utiltx.GenerateAddress().String()},
+			false,
+		},
+		{
+			"fail - empty token",
+			&types.MsgDeregisterTokenPair{Authority: authority},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgDeregisterTokenPair{Authority: authority, Token: utiltx.GenerateAddress().String()},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -229,6 +230,113 @@ func (m *QueryTokenPairResponse) GetTokenPair() TokenPair {
 	return TokenPair{}
 }
 
+// QueryTokenPairSupplyRequest is the request type for the Query/TokenPairSupply
+// RPC method.
+type QueryTokenPairSupplyRequest struct {
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *QueryTokenPairSupplyRequest) Reset()         { *m = QueryTokenPairSupplyRequest{} }
+func (m *QueryTokenPairSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenPairSupplyRequest) ProtoMessage()    {}
+func (*QueryTokenPairSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{4}
+}
+func (m *QueryTokenPairSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenPairSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenPairSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenPairSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenPairSupplyRequest.Merge(m, src)
+}
+func (m *QueryTokenPairSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenPairSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenPairSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenPairSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryTokenPairSupplyRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// QueryTokenPairSupplyResponse is the response type for the
+// Query/TokenPairSupply RPC method.
+type QueryTokenPairSupplyResponse struct {
+	// token_pair is the registered token pair
+	TokenPair TokenPair `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair"`
+	// erc20_amount is the ERC20 side of the token pair. For external ERC20 token
+	// pairs it is the ERC20 balance escrowed on the module account, for native
+	// Cosmos coin token pairs it is the ERC20 total supply.
+	Erc20Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=erc20_amount,json=erc20Amount,proto3,customtype=cosmossdk.io/math.Int" json:"erc20_amount"`
+	// coin_amount is the Cosmos side of the token pair. For external ERC20 token
+	// pairs it is the bank supply of the coin, for native Cosmos coin token pairs
+	// it is the amount of coins backing the ERC20 tokens.
+	CoinAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=coin_amount,json=coinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"coin_amount"`
+	// delta is the ERC20 amount minus the coin amount. A negative delta on an
+	// external ERC20 token pair or a positive delta on a native Cosmos coin token
+	// pair means the circulating tokens are not fully backed.
+	Delta cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=delta,proto3,customtype=cosmossdk.io/math.Int" json:"delta"`
+}
+
+func (m *QueryTokenPairSupplyResponse) Reset()         { *m = QueryTokenPairSupplyResponse{} }
+func (m *QueryTokenPairSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenPairSupplyResponse) ProtoMessage()    {}
+func (*QueryTokenPairSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{5}
+}
+func (m *QueryTokenPairSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenPairSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenPairSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenPairSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenPairSupplyResponse.Merge(m, src)
+}
+func (m *QueryTokenPairSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenPairSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenPairSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenPairSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryTokenPairSupplyResponse) GetTokenPair() TokenPair {
+	if m != nil {
+		return m.TokenPair
+	}
+	return TokenPair{}
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -237,7 +345,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1630a6677a16bf4, []int{4}
+	return fileDescriptor_f1630a6677a16bf4, []int{6}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,7 +385,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1630a6677a16bf4, []int{5}
+	return fileDescriptor_f1630a6677a16bf4, []int{7}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,6 +426,8 @@ func init() {
 	proto.RegisterType((*QueryTokenPairsResponse)(nil), "cosmos.evm.erc20.v1.QueryTokenPairsResponse")
 	proto.RegisterType((*QueryTokenPairRequest)(nil), "cosmos.evm.erc20.v1.QueryTokenPairRequest")
 	proto.RegisterType((*QueryTokenPairResponse)(nil), "cosmos.evm.erc20.v1.QueryTokenPairResponse")
+	proto.RegisterType((*QueryTokenPairSupplyRequest)(nil), "cosmos.evm.erc20.v1.QueryTokenPairSupplyRequest")
+	proto.RegisterType((*QueryTokenPairSupplyResponse)(nil), "cosmos.evm.erc20.v1.QueryTokenPairSupplyResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.erc20.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.erc20.v1.QueryParamsResponse")
 }
@@ -325,40 +435,48 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/query.proto", fileDescriptor_f1630a6677a16bf4) }
 
 var fileDescriptor_f1630a6677a16bf4 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x3b, 0xbb, 0x6c, 0xa1, 0xaf, 0x27, 0x67, 0xab, 0x2e, 0x5d, 0xcd, 0xd6, 0x2c, 0xb8,
-	0xa1, 0xab, 0x33, 0xdb, 0x7a, 0xd6, 0xc3, 0x1e, 0x54, 0x3c, 0xd5, 0xa2, 0x17, 0x2f, 0x3a, 0x29,
-	0x43, 0x0c, 0x9a, 0x4c, 0x36, 0x33, 0x0d, 0x2e, 0x22, 0x88, 0x9f, 0x40, 0x11, 0xfc, 0x0c, 0x9e,
-	0xc4, 0x9b, 0x5f, 0x61, 0x8f, 0x05, 0x2f, 0x9e, 0x44, 0x5a, 0xc1, 0xaf, 0x21, 0x99, 0x99, 0xa6,
-	0x8d, 0x46, 0x5a, 0x2f, 0x25, 0xf3, 0xf8, 0xbf, 0xff, 0xff, 0xf7, 0xde, 0x0c, 0x85, 0xbd, 0x91,
-	0x90, 0x91, 0x90, 0x94, 0x67, 0x11, 0xe5, 0xe9, 0xa8, 0x7f, 0x44, 0xb3, 0x1e, 0x3d, 0x19, 0xf3,
-	0xf4, 0x94, 0x24, 0xa9, 0x50, 0x02, 0x6f, 0x1b, 0x01, 0xe1, 0x59, 0x44, 0xb4, 0x80, 0x64, 0xbd,
-	0xf6, 0x39, 0x16, 0x85, 0xb1, 0xa0, 0xfa, 0xd7, 0xe8, 0xda, 0x5d, 0x6b, 0xe4, 0x33, 0xc9, 0x8d,
-	0x01, 0xcd, 0x7a, 0x3e, 0x57, 0xac, 0x47, 0x13, 0x16, 0x84, 0x31, 0x53, 0xa1, 0x88, 0xad, 0xb6,
-	0x32, 0xd4, 0x98, 0x1b, 0xc1, 0x95, 0x2a, 0x41, 0xc0, 0x63, 0x2e, 0x43, 0x69, 0x25, 0xad, 0x40,
-	0x04, 0x42, 0x7f, 0xd2, 0xfc, 0xcb, 0x56, 0x2f, 0x05, 0x42, 0x04, 0xcf, 0x39, 0x65, 0x49, 0x48,
-	0x59, 0x1c, 0x0b, 0xa5, 0x63, 0x6d, 0x8f, 0xfb, 0x04, 0x2e, 0xdc, 0xcf, 0xc9, 0x1e, 0x88, 0x67,
-	0x3c, 0x1e, 0xb0, 0x30, 0x95, 0x43, 0x7e, 0x32, 0xe6, 0x52, 0xe1, 0xdb, 0x00, 0x0b, 0xca, 0x1d,
-	0xd4, 0x41, 0x5e, 0xb3, 0x7f, 0x95, 0xd8, 0xd1, 0xf3, 0x91, 0x88, 0xd9, 0x89, 0x1d, 0x89, 0x0c,
-	0x58, 0xc0, 0x6d, 0xef, 0x70, 0xa9, 0xd3, 0xfd, 0x84, 0xe0, 0xe2, 0x5f, 0x11, 0x32, 0x11, 0xb1,
-	0xe4, 0xf8, 0x1e, 0x34, 0x55, 0x5e, 0x7d, 0x9c, 0xe4, 0xe5, 0x1d, 0xd4, 0xd9, 0xf4, 0x9a, 0x7d,
-	0x87, 0x54, 0xec, 0x97, 0x14, 0xdd, 0xc7, 0x8d, 0xb3, 0xef, 0x7b, 0xb5, 0x8f, 0xbf, 0x3e, 0x77,
-	0xd1, 0x10, 0x54, 0xe1, 0x89, 0xef, 0x94, 0x78, 0x37, 0x34, 0xef, 0xc1, 0x4a, 0x5e, 0x03, 0x52,
-	0x02, 0xbe, 0x0e, 0xe7, 0xcb, 0xbc, 0xf3, 0x8d, 0xb4, 0x60, 0x4b, 0xe7, 0xe9, 0x65, 0x34, 0x86,
-	0xe6, 0xe0, 0xfa, 0x7f, 0x6e, 0xb0, 0x98, 0xee, 0x2e, 0xc0, 0x62, 0x3a, 0xbb, 0xc1, 0xff, 0x18,
-	0xae, 0x51, 0x0c, 0xe7, 0xb6, 0x00, 0xeb, 0x8c, 0x01, 0x4b, 0x59, 0x34, 0xbf, 0x21, 0xf7, 0x21,
-	0x6c, 0x97, 0xaa, 0x36, 0xf6, 0x16, 0xd4, 0x13, 0x5d, 0xb1, 0x91, 0xbb, 0x95, 0x91, 0xa6, 0x69,
-	0x39, 0xcf, 0x76, 0xf5, 0xbf, 0x6c, 0xc2, 0x96, 0xf6, 0xc5, 0xef, 0x10, 0xc0, 0xe2, 0xd6, 0xf0,
-	0x61, 0xa5, 0x51, 0xf5, 0xf3, 0x69, 0x5f, 0x5b, 0x4f, 0x6c, 0x98, 0x5d, 0xef, 0xcd, 0xd7, 0x9f,
-	0xef, 0x37, 0x5c, 0xdc, 0xa1, 0x55, 0xcf, 0x7c, 0xe9, 0x8d, 0xe0, 0x0f, 0x08, 0x1a, 0x85, 0x01,
-	0xee, 0xae, 0x91, 0x32, 0x27, 0x3a, 0x5c, 0x4b, 0x6b, 0x81, 0x8e, 0x34, 0x50, 0x17, 0x7b, 0xab,
-	0x80, 0xe8, 0x4b, 0x7d, 0x78, 0x85, 0x5f, 0x23, 0xa8, 0x9b, 0xa5, 0xe2, 0x83, 0x7f, 0x27, 0x95,
-	0x6e, 0xb0, 0xed, 0xad, 0x16, 0x5a, 0x9e, 0x7d, 0xcd, 0x73, 0x19, 0xef, 0x56, 0xf2, 0x98, 0x9b,
-	0x3b, 0xbe, 0x79, 0x36, 0x75, 0xd0, 0x64, 0xea, 0xa0, 0x1f, 0x53, 0x07, 0xbd, 0x9d, 0x39, 0xb5,
-	0xc9, 0xcc, 0xa9, 0x7d, 0x9b, 0x39, 0xb5, 0x47, 0xfb, 0x41, 0xa8, 0x9e, 0x8e, 0x7d, 0x32, 0x12,
-	0xd1, 0xb2, 0xc1, 0x0b, 0x6b, 0xa1, 0x4e, 0x13, 0x2e, 0xfd, 0xba, 0xfe, 0x4b, 0xb8, 0xf1, 0x3b,
-	0x00, 0x00, 0xff, 0xff, 0xdc, 0xca, 0x86, 0x63, 0x01, 0x05, 0x00, 0x00,
+	// 647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0x3b, 0xfc, 0x4b, 0xfa, 0xd6, 0xc4, 0x38, 0x80, 0x92, 0x02, 0x0b, 0x2e, 0x89, 0x34,
+	0x45, 0x67, 0xd8, 0x72, 0xf0, 0x24, 0x51, 0x0e, 0xfe, 0x3b, 0x61, 0xd5, 0x8b, 0x17, 0x9c, 0x96,
+	0xc9, 0xb2, 0x81, 0xdd, 0x59, 0x3a, 0xd3, 0x46, 0x62, 0x4c, 0x8c, 0x9f, 0x40, 0x63, 0xe2, 0xd5,
+	0xab, 0x27, 0xf5, 0x63, 0x70, 0x24, 0xf1, 0x62, 0x3c, 0x10, 0x03, 0x26, 0xc6, 0x6f, 0x61, 0x76,
+	0x66, 0x5a, 0xba, 0xb8, 0xda, 0x25, 0xf1, 0x42, 0xda, 0xc9, 0xf3, 0x3c, 0xef, 0xef, 0x7d, 0x32,
+	0x43, 0x61, 0xae, 0x29, 0x64, 0x28, 0x24, 0xe5, 0x9d, 0x90, 0xf2, 0x56, 0xb3, 0xb6, 0x4c, 0x3b,
+	0x1e, 0xdd, 0x6d, 0xf3, 0xd6, 0x1e, 0x89, 0x5b, 0x42, 0x09, 0x3c, 0x6e, 0x04, 0x84, 0x77, 0x42,
+	0xa2, 0x05, 0xa4, 0xe3, 0x95, 0x2f, 0xb0, 0x30, 0x88, 0x04, 0xd5, 0x7f, 0x8d, 0xae, 0x5c, 0xb5,
+	0x41, 0x0d, 0x26, 0xb9, 0x09, 0xa0, 0x1d, 0xaf, 0xc1, 0x15, 0xf3, 0x68, 0xcc, 0xfc, 0x20, 0x62,
+	0x2a, 0x10, 0x91, 0xd5, 0x66, 0x0e, 0x35, 0xe1, 0x46, 0x70, 0x39, 0x4b, 0xe0, 0xf3, 0x88, 0xcb,
+	0x40, 0x5a, 0xc9, 0x84, 0x2f, 0x7c, 0xa1, 0x3f, 0xd2, 0xe4, 0x93, 0x3d, 0x9d, 0xf1, 0x85, 0xf0,
+	0x77, 0x38, 0x65, 0x71, 0x40, 0x59, 0x14, 0x09, 0xa5, 0xc7, 0x5a, 0x8f, 0xfb, 0x14, 0x2e, 0x3e,
+	0x48, 0xc8, 0x1e, 0x89, 0x6d, 0x1e, 0xad, 0xb3, 0xa0, 0x25, 0xeb, 0x7c, 0xb7, 0xcd, 0xa5, 0xc2,
+	0xb7, 0x01, 0x4e, 0x28, 0xa7, 0xd0, 0x3c, 0xaa, 0x94, 0x6a, 0x57, 0x88, 0x5d, 0x3d, 0x59, 0x89,
+	0x98, 0x4e, 0xec, 0x4a, 0x64, 0x9d, 0xf9, 0xdc, 0x7a, 0xeb, 0x7d, 0x4e, 0xf7, 0x23, 0x82, 0x4b,
+	0x7f, 0x8c, 0x90, 0xb1, 0x88, 0x24, 0xc7, 0xf7, 0xa1, 0xa4, 0x92, 0xd3, 0x8d, 0x38, 0x39, 0x9e,
+	0x42, 0xf3, 0xc3, 0x95, 0x52, 0xcd, 0x21, 0x19, 0xfd, 0x92, 0x9e, 0x7b, 0xad, 0xb8, 0x7f, 0x38,
+	0x57, 0xf8, 0xf0, 0xf3, 0x73, 0x15, 0xd5, 0x41, 0xf5, 0x32, 0xf1, 0x9d, 0x14, 0xef, 0x90, 0xe6,
+	0x5d, 0x1c, 0xc8, 0x6b, 0x40, 0x52, 0xc0, 0xd7, 0x60, 0x32, 0xcd, 0xdb, 0x6d, 0x64, 0x02, 0x46,
+	0xf5, 0x3c, 0x5d, 0x46, 0xb1, 0x6e, 0xbe, 0xb8, 0x8d, 0xd3, 0x0d, 0xf6, 0xb6, 0xbb, 0x0b, 0x70,
+	0xb2, 0x9d, 0x6d, 0xf0, 0x0c, 0xcb, 0x15, 0x7b, 0xcb, 0xb9, 0x2b, 0x30, 0x9d, 0x9e, 0xf1, 0xb0,
+	0x1d, 0xc7, 0x3b, 0x7b, 0xff, 0x06, 0x7b, 0x3f, 0x04, 0x33, 0xd9, 0xae, 0xff, 0xcd, 0x87, 0x6f,
+	0xc2, 0x39, 0xad, 0xdd, 0x60, 0xa1, 0x68, 0x47, 0x4a, 0xb7, 0x5f, 0x5c, 0x9b, 0x4d, 0xb4, 0xdf,
+	0x0e, 0xe7, 0x26, 0x4d, 0xa4, 0xdc, 0xdc, 0x26, 0x81, 0xa0, 0x21, 0x53, 0x5b, 0xe4, 0x5e, 0xa4,
+	0xea, 0x25, 0x6d, 0xb9, 0xa5, 0x1d, 0x78, 0x15, 0x4a, 0x4d, 0x11, 0x44, 0xdd, 0x80, 0xe1, 0x3c,
+	0x01, 0x90, 0x38, 0xac, 0x7f, 0x05, 0x46, 0x37, 0xf9, 0x8e, 0x62, 0x53, 0x23, 0x79, 0x9c, 0x46,
+	0xeb, 0x4e, 0x00, 0xd6, 0x05, 0xad, 0xb3, 0x16, 0x0b, 0xbb, 0x17, 0xdf, 0x7d, 0x0c, 0xe3, 0xa9,
+	0x53, 0xdb, 0xd6, 0x2a, 0x8c, 0xc5, 0xfa, 0xc4, 0x36, 0x35, 0x9d, 0xd9, 0x94, 0x31, 0xf5, 0xd7,
+	0x64, 0x5d, 0xb5, 0x5f, 0x23, 0x30, 0xaa, 0x73, 0xf1, 0x1b, 0x04, 0x70, 0xf2, 0x18, 0xf0, 0x52,
+	0x66, 0x50, 0xf6, 0xab, 0x2c, 0x5f, 0xcd, 0x27, 0x36, 0xcc, 0x6e, 0xe5, 0xd5, 0x97, 0x1f, 0x6f,
+	0x87, 0x5c, 0x3c, 0x4f, 0xb3, 0xfe, 0x7b, 0xf4, 0x3d, 0x3d, 0xfc, 0x0e, 0x41, 0xb1, 0x17, 0x80,
+	0xab, 0x39, 0xa6, 0x74, 0x89, 0x96, 0x72, 0x69, 0x2d, 0xd0, 0xb2, 0x06, 0xaa, 0xe2, 0xca, 0x20,
+	0x20, 0xfa, 0x5c, 0x7f, 0x79, 0x81, 0x3f, 0x21, 0x38, 0x7f, 0xea, 0x02, 0xe3, 0xe5, 0x1c, 0x23,
+	0x53, 0x2f, 0xa4, 0xec, 0x9d, 0xc1, 0x61, 0x51, 0xaf, 0x6b, 0x54, 0x0f, 0xd3, 0xbc, 0xa8, 0x54,
+	0x1a, 0xba, 0x97, 0x08, 0xc6, 0xcc, 0x35, 0xc0, 0x8b, 0x7f, 0x1f, 0x9b, 0xba, 0x73, 0xe5, 0xca,
+	0x60, 0xa1, 0xc5, 0x5a, 0xd0, 0x58, 0xb3, 0x78, 0x3a, 0x13, 0xcb, 0xdc, 0xb5, 0xb5, 0x1b, 0xfb,
+	0x47, 0x0e, 0x3a, 0x38, 0x72, 0xd0, 0xf7, 0x23, 0x07, 0xbd, 0x3e, 0x76, 0x0a, 0x07, 0xc7, 0x4e,
+	0xe1, 0xeb, 0xb1, 0x53, 0x78, 0xb2, 0xe0, 0x07, 0x6a, 0xab, 0xdd, 0x20, 0x4d, 0x11, 0xf6, 0x07,
+	0x3c, 0xb3, 0x11, 0x6a, 0x2f, 0xe6, 0xb2, 0x31, 0xa6, 0x7f, 0x1b, 0x56, 0x7e, 0x0f, 0x00, 0x62,
+	0x7d, 0x10, 0xa7, 0x0a, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -377,6 +495,9 @@ type QueryClient interface {
 	TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
+	// TokenPairSupply retrieves the amounts held on both sides of a registered
+	// token pair
+	TokenPairSupply(ctx context.Context, in *QueryTokenPairSupplyRequest, opts ...grpc.CallOption) (*QueryTokenPairSupplyResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -407,6 +528,15 @@ func (c *queryClient) TokenPair(ctx context.Context, in *QueryTokenPairRequest,
 	return out, nil
 }
 
+func (c *queryClient) TokenPairSupply(ctx context.Context, in *QueryTokenPairSupplyRequest, opts ...grpc.CallOption) (*QueryTokenPairSupplyResponse, error) {
+	out := new(QueryTokenPairSupplyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Query/TokenPairSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Query/Params", in, out, opts...)
@@ -422,6 +552,9 @@ type QueryServer interface {
 	TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error)
 	// TokenPair retrieves a registered token pair
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
+	// TokenPairSupply retrieves the amounts held on both sides of a registered
+	// token pair
+	TokenPairSupply(context.Context, *QueryTokenPairSupplyRequest) (*QueryTokenPairSupplyResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -436,6 +569,9 @@ func (*UnimplementedQueryServer) TokenPairs(ctx context.Context, req *QueryToken
 func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenPairRequest) (*QueryTokenPairResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenPair not implemented")
 }
+func (*UnimplementedQueryServer) TokenPairSupply(ctx context.Context, req *QueryTokenPairSupplyRequest) (*QueryTokenPairSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPairSupply not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -480,6 +616,24 @@ func _Query_TokenPair_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenPairSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPairSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Query/TokenPairSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPairSupply(ctx, req.(*QueryTokenPairSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -510,6 +664,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TokenPair",
 			Handler:    _Query_TokenPair_Handler,
 		},
+		{
+			MethodName: "TokenPairSupply",
+			Handler:    _Query_TokenPairSupply_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -666,6 +824,99 @@ func (m *QueryTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTokenPairSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenPairSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Delta.Size()
+		i -= size
+		if _, err := m.Delta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CoinAmount.Size()
+		i -= size
+		if _, err := m.CoinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Erc20Amount.Size()
+		i -= size
+		if _, err := m.Erc20Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TokenPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -789,6 +1040,36 @@ func (m *QueryTokenPairResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTokenPairSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Erc20Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CoinAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Delta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1186,6 +1467,273 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTokenPairSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
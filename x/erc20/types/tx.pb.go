@@ -11,6 +11,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -527,6 +528,300 @@ func (m *MsgToggleConversionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgToggleConversionResponse proto.InternalMessageInfo
 
+// MsgUpdateCoinMetadata is the Msg/UpdateCoinMetadata request type for
+// updating the bank metadata of the Cosmos coin of a token pair.
+type MsgUpdateCoinMetadata struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// metadata is the new bank metadata of the coin. Its base denomination must
+	// be the Cosmos coin of a registered token pair.
+	Metadata types1.Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *MsgUpdateCoinMetadata) Reset()         { *m = MsgUpdateCoinMetadata{} }
+func (m *MsgUpdateCoinMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCoinMetadata) ProtoMessage()    {}
+func (*MsgUpdateCoinMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{10}
+}
+func (m *MsgUpdateCoinMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCoinMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCoinMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCoinMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCoinMetadata.Merge(m, src)
+}
+func (m *MsgUpdateCoinMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCoinMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCoinMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCoinMetadata proto.InternalMessageInfo
+
+func (m *MsgUpdateCoinMetadata) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateCoinMetadata) GetMetadata() types1.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return types1.Metadata{}
+}
+
+// MsgUpdateCoinMetadataResponse defines the response structure for executing
+// a UpdateCoinMetadata message.
+type MsgUpdateCoinMetadataResponse struct {
+}
+
+func (m *MsgUpdateCoinMetadataResponse) Reset()         { *m = MsgUpdateCoinMetadataResponse{} }
+func (m *MsgUpdateCoinMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCoinMetadataResponse) ProtoMessage()    {}
+func (*MsgUpdateCoinMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{11}
+}
+func (m *MsgUpdateCoinMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCoinMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCoinMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCoinMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCoinMetadataResponse.Merge(m, src)
+}
+func (m *MsgUpdateCoinMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCoinMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCoinMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCoinMetadataResponse proto.InternalMessageInfo
+
+// MsgMigrateTokenPair is the Msg/MigrateTokenPair request type for migrating a
+// native Cosmos coin token pair to a new ERC20 precompile address.
+type MsgMigrateTokenPair struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// new_erc20_address is the hex address of the new ERC20 precompile
+	NewErc20Address string `protobuf:"bytes,3,opt,name=new_erc20_address,json=newErc20Address,proto3" json:"new_erc20_address,omitempty"`
+}
+
+func (m *MsgMigrateTokenPair) Reset()         { *m = MsgMigrateTokenPair{} }
+func (m *MsgMigrateTokenPair) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateTokenPair) ProtoMessage()    {}
+func (*MsgMigrateTokenPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{12}
+}
+func (m *MsgMigrateTokenPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateTokenPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateTokenPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateTokenPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateTokenPair.Merge(m, src)
+}
+func (m *MsgMigrateTokenPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateTokenPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateTokenPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateTokenPair proto.InternalMessageInfo
+
+func (m *MsgMigrateTokenPair) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgMigrateTokenPair) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *MsgMigrateTokenPair) GetNewErc20Address() string {
+	if m != nil {
+		return m.NewErc20Address
+	}
+	return ""
+}
+
+// MsgMigrateTokenPairResponse defines the response structure for executing a
+// MigrateTokenPair message.
+type MsgMigrateTokenPairResponse struct {
+}
+
+func (m *MsgMigrateTokenPairResponse) Reset()         { *m = MsgMigrateTokenPairResponse{} }
+func (m *MsgMigrateTokenPairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateTokenPairResponse) ProtoMessage()    {}
+func (*MsgMigrateTokenPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{13}
+}
+func (m *MsgMigrateTokenPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateTokenPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateTokenPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateTokenPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateTokenPairResponse.Merge(m, src)
+}
+func (m *MsgMigrateTokenPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateTokenPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateTokenPairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateTokenPairResponse proto.InternalMessageInfo
+
+// MsgDeregisterTokenPair is the Msg/DeregisterTokenPair request type for
+// removing the token pair of an external ERC20 contract.
+type MsgDeregisterTokenPair struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *MsgDeregisterTokenPair) Reset()         { *m = MsgDeregisterTokenPair{} }
+func (m *MsgDeregisterTokenPair) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterTokenPair) ProtoMessage()    {}
+func (*MsgDeregisterTokenPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{14}
+}
+func (m *MsgDeregisterTokenPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterTokenPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterTokenPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterTokenPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterTokenPair.Merge(m, src)
+}
+func (m *MsgDeregisterTokenPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterTokenPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterTokenPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterTokenPair proto.InternalMessageInfo
+
+func (m *MsgDeregisterTokenPair) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDeregisterTokenPair) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// MsgDeregisterTokenPairResponse defines the response structure for executing
+// a DeregisterTokenPair message.
+type MsgDeregisterTokenPairResponse struct {
+}
+
+func (m *MsgDeregisterTokenPairResponse) Reset()         { *m = MsgDeregisterTokenPairResponse{} }
+func (m *MsgDeregisterTokenPairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterTokenPairResponse) ProtoMessage()    {}
+func (*MsgDeregisterTokenPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{15}
+}
+func (m *MsgDeregisterTokenPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterTokenPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterTokenPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterTokenPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterTokenPairResponse.Merge(m, src)
+}
+func (m *MsgDeregisterTokenPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterTokenPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterTokenPairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterTokenPairResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgConvertERC20)(nil), "cosmos.evm.erc20.v1.MsgConvertERC20")
 	proto.RegisterType((*MsgConvertERC20Response)(nil), "cosmos.evm.erc20.v1.MsgConvertERC20Response")
@@ -538,62 +833,80 @@ func init() {
 	proto.RegisterType((*MsgRegisterERC20Response)(nil), "cosmos.evm.erc20.v1.MsgRegisterERC20Response")
 	proto.RegisterType((*MsgToggleConversion)(nil), "cosmos.evm.erc20.v1.MsgToggleConversion")
 	proto.RegisterType((*MsgToggleConversionResponse)(nil), "cosmos.evm.erc20.v1.MsgToggleConversionResponse")
+	proto.RegisterType((*MsgUpdateCoinMetadata)(nil), "cosmos.evm.erc20.v1.MsgUpdateCoinMetadata")
+	proto.RegisterType((*MsgUpdateCoinMetadataResponse)(nil), "cosmos.evm.erc20.v1.MsgUpdateCoinMetadataResponse")
+	proto.RegisterType((*MsgMigrateTokenPair)(nil), "cosmos.evm.erc20.v1.MsgMigrateTokenPair")
+	proto.RegisterType((*MsgMigrateTokenPairResponse)(nil), "cosmos.evm.erc20.v1.MsgMigrateTokenPairResponse")
+	proto.RegisterType((*MsgDeregisterTokenPair)(nil), "cosmos.evm.erc20.v1.MsgDeregisterTokenPair")
+	proto.RegisterType((*MsgDeregisterTokenPairResponse)(nil), "cosmos.evm.erc20.v1.MsgDeregisterTokenPairResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/tx.proto", fileDescriptor_e06c8e6992ada536) }
 
 var fileDescriptor_e06c8e6992ada536 = []byte{
-	// 796 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0x13, 0x4d,
-	0x18, 0xee, 0x42, 0x69, 0xbe, 0x0e, 0x7c, 0xc0, 0xb7, 0xf0, 0x41, 0x59, 0xf8, 0xca, 0xe7, 0xf2,
-	0x43, 0xac, 0xb0, 0xdb, 0x16, 0x35, 0xb1, 0x89, 0x26, 0x96, 0x78, 0xf0, 0xd0, 0xc4, 0xac, 0x7a,
-	0xf1, 0x42, 0xb6, 0xdb, 0xc9, 0xb0, 0x81, 0x9d, 0x69, 0x76, 0x86, 0x06, 0x6e, 0x86, 0xa3, 0x89,
-	0x89, 0xc6, 0xbb, 0x89, 0x37, 0x8f, 0x1c, 0xfc, 0x03, 0x3c, 0x19, 0x8e, 0x44, 0x2f, 0xc6, 0x03,
-	0x31, 0x60, 0xc2, 0xc1, 0x9b, 0x7f, 0x81, 0xd9, 0x99, 0xe9, 0xb2, 0x5d, 0xb6, 0xb6, 0xf1, 0xd2,
-	0x74, 0xde, 0xf7, 0x79, 0xe7, 0x7d, 0x9e, 0xf7, 0xc7, 0x2c, 0x98, 0x73, 0x08, 0xf5, 0x08, 0x35,
-	0x61, 0xcb, 0x33, 0xa1, 0xef, 0x94, 0x8b, 0x66, 0xab, 0x64, 0xb2, 0x3d, 0xa3, 0xe9, 0x13, 0x46,
-	0xd4, 0x09, 0xe1, 0x35, 0x60, 0xcb, 0x33, 0xb8, 0xd7, 0x68, 0x95, 0xb4, 0x7f, 0x6c, 0xcf, 0xc5,
-	0xc4, 0xe4, 0xbf, 0x02, 0xa7, 0xe5, 0xe5, 0x2d, 0x75, 0x9b, 0x42, 0xb3, 0x55, 0xaa, 0x43, 0x66,
-	0x97, 0x4c, 0x87, 0xb8, 0x58, 0xfa, 0xaf, 0x24, 0x65, 0x41, 0x10, 0x43, 0xea, 0x52, 0x09, 0x99,
-	0x96, 0x10, 0x8f, 0xa2, 0xc0, 0xe9, 0x51, 0x24, 0x1d, 0x33, 0xc2, 0xb1, 0xc9, 0x4f, 0xa6, 0x24,
-	0x24, 0x5c, 0x93, 0x88, 0x20, 0x22, 0xec, 0xc1, 0x3f, 0x69, 0x9d, 0x43, 0x84, 0xa0, 0x1d, 0x68,
-	0xda, 0x4d, 0xd7, 0xb4, 0x31, 0x26, 0xcc, 0x66, 0x2e, 0xc1, 0x32, 0x46, 0xff, 0xa9, 0x80, 0xb1,
-	0x1a, 0x45, 0x1b, 0x04, 0xb7, 0xa0, 0xcf, 0xee, 0x5b, 0x1b, 0xe5, 0xa2, 0x7a, 0x0d, 0x8c, 0x3b,
-	0x04, 0x33, 0xdf, 0x76, 0xd8, 0xa6, 0xdd, 0x68, 0xf8, 0x90, 0xd2, 0x9c, 0xf2, 0xbf, 0xb2, 0x92,
-	0xb5, 0xc6, 0xda, 0xf6, 0x7b, 0xc2, 0xac, 0x56, 0x40, 0xc6, 0xf6, 0xc8, 0x2e, 0x66, 0xb9, 0x81,
-	0x00, 0x50, 0xd5, 0x8f, 0x4e, 0xe6, 0x53, 0x5f, 0x4f, 0xe6, 0xff, 0x15, 0xc4, 0x68, 0x63, 0xdb,
-	0x70, 0x89, 0xe9, 0xd9, 0x6c, 0xcb, 0x78, 0x80, 0xd9, 0xbb, 0xf3, 0xc3, 0x82, 0x62, 0xc9, 0x08,
-	0xf5, 0x06, 0xf8, 0xcb, 0x87, 0x0e, 0x74, 0x5b, 0xd0, 0xcf, 0x0d, 0xf2, 0xe8, 0xdc, 0xa7, 0xf7,
-	0x6b, 0x93, 0x52, 0x92, 0xcc, 0xf0, 0x88, 0xf9, 0x2e, 0x46, 0x56, 0x88, 0x54, 0xa7, 0x40, 0x86,
-	0x42, 0xdc, 0x80, 0x7e, 0x2e, 0xcd, 0x29, 0xc9, 0x53, 0xa5, 0x70, 0x70, 0x7e, 0x58, 0x90, 0x87,
-	0xe7, 0xe7, 0x87, 0x05, 0x2d, 0x52, 0xe3, 0x98, 0x40, 0x7d, 0x06, 0x4c, 0xc7, 0x4c, 0x16, 0xa4,
-	0x4d, 0x82, 0x29, 0xd4, 0x3f, 0x2a, 0x60, 0xf4, 0xc2, 0xb7, 0x41, 0x5c, 0xac, 0xae, 0x83, 0x74,
-	0xd0, 0x3b, 0x5e, 0x82, 0xe1, 0xf2, 0x8c, 0x21, 0x09, 0x06, 0xcd, 0x35, 0x64, 0x73, 0x8d, 0x00,
-	0x58, 0x4d, 0x07, 0xe2, 0x2d, 0x0e, 0x56, 0xb5, 0x88, 0x38, 0x5e, 0x9a, 0x88, 0x84, 0x62, 0x28,
-	0xa1, 0x97, 0xec, 0xb6, 0xb8, 0x52, 0x4c, 0x5c, 0x74, 0x80, 0xf6, 0xe4, 0x08, 0x75, 0xb2, 0xd6,
-	0x73, 0x60, 0xaa, 0xd3, 0x12, 0x4a, 0xfc, 0x20, 0x5a, 0xfe, 0xa4, 0xd9, 0xb0, 0x19, 0x7c, 0x68,
-	0xfb, 0xb6, 0x47, 0xd5, 0x5b, 0x20, 0x6b, 0xef, 0xb2, 0x2d, 0xe2, 0xbb, 0x6c, 0x5f, 0xf4, 0xfa,
-	0x37, 0xac, 0x2e, 0xa0, 0xea, 0x5d, 0x90, 0x69, 0xf2, 0x1b, 0xb8, 0xc8, 0xe1, 0xf2, 0xac, 0x91,
-	0xb0, 0x22, 0x86, 0x48, 0x52, 0xcd, 0x06, 0xf5, 0x91, 0x33, 0x20, 0xa2, 0x2a, 0x37, 0x03, 0x61,
-	0x17, 0xf7, 0x05, 0xda, 0xf4, 0x64, 0x6d, 0x51, 0xba, 0xb2, 0x81, 0x51, 0x53, 0xa8, 0xee, 0xad,
-	0x02, 0xc6, 0x6b, 0x14, 0x59, 0x10, 0xb9, 0x94, 0x41, 0x5f, 0x4c, 0x74, 0x50, 0x71, 0x17, 0x61,
-	0xe8, 0xf7, 0xd4, 0x26, 0x71, 0xea, 0x32, 0x18, 0xe5, 0xa9, 0xe5, 0xfc, 0xc3, 0x40, 0xe0, 0xe0,
-	0x4a, 0xd6, 0x8a, 0x59, 0x2b, 0xeb, 0xa2, 0x33, 0x3c, 0x28, 0x60, 0xbf, 0x90, 0xcc, 0xbe, 0x83,
-	0x8e, 0xae, 0x81, 0x5c, 0xdc, 0x16, 0xf2, 0x7f, 0xa3, 0x80, 0x89, 0x1a, 0x45, 0x8f, 0x09, 0x42,
-	0x3b, 0x50, 0xb4, 0x8f, 0xba, 0x04, 0xff, 0x71, 0x87, 0x26, 0xc1, 0x10, 0x23, 0xdb, 0x10, 0xcb,
-	0x29, 0x14, 0x87, 0xca, 0xed, 0xcb, 0x75, 0x5f, 0x4e, 0x66, 0x1e, 0x27, 0xa2, 0xff, 0x07, 0x66,
-	0x13, 0xcc, 0x6d, 0xfe, 0xe5, 0x1f, 0x69, 0x30, 0x58, 0xa3, 0x48, 0x7d, 0xa5, 0x80, 0x91, 0x8e,
-	0x57, 0x65, 0x31, 0x71, 0x34, 0x62, 0x7b, 0xa8, 0xad, 0xf6, 0x83, 0x0a, 0x8b, 0xb5, 0x76, 0xf0,
-	0xf9, 0xfb, 0xeb, 0x81, 0xab, 0xea, 0x92, 0x99, 0xfc, 0x6e, 0x9b, 0x8e, 0x88, 0xda, 0xe4, 0x36,
-	0xf5, 0x85, 0x02, 0x86, 0xa3, 0x9b, 0xbd, 0xd0, 0x23, 0x59, 0x00, 0xd2, 0xae, 0xf7, 0x01, 0x0a,
-	0x09, 0xad, 0x72, 0x42, 0xcb, 0xea, 0x62, 0x2f, 0x42, 0xfc, 0x91, 0xa8, 0x83, 0x91, 0x8e, 0x2d,
-	0xec, 0x5a, 0xa2, 0x28, 0xaa, 0x7b, 0x89, 0x92, 0xf6, 0x41, 0x85, 0xe0, 0xef, 0xce, 0x5d, 0x58,
-	0xea, 0x16, 0xde, 0x01, 0xd3, 0xd6, 0xfa, 0x82, 0x85, 0x69, 0x30, 0x18, 0xbf, 0x34, 0xb2, 0x2b,
-	0xdd, 0xae, 0x88, 0x23, 0xb5, 0x62, 0xbf, 0xc8, 0x76, 0x3e, 0x6d, 0xe8, 0x59, 0xf0, 0x8e, 0x54,
-	0xef, 0x1c, 0x9d, 0xe6, 0x95, 0xe3, 0xd3, 0xbc, 0xf2, 0xed, 0x34, 0xaf, 0xbc, 0x3c, 0xcb, 0xa7,
-	0x8e, 0xcf, 0xf2, 0xa9, 0x2f, 0x67, 0xf9, 0xd4, 0xd3, 0x05, 0xe4, 0xb2, 0xad, 0xdd, 0xba, 0xe1,
-	0x10, 0xcf, 0x4c, 0x98, 0x6c, 0xb6, 0xdf, 0x84, 0xb4, 0x9e, 0xe1, 0x1f, 0xc1, 0xf5, 0x5f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x3b, 0x2d, 0x64, 0x53, 0xf7, 0x07, 0x00, 0x00,
+	// 984 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x36, 0x69, 0x68, 0x26, 0xa5, 0x4d, 0x27, 0x69, 0xeb, 0x6c, 0x1b, 0xa7, 0x6c, 0xda,
+	0x90, 0x9a, 0x64, 0xd7, 0x76, 0x00, 0x09, 0xf3, 0x43, 0x22, 0x69, 0x0f, 0x1c, 0x2c, 0x55, 0xa6,
+	0x5c, 0xb8, 0x44, 0x63, 0x7b, 0x34, 0x59, 0xa5, 0x3b, 0x63, 0xed, 0x4c, 0x9c, 0xf6, 0x86, 0x7a,
+	0x44, 0x42, 0x02, 0x71, 0x47, 0x42, 0xe2, 0xc0, 0x31, 0x07, 0xfe, 0x00, 0x4e, 0xa8, 0xea, 0xa9,
+	0x2a, 0x17, 0xc4, 0xa1, 0x42, 0x09, 0x52, 0xee, 0xfc, 0x05, 0x68, 0x7e, 0x78, 0xb2, 0x5e, 0x8f,
+	0x6b, 0xb7, 0x82, 0x4b, 0x94, 0x79, 0xef, 0x7b, 0xf3, 0xbe, 0xef, 0xbd, 0xb7, 0x6f, 0x0c, 0xae,
+	0xb7, 0x18, 0x4f, 0x18, 0x8f, 0x70, 0x37, 0x89, 0x70, 0xda, 0xaa, 0x96, 0xa3, 0x6e, 0x25, 0x12,
+	0x0f, 0xc3, 0x4e, 0xca, 0x04, 0x83, 0xf3, 0xda, 0x1b, 0xe2, 0x6e, 0x12, 0x2a, 0x6f, 0xd8, 0xad,
+	0xf8, 0x97, 0x50, 0x12, 0x53, 0x16, 0xa9, 0xbf, 0x1a, 0xe7, 0x17, 0xcd, 0x2d, 0x4d, 0x44, 0xf7,
+	0xa2, 0x6e, 0xa5, 0x89, 0x05, 0xaa, 0xa8, 0xc3, 0x80, 0x9f, 0x63, 0xeb, 0x6f, 0xb1, 0x98, 0x1a,
+	0xff, 0x5b, 0x2e, 0x16, 0x04, 0x53, 0xcc, 0x63, 0x6e, 0x20, 0x57, 0x0d, 0x24, 0xe1, 0x44, 0x3a,
+	0x13, 0x4e, 0x8c, 0x63, 0x51, 0x3b, 0x76, 0xd4, 0x29, 0x32, 0x84, 0xb5, 0x6b, 0x81, 0x30, 0xc2,
+	0xb4, 0x5d, 0xfe, 0x67, 0xac, 0xd7, 0x09, 0x63, 0xe4, 0x01, 0x8e, 0x50, 0x27, 0x8e, 0x10, 0xa5,
+	0x4c, 0x20, 0x11, 0x33, 0x6a, 0x62, 0x82, 0x7f, 0x3c, 0x70, 0xb1, 0xce, 0xc9, 0x36, 0xa3, 0x5d,
+	0x9c, 0x8a, 0xbb, 0x8d, 0xed, 0x6a, 0x19, 0xde, 0x06, 0x73, 0x2d, 0x46, 0x45, 0x8a, 0x5a, 0x62,
+	0x07, 0xb5, 0xdb, 0x29, 0xe6, 0xbc, 0xe0, 0xdd, 0xf0, 0xd6, 0x66, 0x1a, 0x17, 0x7b, 0xf6, 0x4f,
+	0xb5, 0x19, 0xd6, 0xc0, 0x34, 0x4a, 0xd8, 0x3e, 0x15, 0x85, 0x33, 0x12, 0xb0, 0x15, 0x3c, 0x79,
+	0xb1, 0x3c, 0xf1, 0xe7, 0x8b, 0xe5, 0xcb, 0x9a, 0x18, 0x6f, 0xef, 0x85, 0x31, 0x8b, 0x12, 0x24,
+	0x76, 0xc3, 0xcf, 0xa8, 0xf8, 0xf9, 0xe4, 0xb0, 0xe4, 0x35, 0x4c, 0x04, 0x7c, 0x17, 0x9c, 0x4b,
+	0x71, 0x0b, 0xc7, 0x5d, 0x9c, 0x16, 0x26, 0x55, 0x74, 0xe1, 0xf9, 0x2f, 0x1b, 0x0b, 0x46, 0x92,
+	0xc9, 0xf0, 0xb9, 0x48, 0x63, 0x4a, 0x1a, 0x16, 0x09, 0xaf, 0x80, 0x69, 0x8e, 0x69, 0x1b, 0xa7,
+	0x85, 0x29, 0x45, 0xc9, 0x9c, 0x6a, 0xa5, 0xc7, 0x27, 0x87, 0x25, 0x73, 0xf8, 0xfa, 0xe4, 0xb0,
+	0xe4, 0x67, 0x6a, 0x9c, 0x13, 0x18, 0x2c, 0x82, 0xab, 0x39, 0x53, 0x03, 0xf3, 0x0e, 0xa3, 0x1c,
+	0x07, 0xbf, 0x79, 0xe0, 0xc2, 0xa9, 0x6f, 0x9b, 0xc5, 0x14, 0x6e, 0x82, 0x29, 0xd9, 0x3b, 0x55,
+	0x82, 0xd9, 0xea, 0x62, 0x68, 0x08, 0xca, 0xe6, 0x86, 0xa6, 0xb9, 0xa1, 0x04, 0x6e, 0x4d, 0x49,
+	0xf1, 0x0d, 0x05, 0x86, 0x7e, 0x46, 0x9c, 0x2a, 0x4d, 0x46, 0x42, 0xd9, 0x4a, 0x18, 0x25, 0xbb,
+	0x27, 0xae, 0x92, 0x13, 0x97, 0x1d, 0xa0, 0x87, 0x66, 0x84, 0xfa, 0x59, 0x07, 0x05, 0x70, 0xa5,
+	0xdf, 0x62, 0x25, 0xfe, 0xaa, 0x5b, 0xfe, 0x45, 0xa7, 0x8d, 0x04, 0xbe, 0x87, 0x52, 0x94, 0x70,
+	0xf8, 0x3e, 0x98, 0x41, 0xfb, 0x62, 0x97, 0xa5, 0xb1, 0x78, 0x54, 0xf0, 0x46, 0xb0, 0x3a, 0x85,
+	0xc2, 0x4f, 0xc0, 0x74, 0x47, 0xdd, 0xa0, 0x44, 0xce, 0x56, 0xaf, 0x85, 0x8e, 0x4f, 0x28, 0xd4,
+	0x49, 0xb6, 0x66, 0x64, 0x7d, 0xcc, 0x0c, 0xe8, 0xa8, 0xda, 0x7b, 0x52, 0xd8, 0xe9, 0x7d, 0x52,
+	0x5b, 0xe0, 0xd6, 0x96, 0xa5, 0x6b, 0x1a, 0x98, 0x35, 0x59, 0x75, 0x3f, 0x7a, 0x60, 0xae, 0xce,
+	0x49, 0x03, 0x93, 0x98, 0x0b, 0x9c, 0xea, 0x89, 0x96, 0x15, 0x8f, 0x09, 0xc5, 0xe9, 0x48, 0x6d,
+	0x06, 0x07, 0x57, 0xc1, 0x05, 0x95, 0xda, 0xcc, 0x3f, 0x96, 0x02, 0x27, 0xd7, 0x66, 0x1a, 0x39,
+	0x6b, 0x6d, 0x53, 0x77, 0x46, 0x05, 0x49, 0xf6, 0x2b, 0x6e, 0xf6, 0x7d, 0x74, 0x02, 0x1f, 0x14,
+	0xf2, 0x36, 0xcb, 0xff, 0x07, 0x0f, 0xcc, 0xd7, 0x39, 0xb9, 0xcf, 0x08, 0x79, 0x80, 0x75, 0xfb,
+	0x78, 0xcc, 0xe8, 0x6b, 0x77, 0x68, 0x01, 0x9c, 0x15, 0x6c, 0x0f, 0x53, 0x33, 0x85, 0xfa, 0x50,
+	0xfb, 0x60, 0xb0, 0xee, 0xab, 0x6e, 0xe6, 0x79, 0x22, 0xc1, 0x12, 0xb8, 0xe6, 0x30, 0x5b, 0xfe,
+	0xcf, 0x3d, 0x70, 0xd9, 0xf6, 0x46, 0xce, 0x5d, 0x1d, 0x0b, 0xd4, 0x46, 0x02, 0xbd, 0xb6, 0x82,
+	0x3b, 0xe0, 0x5c, 0x62, 0xee, 0x30, 0x53, 0xb6, 0x74, 0xfa, 0x0d, 0xd2, 0x3d, 0xfb, 0x0d, 0xf6,
+	0x12, 0x65, 0xe7, 0xcc, 0x46, 0xd6, 0x3e, 0x1c, 0x54, 0xbc, 0xf6, 0xb2, 0x49, 0xcb, 0x52, 0x0f,
+	0x96, 0xc1, 0x92, 0xd3, 0x61, 0x55, 0x3f, 0xd5, 0x5d, 0xab, 0xc7, 0x24, 0x45, 0x02, 0xdf, 0x97,
+	0x35, 0xbe, 0x87, 0xe2, 0xf4, 0xbf, 0xed, 0x1a, 0x2c, 0x81, 0x4b, 0x14, 0x1f, 0xec, 0x28, 0xa6,
+	0x76, 0x33, 0x4f, 0xea, 0xcd, 0x4c, 0xf1, 0xc1, 0x5d, 0x69, 0x37, 0x57, 0xbe, 0x42, 0x87, 0xf3,
+	0xa4, 0x4d, 0x87, 0xf3, 0x66, 0xab, 0xf5, 0x27, 0x4f, 0xad, 0x96, 0x3b, 0x38, 0x35, 0x03, 0xfc,
+	0x3f, 0xc9, 0xad, 0x7d, 0x34, 0x28, 0xe1, 0xb6, 0x5b, 0x82, 0x83, 0x4b, 0x70, 0x03, 0x14, 0xdd,
+	0x9e, 0x9e, 0x90, 0xea, 0xd3, 0x37, 0xc0, 0x64, 0x9d, 0x13, 0xf8, 0x9d, 0x07, 0xce, 0xf7, 0x3d,
+	0x80, 0x37, 0x9d, 0x5b, 0x2c, 0xf7, 0x64, 0xf8, 0xeb, 0xe3, 0xa0, 0x6c, 0xd5, 0x36, 0x1e, 0xff,
+	0xfe, 0xf7, 0xf7, 0x67, 0xde, 0x86, 0xb7, 0x22, 0xf7, 0x4f, 0x90, 0xa8, 0xa5, 0xa3, 0x74, 0x77,
+	0xe1, 0x37, 0x1e, 0x98, 0xcd, 0x3e, 0x42, 0x2b, 0x23, 0x92, 0x49, 0x90, 0xff, 0xce, 0x18, 0x20,
+	0x4b, 0x68, 0x5d, 0x11, 0x5a, 0x85, 0x37, 0x47, 0x11, 0x52, 0xef, 0x59, 0x13, 0x9c, 0xef, 0x7b,
+	0x30, 0x86, 0x96, 0x28, 0x8b, 0xf2, 0xd7, 0xc7, 0x41, 0xf5, 0x18, 0x41, 0x0c, 0xde, 0xec, 0x5f,
+	0xdb, 0xb7, 0x86, 0x85, 0xf7, 0xc1, 0xfc, 0x8d, 0xb1, 0x60, 0x36, 0x0d, 0x05, 0x73, 0x03, 0xdb,
+	0x75, 0x6d, 0xd8, 0x15, 0x79, 0xa4, 0x5f, 0x1e, 0x17, 0x69, 0xf3, 0x09, 0x00, 0x1d, 0xdb, 0xb0,
+	0xf4, 0xf2, 0xd2, 0x64, 0xb1, 0x7e, 0x75, 0x7c, 0x6c, 0x56, 0xe5, 0xc0, 0x36, 0x1a, 0xaa, 0x32,
+	0x8f, 0xf4, 0xcb, 0xe3, 0x22, 0x6d, 0xbe, 0x03, 0x30, 0xef, 0xda, 0x08, 0x43, 0x47, 0xd2, 0x01,
+	0xf6, 0x37, 0x5f, 0x01, 0xdc, 0x4b, 0xec, 0x9f, 0xfd, 0x4a, 0x6e, 0xfa, 0xad, 0x8f, 0x9f, 0x1c,
+	0x15, 0xbd, 0x67, 0x47, 0x45, 0xef, 0xaf, 0xa3, 0xa2, 0xf7, 0xed, 0x71, 0x71, 0xe2, 0xd9, 0x71,
+	0x71, 0xe2, 0x8f, 0xe3, 0xe2, 0xc4, 0x97, 0x2b, 0x24, 0x16, 0xbb, 0xfb, 0xcd, 0xb0, 0xc5, 0x92,
+	0xc8, 0xb1, 0x3e, 0xc4, 0xa3, 0x0e, 0xe6, 0xcd, 0x69, 0xf5, 0x73, 0x78, 0xf3, 0xdf, 0x01, 0x00,
+	0x9e, 0xec, 0xf2, 0x3a, 0x21, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -626,6 +939,19 @@ type MsgClient interface {
 	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error)
+	// UpdateCoinMetadata defines a governance operation for updating the bank
+	// metadata of the Cosmos coin of a token pair. The authority is hard-coded to
+	// the Cosmos SDK x/gov module account
+	UpdateCoinMetadata(ctx context.Context, in *MsgUpdateCoinMetadata, opts ...grpc.CallOption) (*MsgUpdateCoinMetadataResponse, error)
+	// MigrateTokenPair defines a governance operation for migrating a native
+	// Cosmos coin token pair to a new ERC20 precompile address. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	MigrateTokenPair(ctx context.Context, in *MsgMigrateTokenPair, opts ...grpc.CallOption) (*MsgMigrateTokenPairResponse, error)
+	// DeregisterTokenPair defines a governance operation for removing the token
+	// pair of an external ERC20 contract after returning the escrowed ERC20
+	// tokens to the holders of the Cosmos coins. The authority is hard-coded to
+	// the Cosmos SDK x/gov module account
+	DeregisterTokenPair(ctx context.Context, in *MsgDeregisterTokenPair, opts ...grpc.CallOption) (*MsgDeregisterTokenPairResponse, error)
 }
 
 type msgClient struct {
@@ -681,6 +1007,33 @@ func (c *msgClient) ToggleConversion(ctx context.Context, in *MsgToggleConversio
 	return out, nil
 }
 
+func (c *msgClient) UpdateCoinMetadata(ctx context.Context, in *MsgUpdateCoinMetadata, opts ...grpc.CallOption) (*MsgUpdateCoinMetadataResponse, error) {
+	out := new(MsgUpdateCoinMetadataResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Msg/UpdateCoinMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateTokenPair(ctx context.Context, in *MsgMigrateTokenPair, opts ...grpc.CallOption) (*MsgMigrateTokenPairResponse, error) {
+	out := new(MsgMigrateTokenPairResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Msg/MigrateTokenPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterTokenPair(ctx context.Context, in *MsgDeregisterTokenPair, opts ...grpc.CallOption) (*MsgDeregisterTokenPairResponse, error) {
+	out := new(MsgDeregisterTokenPairResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Msg/DeregisterTokenPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
@@ -701,6 +1054,19 @@ type MsgServer interface {
 	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error)
+	// UpdateCoinMetadata defines a governance operation for updating the bank
+	// metadata of the Cosmos coin of a token pair. The authority is hard-coded to
+	// the Cosmos SDK x/gov module account
+	UpdateCoinMetadata(context.Context, *MsgUpdateCoinMetadata) (*MsgUpdateCoinMetadataResponse, error)
+	// MigrateTokenPair defines a governance operation for migrating a native
+	// Cosmos coin token pair to a new ERC20 precompile address. The authority is
+	// hard-coded to the Cosmos SDK x/gov module account
+	MigrateTokenPair(context.Context, *MsgMigrateTokenPair) (*MsgMigrateTokenPairResponse, error)
+	// DeregisterTokenPair defines a governance operation for removing the token
+	// pair of an external ERC20 contract after returning the escrowed ERC20
+	// tokens to the holders of the Cosmos coins. The authority is hard-coded to
+	// the Cosmos SDK x/gov module account
+	DeregisterTokenPair(context.Context, *MsgDeregisterTokenPair) (*MsgDeregisterTokenPairResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -722,6 +1088,15 @@ func (*UnimplementedMsgServer) RegisterERC20(ctx context.Context, req *MsgRegist
 func (*UnimplementedMsgServer) ToggleConversion(ctx context.Context, req *MsgToggleConversion) (*MsgToggleConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleConversion not implemented")
 }
+func (*UnimplementedMsgServer) UpdateCoinMetadata(ctx context.Context, req *MsgUpdateCoinMetadata) (*MsgUpdateCoinMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCoinMetadata not implemented")
+}
+func (*UnimplementedMsgServer) MigrateTokenPair(ctx context.Context, req *MsgMigrateTokenPair) (*MsgMigrateTokenPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateTokenPair not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterTokenPair(ctx context.Context, req *MsgDeregisterTokenPair) (*MsgDeregisterTokenPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterTokenPair not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -817,6 +1192,60 @@ func _Msg_ToggleConversion_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateCoinMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCoinMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCoinMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Msg/UpdateCoinMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCoinMetadata(ctx, req.(*MsgUpdateCoinMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateTokenPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateTokenPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateTokenPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Msg/MigrateTokenPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateTokenPair(ctx, req.(*MsgMigrateTokenPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterTokenPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterTokenPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterTokenPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Msg/DeregisterTokenPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterTokenPair(ctx, req.(*MsgDeregisterTokenPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.erc20.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -841,6 +1270,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleConversion",
 			Handler:    _Msg_ToggleConversion_Handler,
 		},
+		{
+			MethodName: "UpdateCoinMetadata",
+			Handler:    _Msg_UpdateCoinMetadata_Handler,
+		},
+		{
+			MethodName: "MigrateTokenPair",
+			Handler:    _Msg_MigrateTokenPair_Handler,
+		},
+		{
+			MethodName: "DeregisterTokenPair",
+			Handler:    _Msg_DeregisterTokenPair_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/erc20/v1/tx.proto",
@@ -1178,15 +1619,205 @@ func (m *MsgToggleConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateCoinMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCoinMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCoinMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCoinMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCoinMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCoinMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateTokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateTokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateTokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewErc20Address) > 0 {
+		i -= len(m.NewErc20Address)
+		copy(dAtA[i:], m.NewErc20Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewErc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateTokenPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateTokenPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterTokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterTokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterTokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterTokenPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterTokenPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *MsgConvertERC20) Size() (n int) {
@@ -1327,13 +1958,520 @@ func (m *MsgToggleConversionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateCoinMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateCoinMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateTokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewErc20Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateTokenPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterTokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterTokenPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1356,15 +2494,15 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1392,13 +2530,13 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1408,29 +2546,128 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1458,11 +2695,11 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1490,7 +2727,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Erc20Addresses = append(m.Erc20Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1513,7 +2750,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterERC20Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1536,10 +2773,10 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterERC20Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1563,7 +2800,7 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+func (m *MsgToggleConversion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1586,48 +2823,15 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgToggleConversion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgToggleConversion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1655,11 +2859,11 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1687,7 +2891,7 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1710,7 +2914,7 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgToggleConversionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1733,10 +2937,10 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgToggleConversionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgToggleConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1760,7 +2964,7 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCoinMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1783,10 +2987,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCoinMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCoinMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1823,7 +3027,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1850,7 +3054,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1875,7 +3079,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCoinMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1898,10 +3102,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCoinMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCoinMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1925,7 +3129,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateTokenPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1948,15 +3152,15 @@ func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateTokenPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1984,11 +3188,11 @@ func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2016,7 +3220,39 @@ func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Addresses = append(m.Erc20Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewErc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewErc20Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2039,7 +3275,7 @@ func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateTokenPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2062,10 +3298,10 @@ func (m *MsgRegisterERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateTokenPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2089,7 +3325,7 @@ func (m *MsgRegisterERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleConversion) Unmarshal(dAtA []byte) error {
+func (m *MsgDeregisterTokenPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2112,10 +3348,10 @@ func (m *MsgToggleConversion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleConversion: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeregisterTokenPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeregisterTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2203,7 +3439,7 @@ func (m *MsgToggleConversion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgToggleConversionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeregisterTokenPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2226,10 +3462,10 @@ func (m *MsgToggleConversionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgToggleConversionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeregisterTokenPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgToggleConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeregisterTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/x/erc721/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTxCmd returns a root CLI command handler for erc721 transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "erc721 subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewConvertNFTCmd(),
+		NewConvertERC721Cmd(),
+	)
+	return txCmd
+}
+
+// NewConvertERC721Cmd returns a CLI command handler for converting ERC721 tokens
+func NewConvertERC721Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "convert-erc721 CONTRACT_ADDRESS TOKEN_IDS [RECEIVER]",
+		Short:   "Convert ERC721 tokens to x/nft NFTs. When the receiver [optional] is omitted, the NFTs are transferred to the sender.",
+		Example: "tx erc721 convert-erc721 0x... 1,2,3",
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := cosmosevmtypes.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC721 contract address %w", err)
+			}
+
+			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+
+			receiver := cliCtx.GetFromAddress()
+			if len(args) == 3 {
+				receiver, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgConvertERC721(strings.Split(args[1], ","), receiver, common.HexToAddress(contract), from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertNFTCmd returns a CLI command handler for converting x/nft NFTs
+func NewConvertNFTCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "convert-nft CLASS_ID TOKEN_IDS [RECEIVER_HEX]",
+		Short:   "Convert x/nft NFTs to ERC721 tokens. When the receiver [optional] is omitted, the ERC721 tokens are transferred to the sender.",
+		Example: "tx erc721 convert-nft my-class 1,2,3",
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			receiver := common.BytesToAddress(sender)
+
+			if len(args) == 3 {
+				if err := cosmosevmtypes.ValidateAddress(args[2]); err != nil {
+					return fmt.Errorf("invalid receiver hex address %w", err)
+				}
+				receiver = common.HexToAddress(args[2])
+			}
+
+			msg := types.NewMsgConvertNFT(args[0], strings.Split(args[1], ","), receiver, sender)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,70 @@
+package erc721
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc721/keeper"
+	"github.com/cosmos/evm/x/erc721/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accountKeeper authkeeper.AccountKeeper,
+	data types.GenesisState,
+) {
+	err := k.SetParams(ctx, data.Params)
+	if err != nil {
+		panic(fmt.Errorf("error setting params %s", err))
+	}
+
+	// ensure erc721 module account is set on genesis
+	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
+		// NOTE: shouldn't occur
+		panic("the erc721 module account has not been set")
+	}
+
+	for _, pair := range data.TokenPairs {
+		k.SetToken(ctx, pair)
+	}
+
+	for _, approval := range data.TokenApprovals {
+		tokenID, err := types.ParseTokenID(approval.TokenId)
+		if err != nil {
+			panic(fmt.Errorf("error setting token approval %s", err))
+		}
+		k.SetTokenApproval(
+			ctx,
+			common.HexToAddress(approval.Erc721Address),
+			tokenID,
+			common.HexToAddress(approval.Owner),
+			common.HexToAddress(approval.Spender),
+		)
+	}
+
+	for _, approval := range data.OperatorApprovals {
+		k.SetApprovalForAll(
+			ctx,
+			common.HexToAddress(approval.Erc721Address),
+			common.HexToAddress(approval.Owner),
+			common.HexToAddress(approval.Operator),
+			true,
+		)
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:            k.GetParams(ctx),
+		TokenPairs:        k.GetTokenPairs(ctx),
+		TokenApprovals:    k.GetAllTokenApprovals(ctx),
+		OperatorApprovals: k.GetAllOperatorApprovals(ctx),
+	}
+}
@@ -0,0 +1,123 @@
+package erc721
+
+import (
+	"errors"
+
+	"github.com/cosmos/evm/ibc"
+	erc721types "github.com/cosmos/evm/x/erc721/types"
+	nfttransfertypes "github.com/cosmos/evm/x/ibc/nfttransfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ porttypes.IBCModule             = &IBCMiddleware{}
+	_ porttypes.PacketDataUnmarshaler = &IBCMiddleware{}
+)
+
+// IBCMiddleware implements the ICS26 callbacks for the nft-transfer middleware
+// given the erc721 keeper and the underlying application.
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper erc721types.Erc721Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application
+func NewIBCMiddleware(k erc721types.Erc721Keeper, app porttypes.IBCModule) IBCMiddleware {
+	if app == nil {
+		panic(errors.New("underlying application cannot be nil"))
+	}
+	if k == nil {
+		panic(errors.New("erc721 keeper cannot be nil"))
+	}
+
+	return IBCMiddleware{
+		Module: ibc.NewModule(app),
+		keeper: k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// It receives the NFTs through the default ICS721 OnRecvPacket callback logic
+// and then automatically converts the x/nft tokens to their ERC721
+// representation.
+// If the acknowledgement fails, this callback will default to the ibc-core
+// packet callback.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	ack := im.Module.OnRecvPacket(ctx, channelVersion, packet, relayer)
+
+	// return if the acknowledgement is an error ACK
+	if !ack.Success() {
+		return ack
+	}
+
+	return im.keeper.OnRecvPacket(ctx, packet, ack)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// It refunds the NFTs transferred and then automatically converts them to
+// their ERC721 representation.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := nfttransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-721 nft-transfer packet acknowledgement: %v", err)
+	}
+
+	data, err := nfttransfertypes.UnmarshalPacketData(packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	if err := im.Module.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// It refunds the NFTs transferred and then automatically converts them to
+// their ERC721 representation.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	data, err := nfttransfertypes.UnmarshalPacketData(packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	if err := im.Module.OnTimeoutPacket(ctx, channelVersion, packet, relayer); err != nil {
+		return err
+	}
+
+	return im.keeper.OnTimeoutPacket(ctx, packet, data)
+}
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface.
+func (im IBCMiddleware) UnmarshalPacketData(
+	ctx sdk.Context,
+	portID, channelID string,
+	data []byte,
+) (any, string, error) {
+	return im.Module.UnmarshalPacketData(ctx, portID, channelID, data)
+}
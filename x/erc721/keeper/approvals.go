@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc721/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var isTrue = []byte{0x01}
+
+// GetTokenApproval returns the address approved to transfer the given token on
+// the given erc721 precompile address. The approval is only valid for the owner
+// that granted it, so the zero address is returned if the token changed hands
+// without going through the precompile, e.g. on a x/nft MsgSend.
+func (k Keeper) GetTokenApproval(
+	ctx sdk.Context,
+	erc721 common.Address,
+	tokenID *big.Int,
+	owner common.Address,
+) common.Address {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenApproval)
+	bz := store.Get(types.TokenApprovalKey(erc721, tokenID))
+	if bz == nil {
+		return common.Address{}
+	}
+
+	var approval types.TokenApproval
+	k.cdc.MustUnmarshal(bz, &approval)
+	if common.HexToAddress(approval.Owner) != owner {
+		return common.Address{}
+	}
+
+	return common.HexToAddress(approval.Spender)
+}
+
+// SetTokenApproval approves the spender to transfer the given token of the
+// owner on the given erc721 precompile address.
+func (k Keeper) SetTokenApproval(
+	ctx sdk.Context,
+	erc721 common.Address,
+	tokenID *big.Int,
+	owner common.Address,
+	spender common.Address,
+) {
+	approval := types.TokenApproval{
+		Erc721Address: erc721.Hex(),
+		TokenId:       tokenID.String(),
+		Owner:         owner.Hex(),
+		Spender:       spender.Hex(),
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenApproval)
+	store.Set(types.TokenApprovalKey(erc721, tokenID), k.cdc.MustMarshal(&approval))
+}
+
+// DeleteTokenApproval deletes the approval of the given token on the given
+// erc721 precompile address.
+func (k Keeper) DeleteTokenApproval(
+	ctx sdk.Context,
+	erc721 common.Address,
+	tokenID *big.Int,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenApproval)
+	store.Delete(types.TokenApprovalKey(erc721, tokenID))
+}
+
+// GetAllTokenApprovals returns all the token approvals stored on the module.
+func (k Keeper) GetAllTokenApprovals(ctx sdk.Context) []types.TokenApproval {
+	approvals := []types.TokenApproval{}
+
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixTokenApproval)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.TokenApproval
+		k.cdc.MustUnmarshal(iterator.Value(), &approval)
+		approvals = append(approvals, approval)
+	}
+
+	return approvals
+}
+
+// IsApprovedForAll returns true if the operator is approved to transfer all the
+// tokens of the owner on the given erc721 precompile address.
+func (k Keeper) IsApprovedForAll(
+	ctx sdk.Context,
+	erc721 common.Address,
+	owner common.Address,
+	operator common.Address,
+) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorApproval)
+	return store.Has(types.OperatorApprovalKey(erc721, owner, operator))
+}
+
+// SetApprovalForAll approves or removes the operator to transfer all the tokens
+// of the owner on the given erc721 precompile address.
+func (k Keeper) SetApprovalForAll(
+	ctx sdk.Context,
+	erc721 common.Address,
+	owner common.Address,
+	operator common.Address,
+	approved bool,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorApproval)
+	key := types.OperatorApprovalKey(erc721, owner, operator)
+	if approved {
+		store.Set(key, isTrue)
+		return
+	}
+	store.Delete(key)
+}
+
+// GetAllOperatorApprovals returns all the operator approvals stored on the
+// module.
+func (k Keeper) GetAllOperatorApprovals(ctx sdk.Context) []types.OperatorApproval {
+	approvals := []types.OperatorApproval{}
+
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorApproval)
+	defer iterator.Close()
+
+	addrLen := common.AddressLength
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.KeyPrefixOperatorApproval):]
+		approvals = append(approvals, types.OperatorApproval{
+			Erc721Address: common.BytesToAddress(key[:addrLen]).Hex(),
+			Owner:         common.BytesToAddress(key[addrLen : 2*addrLen]).Hex(),
+			Operator:      common.BytesToAddress(key[2*addrLen:]).Hex(),
+		})
+	}
+
+	return approvals
+}
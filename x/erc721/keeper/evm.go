@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	erc721precompile "github.com/cosmos/evm/precompiles/erc721"
+	"github.com/cosmos/evm/x/erc721/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// erc721ABI returns the ABI used to interact with the ERC721 contracts deployed
+// on the EVM. It is the one of the ERC-721 precompile, which implements the
+// ERC-721 standard and its metadata extension.
+func erc721ABI() (abi.ABI, error) {
+	return erc721precompile.LoadABI()
+}
+
+// QueryERC721 returns the name and symbol of a deployed ERC721 contract.
+func (k Keeper) QueryERC721(
+	ctx sdk.Context,
+	contract common.Address,
+) (name, symbol string, err error) {
+	erc721, err := erc721ABI()
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := k.callERC721(ctx, erc721, contract, &name, erc721precompile.NameMethod); err != nil {
+		return "", "", err
+	}
+
+	if err := k.callERC721(ctx, erc721, contract, &symbol, erc721precompile.SymbolMethod); err != nil {
+		return "", "", err
+	}
+
+	return name, symbol, nil
+}
+
+// OwnerOf queries the owner of the given token of a deployed ERC721 contract.
+func (k Keeper) OwnerOf(
+	ctx sdk.Context,
+	contract common.Address,
+	tokenID *big.Int,
+) (common.Address, error) {
+	erc721, err := erc721ABI()
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	var owner common.Address
+	if err := k.callERC721(ctx, erc721, contract, &owner, erc721precompile.OwnerOfMethod, tokenID); err != nil {
+		return common.Address{}, err
+	}
+
+	return owner, nil
+}
+
+// TokenURI queries the URI of the given token of a deployed ERC721 contract. An
+// empty URI is returned if the contract does not implement the metadata
+// extension.
+func (k Keeper) TokenURI(
+	ctx sdk.Context,
+	contract common.Address,
+	tokenID *big.Int,
+) string {
+	erc721, err := erc721ABI()
+	if err != nil {
+		return ""
+	}
+
+	var uri string
+	if err := k.callERC721(ctx, erc721, contract, &uri, erc721precompile.TokenURIMethod, tokenID); err != nil {
+		return ""
+	}
+
+	return uri
+}
+
+// TransferERC721 transfers the given token of a deployed ERC721 contract from
+// the given address, which must be the owner of the token or approved by it, to
+// the receiver. The post transfer owner of the token is checked to prevent
+// malicious contracts from breaking the conversion invariants.
+func (k Keeper) TransferERC721(
+	ctx sdk.Context,
+	contract common.Address,
+	from, to common.Address,
+	tokenID *big.Int,
+) error {
+	erc721, err := erc721ABI()
+	if err != nil {
+		return err
+	}
+
+	res, err := k.evmKeeper.CallEVM(ctx, erc721, from, contract, true, nil, erc721precompile.TransferFromMethod, from, to, tokenID)
+	if err != nil {
+		return err
+	}
+	if res.Failed() {
+		return errorsmod.Wrapf(types.ErrEVMCall, "failed to execute transfer: %s", res.VmError)
+	}
+
+	owner, err := k.OwnerOf(ctx, contract, tokenID)
+	if err != nil {
+		return err
+	}
+	if owner != to {
+		return errorsmod.Wrapf(
+			types.ErrOwnerInvariance,
+			"owner of token %s is %s, expected %s", tokenID, owner, to,
+		)
+	}
+
+	return nil
+}
+
+// callERC721 performs a read-only call of the given method on a deployed ERC721
+// contract and unpacks the result into out.
+func (k Keeper) callERC721(
+	ctx sdk.Context,
+	erc721 abi.ABI,
+	contract common.Address,
+	out interface{},
+	method string,
+	args ...interface{},
+) error {
+	res, err := k.evmKeeper.CallEVM(ctx, erc721, types.ModuleAddress, contract, false, nil, method, args...)
+	if err != nil {
+		return err
+	}
+
+	if err := erc721.UnpackIntoInterface(out, method, res.Ret); err != nil {
+		return errorsmod.Wrapf(
+			types.ErrABIUnpack, "failed to unpack %s: %s", method, err.Error(),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc721/types"
+	nfttransfertypes "github.com/cosmos/evm/x/ibc/nfttransfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// OnRecvPacket performs the ICS-721 middleware receive callback. There are 2
+// cases that require an action:
+//
+//  1. The NFTs are received under a new ibc/{hash} voucher class. The class is
+//     registered with an ERC-721 precompile so that the vouchers are available
+//     on the EVM.
+//  2. The NFTs are returning to the class of an ERC721 contract deployed on the
+//     EVM. The NFTs are automatically converted into the ERC721 tokens for the
+//     hex address of the receiver.
+//
+// CONTRACT: This middleware MUST be executed after the ICS-721 OnRecvPacket.
+func (k Keeper) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack exported.Acknowledgement,
+) exported.Acknowledgement {
+	// If ERC721 module is disabled no-op
+	if !k.IsERC721Enabled(ctx) {
+		return ack
+	}
+
+	data, err := nfttransfertypes.UnmarshalPacketData(packet.GetData())
+	if err != nil {
+		// NOTE: shouldn't happen as the packet has already
+		// been decoded on ICS-721 transfer logic
+		err = errorsmod.Wrapf(errortypes.ErrInvalidType, "cannot unmarshal ICS-721 nft-transfer packet data")
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// use a zero gas config to avoid extra costs for the relayers
+	ctx = ctx.
+		WithKVGasConfig(storetypes.GasConfig{}).
+		WithTransientKVGasConfig(storetypes.GasConfig{})
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	classID := nfttransfertypes.GetReceivedClassID(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.ClassId,
+	)
+
+	pair, found := k.GetTokenPair(ctx, k.GetClassMap(ctx, classID))
+	switch {
+	// Case 1. the voucher class is not registered
+	case !found && strings.HasPrefix(classID, nfttransfertypes.ClassPrefix+"/"):
+		tokenPair, err := k.RegisterClassExtension(ctx, classID)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRegisterERC721Extension,
+				sdk.NewAttribute(types.AttributeClassSourceChannel, packet.SourceChannel),
+				sdk.NewAttribute(types.AttributeKeyERC721Token, tokenPair.Erc721Address),
+				sdk.NewAttribute(types.AttributeKeyClassID, tokenPair.ClassId),
+			),
+		)
+
+	// Case 2. the class of an ERC721 contract
+	case found && pair.IsNativeERC721():
+		// Token pair is disabled -> return
+		if !pair.Enabled {
+			return ack
+		}
+
+		// return acknowledgement without conversion if receiver is a module account
+		if isModuleAccount(k.accountKeeper.GetAccount(ctx, receiver)) {
+			return ack
+		}
+
+		if err := k.ConvertNFTsIntoERC721(ctx, pair, data.TokenIds, receiver, common.BytesToAddress(receiver)); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacket responds to the success or failure of a packet
+// acknowledgement written on the receiving chain. If the acknowledgement was a
+// success then nothing occurs. If the acknowledgement failed, then the sender
+// is refunded and then the NFTs are converted to ERC721.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context, _ channeltypes.Packet,
+	data nfttransfertypes.NonFungibleTokenPacketData,
+	ack channeltypes.Acknowledgement,
+) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		// convert the NFTs to their ERC721 representation
+		return k.ConvertNFTsToERC721FromPacket(ctx, data)
+	default:
+		// the acknowledgement succeeded on the receiving chain so nothing needs to
+		// be executed and no error needs to be returned
+		return nil
+	}
+}
+
+// OnTimeoutPacket converts the NFTs to ERC721 after refunding the sender since
+// the original packet sent was never received and has been timed out.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, _ channeltypes.Packet, data nfttransfertypes.NonFungibleTokenPacketData) error {
+	return k.ConvertNFTsToERC721FromPacket(ctx, data)
+}
+
+// ConvertNFTsToERC721FromPacket converts the refunded NFTs of the class of an
+// ERC721 contract back to ERC721 tokens. This function is only executed when
+// IBC timeout or an Error ACK happens.
+func (k Keeper) ConvertNFTsToERC721FromPacket(ctx sdk.Context, data nfttransfertypes.NonFungibleTokenPacketData) error {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	classID := nfttransfertypes.ParseClassTrace(data.ClassId).IBCClassID()
+	pair, found := k.GetTokenPair(ctx, k.GetClassMap(ctx, classID))
+	if !found || !pair.IsNativeERC721() {
+		// no-op, the class is not the one of an ERC721 contract
+		return nil
+	}
+
+	if !k.IsERC721Enabled(ctx) || !pair.Enabled {
+		// no-op, ERC721s are disabled or the pair is disabled
+		return nil
+	}
+
+	// use a zero gas config to avoid extra costs for the relayers
+	ctx = ctx.
+		WithKVGasConfig(storetypes.GasConfig{}).
+		WithTransientKVGasConfig(storetypes.GasConfig{})
+
+	// module accounts keep their tokens in the x/nft representation
+	if isModuleAccount(k.accountKeeper.GetAccount(ctx, sender)) {
+		return nil
+	}
+
+	return k.ConvertNFTsIntoERC721(ctx, pair, data.TokenIds, sender, common.BytesToAddress(sender))
+}
+
+// isModuleAccount returns true if the given account is a module account
+func isModuleAccount(acc sdk.AccountI) bool {
+	_, isModuleAccount := acc.(sdk.ModuleAccountI)
+	return isModuleAccount
+}
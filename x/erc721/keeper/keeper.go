@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/x/erc721/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of this module maintains the pairs between x/nft classes and ERC-721
+// contracts.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	accountKeeper types.AccountKeeper
+	nftKeeper     types.NFTKeeper
+	evmKeeper     types.EVMKeeper
+}
+
+// NewKeeper creates new instances of the erc721 Keeper
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
+	ak types.AccountKeeper,
+	nftKeeper types.NFTKeeper,
+	evmKeeper types.EVMKeeper,
+) Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		authority:     authority,
+		storeKey:      storeKey,
+		cdc:           cdc,
+		accountKeeper: ak,
+		nftKeeper:     nftKeeper,
+		evmKeeper:     evmKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
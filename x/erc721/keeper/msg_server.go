@@ -0,0 +1,298 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc721/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// ConvertERC721 converts ERC721 tokens into x/nft NFTs. Only the pairs of
+// ERC721 contracts deployed on the EVM can be converted, as the NFTs of module
+// owned classes are already accessible through their ERC-721 precompile.
+func (k Keeper) ConvertERC721(
+	goCtx context.Context,
+	msg *types.MsgConvertERC721,
+) (*types.MsgConvertERC721Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver := sdk.MustAccAddressFromBech32(msg.Receiver)
+	sender := common.HexToAddress(msg.Sender)
+
+	pair, err := k.ConversionEnabled(ctx, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case pair.IsNativeERC721():
+		if err := k.ConvertERC721IntoNFTs(ctx, pair, msg.TokenIds, sender, receiver); err != nil {
+			return nil, err
+		}
+	case pair.IsNativeClass():
+		return nil, types.ErrNativeConversionDisabled
+	default:
+		return nil, types.ErrUndefinedOwner
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertERC721,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, pair.ClassId),
+			sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(msg.TokenIds, ",")),
+		),
+	)
+
+	return &types.MsgConvertERC721Response{}, nil
+}
+
+// ConvertNFT converts x/nft NFTs into ERC721 tokens. Only the pairs of ERC721
+// contracts deployed on the EVM can be converted, as the NFTs of module owned
+// classes are already accessible through their ERC-721 precompile.
+func (k Keeper) ConvertNFT(
+	goCtx context.Context,
+	msg *types.MsgConvertNFT,
+) (*types.MsgConvertNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	receiver := common.HexToAddress(msg.Receiver)
+
+	pair, err := k.ConversionEnabled(ctx, msg.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case pair.IsNativeERC721():
+		if err := k.ConvertNFTsIntoERC721(ctx, pair, msg.TokenIds, sender, receiver); err != nil {
+			return nil, err
+		}
+	case pair.IsNativeClass():
+		return nil, types.ErrNativeConversionDisabled
+	default:
+		return nil, types.ErrUndefinedOwner
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertNFT,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, pair.ClassId),
+			sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(msg.TokenIds, ",")),
+		),
+	)
+
+	return &types.MsgConvertNFTResponse{}, nil
+}
+
+// ConversionEnabled returns the token pair of the given class id or ERC721
+// contract address if the module and the pair conversions are enabled.
+func (k Keeper) ConversionEnabled(ctx sdk.Context, token string) (types.TokenPair, error) {
+	if !k.IsERC721Enabled(ctx) {
+		return types.TokenPair{}, errorsmod.Wrap(
+			types.ErrERC721Disabled, "module is currently disabled by governance",
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, token))
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	if !pair.Enabled {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrERC721TokenPairDisabled, "conversion of token '%s' is not enabled by governance", token,
+		)
+	}
+
+	return pair, nil
+}
+
+// ConvertERC721IntoNFTs handles the conversion of the tokens of an ERC721
+// contract deployed on the EVM:
+//   - escrow the ERC721 tokens on the module account
+//   - check that the module account owns the escrowed tokens
+//   - mint the NFTs of the class of the pair with the token URIs to the receiver
+func (k Keeper) ConvertERC721IntoNFTs(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	tokenIDs []string,
+	sender common.Address,
+	receiver sdk.AccAddress,
+) error {
+	contract := pair.GetERC721Contract()
+	for _, id := range tokenIDs {
+		tokenID, err := types.ParseTokenID(id)
+		if err != nil {
+			return err
+		}
+
+		if err := k.TransferERC721(ctx, contract, sender, types.ModuleAddress, tokenID); err != nil {
+			return errorsmod.Wrapf(err, "failed to escrow token %s", id)
+		}
+
+		token := nft.NFT{
+			ClassId: pair.ClassId,
+			Id:      id,
+			Uri:     k.TokenURI(ctx, contract, tokenID),
+		}
+		if err := k.nftKeeper.Mint(ctx, token, receiver); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ConvertNFTsIntoERC721 handles the conversion of the NFTs of the class of an
+// ERC721 contract deployed on the EVM:
+//   - check that the sender owns the NFTs
+//   - burn the NFTs
+//   - unescrow the ERC721 tokens previously escrowed by ConvertERC721 and send
+//     them to the receiver
+//   - check that the receiver owns the unescrowed tokens
+func (k Keeper) ConvertNFTsIntoERC721(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	tokenIDs []string,
+	sender sdk.AccAddress,
+	receiver common.Address,
+) error {
+	contract := pair.GetERC721Contract()
+	for _, id := range tokenIDs {
+		tokenID, err := types.ParseTokenID(id)
+		if err != nil {
+			return err
+		}
+
+		if owner := k.nftKeeper.GetOwner(ctx, pair.ClassId, id); !owner.Equals(sender) {
+			return errorsmod.Wrapf(types.ErrUnauthorized, "class %s token %s", pair.ClassId, id)
+		}
+
+		if err := k.nftKeeper.Burn(ctx, pair.ClassId, id); err != nil {
+			return err
+		}
+
+		if err := k.TransferERC721(ctx, contract, types.ModuleAddress, receiver, tokenID); err != nil {
+			return errorsmod.Wrapf(err, "failed to unescrow token %s", id)
+		}
+	}
+
+	return nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
+// it updates the parameters in the keeper only if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// RegisterERC721 implements the gRPC MsgServer interface. After a successful
+// governance vote it registers the pairs of ERC721 contracts deployed on the
+// EVM with new x/nft classes.
+func (k *Keeper) RegisterERC721(goCtx context.Context, req *types.MsgRegisterERC721) (*types.MsgRegisterERC721Response, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsERC721Enabled(ctx) {
+		return nil, types.ErrERC721Disabled.Wrap("registration is currently disabled by governance")
+	}
+
+	for _, addr := range req.Erc721Addresses {
+		if !common.IsHexAddress(addr) {
+			return nil, errortypes.ErrInvalidAddress.Wrapf("invalid ERC721 contract address: %s", addr)
+		}
+
+		pair, err := k.registerERC721(ctx, common.HexToAddress(addr))
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRegisterERC721,
+				sdk.NewAttribute(types.AttributeKeyClassID, pair.ClassId),
+				sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+			),
+		)
+	}
+
+	return &types.MsgRegisterERC721Response{}, nil
+}
+
+// RegisterClass implements the gRPC MsgServer interface. After a successful
+// governance vote it registers the pairs of existing x/nft classes with ERC-721
+// precompiles.
+func (k *Keeper) RegisterClass(goCtx context.Context, req *types.MsgRegisterClass) (*types.MsgRegisterClassResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsERC721Enabled(ctx) {
+		return nil, types.ErrERC721Disabled.Wrap("registration is currently disabled by governance")
+	}
+
+	for _, classID := range req.ClassIds {
+		pair, err := k.registerClass(ctx, classID)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRegisterClass,
+				sdk.NewAttribute(types.AttributeKeyClassID, pair.ClassId),
+				sdk.NewAttribute(types.AttributeKeyERC721Token, pair.Erc721Address),
+			),
+		)
+	}
+
+	return &types.MsgRegisterClassResponse{}, nil
+}
+
+// validateAuthority is a helper function to validate that the provided authority
+// is the keeper's authority address
+func (k *Keeper) validateAuthority(authority string) error {
+	if _, err := k.accountKeeper.AddressCodec().StringToBytes(authority); err != nil {
+		return errortypes.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if k.authority.String() != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
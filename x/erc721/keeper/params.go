@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/erc721/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the total set of erc721 parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixParams)
+	if bz == nil {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the erc721 parameters in the store.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.KeyPrefixParams, bz)
+	return nil
+}
+
+// IsERC721Enabled returns true if the module logic is enabled
+func (k Keeper) IsERC721Enabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).EnableErc721
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	erc721precompile "github.com/cosmos/evm/precompiles/erc721"
+	"github.com/cosmos/evm/x/erc721/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetERC721PrecompileInstance returns the ERC-721 precompile instance for the
+// given address. Only the enabled pairs of module owned x/nft classes are
+// represented by a precompile.
+func (k Keeper) GetERC721PrecompileInstance(
+	ctx sdk.Context,
+	address common.Address,
+) (contract vm.PrecompiledContract, found bool, err error) {
+	pair, found := k.GetTokenPair(ctx, k.GetERC721Map(ctx, address))
+	if !found || !pair.IsNativeClass() || !pair.Enabled {
+		return nil, false, nil
+	}
+
+	precompile, err := erc721precompile.NewPrecompile(pair, k.nftKeeper, k)
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "precompiled contract not initialized: %s", address.String())
+	}
+
+	return precompile, true, nil
+}
+
+// RegisterERC721CodeHash sets the codehash for the erc721 precompile account
+// if the bytecode for the erc721 codehash does not exists, it stores it.
+func (k Keeper) RegisterERC721CodeHash(ctx sdk.Context, erc721Addr common.Address) error {
+	var (
+		// bytecode and codeHash is the same for all the classes
+		bytecode = common.FromHex(types.Erc721Bytecode)
+		codeHash = crypto.Keccak256(bytecode)
+	)
+	// check if code was already stored
+	code := k.evmKeeper.GetCode(ctx, common.Hash(codeHash))
+	if len(code) == 0 {
+		k.evmKeeper.SetCode(ctx, codeHash, bytecode)
+	}
+
+	var (
+		nonce   uint64
+		balance = common.U2560
+	)
+	// keep balance and nonce if account exists
+	if acc := k.evmKeeper.GetAccount(ctx, erc721Addr); acc != nil {
+		nonce = acc.Nonce
+		balance = acc.Balance
+	}
+
+	return k.evmKeeper.SetAccount(ctx, erc721Addr, statedb.Account{
+		CodeHash: codeHash,
+		Nonce:    nonce,
+		Balance:  balance,
+	})
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc721/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// registerERC721 creates the x/nft class that represents the ERC721 contract
+// and registers the token pair between the class and the contract.
+func (k Keeper) registerERC721(
+	ctx sdk.Context,
+	contract common.Address,
+) (*types.TokenPair, error) {
+	// Check if ERC721 is already registered
+	if k.IsERC721Registered(ctx, contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "token ERC721 contract already registered: %s", contract.String(),
+		)
+	}
+
+	classID := types.CreateClassID(contract)
+	if k.nftKeeper.HasClass(ctx, classID) || k.IsClassRegistered(ctx, classID) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "class already registered: %s", classID,
+		)
+	}
+
+	name, symbol, err := k.QueryERC721(ctx, contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to query the ERC721 metadata")
+	}
+
+	class := nft.Class{
+		Id:          classID,
+		Name:        name,
+		Symbol:      symbol,
+		Description: "Cosmos NFT class representation of " + contract.String(),
+	}
+	if err := k.nftKeeper.SaveClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	pair := types.NewTokenPairForERC721(contract)
+	k.SetToken(ctx, pair)
+	return &pair, nil
+}
+
+// registerClass registers the token pair between an existing x/nft class and
+// a module owned ERC-721 precompile.
+func (k Keeper) registerClass(
+	ctx sdk.Context,
+	classID string,
+) (*types.TokenPair, error) {
+	if !k.nftKeeper.HasClass(ctx, classID) {
+		return nil, errorsmod.Wrapf(nft.ErrClassNotExists, "class %s", classID)
+	}
+
+	return k.RegisterClassExtension(ctx, classID)
+}
+
+// RegisterClassExtension creates the token pair of a x/nft class and registers
+// the code hash of its ERC-721 precompile.
+//
+// CONTRACT: the class must exist on x/nft.
+func (k Keeper) RegisterClassExtension(ctx sdk.Context, classID string) (*types.TokenPair, error) {
+	if k.IsClassRegistered(ctx, classID) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "class already registered: %s", classID,
+		)
+	}
+
+	pair := types.NewTokenPairForClass(classID)
+	if k.IsERC721Registered(ctx, pair.GetERC721Contract()) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "token ERC721 contract already registered: %s", pair.Erc721Address,
+		)
+	}
+
+	if err := k.RegisterERC721CodeHash(ctx, pair.GetERC721Contract()); err != nil {
+		return nil, err
+	}
+
+	k.SetToken(ctx, pair)
+	return &pair, nil
+}
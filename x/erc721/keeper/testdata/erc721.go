@@ -0,0 +1,160 @@
+package testdata
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/program"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+const (
+	// ERC721Name is the name returned by the test ERC721 contract.
+	ERC721Name = "TestNFT"
+	// ERC721Symbol is the symbol returned by the test ERC721 contract.
+	ERC721Symbol = "TNFT"
+	// ERC721TokenURI is the uri returned by the test ERC721 contract for every
+	// token.
+	ERC721TokenURI = "ipfs://test-nft"
+)
+
+// erc721ABI is the ABI of the methods implemented by the test ERC721 contract.
+const erc721ABI = `[
+	{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"tokenURI","stateMutability":"view","inputs":[{"name":"tokenId","type":"uint256"}],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"ownerOf","stateMutability":"view","inputs":[{"name":"tokenId","type":"uint256"}],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"transferFrom","stateMutability":"nonpayable","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"mint","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"}],"outputs":[]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"tokenId","type":"uint256","indexed":true}]}
+]`
+
+// LoadERC721Contract returns a minimal ERC721 contract with a public mint
+// method. The owner of each token is stored in the storage slot of the token
+// id and only the owner can transfer a token, as approvals are not supported.
+//
+// The contract is assembled from opcodes because the tests only need the
+// subset of the standard used by the x/erc721 conversions.
+func LoadERC721Contract() (evmtypes.CompiledContract, error) {
+	contractABI, err := abi.JSON(strings.NewReader(erc721ABI))
+	if err != nil {
+		return evmtypes.CompiledContract{}, err
+	}
+
+	// the jump destinations are only known after a first assembly pass
+	labels := assembleERC721(contractABI, nil).labels
+	runtime := assembleERC721(contractABI, labels).Bytes()
+
+	return evmtypes.CompiledContract{
+		ABI: contractABI,
+		Bin: program.New().ReturnViaCodeCopy(runtime).Bytes(),
+	}, nil
+}
+
+// assembler wraps a program to resolve named jump destinations.
+type assembler struct {
+	*program.Program
+	// targets are the jump destinations of a previous pass
+	targets map[string]uint64
+	// labels are the jump destinations of the current pass
+	labels map[string]uint64
+}
+
+// label adds a named jump destination.
+func (a *assembler) label(name string) {
+	_, pc := a.Jumpdest()
+	a.labels[name] = pc
+}
+
+// jumpIf jumps to the named destination if the value on top of the stack is
+// not zero. The destination is always pushed with PUSH2 so that the code
+// size does not change between passes.
+func (a *assembler) jumpIf(name string) {
+	pc := a.targets[name]
+	a.Op(vm.PUSH2).Append([]byte{byte(pc >> 8), byte(pc)})
+	a.Op(vm.JUMPI)
+}
+
+// returnString returns the ABI encoding of a string of up to 32 bytes.
+func (a *assembler) returnString(s string) {
+	word := make([]byte, 32)
+	copy(word, s)
+
+	a.Push(0x20).Push(0).Op(vm.MSTORE)
+	a.Push(len(s)).Push(0x20).Op(vm.MSTORE)
+	a.Push(word).Push(0x40).Op(vm.MSTORE)
+	a.Return(0, 0x60)
+}
+
+func assembleERC721(contractABI abi.ABI, targets map[string]uint64) *assembler {
+	a := &assembler{
+		Program: program.New(),
+		targets: targets,
+		labels:  make(map[string]uint64),
+	}
+	transferEvent := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+	// dispatch the call on the method selector
+	methods := []string{"name", "symbol", "tokenURI", "ownerOf", "transferFrom", "mint"}
+	a.Push(0).Op(vm.CALLDATALOAD).Push(0xe0).Op(vm.SHR)
+	for _, name := range methods {
+		a.Op(vm.DUP1).Push(contractABI.Methods[name].ID).Op(vm.EQ)
+		a.jumpIf(name)
+	}
+	a.Push(0).Op(vm.DUP1).Op(vm.REVERT)
+
+	a.label("name")
+	a.returnString(ERC721Name)
+
+	a.label("symbol")
+	a.returnString(ERC721Symbol)
+
+	a.label("tokenURI")
+	a.returnString(ERC721TokenURI)
+
+	// ownerOf(tokenId) reverts if the token does not exist
+	a.label("ownerOf")
+	a.Push(0x04).Op(vm.CALLDATALOAD, vm.SLOAD)
+	a.Op(vm.DUP1, vm.ISZERO)
+	a.jumpIf("revert")
+	a.Push(0).Op(vm.MSTORE)
+	a.Return(0, 0x20)
+
+	// transferFrom(from, to, tokenId) requires the caller to be the owner
+	a.label("transferFrom")
+	a.Push(0x44).Op(vm.CALLDATALOAD) // [id]
+	a.Op(vm.DUP1, vm.SLOAD)          // [id, owner]
+	a.Push(0x04).Op(vm.CALLDATALOAD) // [id, owner, from]
+	a.Op(vm.DUP1, vm.CALLER, vm.EQ, vm.ISZERO)
+	a.jumpIf("revert")
+	a.Op(vm.EQ, vm.ISZERO) // [id]
+	a.jumpIf("revert")
+	a.Push(0x24).Op(vm.CALLDATALOAD) // [id, to]
+	a.Op(vm.DUP1, vm.ISZERO)
+	a.jumpIf("revert")
+	a.Op(vm.DUP2, vm.DUP2, vm.SWAP1, vm.SSTORE)
+	a.Push(0x04).Op(vm.CALLDATALOAD) // [id, to, from]
+	a.Push(transferEvent).Push(0).Push(0).Op(vm.LOG4)
+	a.Op(vm.STOP)
+
+	// mint(to, tokenId) reverts if the token already exists
+	a.label("mint")
+	a.Push(0x24).Op(vm.CALLDATALOAD) // [id]
+	a.Op(vm.DUP1, vm.SLOAD)
+	a.jumpIf("revert")
+	a.Push(0x04).Op(vm.CALLDATALOAD) // [id, to]
+	a.Op(vm.DUP1, vm.ISZERO)
+	a.jumpIf("revert")
+	a.Op(vm.DUP2, vm.DUP2, vm.SWAP1, vm.SSTORE)
+	a.Push(0) // [id, to, from]
+	a.Push(transferEvent).Push(0).Push(0).Op(vm.LOG4)
+	a.Op(vm.STOP)
+
+	a.label("revert")
+	a.Push(0).Op(vm.DUP1).Op(vm.REVERT)
+
+	return a
+}
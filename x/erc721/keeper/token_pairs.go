@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc721/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetToken stores a token pair, class map and erc721 map.
+func (k Keeper) SetToken(ctx sdk.Context, pair types.TokenPair) {
+	k.SetTokenPair(ctx, pair)
+	k.SetClassMap(ctx, pair.ClassId, pair.GetID())
+	k.SetERC721Map(ctx, pair.GetERC721Contract(), pair.GetID())
+}
+
+// GetTokenPairs gets all registered token pairs.
+func (k Keeper) GetTokenPairs(ctx sdk.Context) []types.TokenPair {
+	tokenPairs := []types.TokenPair{}
+
+	k.IterateTokenPairs(ctx, func(tokenPair types.TokenPair) (stop bool) {
+		tokenPairs = append(tokenPairs, tokenPair)
+		return false
+	})
+
+	return tokenPairs
+}
+
+// IterateTokenPairs iterates over all the stored token pairs.
+func (k Keeper) IterateTokenPairs(ctx sdk.Context, cb func(tokenPair types.TokenPair) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixTokenPair)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tokenPair types.TokenPair
+		k.cdc.MustUnmarshal(iterator.Value(), &tokenPair)
+
+		if cb(tokenPair) {
+			break
+		}
+	}
+}
+
+// GetTokenPairID returns the pair id for the specified token. Hex address or
+// class id can be used as token argument. If the token is not registered empty
+// bytes are returned.
+func (k Keeper) GetTokenPairID(ctx sdk.Context, token string) []byte {
+	if common.IsHexAddress(token) {
+		addr := common.HexToAddress(token)
+		return k.GetERC721Map(ctx, addr)
+	}
+	return k.GetClassMap(ctx, token)
+}
+
+// GetTokenPair gets a registered token pair from the identifier.
+func (k Keeper) GetTokenPair(ctx sdk.Context, id []byte) (types.TokenPair, bool) {
+	if id == nil {
+		return types.TokenPair{}, false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	var tokenPair types.TokenPair
+	bz := store.Get(id)
+	if len(bz) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &tokenPair)
+	return tokenPair, true
+}
+
+// SetTokenPair stores a token pair.
+func (k Keeper) SetTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	key := tokenPair.GetID()
+	bz := k.cdc.MustMarshal(&tokenPair)
+	store.Set(key, bz)
+}
+
+// GetERC721Map returns the token pair id for the given address.
+func (k Keeper) GetERC721Map(ctx sdk.Context, erc721 common.Address) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC721)
+	return store.Get(erc721.Bytes())
+}
+
+// GetClassMap returns the token pair id for the given class id.
+func (k Keeper) GetClassMap(ctx sdk.Context, classID string) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByClass)
+	return store.Get([]byte(classID))
+}
+
+// SetERC721Map sets the token pair id for the given address.
+func (k Keeper) SetERC721Map(ctx sdk.Context, erc721 common.Address, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC721)
+	store.Set(erc721.Bytes(), id)
+}
+
+// SetClassMap sets the token pair id for the class id.
+func (k Keeper) SetClassMap(ctx sdk.Context, classID string, id []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByClass)
+	store.Set([]byte(classID), id)
+}
+
+// IsTokenPairRegistered - check if registered token tokenPair is registered.
+func (k Keeper) IsTokenPairRegistered(ctx sdk.Context, id []byte) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	return store.Has(id)
+}
+
+// IsERC721Registered check if registered ERC721 token is registered.
+func (k Keeper) IsERC721Registered(ctx sdk.Context, erc721 common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByERC721)
+	return store.Has(erc721.Bytes())
+}
+
+// IsClassRegistered check if registered x/nft class is registered.
+func (k Keeper) IsClassRegistered(ctx sdk.Context, classID string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByClass)
+	return store.Has([]byte(classID))
+}
+
+// GetClassTokenPair returns the token pair of the given class id or an error if
+// the class is not registered.
+func (k Keeper) GetClassTokenPair(ctx sdk.Context, classID string) (types.TokenPair, error) {
+	pair, found := k.GetTokenPair(ctx, k.GetClassMap(ctx, classID))
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "class '%s' not registered", classID,
+		)
+	}
+	return pair, nil
+}
+
+// GetERC721TokenPair returns the token pair of the given ERC721 contract or an
+// error if the contract is not registered.
+func (k Keeper) GetERC721TokenPair(ctx sdk.Context, contract common.Address) (types.TokenPair, error) {
+	pair, found := k.GetTokenPair(ctx, k.GetERC721Map(ctx, contract))
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", contract,
+		)
+	}
+	return pair, nil
+}
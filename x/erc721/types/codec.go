@@ -0,0 +1,62 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global erc721 module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding.
+	//
+	// The actual codec used for serialization should be provided to modules/erc721 and
+	// defined at the application level.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino) //nolint:staticcheck
+)
+
+const (
+	// Amino names
+	convertERC721Name  = "cosmos/evm/x/erc721/MsgConvertERC721"
+	convertNFTName     = "cosmos/evm/x/erc721/MsgConvertNFT"
+	updateParamsName   = "cosmos/evm/x/erc721/MsgUpdateParams"
+	registerERC721Name = "cosmos/evm/x/erc721/MsgRegisterERC721"
+	registerClassName  = "cosmos/evm/x/erc721/MsgRegisterClass"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces register implementations
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgConvertERC721{},
+		&MsgConvertNFT{},
+		&MsgUpdateParams{},
+		&MsgRegisterERC721{},
+		&MsgRegisterClass{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/erc721 interfaces and
+// concrete types on the provided LegacyAmino codec. These types are used for
+// Amino JSON serialization and EIP-712 compatibility.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgConvertERC721{}, convertERC721Name, nil)
+	cdc.RegisterConcrete(&MsgConvertNFT{}, convertNFTName, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgRegisterERC721{}, registerERC721Name, nil)
+	cdc.RegisterConcrete(&MsgRegisterClass{}, registerClassName, nil)
+}
@@ -0,0 +1,7 @@
+package types
+
+// Erc721Bytecode is the runtime bytecode set on the accounts of the ERC-721
+// precompiles of the module owned classes, so that they are identified as
+// contracts. It only reverts: calls to these accounts are always executed by the
+// precompile.
+const Erc721Bytecode = "0x60006000fd"
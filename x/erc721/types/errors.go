@@ -0,0 +1,22 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrERC721Disabled           = errorsmod.Register(ModuleName, 2, "erc721 module is disabled")
+	ErrTokenPairNotFound        = errorsmod.Register(ModuleName, 3, "token pair not found")
+	ErrTokenPairAlreadyExists   = errorsmod.Register(ModuleName, 4, "token pair already exists")
+	ErrUndefinedOwner           = errorsmod.Register(ModuleName, 5, "undefined owner of contract pair")
+	ErrERC721TokenPairDisabled  = errorsmod.Register(ModuleName, 6, "erc721 token pair is disabled")
+	ErrNativeConversionDisabled = errorsmod.Register(ModuleName, 7, "native NFTs manual conversion is disabled")
+	ErrEVMCall                  = errorsmod.Register(ModuleName, 8, "EVM call unexpected error")
+	ErrABIPack                  = errorsmod.Register(ModuleName, 9, "contract ABI pack failed")
+	ErrABIUnpack                = errorsmod.Register(ModuleName, 10, "contract ABI unpack failed")
+	ErrInvalidTokenID           = errorsmod.Register(ModuleName, 11, "invalid token id")
+	ErrOwnerInvariance          = errorsmod.Register(ModuleName, 12, "post transfer owner invariant failed")
+	ErrUnauthorized             = errorsmod.Register(ModuleName, 13, "not the owner of the token")
+	ErrInvalidIBC               = errorsmod.Register(ModuleName, 14, "invalid IBC transaction")
+)
@@ -0,0 +1,16 @@
+package types
+
+// erc721 events
+const (
+	EventTypeConvertERC721           = "convert_erc721"
+	EventTypeConvertNFT              = "convert_nft"
+	EventTypeRegisterERC721          = "register_erc721"
+	EventTypeRegisterClass           = "register_class"
+	EventTypeRegisterERC721Extension = "register_erc721_extension"
+
+	AttributeClassSourceChannel = "source_channel"
+	AttributeKeyClassID         = "class_id"
+	AttributeKeyERC721Token     = "erc721_token"
+	AttributeKeyTokenIDs        = "token_ids"
+	AttributeKeyReceiver        = "receiver"
+)
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"fmt"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(
+	params Params,
+	pairs []TokenPair,
+	tokenApprovals []TokenApproval,
+	operatorApprovals []OperatorApproval,
+) GenesisState {
+	return GenesisState{
+		Params:            params,
+		TokenPairs:        pairs,
+		TokenApprovals:    tokenApprovals,
+		OperatorApprovals: operatorApprovals,
+	}
+}
+
+// DefaultGenesisState sets default erc721 genesis state with no token pairs
+// and default params.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:            DefaultParams(),
+		TokenPairs:        []TokenPair{},
+		TokenApprovals:    []TokenApproval{},
+		OperatorApprovals: []OperatorApproval{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenErc721 := make(map[string]bool)
+	seenClass := make(map[string]bool)
+	nativeClasses := make(map[string]bool)
+
+	for _, p := range gs.TokenPairs {
+		if seenErc721[p.Erc721Address] {
+			return fmt.Errorf("token ERC721 contract duplicated on genesis '%s'", p.Erc721Address)
+		}
+		if seenClass[p.ClassId] {
+			return fmt.Errorf("class id duplicated on genesis: '%s'", p.ClassId)
+		}
+
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		seenErc721[p.Erc721Address] = true
+		seenClass[p.ClassId] = true
+		if p.IsNativeClass() {
+			nativeClasses[p.Erc721Address] = true
+		}
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params on genesis: %w", err)
+	}
+
+	seenApproval := make(map[string]bool)
+	for _, a := range gs.TokenApprovals {
+		if !nativeClasses[a.Erc721Address] {
+			return fmt.Errorf("token approval has no corresponding precompile token pair on genesis: %s", a.Erc721Address)
+		}
+		if err := a.Validate(); err != nil {
+			return fmt.Errorf("invalid token approval on genesis: %w", err)
+		}
+		if seenApproval[a.Erc721Address+a.TokenId] {
+			return fmt.Errorf("duplicated token approval on genesis: %s", a.Erc721Address+a.TokenId)
+		}
+		seenApproval[a.Erc721Address+a.TokenId] = true
+	}
+
+	seenOperator := make(map[string]bool)
+	for _, a := range gs.OperatorApprovals {
+		if !nativeClasses[a.Erc721Address] {
+			return fmt.Errorf("operator approval has no corresponding precompile token pair on genesis: %s", a.Erc721Address)
+		}
+		if err := a.Validate(); err != nil {
+			return fmt.Errorf("invalid operator approval on genesis: %w", err)
+		}
+		if seenOperator[a.Erc721Address+a.Owner+a.Operator] {
+			return fmt.Errorf("duplicated operator approval on genesis: %s", a.Erc721Address+a.Owner+a.Operator)
+		}
+		seenOperator[a.Erc721Address+a.Owner+a.Operator] = true
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of a TokenApproval
+func (a TokenApproval) Validate() error {
+	if _, err := ParseTokenID(a.TokenId); err != nil {
+		return err
+	}
+	for _, addr := range []string{a.Erc721Address, a.Owner, a.Spender} {
+		if err := cosmosevmtypes.ValidateAddress(addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of an OperatorApproval
+func (a OperatorApproval) Validate() error {
+	for _, addr := range []string{a.Erc721Address, a.Owner, a.Operator} {
+		if err := cosmosevmtypes.ValidateAddress(addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/erc721/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	nativePair := types.NewTokenPairForClass("class")
+	externalPair := types.NewTokenPairForERC721(utiltx.GenerateAddress())
+	owner := utiltx.GenerateAddress().Hex()
+	spender := utiltx.GenerateAddress().Hex()
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			name:     "default",
+			genState: types.DefaultGenesisState(),
+			expPass:  true,
+		},
+		{
+			name: "valid genesis",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: []types.TokenPair{nativePair, externalPair},
+				TokenApprovals: []types.TokenApproval{
+					{Erc721Address: nativePair.Erc721Address, TokenId: "1", Owner: owner, Spender: spender},
+				},
+				OperatorApprovals: []types.OperatorApproval{
+					{Erc721Address: nativePair.Erc721Address, Owner: owner, Operator: spender},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated token pair",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: []types.TokenPair{nativePair, nativePair},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated class",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					nativePair,
+					types.NewTokenPair(utiltx.GenerateAddress(), nativePair.ClassId, types.OWNER_MODULE),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid token pair",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: []types.TokenPair{{Erc721Address: "0xinvalid", ClassId: "class"}},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - token approval of an external contract",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: []types.TokenPair{nativePair, externalPair},
+				TokenApprovals: []types.TokenApproval{
+					{Erc721Address: externalPair.Erc721Address, TokenId: "1", Owner: owner, Spender: spender},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - token approval with invalid token id",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: []types.TokenPair{nativePair},
+				TokenApprovals: []types.TokenApproval{
+					{Erc721Address: nativePair.Erc721Address, TokenId: "token", Owner: owner, Spender: spender},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated token approval",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: []types.TokenPair{nativePair},
+				TokenApprovals: []types.TokenApproval{
+					{Erc721Address: nativePair.Erc721Address, TokenId: "1", Owner: owner, Spender: spender},
+					{Erc721Address: nativePair.Erc721Address, TokenId: "1", Owner: owner, Spender: owner},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - operator approval without token pair",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				OperatorApprovals: []types.OperatorApproval{
+					{Erc721Address: nativePair.Erc721Address, Owner: owner, Operator: spender},
+				},
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	nfttransfertypes "github.com/cosmos/evm/x/ibc/nfttransfer/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the expected interface needed to retrieve account info.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+	GetModuleAddress(moduleName string) sdk.AccAddress
+	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI
+}
+
+// NFTKeeper defines the expected x/nft keeper interface used on erc721
+type NFTKeeper interface {
+	SaveClass(ctx context.Context, class nft.Class) error
+	GetClass(ctx context.Context, classID string) (nft.Class, bool)
+	HasClass(ctx context.Context, classID string) bool
+	Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error
+	Burn(ctx context.Context, classID, nftID string) error
+	Transfer(ctx context.Context, classID, nftID string, receiver sdk.AccAddress) error
+	GetNFT(ctx context.Context, classID, nftID string) (nft.NFT, bool)
+	HasNFT(ctx context.Context, classID, id string) bool
+	GetOwner(ctx context.Context, classID, nftID string) sdk.AccAddress
+	GetBalance(ctx context.Context, classID string, owner sdk.AccAddress) uint64
+	GetTotalSupply(ctx context.Context, classID string) uint64
+}
+
+// EVMKeeper defines the expected EVM keeper interface used on erc721
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
+	CallEVM(ctx sdk.Context, abi abi.ABI, from, contract common.Address, commit bool, gasCap *big.Int, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error)
+	GetCode(ctx sdk.Context, hash common.Hash) []byte
+	SetCode(ctx sdk.Context, hash []byte, bytecode []byte)
+	SetAccount(ctx sdk.Context, address common.Address, account statedb.Account) error
+	GetAccount(ctx sdk.Context, address common.Address) *statedb.Account
+}
+
+// Erc721Keeper defines the expected erc721 keeper interface used on the
+// ICS-721 middleware
+type Erc721Keeper interface {
+	OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, ack exported.Acknowledgement) exported.Acknowledgement
+	OnAcknowledgementPacket(
+		ctx sdk.Context, packet channeltypes.Packet,
+		data nfttransfertypes.NonFungibleTokenPacketData,
+		ack channeltypes.Acknowledgement,
+	) error
+	OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data nfttransfertypes.NonFungibleTokenPacketData) error
+	Logger(ctx sdk.Context) log.Logger
+}
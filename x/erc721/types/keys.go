@@ -0,0 +1,67 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "erc721"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// ModuleAddress is the native module address for ERC-721
+var ModuleAddress common.Address
+
+func init() {
+	ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName).Bytes())
+}
+
+// prefix bytes for the ERC-721 persistent store
+const (
+	prefixTokenPair = iota + 1
+	prefixTokenPairByERC721
+	prefixTokenPairByClass
+	prefixTokenApproval
+	prefixOperatorApproval
+	prefixParams
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixTokenPair         = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC721 = []byte{prefixTokenPairByERC721}
+	KeyPrefixTokenPairByClass  = []byte{prefixTokenPairByClass}
+	KeyPrefixTokenApproval     = []byte{prefixTokenApproval}
+	KeyPrefixOperatorApproval  = []byte{prefixOperatorApproval}
+	KeyPrefixParams            = []byte{prefixParams}
+)
+
+// TokenApprovalKey returns the key of the approval of the given token on the
+// given erc721 precompile address.
+func TokenApprovalKey(
+	erc721 common.Address,
+	tokenID *big.Int,
+) []byte {
+	return append(erc721.Bytes(), common.BigToHash(tokenID).Bytes()...)
+}
+
+// OperatorApprovalKey returns the key of the operator approval granted by the
+// owner to the operator on the given erc721 precompile address.
+func OperatorApprovalKey(
+	erc721 common.Address,
+	owner common.Address,
+	operator common.Address,
+) []byte {
+	return append(append(erc721.Bytes(), owner.Bytes()...), operator.Bytes()...)
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	protov2 "google.golang.org/protobuf/proto"
+
+	erc721api "github.com/cosmos/evm/api/cosmos/evm/erc721/v1"
+
+	errorsmod "cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg              = &MsgConvertERC721{}
+	_ sdk.Msg              = &MsgConvertNFT{}
+	_ sdk.Msg              = &MsgUpdateParams{}
+	_ sdk.Msg              = &MsgRegisterERC721{}
+	_ sdk.Msg              = &MsgRegisterClass{}
+	_ sdk.HasValidateBasic = &MsgConvertERC721{}
+	_ sdk.HasValidateBasic = &MsgConvertNFT{}
+	_ sdk.HasValidateBasic = &MsgUpdateParams{}
+	_ sdk.HasValidateBasic = &MsgRegisterERC721{}
+	_ sdk.HasValidateBasic = &MsgRegisterClass{}
+)
+
+var MsgConvertERC721CustomGetSigner = txsigning.CustomGetSigner{
+	MsgType: protov2.MessageName(&erc721api.MsgConvertERC721{}),
+	Fn:      erc721api.GetSigners,
+}
+
+// NewMsgConvertERC721 creates a new instance of MsgConvertERC721
+func NewMsgConvertERC721(tokenIDs []string, receiver sdk.AccAddress, contract, sender common.Address) *MsgConvertERC721 { //nolint: interfacer
+	return &MsgConvertERC721{
+		ContractAddress: contract.String(),
+		TokenIds:        tokenIDs,
+		Receiver:        receiver.String(),
+		Sender:          sender.Hex(),
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertERC721) ValidateBasic() error {
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	if err := validateTokenIDs(msg.TokenIds, true); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return errorsmod.Wrap(err, "invalid receiver address")
+	}
+	if !common.IsHexAddress(msg.Sender) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid sender hex address %s", msg.Sender)
+	}
+	return nil
+}
+
+// NewMsgConvertNFT creates a new instance of MsgConvertNFT
+func NewMsgConvertNFT(classID string, tokenIDs []string, receiver common.Address, sender sdk.AccAddress) *MsgConvertNFT { //nolint: interfacer
+	return &MsgConvertNFT{
+		ClassId:  classID,
+		TokenIds: tokenIDs,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertNFT) ValidateBasic() error {
+	if strings.TrimSpace(msg.ClassId) == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "class id cannot be blank")
+	}
+	if err := validateTokenIDs(msg.TokenIds, false); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(msg.Receiver) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid receiver hex address %s", msg.Receiver)
+	}
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterERC721) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	for _, addr := range m.Erc721Addresses {
+		if !common.IsHexAddress(addr) {
+			return errortypes.ErrInvalidAddress.Wrapf("invalid ERC721 contract address: %s", addr)
+		}
+	}
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterClass) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	for _, classID := range m.ClassIds {
+		if strings.TrimSpace(classID) == "" {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, "class id cannot be blank")
+		}
+		if strings.HasPrefix(classID, ClassIDPrefix) {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "class %s represents an ERC721 contract", classID)
+		}
+	}
+	return nil
+}
+
+// validateTokenIDs checks that the token ids are not empty nor duplicated. If
+// numeric is true, the ids must also be base 10 uint256 values.
+func validateTokenIDs(tokenIDs []string, numeric bool) error {
+	if len(tokenIDs) == 0 {
+		return errorsmod.Wrap(ErrInvalidTokenID, "token ids cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(tokenIDs))
+	for _, id := range tokenIDs {
+		if strings.TrimSpace(id) == "" {
+			return errorsmod.Wrap(ErrInvalidTokenID, "token id cannot be blank")
+		}
+		if numeric {
+			if _, err := ParseTokenID(id); err != nil {
+				return err
+			}
+		}
+		if seen[id] {
+			return errorsmod.Wrapf(ErrInvalidTokenID, "duplicated token id %s", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
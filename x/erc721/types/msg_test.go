@@ -0,0 +1,106 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/erc721/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC721ValidateBasic() {
+	receiver := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := utiltx.GenerateAddress()
+	sender := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		msg        string
+		convert    *types.MsgConvertERC721
+		expectPass bool
+	}{
+		{"pass", types.NewMsgConvertERC721([]string{"1", "2"}, receiver, contract, sender), true},
+		{"no token ids", types.NewMsgConvertERC721(nil, receiver, contract, sender), false},
+		{"non numeric token id", types.NewMsgConvertERC721([]string{"token"}, receiver, contract, sender), false},
+		{"duplicated token id", types.NewMsgConvertERC721([]string{"1", "1"}, receiver, contract, sender), false},
+		{"invalid contract", &types.MsgConvertERC721{ContractAddress: "0xinvalid", TokenIds: []string{"1"}, Receiver: receiver.String(), Sender: sender.Hex()}, false},
+		{"invalid receiver", &types.MsgConvertERC721{ContractAddress: contract.Hex(), TokenIds: []string{"1"}, Receiver: "receiver", Sender: sender.Hex()}, false},
+		{"invalid sender", &types.MsgConvertERC721{ContractAddress: contract.Hex(), TokenIds: []string{"1"}, Receiver: receiver.String(), Sender: "0xinvalid"}, false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.convert.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertNFTValidateBasic() {
+	receiver := utiltx.GenerateAddress()
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		msg        string
+		convert    *types.MsgConvertNFT
+		expectPass bool
+	}{
+		{"pass", types.NewMsgConvertNFT("class", []string{"1"}, receiver, sender), true},
+		{"pass - non numeric token id", types.NewMsgConvertNFT("class", []string{"token"}, receiver, sender), true},
+		{"blank class id", types.NewMsgConvertNFT(" ", []string{"1"}, receiver, sender), false},
+		{"blank token id", types.NewMsgConvertNFT("class", []string{""}, receiver, sender), false},
+		{"invalid receiver", &types.MsgConvertNFT{ClassId: "class", TokenIds: []string{"1"}, Receiver: "0xinvalid", Sender: sender.String()}, false},
+		{"invalid sender", &types.MsgConvertNFT{ClassId: "class", TokenIds: []string{"1"}, Receiver: receiver.Hex(), Sender: "sender"}, false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.convert.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		msg        string
+		register   sdk.HasValidateBasic
+		expectPass bool
+	}{
+		{"pass - register erc721", &types.MsgRegisterERC721{Authority: authority, Erc721Addresses: []string{common.Address{}.Hex()}}, true},
+		{"invalid authority - register erc721", &types.MsgRegisterERC721{Authority: "authority"}, false},
+		{"invalid address - register erc721", &types.MsgRegisterERC721{Authority: authority, Erc721Addresses: []string{"0xinvalid"}}, false},
+		{"pass - register class", &types.MsgRegisterClass{Authority: authority, ClassIds: []string{"class"}}, true},
+		{"invalid authority - register class", &types.MsgRegisterClass{Authority: "authority", ClassIds: []string{"class"}}, false},
+		{"blank class - register class", &types.MsgRegisterClass{Authority: authority, ClassIds: []string{""}}, false},
+		{"erc721 class - register class", &types.MsgRegisterClass{Authority: authority, ClassIds: []string{types.CreateClassID(common.Address{})}}, false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.register.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
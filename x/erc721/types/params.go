@@ -0,0 +1,20 @@
+package types
+
+// NewParams creates a new Params object
+func NewParams(enableErc721 bool) Params {
+	return Params{
+		EnableErc721: enableErc721,
+	}
+}
+
+// DefaultParams returns the default erc721 module parameters
+func DefaultParams() Params {
+	return Params{
+		EnableErc721: true,
+	}
+}
+
+// Validate performs a stateless validation of the params
+func (p Params) Validate() error {
+	return nil
+}
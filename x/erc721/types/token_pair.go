@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ClassIDPrefix is the prefix of the x/nft class ids that represent ERC721
+// contracts deployed on the EVM, i.e. erc721/{contract hex address}.
+const ClassIDPrefix = "erc721/"
+
+// NewTokenPair returns an instance of TokenPair
+func NewTokenPair(erc721Address common.Address, classID string, contractOwner Owner) TokenPair {
+	return TokenPair{
+		Erc721Address: erc721Address.String(),
+		ClassId:       classID,
+		Enabled:       true,
+		ContractOwner: contractOwner,
+	}
+}
+
+// NewTokenPairForClass returns a module owned TokenPair for the given x/nft
+// class. The ERC721 precompile address is derived from the class id.
+func NewTokenPairForClass(classID string) TokenPair {
+	return NewTokenPair(ClassPrecompileAddress(classID), classID, OWNER_MODULE)
+}
+
+// NewTokenPairForERC721 returns an externally owned TokenPair for the given
+// ERC721 contract and its erc721/{contract} x/nft class.
+func NewTokenPairForERC721(contract common.Address) TokenPair {
+	return NewTokenPair(contract, CreateClassID(contract), OWNER_EXTERNAL)
+}
+
+// ClassPrecompileAddress derives the address of the ERC721 precompile of a
+// module owned x/nft class.
+func ClassPrecompileAddress(classID string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(ModuleName + "|" + classID)))
+}
+
+// CreateClassID returns the x/nft class id that represents the given ERC721
+// contract.
+func CreateClassID(contract common.Address) string {
+	return ClassIDPrefix + contract.String()
+}
+
+// GetID returns the SHA256 hash of the ERC721 address and class id
+func (tp TokenPair) GetID() []byte {
+	id := tp.Erc721Address + "|" + tp.ClassId
+	return tmhash.Sum([]byte(id))
+}
+
+// GetERC721Contract casts the hex string address of the ERC721 to common.Address
+func (tp TokenPair) GetERC721Contract() common.Address {
+	return common.HexToAddress(tp.Erc721Address)
+}
+
+// Validate performs a stateless validation of a TokenPair
+func (tp TokenPair) Validate() error {
+	if strings.TrimSpace(tp.ClassId) == "" {
+		return fmt.Errorf("class id cannot be blank")
+	}
+
+	return cosmosevmtypes.ValidateAddress(tp.Erc721Address)
+}
+
+// IsNativeClass returns true if the NFT class is native to x/nft and the
+// ERC721 contract is a precompile owned by the erc721 module
+func (tp TokenPair) IsNativeClass() bool {
+	return tp.ContractOwner == OWNER_MODULE
+}
+
+// IsNativeERC721 returns true if the ERC721 contract is deployed on the EVM.
+func (tp TokenPair) IsNativeERC721() bool {
+	return tp.ContractOwner == OWNER_EXTERNAL
+}
+
+// ParseTokenID parses the decimal x/nft id of an ERC721 token. Only NFTs with
+// ids that are base 10 uint256 values can be represented as ERC721 tokens.
+func ParseTokenID(id string) (*big.Int, error) {
+	tokenID, ok := new(big.Int).SetString(id, 10)
+	if !ok || tokenID.Sign() < 0 || tokenID.BitLen() > 256 || tokenID.String() != id {
+		return nil, errorsmod.Wrapf(ErrInvalidTokenID, "'%s' is not a base 10 uint256", id)
+	}
+	return tokenID, nil
+}
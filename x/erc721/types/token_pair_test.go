@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/erc721/types"
+)
+
+type TokenPairTestSuite struct {
+	suite.Suite
+}
+
+func TestTokenPairSuite(t *testing.T) {
+	suite.Run(t, new(TokenPairTestSuite))
+}
+
+func (suite *TokenPairTestSuite) TestTokenPairValidate() {
+	testCases := []struct {
+		msg        string
+		pair       types.TokenPair
+		expectPass bool
+	}{
+		{msg: "blank class id", pair: types.TokenPair{Erc721Address: utiltx.GenerateAddress().String(), ClassId: " ", Enabled: true, ContractOwner: types.OWNER_MODULE}, expectPass: false},
+		{msg: "invalid address", pair: types.TokenPair{Erc721Address: "0x", ClassId: "class", Enabled: true, ContractOwner: types.OWNER_MODULE}, expectPass: false},
+		{msg: "pass", pair: types.TokenPair{Erc721Address: utiltx.GenerateAddress().String(), ClassId: "class", Enabled: true, ContractOwner: types.OWNER_MODULE}, expectPass: true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.pair.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *TokenPairTestSuite) TestNewTokenPairForClass() {
+	pair := types.NewTokenPairForClass("class")
+	suite.Require().NoError(pair.Validate())
+	suite.Require().True(pair.IsNativeClass())
+	suite.Require().False(pair.IsNativeERC721())
+	suite.Require().Equal(types.ClassPrecompileAddress("class"), pair.GetERC721Contract())
+	suite.Require().NotEqual(types.ClassPrecompileAddress("other"), pair.GetERC721Contract())
+}
+
+func (suite *TokenPairTestSuite) TestNewTokenPairForERC721() {
+	contract := utiltx.GenerateAddress()
+	pair := types.NewTokenPairForERC721(contract)
+	suite.Require().NoError(pair.Validate())
+	suite.Require().True(pair.IsNativeERC721())
+	suite.Require().False(pair.IsNativeClass())
+	suite.Require().Equal(contract, pair.GetERC721Contract())
+	suite.Require().Equal(types.ClassIDPrefix+contract.String(), pair.ClassId)
+}
+
+func (suite *TokenPairTestSuite) TestGetID() {
+	pair := types.NewTokenPairForClass("class")
+	suite.Require().Equal(pair.GetID(), types.NewTokenPairForClass("class").GetID())
+	suite.Require().NotEqual(pair.GetID(), types.NewTokenPairForClass("other").GetID())
+}
+
+func (suite *TokenPairTestSuite) TestParseTokenID() {
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
+
+	testCases := []struct {
+		msg        string
+		id         string
+		expectPass bool
+	}{
+		{msg: "zero", id: "0", expectPass: true},
+		{msg: "max uint256", id: maxUint256.String(), expectPass: true},
+		{msg: "empty", id: "", expectPass: false},
+		{msg: "not a number", id: "token", expectPass: false},
+		{msg: "negative", id: "-1", expectPass: false},
+		{msg: "leading zeros", id: "01", expectPass: false},
+		{msg: "overflow", id: new(big.Int).Add(maxUint256, common.Big1).String(), expectPass: false},
+		{msg: "too long", id: strings.Repeat("9", 80), expectPass: false},
+	}
+
+	for _, tc := range testCases {
+		tokenID, err := types.ParseTokenID(tc.id)
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+			suite.Require().Equal(tc.id, tokenID.String(), tc.msg)
+		} else {
+			suite.Require().ErrorIs(err, types.ErrInvalidTokenID, tc.msg)
+		}
+	}
+}
@@ -23,6 +23,7 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetBurnedBaseFeeCmd(),
 	)
 	return cmd
 }
@@ -114,3 +115,30 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetBurnedBaseFeeCmd queries the cumulative burned base fee
+func GetBurnedBaseFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-base-fee",
+		Short: "Get the cumulative amount of the EVM denom burned from the base fee",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurnedBaseFee(cmd.Context(), &types.QueryBurnedBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -24,13 +24,32 @@ func InitGenesis(
 
 	k.SetBlockGasWanted(ctx, data.BlockGas)
 
+	if !data.BurnedBaseFee.IsNil() && data.BurnedBaseFee.IsPositive() {
+		k.SetBurnedBaseFee(ctx, data.BurnedBaseFee)
+	}
+
+	k.SetBaseFeeState(ctx, data.BaseFeeState)
+
+	for _, accFeeDenom := range data.AccountFeeDenoms {
+		k.SetAccountFeeDenom(ctx, sdk.MustAccAddressFromBech32(accFeeDenom.Address), accFeeDenom.Denom)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis exports genesis state of the fee market module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	accFeeDenoms := []types.AccountFeeDenom{}
+	k.IterateAccountFeeDenoms(ctx, func(addr sdk.AccAddress, denom string) bool {
+		accFeeDenoms = append(accFeeDenoms, types.AccountFeeDenom{Address: addr.String(), Denom: denom})
+		return false
+	})
+
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		BlockGas: k.GetBlockGasWanted(ctx),
+		Params:           k.GetParams(ctx),
+		BlockGas:         k.GetBlockGasWanted(ctx),
+		BurnedBaseFee:    k.GetBurnedBaseFee(ctx),
+		BaseFeeState:     k.GetBaseFeeState(ctx),
+		AccountFeeDenoms: accFeeDenoms,
 	}
 }
@@ -44,7 +44,8 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	return nil
 }
 
-// EndBlock update block gas wanted.
+// EndBlock update block gas wanted and the base fee algorithm state, and splits
+// the base fee paid in the block.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
@@ -78,6 +79,11 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	updatedGasWanted := math.LegacyMaxDec(limitedGasWanted, math.LegacyNewDec(gasUsed.Int64())).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
 
+	if err := k.UpdateBaseFeeState(ctx, updatedGasWanted); err != nil {
+		k.Logger(ctx).Error(err.Error())
+		return err
+	}
+
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas")
 	}()
@@ -88,5 +94,14 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 		sdk.NewAttribute("amount", fmt.Sprintf("%d", updatedGasWanted)),
 	))
 
+	// a failed split must not halt the chain, so the base fee is left in the
+	// fee collector instead
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.SplitBaseFee(cacheCtx); err != nil {
+		k.Logger(ctx).Error("failed to split base fee", "error", err.Error())
+		return nil
+	}
+	writeCache()
+
 	return nil
 }
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SplitBaseFee distributes the base fee paid by the EVM transactions of the
+// current block according to the base fee split parameter. The base fee is
+// computed from the gas used by the transactions that paid their fees in the
+// EVM denom, and it is capped to the fee collector balance. The fees paid in
+// one of the accepted fee denoms are not split. The part of the fees above the
+// base fee, i.e. the priority tip, always stays in the fee collector.
+func (k Keeper) SplitBaseFee(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	split := params.BaseFeeSplit
+
+	// params stored before the split was introduced keep the whole base fee in
+	// the fee collector
+	if !split.IsSet() || split.FeeCollector.Equal(math.LegacyOneDec()) {
+		return nil
+	}
+
+	baseFee := k.GetBaseFee(ctx)
+	gasUsed := k.GetTransientEVMGasUsed(ctx)
+	if baseFee.IsNil() || !baseFee.IsPositive() || gasUsed == 0 {
+		return nil
+	}
+
+	denom := evmtypes.GetEVMCoinDenom()
+	feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	total := baseFee.MulInt(math.NewIntFromUint64(gasUsed)).TruncateInt()
+	total = math.MinInt(total, k.bankKeeper.GetBalance(ctx, feeCollector, denom).Amount)
+
+	burned := split.Burn.MulInt(total).TruncateInt()
+	communityPool := split.CommunityPool.MulInt(total).TruncateInt()
+
+	totalBurned := k.GetBurnedBaseFee(ctx)
+	if burned.IsPositive() {
+		coins := sdk.Coins{sdk.NewCoin(denom, burned)}
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+
+		totalBurned = totalBurned.Add(burned)
+		k.SetBurnedBaseFee(ctx, totalBurned)
+	}
+
+	if communityPool.IsPositive() {
+		coins := sdk.Coins{sdk.NewCoin(denom, communityPool)}
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, coins, feeCollector); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBaseFeeSplit,
+		sdk.NewAttribute(types.AttributeKeyBurned, sdk.NewCoin(denom, burned).String()),
+		sdk.NewAttribute(types.AttributeKeyCommunityPool, sdk.NewCoin(denom, communityPool).String()),
+		sdk.NewAttribute(types.AttributeKeyFeeCollector, sdk.NewCoin(denom, total.Sub(burned).Sub(communityPool)).String()),
+		sdk.NewAttribute(types.AttributeKeyTotalBurned, sdk.NewCoin(denom, totalBurned).String()),
+	))
+
+	return nil
+}
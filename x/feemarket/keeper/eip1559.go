@@ -3,6 +3,8 @@ package keeper
 import (
 	"math"
 
+	"github.com/cosmos/evm/x/feemarket/types"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,8 +12,7 @@ import (
 
 // CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
 // block during BeginBlock. If the NoBaseFee parameter is enabled or below activation height, this function returns nil.
-// NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
-// chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
+// The base fee is calculated by the algorithm selected by the BaseFeeAlgorithm parameter.
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 	params := k.GetParams(ctx)
 
@@ -20,8 +21,6 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 		return sdkmath.LegacyDec{}
 	}
 
-	consParams := ctx.ConsensusParams()
-
 	// If the current block is the first EIP-1559 block, return the base fee
 	// defined in the parameters (DefaultBaseFee if it hasn't been changed by
 	// governance).
@@ -38,57 +37,44 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 		return sdkmath.LegacyDec{}
 	}
 
-	parentGasUsed := k.GetBlockGasWanted(ctx)
-
-	gasLimit := sdkmath.NewIntFromUint64(math.MaxUint64)
-
-	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
-	if consParams.Block != nil && consParams.Block.MaxGas > -1 {
-		gasLimit = sdkmath.NewInt(consParams.Block.MaxGas)
-	}
-
-	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
-	// validation
-	parentGasTargetInt := gasLimit.Quo(sdkmath.NewIntFromUint64(uint64(params.ElasticityMultiplier)))
-	if !parentGasTargetInt.IsUint64() {
+	calculator, err := types.NewBaseFeeCalculator(params.BaseFeeAlgorithm)
+	if err != nil {
+		k.Logger(ctx).Error("failed to calculate base fee", "error", err.Error())
 		return sdkmath.LegacyDec{}
 	}
 
-	parentGasTarget := parentGasTargetInt.Uint64()
-	baseFeeChangeDenominator := sdkmath.NewIntFromUint64(uint64(params.BaseFeeChangeDenominator))
+	parentGasUsed := k.GetBlockGasWanted(ctx)
+	return calculator.CalculateBaseFee(params, k.GetBaseFeeState(ctx), parentBaseFee, parentGasUsed, blockGasLimit(ctx))
+}
 
-	// If the parent gasUsed is the same as the target, the baseFee remains
-	// unchanged.
-	if parentGasUsed == parentGasTarget {
-		return parentBaseFee
+// UpdateBaseFeeState updates the state of the selected base fee algorithm with
+// the gas used by the current block.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) UpdateBaseFeeState(ctx sdk.Context, gasUsed uint64) error {
+	params := k.GetParams(ctx)
+	if !params.IsBaseFeeEnabled(ctx.BlockHeight()) {
+		return nil
 	}
 
-	if parentGasTargetInt.IsZero() {
-		return sdkmath.LegacyZeroDec()
+	calculator, err := types.NewBaseFeeCalculator(params.BaseFeeAlgorithm)
+	if err != nil {
+		return err
 	}
 
-	if parentGasUsed > parentGasTarget {
-		// If the parent block used more gas than its target, the baseFee should
-		// increase.
-		gasUsedDelta := sdkmath.NewIntFromUint64(parentGasUsed - parentGasTarget)
-		x := parentBaseFee.MulInt(gasUsedDelta)
-		y := x.QuoInt(parentGasTargetInt)
-		baseFeeDelta := sdkmath.LegacyMaxDec(
-			y.QuoInt(baseFeeChangeDenominator),
-			sdkmath.LegacyOneDec(),
-		)
-
-		return parentBaseFee.Add(baseFeeDelta)
-	}
+	state := calculator.UpdateState(params, k.GetBaseFeeState(ctx), gasUsed, blockGasLimit(ctx))
+	k.SetBaseFeeState(ctx, state)
+	return nil
+}
+
+// blockGasLimit returns the block gas limit from the consensus params.
+func blockGasLimit(ctx sdk.Context) sdkmath.Int {
+	gasLimit := sdkmath.NewIntFromUint64(math.MaxUint64)
 
-	// Otherwise if the parent block used less gas than its target, the baseFee
-	// should decrease.
-	gasUsedDelta := sdkmath.NewIntFromUint64(parentGasTarget - parentGasUsed)
-	x := parentBaseFee.MulInt(gasUsedDelta)
-	y := x.QuoInt(parentGasTargetInt)
-	baseFeeDelta := y.QuoInt(baseFeeChangeDenominator)
+	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
+	consParams := ctx.ConsensusParams()
+	if consParams.Block != nil && consParams.Block.MaxGas > -1 {
+		gasLimit = sdkmath.NewInt(consParams.Block.MaxGas)
+	}
 
-	// Set global min gas price as lower bound of the base fee, transactions below
-	// the min gas price don't even reach the mempool.
-	return sdkmath.LegacyMaxDec(parentBaseFee.Sub(baseFeeDelta), params.MinGasPrice)
+	return gasLimit
 }
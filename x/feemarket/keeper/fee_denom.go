@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetAccountFeeDenom returns the accepted fee denom selected by the account to
+// pay the fees of its EVM transactions. It returns false if the account pays
+// the fees in the EVM denom, including when the selected denom is no longer
+// accepted by the fee market params.
+func (k Keeper) GetAccountFeeDenom(ctx sdk.Context, addr sdk.AccAddress) (types.FeeDenom, bool) {
+	denom := k.getAccountFeeDenom(ctx, addr)
+	if denom == "" {
+		return types.FeeDenom{}, false
+	}
+
+	return k.GetParams(ctx).GetFeeDenom(denom)
+}
+
+// SetAccountFeeDenom sets the fee denom selected by the account to pay the
+// fees of its EVM transactions. An empty denom selects the EVM denom.
+func (k Keeper) SetAccountFeeDenom(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccountFeeDenom)
+	if denom == "" {
+		store.Delete(addr)
+		return
+	}
+	store.Set(addr, []byte(denom))
+}
+
+// IterateAccountFeeDenoms iterates over the fee denoms selected by the
+// accounts, whether they are still accepted or not.
+func (k Keeper) IterateAccountFeeDenoms(ctx sdk.Context, cb func(addr sdk.AccAddress, denom string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccountFeeDenom)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key()), string(iterator.Value())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) getAccountFeeDenom(ctx sdk.Context, addr sdk.AccAddress) string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccountFeeDenom)
+	return string(store.Get(addr))
+}
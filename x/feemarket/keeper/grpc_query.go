@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -47,3 +51,33 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// BurnedBaseFee implements the Query/BurnedBaseFee gRPC method
+func (k Keeper) BurnedBaseFee(c context.Context, _ *types.QueryBurnedBaseFeeRequest) (*types.QueryBurnedBaseFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBurnedBaseFeeResponse{
+		BurnedBaseFee: sdk.NewCoin(evmtypes.GetEVMCoinDenom(), k.GetBurnedBaseFee(ctx)),
+	}, nil
+}
+
+// AccountFeeDenom implements the Query/AccountFeeDenom gRPC method
+func (k Keeper) AccountFeeDenom(c context.Context, req *types.QueryAccountFeeDenomRequest) (*types.QueryAccountFeeDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryAccountFeeDenomResponse{}
+	if feeDenom, found := k.GetAccountFeeDenom(ctx, addr); found {
+		res.FeeDenom = &feeDenom
+	}
+
+	return res, nil
+}
@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/evm/x/feemarket/types"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -19,11 +22,16 @@ type Keeper struct {
 	transientKey storetypes.StoreKey
 	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
 	authority sdk.AccAddress
+	// keepers used to split the base fee collected in the fee collector
+	accountKeeper       types.AccountKeeper
+	bankKeeper          types.BankKeeper
+	communityPoolKeeper types.CommunityPoolKeeper
 }
 
 // NewKeeper generates new fee market module keeper
 func NewKeeper(
 	cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey, transientKey storetypes.StoreKey,
+	ak types.AccountKeeper, bk types.BankKeeper, ck types.CommunityPoolKeeper,
 ) Keeper {
 	// ensure authority account is correctly formatted
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -35,6 +43,10 @@ func NewKeeper(
 		storeKey:     storeKey,
 		authority:    authority,
 		transientKey: transientKey,
+
+		accountKeeper:       ak,
+		bankKeeper:          bk,
+		communityPoolKeeper: ck,
 	}
 }
 
@@ -81,3 +93,75 @@ func (k Keeper) AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64
 	k.SetTransientBlockGasWanted(ctx, result)
 	return result, nil
 }
+
+// GetTransientEVMGasUsed returns the gas used in the current block by the EVM
+// transactions that paid their fees in the EVM denom.
+func (k Keeper) GetTransientEVMGasUsed(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixTransientEVMGasUsed))
+}
+
+// AddTransientEVMGasUsed adds the gas used by an EVM transaction to the
+// cumulative gas used in the current block.
+func (k Keeper) AddTransientEVMGasUsed(ctx sdk.Context, gasUsed uint64) uint64 {
+	result := k.GetTransientEVMGasUsed(ctx) + gasUsed
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientEVMGasUsed, sdk.Uint64ToBigEndian(result))
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Base Fee State
+// Required by the stateful base fee algorithms.
+// ----------------------------------------------------------------------------
+
+// GetBaseFeeState returns the state of the base fee algorithms.
+func (k Keeper) GetBaseFeeState(ctx sdk.Context) types.BaseFeeState {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixBaseFeeState)
+	if bz == nil {
+		return types.DefaultBaseFeeState()
+	}
+
+	var state types.BaseFeeState
+	k.cdc.MustUnmarshal(bz, &state)
+	return state
+}
+
+// SetBaseFeeState sets the state of the base fee algorithms.
+func (k Keeper) SetBaseFeeState(ctx sdk.Context, state types.BaseFeeState) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixBaseFeeState, k.cdc.MustMarshal(&state))
+}
+
+// ----------------------------------------------------------------------------
+// Burned Base Fee
+// ----------------------------------------------------------------------------
+
+// GetBurnedBaseFee returns the cumulative amount of the EVM denom burned from
+// the base fee.
+func (k Keeper) GetBurnedBaseFee(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixBurnedBaseFee)
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var burned math.Int
+	if err := burned.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("failed to unmarshal burned base fee: %w", err))
+	}
+	return burned
+}
+
+// SetBurnedBaseFee sets the cumulative amount of the EVM denom burned from the
+// base fee.
+func (k Keeper) SetBurnedBaseFee(ctx sdk.Context, burned math.Int) {
+	bz, err := burned.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal burned base fee: %w", err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixBurnedBaseFee, bz)
+}
@@ -8,6 +8,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -21,9 +22,46 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the state of the previous algorithm is stale when switching to another
+	// one, so the new algorithm starts from the default state
+	if k.GetParams(ctx).BaseFeeAlgorithm != req.Params.BaseFeeAlgorithm {
+		k.SetBaseFeeState(ctx, types.DefaultBaseFeeState())
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetFeeDenom implements the gRPC MsgServer interface. It sets the fee denom
+// used to pay the fees of the EVM transactions of the sender, which must be one
+// of the accepted fee denoms, or empty to pay the fees in the EVM denom.
+func (k *Keeper) SetFeeDenom(goCtx context.Context, req *types.MsgSetFeeDenom) (*types.MsgSetFeeDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if req.FeeDenom != "" {
+		if _, found := k.GetParams(ctx).GetFeeDenom(req.FeeDenom); !found {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "%s is not accepted to pay the fees of EVM transactions", req.FeeDenom)
+		}
+	}
+
+	k.SetAccountFeeDenom(ctx, sender, req.FeeDenom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeDenom,
+			sdk.NewAttribute(types.AttributeKeySender, req.Sender),
+			sdk.NewAttribute(types.AttributeKeyFeeDenom, req.FeeDenom),
+		),
+	)
+
+	return &types.MsgSetFeeDenomResponse{}, nil
+}
@@ -0,0 +1,52 @@
+package v2
+
+import (
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore migrates the x/feemarket store from v1 to v2. The params stored
+// in v1 don't have a base fee split nor parameters for the AIMD and
+// exponential base fee algorithms, so they are set to their default value,
+// which keeps the whole base fee in the fee collector and the EIP-1559
+// algorithm. The base fee algorithm state is initialized to its default value.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		params = types.Params{}
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	if params.BaseFeeSplit.Validate() != nil {
+		params.BaseFeeSplit = types.DefaultBaseFeeSplit
+	}
+	if params.AIMDParams.Validate() != nil {
+		params.AIMDParams = types.DefaultAIMDParams
+	}
+	if params.ExponentialParams.Validate() != nil {
+		params.ExponentialParams = types.DefaultExponentialParams
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	state := types.DefaultBaseFeeState()
+	store.Set(types.KeyPrefixBaseFeeState, cdc.MustMarshal(&state))
+
+	return nil
+}
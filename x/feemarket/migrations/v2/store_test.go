@@ -0,0 +1,49 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	evmosencoding "github.com/cosmos/evm/encoding"
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	v2 "github.com/cosmos/evm/x/feemarket/migrations/v2"
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := evmosencoding.MakeConfig(testconstants.ExampleChainID.EVMChainID).Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	// v1 params
+	legacyParams := types.DefaultParams()
+	legacyParams.BaseFee = sdkmath.LegacyNewDec(5_000_000_000)
+	legacyParams.BaseFeeSplit = types.BaseFeeSplit{}
+	legacyParams.AIMDParams = types.AIMDParams{}
+	legacyParams.ExponentialParams = types.ExponentialParams{}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&legacyParams))
+	require.Nil(t, store.Get(types.KeyPrefixBaseFeeState))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.NoError(t, params.Validate())
+
+	// existing params are kept and the new ones set to their default value
+	expParams := types.DefaultParams()
+	expParams.BaseFee = legacyParams.BaseFee
+	require.Equal(t, expParams, params)
+
+	var state types.BaseFeeState
+	cdc.MustUnmarshal(store.Get(types.KeyPrefixBaseFeeState), &state)
+	require.Equal(t, types.DefaultBaseFeeState(), state)
+}
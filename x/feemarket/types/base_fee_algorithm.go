@@ -0,0 +1,260 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// MaxExcessGasExponent bounds the exponent of the exponential base fee
+// algorithm, i.e. the excess gas divided by the update fraction, so that the
+// base fee cannot overflow.
+const MaxExcessGasExponent = 32
+
+// MaxAIMDWindow bounds the number of blocks of the AIMD window, which is kept
+// in the base fee state.
+const MaxAIMDWindow = 1_000
+
+// MaxExponentialMinBaseFee bounds the min base fee of the exponential base fee
+// algorithm, so that the base fee at MaxExcessGasExponent, about 7.9e13 times
+// the min base fee, and the fees it is multiplied into cannot overflow.
+var MaxExponentialMinBaseFee = math.LegacyNewDecFromInt(math.NewIntWithDecimal(1, 30))
+
+// BaseFeeCalculator defines an algorithm calculating the base fee of each
+// block. Calculators are stateless, the state they need between blocks is kept
+// by the fee market keeper in a BaseFeeState and passed to them.
+type BaseFeeCalculator interface {
+	// CalculateBaseFee returns the base fee of the current block from the base
+	// fee and the gas used by the parent block. It returns a nil base fee if it
+	// cannot be calculated.
+	CalculateBaseFee(params Params, state BaseFeeState, parentBaseFee math.LegacyDec, parentGasUsed uint64, gasLimit math.Int) math.LegacyDec
+	// UpdateState returns the state updated with the gas used by the block.
+	UpdateState(params Params, state BaseFeeState, gasUsed uint64, gasLimit math.Int) BaseFeeState
+}
+
+// NewBaseFeeCalculator returns the calculator of the given base fee algorithm.
+func NewBaseFeeCalculator(algorithm BaseFeeAlgorithm) (BaseFeeCalculator, error) {
+	switch algorithm {
+	case BaseFeeAlgorithmEIP1559:
+		return EIP1559Calculator{}, nil
+	case BaseFeeAlgorithmAIMD:
+		return AIMDCalculator{}, nil
+	case BaseFeeAlgorithmExponential:
+		return ExponentialCalculator{}, nil
+	default:
+		return nil, fmt.Errorf("unknown base fee algorithm: %s", algorithm)
+	}
+}
+
+// DefaultBaseFeeState returns the state of the base fee algorithms before any
+// block has been processed.
+func DefaultBaseFeeState() BaseFeeState {
+	return BaseFeeState{
+		LearningRate: DefaultAIMDParams.MinLearningRate,
+	}
+}
+
+// Validate performs a basic validation of the base fee state. A nil learning
+// rate is valid and replaced by the min learning rate.
+func (s BaseFeeState) Validate() error {
+	if !s.LearningRate.IsNil() && s.LearningRate.IsNegative() {
+		return fmt.Errorf("learning rate cannot be negative: %s", s.LearningRate)
+	}
+	return nil
+}
+
+// gasTarget returns the gas target of a block, which is the gas limit divided
+// by the elasticity multiplier.
+// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
+// validation
+func gasTarget(params Params, gasLimit math.Int) math.Int {
+	return gasLimit.Quo(math.NewIntFromUint64(uint64(params.ElasticityMultiplier)))
+}
+
+// EIP1559Calculator implements the EIP-1559 base fee algorithm.
+// NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
+// chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
+type EIP1559Calculator struct{}
+
+var _ BaseFeeCalculator = EIP1559Calculator{}
+
+// CalculateBaseFee implements BaseFeeCalculator.
+func (EIP1559Calculator) CalculateBaseFee(params Params, _ BaseFeeState, parentBaseFee math.LegacyDec, parentGasUsed uint64, gasLimit math.Int) math.LegacyDec {
+	parentGasTargetInt := gasTarget(params, gasLimit)
+	if !parentGasTargetInt.IsUint64() {
+		return math.LegacyDec{}
+	}
+
+	parentGasTarget := parentGasTargetInt.Uint64()
+	baseFeeChangeDenominator := math.NewIntFromUint64(uint64(params.BaseFeeChangeDenominator))
+
+	// If the parent gasUsed is the same as the target, the baseFee remains
+	// unchanged.
+	if parentGasUsed == parentGasTarget {
+		return parentBaseFee
+	}
+
+	if parentGasTargetInt.IsZero() {
+		return math.LegacyZeroDec()
+	}
+
+	if parentGasUsed > parentGasTarget {
+		// If the parent block used more gas than its target, the baseFee should
+		// increase.
+		gasUsedDelta := math.NewIntFromUint64(parentGasUsed - parentGasTarget)
+		x := parentBaseFee.MulInt(gasUsedDelta)
+		y := x.QuoInt(parentGasTargetInt)
+		baseFeeDelta := math.LegacyMaxDec(
+			y.QuoInt(baseFeeChangeDenominator),
+			math.LegacyOneDec(),
+		)
+
+		return parentBaseFee.Add(baseFeeDelta)
+	}
+
+	// Otherwise if the parent block used less gas than its target, the baseFee
+	// should decrease.
+	gasUsedDelta := math.NewIntFromUint64(parentGasTarget - parentGasUsed)
+	x := parentBaseFee.MulInt(gasUsedDelta)
+	y := x.QuoInt(parentGasTargetInt)
+	baseFeeDelta := y.QuoInt(baseFeeChangeDenominator)
+
+	// Set global min gas price as lower bound of the base fee, transactions below
+	// the min gas price don't even reach the mempool.
+	return math.LegacyMaxDec(parentBaseFee.Sub(baseFeeDelta), params.MinGasPrice)
+}
+
+// UpdateState implements BaseFeeCalculator. EIP-1559 only depends on the
+// parent block, so the state is left unchanged.
+func (EIP1559Calculator) UpdateState(_ Params, state BaseFeeState, _ uint64, _ math.Int) BaseFeeState {
+	return state
+}
+
+// AIMDCalculator implements an additive increase multiplicative decrease
+// (AIMD) base fee algorithm. The base fee moves like EIP-1559, but by a
+// learning rate that grows while the utilization of the recent blocks is far
+// from the target, so the base fee catches up faster with bursts of load and
+// goes back down faster once they are over, and that shrinks while the
+// utilization is stable.
+type AIMDCalculator struct{}
+
+var _ BaseFeeCalculator = AIMDCalculator{}
+
+// CalculateBaseFee implements BaseFeeCalculator. The base fee is the parent
+// base fee multiplied by 1 + learningRate * (parentGasUsed - target) / target.
+func (AIMDCalculator) CalculateBaseFee(params Params, state BaseFeeState, parentBaseFee math.LegacyDec, parentGasUsed uint64, gasLimit math.Int) math.LegacyDec {
+	target := gasTarget(params, gasLimit)
+	if target.IsZero() {
+		return math.LegacyZeroDec()
+	}
+
+	learningRate := clampLearningRate(params.AIMDParams, state.LearningRate)
+	gasUsedDelta := math.NewIntFromUint64(parentGasUsed).Sub(target)
+	baseFeeDelta := parentBaseFee.Mul(learningRate).MulInt(gasUsedDelta).QuoInt(target)
+
+	// as in EIP-1559, the base fee increases by at least one so that it can
+	// grow back from zero
+	if gasUsedDelta.IsPositive() {
+		baseFeeDelta = math.LegacyMaxDec(baseFeeDelta, math.LegacyOneDec())
+	}
+
+	return math.LegacyMaxDec(parentBaseFee.Add(baseFeeDelta), params.MinGasPrice)
+}
+
+// UpdateState implements BaseFeeCalculator. The gas used by the block is added
+// to the window, and the learning rate is increased by alpha if the window
+// utilization is below gamma or above 1 - gamma, or multiplied by beta
+// otherwise.
+func (AIMDCalculator) UpdateState(params Params, state BaseFeeState, gasUsed uint64, gasLimit math.Int) BaseFeeState {
+	aimd := params.AIMDParams
+
+	window := append(append([]uint64{}, state.BlockGasWindow...), gasUsed)
+	if uint64(len(window)) > aimd.Window {
+		window = window[uint64(len(window))-aimd.Window:]
+	}
+
+	utilization := math.LegacyZeroDec()
+	if capacity := gasLimit.MulRaw(int64(len(window))); capacity.IsPositive() {
+		total := math.ZeroInt()
+		for _, gas := range window {
+			total = total.Add(math.NewIntFromUint64(gas))
+		}
+		utilization = math.LegacyNewDecFromInt(total).QuoInt(capacity)
+	}
+
+	learningRate := clampLearningRate(aimd, state.LearningRate)
+	if utilization.LTE(aimd.Gamma) || utilization.GTE(math.LegacyOneDec().Sub(aimd.Gamma)) {
+		learningRate = learningRate.Add(aimd.Alpha)
+	} else {
+		learningRate = learningRate.Mul(aimd.Beta)
+	}
+
+	state.LearningRate = clampLearningRate(aimd, learningRate)
+	state.BlockGasWindow = window
+	return state
+}
+
+// clampLearningRate bounds the learning rate to the min and max learning
+// rates of the params.
+func clampLearningRate(params AIMDParams, learningRate math.LegacyDec) math.LegacyDec {
+	if learningRate.IsNil() {
+		return params.MinLearningRate
+	}
+	return math.LegacyMinDec(math.LegacyMaxDec(learningRate, params.MinLearningRate), params.MaxLearningRate)
+}
+
+// ExponentialCalculator implements an exponential base fee algorithm, as the
+// EIP-4844 blob base fee. The gas used above the target accumulates as excess
+// gas, and the base fee is min_base_fee * e^(excess_gas / update_fraction),
+// where the update fraction is the gas target multiplied by the base fee change
+// denominator. A full block thus raises the base fee by about
+// 1/base_fee_change_denominator, and an empty block lowers it by the same
+// factor.
+type ExponentialCalculator struct{}
+
+var _ BaseFeeCalculator = ExponentialCalculator{}
+
+// CalculateBaseFee implements BaseFeeCalculator. The base fee only depends on
+// the excess gas, which already includes the gas used by the parent block.
+func (ExponentialCalculator) CalculateBaseFee(params Params, state BaseFeeState, _ math.LegacyDec, _ uint64, gasLimit math.Int) math.LegacyDec {
+	updateFraction := gasTarget(params, gasLimit).MulRaw(int64(params.BaseFeeChangeDenominator))
+	if !updateFraction.IsPositive() {
+		return math.LegacyMaxDec(params.ExponentialParams.MinBaseFee, params.MinGasPrice)
+	}
+
+	baseFee := fakeExponential(params.ExponentialParams.MinBaseFee, math.NewIntFromUint64(state.ExcessGas), updateFraction)
+	return math.LegacyMaxDec(baseFee, params.MinGasPrice)
+}
+
+// UpdateState implements BaseFeeCalculator. The excess gas is increased by the
+// gas used above the target and decreased by the gas left below it, without
+// going below zero or above MaxExcessGasExponent update fractions.
+func (ExponentialCalculator) UpdateState(params Params, state BaseFeeState, gasUsed uint64, gasLimit math.Int) BaseFeeState {
+	target := gasTarget(params, gasLimit)
+
+	excessGas := math.NewIntFromUint64(state.ExcessGas).Add(math.NewIntFromUint64(gasUsed)).Sub(target)
+	maxExcessGas := target.MulRaw(int64(params.BaseFeeChangeDenominator)).MulRaw(MaxExcessGasExponent)
+	excessGas = math.MinInt(math.MaxInt(excessGas, math.ZeroInt()), maxExcessGas)
+	if !excessGas.IsUint64() {
+		return state
+	}
+
+	state.ExcessGas = excessGas.Uint64()
+	return state
+}
+
+// fakeExponential approximates factor * e^(numerator / denominator) using a
+// Taylor expansion, as the fake_exponential function of EIP-4844. The terms
+// are computed from the ratio numerator / denominator rather than from the
+// numerator, so that they only depend on the factor and the exponent and not
+// on the magnitude of the gas limit.
+func fakeExponential(factor math.LegacyDec, numerator, denominator math.Int) math.LegacyDec {
+	exponent := math.LegacyNewDecFromInt(numerator).QuoInt(denominator)
+	output := math.LegacyZeroDec()
+	accum := factor
+	for i := int64(1); accum.IsPositive(); i++ {
+		output = output.Add(accum)
+		accum = accum.Mul(exponent).QuoInt64(i)
+	}
+	return output
+}
@@ -0,0 +1,354 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestNewBaseFeeCalculator(t *testing.T) {
+	for algorithm := range BaseFeeAlgorithm_name {
+		_, err := NewBaseFeeCalculator(BaseFeeAlgorithm(algorithm))
+		require.NoError(t, err)
+	}
+
+	_, err := NewBaseFeeCalculator(BaseFeeAlgorithm(100))
+	require.Error(t, err)
+}
+
+func TestEIP1559Calculator(t *testing.T) {
+	params := DefaultParams()
+	gasLimit := sdkmath.NewInt(100)
+	baseFee := sdkmath.LegacyNewDec(1_000_000_000)
+	state := DefaultBaseFeeState()
+
+	testCases := []struct {
+		name          string
+		parentGasUsed uint64
+		expBaseFee    sdkmath.LegacyDec
+	}{
+		{"gas used equal to target", 50, baseFee},
+		{"full block", 100, sdkmath.LegacyNewDec(1_125_000_000)},
+		{"empty block", 0, sdkmath.LegacyNewDec(875_000_000)},
+	}
+
+	for _, tc := range testCases {
+		res := EIP1559Calculator{}.CalculateBaseFee(params, state, baseFee, tc.parentGasUsed, gasLimit)
+		require.Equal(t, tc.expBaseFee, res, tc.name)
+		require.Equal(t, state, EIP1559Calculator{}.UpdateState(params, state, tc.parentGasUsed, gasLimit), tc.name)
+	}
+}
+
+func TestAIMDCalculatorUpdateState(t *testing.T) {
+	params := DefaultParams()
+	params.AIMDParams.Window = 2
+	gasLimit := sdkmath.NewInt(100)
+	learningRate := sdkmath.LegacyNewDecWithPrec(1, 1)
+
+	testCases := []struct {
+		name            string
+		window          []uint64
+		gasUsed         uint64
+		learningRate    sdkmath.LegacyDec
+		expWindow       []uint64
+		expLearningRate sdkmath.LegacyDec
+	}{
+		{
+			"utilization close to the target - decrease",
+			nil,
+			50,
+			learningRate,
+			[]uint64{50},
+			sdkmath.LegacyNewDecWithPrec(95, 3),
+		},
+		{
+			"utilization above 1 - gamma - increase",
+			[]uint64{90},
+			100,
+			learningRate,
+			[]uint64{90, 100},
+			sdkmath.LegacyNewDecWithPrec(125, 3),
+		},
+		{
+			"utilization below gamma - increase",
+			[]uint64{10},
+			0,
+			learningRate,
+			[]uint64{10, 0},
+			sdkmath.LegacyNewDecWithPrec(125, 3),
+		},
+		{
+			"window trimmed to the most recent blocks",
+			[]uint64{0, 0},
+			50,
+			learningRate,
+			[]uint64{0, 50},
+			sdkmath.LegacyNewDecWithPrec(125, 3),
+		},
+		{
+			"learning rate capped to the max learning rate",
+			nil,
+			100,
+			params.AIMDParams.MaxLearningRate,
+			[]uint64{100},
+			params.AIMDParams.MaxLearningRate,
+		},
+		{
+			"learning rate floored to the min learning rate",
+			nil,
+			50,
+			params.AIMDParams.MinLearningRate,
+			[]uint64{50},
+			params.AIMDParams.MinLearningRate,
+		},
+		{
+			"nil learning rate replaced by the min learning rate",
+			nil,
+			100,
+			sdkmath.LegacyDec{},
+			[]uint64{100},
+			params.AIMDParams.MinLearningRate.Add(params.AIMDParams.Alpha),
+		},
+	}
+
+	for _, tc := range testCases {
+		state := BaseFeeState{LearningRate: tc.learningRate, BlockGasWindow: tc.window}
+		res := AIMDCalculator{}.UpdateState(params, state, tc.gasUsed, gasLimit)
+		require.Equal(t, tc.expWindow, res.BlockGasWindow, tc.name)
+		require.Equal(t, tc.expLearningRate, res.LearningRate, tc.name)
+	}
+
+	// a full window of the max size with the max gas used is kept at the max
+	// size and doesn't overflow
+	params.AIMDParams.Window = MaxAIMDWindow
+	window := make([]uint64, MaxAIMDWindow)
+	for i := range window {
+		window[i] = math.MaxUint64
+	}
+	maxGasLimit := sdkmath.NewIntFromUint64(math.MaxUint64)
+	res := AIMDCalculator{}.UpdateState(params, BaseFeeState{BlockGasWindow: window}, math.MaxUint64, maxGasLimit)
+	require.Len(t, res.BlockGasWindow, MaxAIMDWindow)
+	require.Equal(t, params.AIMDParams.MinLearningRate.Add(params.AIMDParams.Alpha), res.LearningRate)
+}
+
+func TestAIMDCalculatorCalculateBaseFee(t *testing.T) {
+	params := DefaultParams()
+	gasLimit := sdkmath.NewInt(100)
+	baseFee := sdkmath.LegacyNewDec(1_000_000_000)
+	state := BaseFeeState{LearningRate: sdkmath.LegacyNewDecWithPrec(2, 1)}
+
+	testCases := []struct {
+		name          string
+		parentBaseFee sdkmath.LegacyDec
+		parentGasUsed uint64
+		minGasPrice   sdkmath.LegacyDec
+		expBaseFee    sdkmath.LegacyDec
+	}{
+		{"gas used equal to target", baseFee, 50, sdkmath.LegacyZeroDec(), baseFee},
+		{"full block", baseFee, 100, sdkmath.LegacyZeroDec(), sdkmath.LegacyNewDec(1_200_000_000)},
+		{"empty block", baseFee, 0, sdkmath.LegacyZeroDec(), sdkmath.LegacyNewDec(800_000_000)},
+		{"empty block - min gas price", baseFee, 0, sdkmath.LegacyNewDec(900_000_000), sdkmath.LegacyNewDec(900_000_000)},
+		{"full block - grows back from zero", sdkmath.LegacyZeroDec(), 100, sdkmath.LegacyZeroDec(), sdkmath.LegacyOneDec()},
+	}
+
+	for _, tc := range testCases {
+		params.MinGasPrice = tc.minGasPrice
+		res := AIMDCalculator{}.CalculateBaseFee(params, state, tc.parentBaseFee, tc.parentGasUsed, gasLimit)
+		require.Equal(t, tc.expBaseFee, res, tc.name)
+	}
+}
+
+func TestExponentialCalculator(t *testing.T) {
+	params := DefaultParams()
+	gasLimit := sdkmath.NewInt(100)
+	minBaseFee := params.ExponentialParams.MinBaseFee
+	// update fraction = gas target * base fee change denominator = 50 * 8
+	maxExcessGas := uint64(400 * MaxExcessGasExponent)
+
+	testCases := []struct {
+		name         string
+		excessGas    uint64
+		gasUsed      uint64
+		expExcessGas uint64
+	}{
+		{"gas used equal to target", 100, 50, 100},
+		{"full block", 100, 100, 150},
+		{"empty block", 100, 0, 50},
+		{"excess gas floored to zero", 20, 0, 0},
+		{"excess gas capped", maxExcessGas, 100, maxExcessGas},
+	}
+
+	for _, tc := range testCases {
+		state := ExponentialCalculator{}.UpdateState(params, BaseFeeState{ExcessGas: tc.excessGas}, tc.gasUsed, gasLimit)
+		require.Equal(t, tc.expExcessGas, state.ExcessGas, tc.name)
+	}
+
+	// no excess gas gives the min base fee
+	res := ExponentialCalculator{}.CalculateBaseFee(params, BaseFeeState{}, params.BaseFee, 0, gasLimit)
+	require.Equal(t, minBaseFee, res)
+
+	// an excess gas equal to the update fraction multiplies the base fee by e
+	res = ExponentialCalculator{}.CalculateBaseFee(params, BaseFeeState{ExcessGas: 400}, params.BaseFee, 0, gasLimit)
+	requireApproxEqual(t, minBaseFee.MustFloat64()*math.E, res.MustFloat64())
+
+	// the min gas price is the lower bound of the base fee
+	params.MinGasPrice = minBaseFee.MulInt64(2)
+	res = ExponentialCalculator{}.CalculateBaseFee(params, BaseFeeState{}, params.BaseFee, 0, gasLimit)
+	require.Equal(t, params.MinGasPrice, res)
+
+	// the max excess gas doesn't overflow
+	require.NotPanics(t, func() {
+		ExponentialCalculator{}.CalculateBaseFee(params, BaseFeeState{ExcessGas: maxExcessGas}, params.BaseFee, 0, gasLimit)
+	})
+
+	// neither does it with the max min base fee, the base fee being about
+	// e^MaxExcessGasExponent times the min base fee
+	params.MinGasPrice = sdkmath.LegacyZeroDec()
+	params.ExponentialParams.MinBaseFee = MaxExponentialMinBaseFee
+	var baseFee sdkmath.LegacyDec
+	require.NotPanics(t, func() {
+		baseFee = ExponentialCalculator{}.CalculateBaseFee(params, BaseFeeState{ExcessGas: maxExcessGas}, params.BaseFee, 0, gasLimit)
+	})
+	requireApproxEqual(t, MaxExponentialMinBaseFee.MustFloat64()*math.Exp(MaxExcessGasExponent), baseFee.MustFloat64())
+
+	// the fees of a block with the max gas at that base fee don't overflow
+	require.NotPanics(t, func() {
+		baseFee.MulInt(sdkmath.NewIntFromUint64(math.MaxUint64))
+	})
+
+	// and the max exponent doesn't overflow with the max update fraction
+	updateFraction := sdkmath.NewInt(math.MaxInt64).MulRaw(math.MaxUint32)
+	require.NotPanics(t, func() {
+		baseFee = fakeExponential(MaxExponentialMinBaseFee, updateFraction.MulRaw(MaxExcessGasExponent), updateFraction)
+	})
+	requireApproxEqual(t, MaxExponentialMinBaseFee.MustFloat64()*math.Exp(MaxExcessGasExponent), baseFee.MustFloat64())
+}
+
+func TestFakeExponential(t *testing.T) {
+	testCases := []struct {
+		factor      int64
+		numerator   int64
+		denominator int64
+	}{
+		{1, 0, 1},
+		{38493, 0, 1000},
+		{1, 2, 1},
+		{1, 4, 2},
+		{1, 3, 1},
+		{10, 8, 2},
+		{1_000_000_000, 50_000_000, 2_225_652},
+	}
+
+	for _, tc := range testCases {
+		res := fakeExponential(sdkmath.LegacyNewDec(tc.factor), sdkmath.NewInt(tc.numerator), sdkmath.NewInt(tc.denominator))
+		exp := float64(tc.factor) * math.Exp(float64(tc.numerator)/float64(tc.denominator))
+		requireApproxEqual(t, exp, res.MustFloat64())
+	}
+}
+
+// TestBaseFeeTrajectories simulates the base fee of each algorithm under a
+// burst of full blocks preceded by blocks at the gas target and followed by
+// blocks at half the gas target.
+func TestBaseFeeTrajectories(t *testing.T) {
+	const (
+		stableBlocks = 10
+		burstBlocks  = 10
+		quietBlocks  = 60
+	)
+	gasLimit := sdkmath.NewInt(10_000_000)
+
+	var load []uint64
+	for i := 0; i < stableBlocks; i++ {
+		load = append(load, 5_000_000)
+	}
+	for i := 0; i < burstBlocks; i++ {
+		load = append(load, 10_000_000)
+	}
+	for i := 0; i < quietBlocks; i++ {
+		load = append(load, 2_500_000)
+	}
+
+	simulate := func(algorithm BaseFeeAlgorithm) []sdkmath.LegacyDec {
+		params := DefaultParams()
+		params.BaseFeeAlgorithm = algorithm
+		calculator, err := NewBaseFeeCalculator(algorithm)
+		require.NoError(t, err)
+
+		state := DefaultBaseFeeState()
+		baseFee := params.BaseFee
+		trajectory := make([]sdkmath.LegacyDec, 0, len(load))
+		for _, gasUsed := range load {
+			// the state is updated in EndBlock and the base fee of the next
+			// block calculated in BeginBlock
+			state = calculator.UpdateState(params, state, gasUsed, gasLimit)
+			baseFee = calculator.CalculateBaseFee(params, state, baseFee, gasUsed, gasLimit)
+			trajectory = append(trajectory, baseFee)
+		}
+		return trajectory
+	}
+
+	initialBaseFee := DefaultParams().BaseFee
+	trajectories := make(map[BaseFeeAlgorithm][]sdkmath.LegacyDec)
+	for algorithm := range BaseFeeAlgorithm_name {
+		trajectory := simulate(BaseFeeAlgorithm(algorithm))
+		trajectories[BaseFeeAlgorithm(algorithm)] = trajectory
+
+		// the base fee is stable at the gas target
+		for i := 0; i < stableBlocks; i++ {
+			require.Equal(t, initialBaseFee, trajectory[i], "%s: block %d", BaseFeeAlgorithm(algorithm), i)
+		}
+		// the base fee increases during the burst
+		for i := stableBlocks; i < stableBlocks+burstBlocks; i++ {
+			require.True(t, trajectory[i].GT(trajectory[i-1]), "%s: block %d", BaseFeeAlgorithm(algorithm), i)
+		}
+		// and decreases after it
+		require.True(t, trajectory[len(trajectory)-1].LT(trajectory[stableBlocks+burstBlocks-1]), BaseFeeAlgorithm(algorithm).String())
+	}
+
+	peak := func(trajectory []sdkmath.LegacyDec) sdkmath.LegacyDec {
+		return trajectory[stableBlocks+burstBlocks-1]
+	}
+	blocksAboveInitialBaseFee := func(trajectory []sdkmath.LegacyDec) int {
+		blocks := 0
+		for _, baseFee := range trajectory[stableBlocks+burstBlocks:] {
+			if baseFee.LTE(initialBaseFee) {
+				break
+			}
+			blocks++
+		}
+		return blocks
+	}
+
+	eip1559 := trajectories[BaseFeeAlgorithmEIP1559]
+	aimd := trajectories[BaseFeeAlgorithmAIMD]
+	exponential := trajectories[BaseFeeAlgorithmExponential]
+
+	// a full EIP-1559 block raises the base fee by 1/8
+	requireApproxEqual(t, initialBaseFee.MustFloat64()*math.Pow(1.125, burstBlocks), peak(eip1559).MustFloat64())
+
+	// AIMD starts from a low learning rate, so it raises the base fee less
+	// during a short burst, and its learning rate has grown by the end of the
+	// burst, so it brings the base fee back down faster
+	require.True(t, peak(aimd).LT(peak(eip1559)))
+	require.Less(t, blocksAboveInitialBaseFee(aimd), blocksAboveInitialBaseFee(eip1559))
+
+	// the exponential base fee goes back to the min base fee once the excess
+	// gas of the burst has been consumed, and never below it
+	minBaseFee := DefaultParams().ExponentialParams.MinBaseFee
+	requireApproxEqual(t, minBaseFee.MustFloat64()*math.Exp(float64(burstBlocks)/8), peak(exponential).MustFloat64())
+	for _, baseFee := range exponential {
+		require.True(t, baseFee.GTE(minBaseFee))
+	}
+	require.Equal(t, minBaseFee, exponential[len(exponential)-1])
+	// blocks at half the target drain the excess gas of the burst in twice as
+	// many blocks, the last of which is back at the min base fee
+	require.Equal(t, 2*burstBlocks-1, blocksAboveInitialBaseFee(exponential))
+}
+
+func requireApproxEqual(t *testing.T, expected, actual float64) {
+	t.Helper()
+	require.InEpsilon(t, expected, actual, 1e-6)
+}
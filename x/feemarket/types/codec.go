@@ -22,6 +22,7 @@ var (
 const (
 	// Amino names
 	updateParamsName = "os/feemarket/MsgUpdateParams"
+	setFeeDenomName  = "os/feemarket/MsgSetFeeDenom"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -35,6 +36,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSetFeeDenom{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -42,4 +44,5 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgSetFeeDenom{}, setFeeDenomName, nil)
 }
@@ -2,7 +2,15 @@ package types
 
 // feemarket module events
 const (
-	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeMarket    = "fee_market"
+	EventTypeBaseFeeSplit = "base_fee_split"
+	EventTypeSetFeeDenom  = "set_fee_denom"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee       = "base_fee"
+	AttributeKeyBurned        = "burned"
+	AttributeKeyCommunityPool = "community_pool"
+	AttributeKeyFeeCollector  = "fee_collector"
+	AttributeKeyTotalBurned   = "total_burned"
+	AttributeKeySender        = "sender"
+	AttributeKeyFeeDenom      = "fee_denom"
 )
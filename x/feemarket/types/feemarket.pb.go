@@ -25,6 +25,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BaseFeeAlgorithm enumerates the algorithms available to calculate the base
+// fee
+type BaseFeeAlgorithm int32
+
+const (
+	// BASE_FEE_ALGORITHM_EIP1559 adjusts the base fee by at most
+	// 1/base_fee_change_denominator per block, depending on the gas used by the
+	// parent block relative to the gas target
+	BaseFeeAlgorithmEIP1559 BaseFeeAlgorithm = 0
+	// BASE_FEE_ALGORITHM_AIMD adjusts the base fee like EIP-1559 with a learning
+	// rate that is additively increased while the utilization of the recent
+	// blocks is far from the target and multiplicatively decreased otherwise
+	BaseFeeAlgorithmAIMD BaseFeeAlgorithm = 1
+	// BASE_FEE_ALGORITHM_EXPONENTIAL sets the base fee to an exponential of the
+	// gas used above the gas target, as the EIP-4844 blob base fee
+	BaseFeeAlgorithmExponential BaseFeeAlgorithm = 2
+)
+
+var BaseFeeAlgorithm_name = map[int32]string{
+	0: "BASE_FEE_ALGORITHM_EIP1559",
+	1: "BASE_FEE_ALGORITHM_AIMD",
+	2: "BASE_FEE_ALGORITHM_EXPONENTIAL",
+}
+
+var BaseFeeAlgorithm_value = map[string]int32{
+	"BASE_FEE_ALGORITHM_EIP1559":     0,
+	"BASE_FEE_ALGORITHM_AIMD":        1,
+	"BASE_FEE_ALGORITHM_EXPONENTIAL": 2,
+}
+
+func (x BaseFeeAlgorithm) String() string {
+	return proto.EnumName(BaseFeeAlgorithm_name, int32(x))
+}
+
+func (BaseFeeAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{0}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -46,6 +84,20 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// fee_denoms defines the denoms, other than the EVM denom, accepted to pay
+	// the fees of EVM transactions
+	FeeDenoms []FeeDenom `protobuf:"bytes,9,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms"`
+	// base_fee_split defines how the base fee portion of the fees paid by EVM
+	// transactions is distributed at the end of each block
+	BaseFeeSplit BaseFeeSplit `protobuf:"bytes,10,opt,name=base_fee_split,json=baseFeeSplit,proto3" json:"base_fee_split"`
+	// base_fee_algorithm selects the algorithm used to calculate the base fee of
+	// each block
+	BaseFeeAlgorithm BaseFeeAlgorithm `protobuf:"varint,11,opt,name=base_fee_algorithm,json=baseFeeAlgorithm,proto3,enum=cosmos.evm.feemarket.v1.BaseFeeAlgorithm" json:"base_fee_algorithm,omitempty"`
+	// aimd_params defines the parameters of the AIMD base fee algorithm
+	AIMDParams AIMDParams `protobuf:"bytes,12,opt,name=aimd_params,json=aimdParams,proto3" json:"aimd_params"`
+	// exponential_params defines the parameters of the exponential base fee
+	// algorithm
+	ExponentialParams ExponentialParams `protobuf:"bytes,13,opt,name=exponential_params,json=exponentialParams,proto3" json:"exponential_params"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -109,8 +161,311 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetBaseFeeSplit() BaseFeeSplit {
+	if m != nil {
+		return m.BaseFeeSplit
+	}
+	return BaseFeeSplit{}
+}
+
+func (m *Params) GetBaseFeeAlgorithm() BaseFeeAlgorithm {
+	if m != nil {
+		return m.BaseFeeAlgorithm
+	}
+	return BaseFeeAlgorithmEIP1559
+}
+
+func (m *Params) GetAIMDParams() AIMDParams {
+	if m != nil {
+		return m.AIMDParams
+	}
+	return AIMDParams{}
+}
+
+func (m *Params) GetExponentialParams() ExponentialParams {
+	if m != nil {
+		return m.ExponentialParams
+	}
+	return ExponentialParams{}
+}
+
+// AIMDParams defines the parameters of the additive increase multiplicative
+// decrease (AIMD) base fee algorithm
+type AIMDParams struct {
+	// window is the number of recent blocks used to compute the block
+	// utilization, at most 1000
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// alpha is the amount added to the learning rate when the utilization is
+	// outside of the gamma bounds
+	Alpha cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=alpha,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"alpha"`
+	// beta is the factor applied to the learning rate when the utilization is
+	// within the gamma bounds
+	Beta cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=beta,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"beta"`
+	// gamma bounds the utilization considered close to the target, the
+	// learning rate increases when the utilization is below gamma or above
+	// 1 - gamma
+	Gamma cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=gamma,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gamma"`
+	// min_learning_rate is the lower bound of the learning rate
+	MinLearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=min_learning_rate,json=minLearningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_learning_rate"`
+	// max_learning_rate is the upper bound of the learning rate
+	MaxLearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_learning_rate,json=maxLearningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_learning_rate"`
+}
+
+func (m *AIMDParams) Reset()         { *m = AIMDParams{} }
+func (m *AIMDParams) String() string { return proto.CompactTextString(m) }
+func (*AIMDParams) ProtoMessage()    {}
+func (*AIMDParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{1}
+}
+func (m *AIMDParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AIMDParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AIMDParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AIMDParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AIMDParams.Merge(m, src)
+}
+func (m *AIMDParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *AIMDParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AIMDParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AIMDParams proto.InternalMessageInfo
+
+func (m *AIMDParams) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// ExponentialParams defines the parameters of the exponential base fee
+// algorithm. The base fee is min_base_fee * e^(excess_gas / update_fraction),
+// where the update fraction is the gas target multiplied by the
+// base_fee_change_denominator.
+type ExponentialParams struct {
+	// min_base_fee is the base fee when there is no excess gas, at most 10^30
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee"`
+}
+
+func (m *ExponentialParams) Reset()         { *m = ExponentialParams{} }
+func (m *ExponentialParams) String() string { return proto.CompactTextString(m) }
+func (*ExponentialParams) ProtoMessage()    {}
+func (*ExponentialParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{2}
+}
+func (m *ExponentialParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExponentialParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExponentialParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExponentialParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExponentialParams.Merge(m, src)
+}
+func (m *ExponentialParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExponentialParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExponentialParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExponentialParams proto.InternalMessageInfo
+
+// BaseFeeState defines the state kept between blocks by the base fee
+// algorithms
+type BaseFeeState struct {
+	// learning_rate is the current learning rate of the AIMD algorithm
+	LearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=learning_rate,json=learningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"learning_rate"`
+	// block_gas_window is the gas used by the most recent blocks, from the
+	// oldest to the newest, used by the AIMD algorithm
+	BlockGasWindow []uint64 `protobuf:"varint,2,rep,packed,name=block_gas_window,json=blockGasWindow,proto3" json:"block_gas_window,omitempty"`
+	// excess_gas is the cumulative gas used above the gas target, used by the
+	// exponential algorithm
+	ExcessGas uint64 `protobuf:"varint,3,opt,name=excess_gas,json=excessGas,proto3" json:"excess_gas,omitempty"`
+}
+
+func (m *BaseFeeState) Reset()         { *m = BaseFeeState{} }
+func (m *BaseFeeState) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeState) ProtoMessage()    {}
+func (*BaseFeeState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{3}
+}
+func (m *BaseFeeState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeState.Merge(m, src)
+}
+func (m *BaseFeeState) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeState) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeState proto.InternalMessageInfo
+
+func (m *BaseFeeState) GetBlockGasWindow() []uint64 {
+	if m != nil {
+		return m.BlockGasWindow
+	}
+	return nil
+}
+
+func (m *BaseFeeState) GetExcessGas() uint64 {
+	if m != nil {
+		return m.ExcessGas
+	}
+	return 0
+}
+
+// FeeDenom defines a denom accepted to pay the fees of EVM transactions
+type FeeDenom struct {
+	// denom is the bank denom of the fee coin
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// conversion_rate is the amount of denom equivalent to one unit of the EVM
+	// denom, in their respective bank decimals
+	ConversionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=conversion_rate,json=conversionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"conversion_rate"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{4}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// BaseFeeSplit defines the ratios in which the base fee portion of the fees
+// paid by EVM transactions is burned, sent to the community pool and kept in
+// the fee collector. The ratios must add up to one. The priority tip is not
+// affected and always stays in the fee collector. Only the fees paid in the
+// EVM denom are split: the fees paid in one of the fee_denoms stay entirely in
+// the fee collector.
+type BaseFeeSplit struct {
+	// burn is the ratio of the base fee that is burned
+	Burn cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=burn,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn"`
+	// community_pool is the ratio of the base fee sent to the community pool
+	CommunityPool cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool"`
+	// fee_collector is the ratio of the base fee kept in the fee collector and
+	// distributed to stakers
+	FeeCollector cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fee_collector,json=feeCollector,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_collector"`
+}
+
+func (m *BaseFeeSplit) Reset()         { *m = BaseFeeSplit{} }
+func (m *BaseFeeSplit) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeSplit) ProtoMessage()    {}
+func (*BaseFeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{5}
+}
+func (m *BaseFeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeSplit.Merge(m, src)
+}
+func (m *BaseFeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeSplit proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("cosmos.evm.feemarket.v1.BaseFeeAlgorithm", BaseFeeAlgorithm_name, BaseFeeAlgorithm_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.feemarket.v1.Params")
+	proto.RegisterType((*AIMDParams)(nil), "cosmos.evm.feemarket.v1.AIMDParams")
+	proto.RegisterType((*ExponentialParams)(nil), "cosmos.evm.feemarket.v1.ExponentialParams")
+	proto.RegisterType((*BaseFeeState)(nil), "cosmos.evm.feemarket.v1.BaseFeeState")
+	proto.RegisterType((*FeeDenom)(nil), "cosmos.evm.feemarket.v1.FeeDenom")
+	proto.RegisterType((*BaseFeeSplit)(nil), "cosmos.evm.feemarket.v1.BaseFeeSplit")
 }
 
 func init() {
@@ -118,34 +473,68 @@ func init() {
 }
 
 var fileDescriptor_0fc4153d77de08e0 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0xf5, 0x72, 0xc9, 0xc5, 0xd9, 0x70, 0xd2, 0xb1, 0x0a, 0xc2, 0x4a, 0x84, 0x63, 0x85, 0x22,
-	0x56, 0x0a, 0x5b, 0x51, 0x3a, 0x24, 0x0a, 0x2e, 0x11, 0x20, 0x14, 0xa4, 0xc8, 0x05, 0x05, 0xcd,
-	0x6a, 0xed, 0x4c, 0xec, 0x55, 0xbc, 0xbb, 0x96, 0x77, 0x63, 0x71, 0xbf, 0x40, 0xc5, 0x67, 0x50,
-	0xe6, 0x33, 0x52, 0xa6, 0x44, 0x14, 0x11, 0xba, 0x2b, 0x8e, 0xcf, 0x40, 0xe7, 0xe5, 0xce, 0xd7,
-	0x50, 0x5c, 0x63, 0x8d, 0xdf, 0x7b, 0xf3, 0x34, 0x3b, 0xf3, 0xf0, 0x51, 0xa6, 0xb4, 0x50, 0x3a,
-	0x86, 0x46, 0xc4, 0xd7, 0x00, 0x82, 0xd5, 0x37, 0x60, 0xe2, 0xe6, 0xa4, 0xfb, 0x89, 0xaa, 0x5a,
-	0x19, 0x45, 0x5e, 0x58, 0x61, 0x04, 0x8d, 0x88, 0x3a, 0xae, 0x39, 0xd9, 0x7b, 0xc6, 0x04, 0x97,
-	0x2a, 0x6e, 0xbf, 0x56, 0xbb, 0xb7, 0x9b, 0xab, 0x5c, 0xb5, 0x65, 0x3c, 0xaf, 0x2c, 0x7a, 0xf8,
-	0xa7, 0x87, 0xfb, 0x97, 0xac, 0x66, 0x42, 0x13, 0x1f, 0xef, 0x48, 0x45, 0x53, 0xa6, 0x81, 0x5e,
-	0x03, 0x78, 0x28, 0x40, 0xa1, 0x9b, 0x6c, 0x4b, 0x35, 0x62, 0x1a, 0xde, 0x01, 0x90, 0x37, 0x78,
-	0x7f, 0x41, 0xd2, 0xac, 0x60, 0x32, 0x07, 0x7a, 0x05, 0x52, 0x09, 0x2e, 0x99, 0x51, 0xb5, 0xf7,
-	0x24, 0x40, 0xe1, 0x20, 0xf1, 0x52, 0xab, 0x3e, 0x6b, 0x05, 0xe7, 0x1d, 0x4f, 0x4e, 0xf1, 0x73,
-	0x28, 0x99, 0x36, 0x3c, 0xe3, 0x66, 0x4c, 0xc5, 0x6d, 0x69, 0x78, 0x55, 0x72, 0xa8, 0xbd, 0x5e,
-	0xdb, 0xb8, 0xdb, 0x91, 0x9f, 0x96, 0x1c, 0x79, 0x85, 0x07, 0x20, 0x59, 0x5a, 0x02, 0x2d, 0x80,
-	0xe7, 0x85, 0xf1, 0x36, 0x03, 0x14, 0xf6, 0x92, 0xa7, 0x16, 0xfc, 0xd0, 0x62, 0xe4, 0x0c, 0xbb,
-	0xcb, 0xa9, 0xfb, 0x01, 0x0a, 0xb7, 0x47, 0xe1, 0xfd, 0xe3, 0x81, 0xf3, 0xeb, 0xf1, 0x60, 0xdf,
-	0xee, 0x47, 0x5f, 0xdd, 0x44, 0x5c, 0xc5, 0x82, 0x99, 0x22, 0xba, 0x80, 0x9c, 0x65, 0xe3, 0x73,
-	0xc8, 0x7e, 0xcc, 0xee, 0x8e, 0x51, 0xb2, 0xf5, 0x6f, 0x5e, 0x72, 0x81, 0x07, 0x82, 0x4b, 0x9a,
-	0x33, 0x4d, 0xab, 0x9a, 0x67, 0xe0, 0x6d, 0xad, 0xe9, 0xb4, 0x23, 0xb8, 0x7c, 0xcf, 0xf4, 0xe5,
-	0xbc, 0x99, 0x7c, 0xc6, 0x64, 0xe1, 0xb6, 0xf2, 0x52, 0x77, 0x4d, 0xcb, 0xa1, 0xb5, 0xec, 0xf6,
-	0xf1, 0xfa, 0xf0, 0xdb, 0xec, 0xee, 0xf8, 0xe5, 0x4a, 0x3c, 0xbe, 0xae, 0x04, 0xc4, 0xde, 0xf1,
-	0xe3, 0x86, 0xbb, 0x31, 0xdc, 0x4c, 0x86, 0x5c, 0x72, 0xc3, 0x59, 0xb9, 0x3c, 0xe8, 0xe8, 0xed,
-	0xfd, 0xc4, 0x47, 0x0f, 0x13, 0x1f, 0xfd, 0x9e, 0xf8, 0xe8, 0xfb, 0xd4, 0x77, 0x1e, 0xa6, 0xbe,
-	0xf3, 0x73, 0xea, 0x3b, 0x5f, 0x8e, 0x72, 0x6e, 0x8a, 0xdb, 0x34, 0xca, 0x94, 0x88, 0xff, 0xe3,
-	0x6d, 0xc6, 0x15, 0xe8, 0xb4, 0xdf, 0x86, 0xe6, 0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82,
-	0x59, 0x74, 0x66, 0xa1, 0x02, 0x00, 0x00,
+	// 963 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x2d, 0xf9, 0x47, 0x23, 0xc9, 0x91, 0x17, 0x6e, 0x4d, 0xc8, 0x88, 0xcc, 0x2a, 0x28,
+	0xc2, 0xfa, 0x20, 0xc1, 0x0e, 0x7c, 0xe8, 0x2f, 0x20, 0xd9, 0xb2, 0xe3, 0x54, 0x8e, 0x55, 0xc6,
+	0x48, 0x8a, 0x02, 0x05, 0xb1, 0xa2, 0x56, 0xd4, 0xc2, 0xdc, 0x5d, 0x81, 0xa4, 0x15, 0xf9, 0x0d,
+	0x0a, 0x1f, 0x8a, 0xbe, 0x80, 0x4f, 0x05, 0x8a, 0x02, 0xbd, 0xe4, 0x31, 0x72, 0x4c, 0x6f, 0x45,
+	0x0f, 0x46, 0x61, 0x1f, 0x72, 0xe9, 0x43, 0x14, 0x5c, 0x52, 0x12, 0xa3, 0x44, 0x28, 0x78, 0x11,
+	0xb8, 0x33, 0xf3, 0x7d, 0xf3, 0xb3, 0x33, 0xb3, 0x82, 0x87, 0x96, 0xf0, 0x98, 0xf0, 0x6a, 0x64,
+	0xc8, 0x6a, 0x3d, 0x42, 0x18, 0x76, 0xcf, 0x89, 0x5f, 0x1b, 0xee, 0x4c, 0x0f, 0xd5, 0x81, 0x2b,
+	0x7c, 0x81, 0x36, 0x42, 0xc3, 0x2a, 0x19, 0xb2, 0xea, 0x54, 0x37, 0xdc, 0x29, 0xad, 0x61, 0x46,
+	0xb9, 0xa8, 0xc9, 0xdf, 0xd0, 0xb6, 0xb4, 0x6e, 0x0b, 0x5b, 0xc8, 0xcf, 0x5a, 0xf0, 0x15, 0x4a,
+	0x2b, 0x7f, 0x2c, 0xc3, 0x52, 0x1b, 0xbb, 0x98, 0x79, 0xa8, 0x0c, 0x39, 0x2e, 0xcc, 0x0e, 0xf6,
+	0x88, 0xd9, 0x23, 0x44, 0x55, 0x34, 0x45, 0x5f, 0x31, 0xb2, 0x5c, 0x34, 0xb0, 0x47, 0x0e, 0x09,
+	0x41, 0x5f, 0xc3, 0xe6, 0x58, 0x69, 0x5a, 0x7d, 0xcc, 0x6d, 0x62, 0x76, 0x09, 0x17, 0x8c, 0x72,
+	0xec, 0x0b, 0x57, 0x5d, 0xd0, 0x14, 0xbd, 0x60, 0xa8, 0x9d, 0xd0, 0x7a, 0x5f, 0x1a, 0x1c, 0x4c,
+	0xf5, 0xe8, 0x11, 0x7c, 0x44, 0x1c, 0xec, 0xf9, 0xd4, 0xa2, 0xfe, 0xa5, 0xc9, 0x2e, 0x1c, 0x9f,
+	0x0e, 0x1c, 0x4a, 0x5c, 0x35, 0x2d, 0x81, 0xeb, 0x53, 0xe5, 0xc9, 0x44, 0x87, 0x1e, 0x40, 0x81,
+	0x70, 0xdc, 0x71, 0x88, 0xd9, 0x27, 0xd4, 0xee, 0xfb, 0xea, 0xa2, 0xa6, 0xe8, 0x69, 0x23, 0x1f,
+	0x0a, 0x1f, 0x4b, 0x19, 0xda, 0x87, 0x95, 0x49, 0xd4, 0x4b, 0x9a, 0xa2, 0x67, 0x1b, 0xfa, 0xeb,
+	0x9b, 0xad, 0xd4, 0xdf, 0x37, 0x5b, 0x9b, 0x61, 0x7d, 0xbc, 0xee, 0x79, 0x95, 0x8a, 0x1a, 0xc3,
+	0x7e, 0xbf, 0xda, 0x22, 0x36, 0xb6, 0x2e, 0x0f, 0x88, 0xf5, 0xfb, 0xdb, 0x57, 0xdb, 0x8a, 0xb1,
+	0x1c, 0xc5, 0x8b, 0x5a, 0x50, 0x60, 0x94, 0x9b, 0x36, 0xf6, 0xcc, 0x81, 0x4b, 0x2d, 0xa2, 0x2e,
+	0x27, 0x64, 0xca, 0x31, 0xca, 0x8f, 0xb0, 0xd7, 0x0e, 0xc0, 0xe8, 0x39, 0xa0, 0x31, 0x5b, 0x2c,
+	0xd3, 0x95, 0x84, 0x94, 0xc5, 0x90, 0x32, 0x56, 0x8f, 0x6f, 0x01, 0x7a, 0x24, 0xaa, 0xbb, 0xa7,
+	0x66, 0xb5, 0xb4, 0x9e, 0xdb, 0xfd, 0xa4, 0x3a, 0xa7, 0x0b, 0xaa, 0x87, 0x24, 0xbc, 0x81, 0x46,
+	0x36, 0x70, 0x19, 0x72, 0x66, 0x7b, 0x91, 0xd0, 0x43, 0xcf, 0x61, 0x75, 0x72, 0xa1, 0xde, 0xc0,
+	0xa1, 0xbe, 0x0a, 0x9a, 0xa2, 0xe7, 0x76, 0x3f, 0x9d, 0x4b, 0x18, 0xb5, 0xc2, 0xb3, 0xc0, 0x38,
+	0x4e, 0x9a, 0xef, 0xc4, 0x14, 0xe8, 0x05, 0xa0, 0x09, 0x2f, 0x76, 0x6c, 0xe1, 0x52, 0xbf, 0xcf,
+	0xd4, 0x9c, 0xa6, 0xe8, 0xab, 0xbb, 0x9f, 0xfd, 0x1f, 0x77, 0x7d, 0x0c, 0x30, 0x8a, 0x9d, 0x19,
+	0x09, 0xfa, 0x11, 0x72, 0x98, 0xb2, 0xae, 0x39, 0x90, 0x0d, 0xab, 0xe6, 0x65, 0xb4, 0x0f, 0xe6,
+	0x32, 0xd6, 0x8f, 0x4f, 0x0e, 0xc2, 0xde, 0x6e, 0x6c, 0x04, 0xb1, 0xde, 0xde, 0x6c, 0xc1, 0x54,
+	0x16, 0x46, 0x0e, 0x01, 0x61, 0x34, 0x00, 0x5d, 0x40, 0x64, 0x34, 0x10, 0x9c, 0x70, 0x9f, 0x62,
+	0x67, 0xec, 0xa5, 0x20, 0xbd, 0x6c, 0xcf, 0xf5, 0xd2, 0x9c, 0x42, 0x22, 0x67, 0xb1, 0xc2, 0xac,
+	0x91, 0x59, 0xed, 0x17, 0x95, 0xab, 0xb7, 0xaf, 0xb6, 0xef, 0xc7, 0x26, 0x7c, 0x14, 0x9b, 0xf1,
+	0xd0, 0xe6, 0x49, 0x66, 0x25, 0x53, 0x5c, 0x34, 0x8a, 0x94, 0x53, 0x19, 0xc9, 0xb8, 0x9a, 0x95,
+	0x9f, 0xd3, 0x10, 0xcb, 0x00, 0x7d, 0x0c, 0x4b, 0x2f, 0x29, 0xef, 0x8a, 0x97, 0x72, 0x58, 0x33,
+	0x46, 0x74, 0x42, 0xdf, 0xc0, 0x22, 0x76, 0x06, 0x7d, 0xac, 0x2e, 0x24, 0x6c, 0xb8, 0x10, 0x86,
+	0xbe, 0x82, 0x4c, 0x87, 0xf8, 0x58, 0x4d, 0x27, 0x84, 0x4b, 0x54, 0xe0, 0xdd, 0xc6, 0x8c, 0x61,
+	0x35, 0x93, 0xd4, 0xbb, 0x84, 0xa1, 0x33, 0x58, 0x0b, 0x66, 0xc7, 0x21, 0xd8, 0xe5, 0x94, 0xdb,
+	0xa6, 0x8b, 0x7d, 0xa2, 0x2e, 0x26, 0xe4, 0xba, 0xc7, 0x28, 0x6f, 0x45, 0x0c, 0x06, 0xf6, 0x89,
+	0x64, 0xc5, 0xa3, 0x19, 0xd6, 0xa5, 0xc4, 0xac, 0x78, 0x14, 0x67, 0xad, 0x98, 0xb0, 0xf6, 0xde,
+	0xfd, 0xa3, 0x27, 0x90, 0x0f, 0x12, 0x78, 0x67, 0x93, 0x26, 0xf1, 0x02, 0x8c, 0xf2, 0x68, 0x1a,
+	0x2a, 0xbf, 0x29, 0x90, 0x1f, 0x4f, 0x9d, 0x1f, 0xe4, 0x71, 0x02, 0x85, 0x77, 0x73, 0x48, 0xca,
+	0x9e, 0x77, 0xe2, 0x65, 0xd1, 0xa1, 0xd8, 0x71, 0x84, 0x75, 0x2e, 0x57, 0x55, 0xd4, 0x4c, 0x0b,
+	0x5a, 0x5a, 0xcf, 0x18, 0xab, 0x52, 0x7e, 0x84, 0xbd, 0x17, 0x61, 0x53, 0xdd, 0x07, 0x20, 0x23,
+	0x8b, 0x78, 0x5e, 0x60, 0x2a, 0x5b, 0x23, 0x63, 0x64, 0x43, 0xc9, 0x11, 0xf6, 0x2a, 0x1e, 0xac,
+	0x8c, 0xd7, 0x0d, 0x5a, 0x87, 0x45, 0xb9, 0xa1, 0xc2, 0xd8, 0x8c, 0xf0, 0x80, 0xbe, 0x83, 0x7b,
+	0x96, 0xe0, 0x43, 0xe2, 0x7a, 0x54, 0xf0, 0x30, 0xf6, 0xa4, 0xfd, 0xb9, 0x3a, 0x25, 0x90, 0xe5,
+	0xff, 0x37, 0x56, 0x1d, 0xb9, 0x7a, 0x82, 0xce, 0xbd, 0x70, 0x79, 0xe2, 0xa2, 0x48, 0x14, 0x3a,
+	0x85, 0x55, 0x4b, 0x30, 0x76, 0xc1, 0x83, 0x17, 0x6a, 0x20, 0x84, 0x93, 0x38, 0xc0, 0xc2, 0x04,
+	0xdf, 0x16, 0xc2, 0x09, 0x2e, 0x4b, 0xbe, 0x96, 0xc2, 0x71, 0x88, 0x15, 0x3c, 0x92, 0x49, 0x27,
+	0x2a, 0xdf, 0x23, 0x64, 0x7f, 0x8c, 0xde, 0xfe, 0x53, 0x81, 0xe2, 0xec, 0x9a, 0x44, 0x5f, 0x42,
+	0xa9, 0x51, 0x7f, 0xd6, 0x34, 0x0f, 0x9b, 0x4d, 0xb3, 0xde, 0x3a, 0x3a, 0x35, 0x8e, 0xcf, 0x1e,
+	0x9f, 0x98, 0xcd, 0xe3, 0xf6, 0xce, 0xde, 0xde, 0xe7, 0xc5, 0x54, 0x69, 0xf3, 0xea, 0x5a, 0xdb,
+	0x98, 0x45, 0x45, 0x6a, 0xb4, 0x07, 0x1b, 0x1f, 0x00, 0x07, 0x2b, 0xa6, 0xa8, 0x94, 0xd4, 0xab,
+	0x6b, 0x6d, 0x7d, 0x16, 0x19, 0xe8, 0xd0, 0x3e, 0x94, 0x3f, 0xe4, 0xf3, 0xfb, 0xf6, 0xe9, 0xd3,
+	0xe6, 0xd3, 0xb3, 0xe3, 0x7a, 0xab, 0xb8, 0x50, 0xda, 0xba, 0xba, 0xd6, 0x36, 0xdf, 0xf3, 0x3b,
+	0x1d, 0x96, 0x52, 0xe6, 0xa7, 0x5f, 0xcb, 0xa9, 0x46, 0xfd, 0xf5, 0x6d, 0x59, 0x79, 0x73, 0x5b,
+	0x56, 0xfe, 0xb9, 0x2d, 0x2b, 0xbf, 0xdc, 0x95, 0x53, 0x6f, 0xee, 0xca, 0xa9, 0xbf, 0xee, 0xca,
+	0xa9, 0x1f, 0x1e, 0xda, 0xd4, 0xef, 0x5f, 0x74, 0xaa, 0x96, 0x60, 0xb5, 0x39, 0xeb, 0xd2, 0xbf,
+	0x1c, 0x10, 0xaf, 0xb3, 0x24, 0xff, 0xca, 0x3c, 0xfa, 0x6f, 0x00, 0xee, 0x8f, 0x6f, 0x36, 0x37,
+	0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -168,6 +557,55 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ExponentialParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size, err := m.AIMDParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.BaseFeeAlgorithm != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeAlgorithm))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.BaseFeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -226,46 +664,405 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFeemarket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AIMDParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *AIMDParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AIMDParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NoBaseFee {
-		n += 2
+	{
+		size := m.MaxLearningRate.Size()
+		i -= size
+		if _, err := m.MaxLearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
 	}
-	if m.BaseFeeChangeDenominator != 0 {
-		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinLearningRate.Size()
+		i -= size
+		if _, err := m.MinLearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
 	}
-	if m.ElasticityMultiplier != 0 {
-		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Gamma.Size()
+		i -= size
+		if _, err := m.Gamma.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
 	}
-	if m.EnableHeight != 0 {
-		n += 1 + sovFeemarket(uint64(m.EnableHeight))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Beta.Size()
+		i -= size
+		if _, err := m.Beta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
 	}
-	l = m.BaseFee.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	l = m.MinGasPrice.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	l = m.MinGasMultiplier.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	return n
-}
-
-func sovFeemarket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Alpha.Size()
+		i -= size
+		if _, err := m.Alpha.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Window != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExponentialParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExponentialParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExponentialParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseFeeState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExcessGas != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.ExcessGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockGasWindow) > 0 {
+		dAtA5 := make([]byte, len(m.BlockGasWindow)*10)
+		var j4 int
+		for _, num := range m.BlockGasWindow {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintFeemarket(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.LearningRate.Size()
+		i -= size
+		if _, err := m.LearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseFeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeCollector.Size()
+		i -= size
+		if _, err := m.FeeCollector.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoBaseFee {
+		n += 2
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
+	}
+	if m.EnableHeight != 0 {
+		n += 1 + sovFeemarket(uint64(m.EnableHeight))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasMultiplier.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	l = m.BaseFeeSplit.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeAlgorithm != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeAlgorithm))
+	}
+	l = m.AIMDParams.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.ExponentialParams.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *AIMDParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovFeemarket(uint64(m.Window))
+	}
+	l = m.Alpha.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Beta.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Gamma.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinLearningRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MaxLearningRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *ExponentialParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *BaseFeeState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LearningRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.BlockGasWindow) > 0 {
+		l = 0
+		for _, e := range m.BlockGasWindow {
+			l += sovFeemarket(uint64(e))
+		}
+		n += 1 + sovFeemarket(uint64(l)) + l
+	}
+	if m.ExcessGas != 0 {
+		n += 1 + sovFeemarket(uint64(m.ExcessGas))
+	}
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *BaseFeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Burn.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.FeeCollector.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozFeemarket(x uint64) (n int) {
 	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -478,6 +1275,928 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeAlgorithm", wireType)
+			}
+			m.BaseFeeAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeAlgorithm |= BaseFeeAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AIMDParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AIMDParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExponentialParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExponentialParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AIMDParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AIMDParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AIMDParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Alpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gamma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExponentialParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExponentialParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExponentialParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseFeeState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFeemarket
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlockGasWindow = append(m.BlockGasWindow, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFeemarket
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthFeemarket
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthFeemarket
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlockGasWindow) == 0 {
+					m.BlockGasWindow = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFeemarket
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlockGasWindow = append(m.BlockGasWindow, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasWindow", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcessGas", wireType)
+			}
+			m.ExcessGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExcessGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseFeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCollector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
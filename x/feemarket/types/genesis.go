@@ -1,23 +1,57 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:   DefaultParams(),
-		BlockGas: 0,
+		Params:        DefaultParams(),
+		BlockGas:      0,
+		BurnedBaseFee: math.ZeroInt(),
+		BaseFeeState:  DefaultBaseFeeState(),
 	}
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 	return &GenesisState{
-		Params:   params,
-		BlockGas: blockGas,
+		Params:        params,
+		BlockGas:      blockGas,
+		BurnedBaseFee: math.ZeroInt(),
+		BaseFeeState:  DefaultBaseFeeState(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if !gs.BurnedBaseFee.IsNil() && gs.BurnedBaseFee.IsNegative() {
+		return fmt.Errorf("burned base fee cannot be negative: %s", gs.BurnedBaseFee)
+	}
+
+	if err := gs.BaseFeeState.Validate(); err != nil {
+		return err
+	}
+
+	seenAccounts := make(map[string]bool, len(gs.AccountFeeDenoms))
+	for _, accFeeDenom := range gs.AccountFeeDenoms {
+		if _, err := sdk.AccAddressFromBech32(accFeeDenom.Address); err != nil {
+			return fmt.Errorf("invalid account fee denom address %s: %w", accFeeDenom.Address, err)
+		}
+		if seenAccounts[accFeeDenom.Address] {
+			return fmt.Errorf("duplicate account fee denom for %s", accFeeDenom.Address)
+		}
+		if err := sdk.ValidateDenom(accFeeDenom.Denom); err != nil {
+			return fmt.Errorf("invalid fee denom of account %s: %w", accFeeDenom.Address, err)
+		}
+		seenAccounts[accFeeDenom.Address] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -31,6 +33,14 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// burned_base_fee is the cumulative amount of the EVM denom burned from the
+	// base fee.
+	BurnedBaseFee cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=burned_base_fee,json=burnedBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"burned_base_fee"`
+	// base_fee_state is the state of the base fee algorithms.
+	BaseFeeState BaseFeeState `protobuf:"bytes,5,opt,name=base_fee_state,json=baseFeeState,proto3" json:"base_fee_state"`
+	// account_fee_denoms are the fee denoms selected by the accounts to pay the
+	// fees of their EVM transactions.
+	AccountFeeDenoms []AccountFeeDenom `protobuf:"bytes,6,rep,name=account_fee_denoms,json=accountFeeDenoms,proto3" json:"account_fee_denoms"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,8 +90,79 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetBaseFeeState() BaseFeeState {
+	if m != nil {
+		return m.BaseFeeState
+	}
+	return BaseFeeState{}
+}
+
+func (m *GenesisState) GetAccountFeeDenoms() []AccountFeeDenom {
+	if m != nil {
+		return m.AccountFeeDenoms
+	}
+	return nil
+}
+
+// AccountFeeDenom defines the fee denom selected by an account to pay the fees
+// of its EVM transactions.
+type AccountFeeDenom struct {
+	// address is the bech32 address of the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the selected fee denom
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *AccountFeeDenom) Reset()         { *m = AccountFeeDenom{} }
+func (m *AccountFeeDenom) String() string { return proto.CompactTextString(m) }
+func (*AccountFeeDenom) ProtoMessage()    {}
+func (*AccountFeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c64d3a2a89a388, []int{1}
+}
+func (m *AccountFeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountFeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountFeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountFeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountFeeDenom.Merge(m, src)
+}
+func (m *AccountFeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountFeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountFeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountFeeDenom proto.InternalMessageInfo
+
+func (m *AccountFeeDenom) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountFeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.feemarket.v1.GenesisState")
+	proto.RegisterType((*AccountFeeDenom)(nil), "cosmos.evm.feemarket.v1.AccountFeeDenom")
 }
 
 func init() {
@@ -89,24 +170,35 @@ func init() {
 }
 
 var fileDescriptor_07c64d3a2a89a388 = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d,
-	0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x4b, 0x2d, 0xcb, 0xd5, 0x83, 0x2b, 0xd3, 0x2b, 0x33, 0x94,
-	0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0xb5, 0x52, 0xea, 0xb8, 0x8c, 0x44,
-	0x68, 0x84, 0x28, 0x14, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52,
-	0x3d, 0x17, 0x8f, 0x3b, 0xc4, 0xee, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x27, 0x2e, 0xb6, 0x82,
-	0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x79, 0x3d, 0x1c, 0x6e,
-	0xd1, 0x0b, 0x00, 0x2b, 0x73, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c,
-	0x41, 0x50, 0x9d, 0x42, 0xd2, 0x5c, 0x9c, 0x49, 0x39, 0xf9, 0xc9, 0xd9, 0xf1, 0xe9, 0x89, 0xc5,
-	0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x1c, 0x60, 0x01, 0xf7, 0xc4, 0x62, 0x2f, 0x16, 0x0e,
-	0x26, 0x01, 0xe6, 0x20, 0x8e, 0xa4, 0xc4, 0xe2, 0xd4, 0xf8, 0xb4, 0xd4, 0x54, 0x27, 0xc7, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0xf2, 0x64, 0x05, 0x92, 0x37, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0x5e, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xbd, 0xe4, 0x86, 0x5e,
-	0x01, 0x00, 0x00,
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xb3, 0x4d, 0x1a, 0x92, 0x6d, 0xa1, 0x65, 0x15, 0x84, 0x29, 0x92, 0x13, 0x45, 0xaa,
+	0x6a, 0x21, 0xb1, 0xab, 0x86, 0x27, 0x88, 0x85, 0x5a, 0xd1, 0x13, 0x72, 0x25, 0x0e, 0x70, 0xb0,
+	0xd6, 0xf6, 0xd4, 0xb5, 0xc2, 0x7a, 0x23, 0xef, 0x26, 0x82, 0x17, 0xe0, 0xcc, 0x63, 0x70, 0xe4,
+	0xc0, 0x43, 0xf4, 0x58, 0x71, 0x42, 0x1c, 0x22, 0x94, 0x1c, 0x78, 0x0d, 0xe4, 0x5d, 0x07, 0x4c,
+	0x25, 0x5f, 0x2c, 0xcf, 0xcc, 0xff, 0xff, 0xf3, 0x59, 0x1e, 0x7c, 0x1c, 0x4b, 0x25, 0xa4, 0x62,
+	0xb0, 0x14, 0xec, 0x0a, 0x40, 0xf0, 0x62, 0x06, 0x9a, 0x2d, 0x4f, 0x59, 0x0a, 0x39, 0xa8, 0x4c,
+	0xd1, 0x79, 0x21, 0xb5, 0x24, 0x8f, 0xad, 0x8c, 0xc2, 0x52, 0xd0, 0xbf, 0x32, 0xba, 0x3c, 0x3d,
+	0x7a, 0xc8, 0x45, 0x96, 0x4b, 0x66, 0x9e, 0x56, 0x7b, 0x74, 0xd2, 0x14, 0xf9, 0xcf, 0x68, 0x85,
+	0x4f, 0xac, 0x30, 0x34, 0x15, 0xab, 0x36, 0xd8, 0xd1, 0x20, 0x95, 0xa9, 0xb4, 0xfd, 0xf2, 0xcd,
+	0x76, 0xc7, 0x9f, 0xda, 0x78, 0xff, 0xdc, 0x72, 0x5d, 0x6a, 0xae, 0x81, 0xf8, 0xb8, 0x3b, 0xe7,
+	0x05, 0x17, 0xca, 0x41, 0x23, 0xe4, 0xed, 0x4d, 0x86, 0xb4, 0x81, 0x93, 0xbe, 0x36, 0x32, 0xbf,
+	0x7f, 0xb3, 0x1a, 0xb6, 0xbe, 0xfc, 0xfe, 0xfa, 0x0c, 0x05, 0x95, 0x93, 0x3c, 0xc5, 0xfd, 0xe8,
+	0xbd, 0x8c, 0x67, 0x61, 0xca, 0x95, 0xd3, 0x1e, 0x21, 0xaf, 0x13, 0xf4, 0x4c, 0xe3, 0x9c, 0x2b,
+	0x72, 0x81, 0x0f, 0xa2, 0x45, 0x91, 0x43, 0x12, 0x46, 0x5c, 0x41, 0x78, 0x05, 0xe0, 0x74, 0x46,
+	0xc8, 0xeb, 0xfb, 0xe3, 0x32, 0xe8, 0xe7, 0x6a, 0xf8, 0xc8, 0x2e, 0x54, 0xc9, 0x8c, 0x66, 0x92,
+	0x09, 0xae, 0xaf, 0xe9, 0xab, 0x5c, 0xdb, 0x0d, 0xf7, 0xad, 0xd5, 0xe7, 0x0a, 0xce, 0x00, 0xc8,
+	0x1b, 0xfc, 0x60, 0x1b, 0x12, 0xaa, 0x12, 0xdf, 0xd9, 0x35, 0xd0, 0xc7, 0x8d, 0xd0, 0x95, 0xd3,
+	0x7c, 0x6b, 0x1d, 0x7d, 0x3f, 0xaa, 0x0d, 0x08, 0xc7, 0x84, 0xc7, 0xb1, 0x5c, 0xe4, 0xda, 0x44,
+	0x27, 0x90, 0x4b, 0xa1, 0x9c, 0xee, 0xa8, 0xed, 0xed, 0x4d, 0xbc, 0xc6, 0xec, 0xa9, 0xb5, 0x9c,
+	0x01, 0xbc, 0x2c, 0x0d, 0xf5, 0xf8, 0x43, 0xfe, 0xff, 0x4c, 0x5d, 0x74, 0x7a, 0x3b, 0x87, 0xed,
+	0xa0, 0xb7, 0xc5, 0x1f, 0xbf, 0xc3, 0x07, 0x77, 0xfc, 0x64, 0x82, 0xef, 0xf1, 0x24, 0x29, 0x40,
+	0xd9, 0x7f, 0xd1, 0xf7, 0x9d, 0xef, 0xdf, 0x9e, 0x0f, 0xaa, 0xed, 0x53, 0x3b, 0xb9, 0xd4, 0x45,
+	0x96, 0xa7, 0xc1, 0x56, 0x48, 0x06, 0x78, 0xd7, 0xd0, 0x3a, 0x3b, 0xa5, 0x23, 0xb0, 0x85, 0x3f,
+	0xbd, 0x59, 0xbb, 0xe8, 0x76, 0xed, 0xa2, 0x5f, 0x6b, 0x17, 0x7d, 0xde, 0xb8, 0xad, 0xdb, 0x8d,
+	0xdb, 0xfa, 0xb1, 0x71, 0x5b, 0x6f, 0x4f, 0xd2, 0x4c, 0x5f, 0x2f, 0x22, 0x1a, 0x4b, 0xc1, 0x6a,
+	0x47, 0xf6, 0xa1, 0x76, 0x66, 0xfa, 0xe3, 0x1c, 0x54, 0xd4, 0x35, 0xf7, 0xf2, 0xe2, 0xcf, 0x00,
+	0x95, 0x62, 0xdb, 0xf4, 0xde, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +221,40 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccountFeeDenoms) > 0 {
+		for iNdEx := len(m.AccountFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountFeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.BaseFeeState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BurnedBaseFee.Size()
+		i -= size
+		if _, err := m.BurnedBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -147,6 +273,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccountFeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountFeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountFeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -169,6 +332,33 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	l = m.BurnedBaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseFeeState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AccountFeeDenoms) > 0 {
+		for _, e := range m.AccountFeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountFeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -259,6 +449,221 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountFeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountFeeDenoms = append(m.AccountFeeDenoms, AccountFeeDenom{})
+			if err := m.AccountFeeDenoms[len(m.AccountFeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountFeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountFeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountFeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,6 +4,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type GenesisTestSuite struct {
@@ -15,6 +19,8 @@ func TestGenesisTestSuite(t *testing.T) {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
+	addr := sdk.AccAddress([]byte("account_fee_denom___")).String()
+
 	testCases := []struct {
 		name     string
 		genState *GenesisState
@@ -28,8 +34,9 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			"valid genesis",
 			&GenesisState{
-				DefaultParams(),
-				uint64(1),
+				Params:        DefaultParams(),
+				BlockGas:      uint64(1),
+				BurnedBaseFee: math.NewInt(100),
 			},
 			true,
 		},
@@ -49,6 +56,57 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			false,
 		},
+		{
+			"negative burned base fee",
+			&GenesisState{
+				Params:        DefaultParams(),
+				BurnedBaseFee: math.NewInt(-1),
+			},
+			false,
+		},
+		{
+			"negative learning rate",
+			&GenesisState{
+				Params:       DefaultParams(),
+				BaseFeeState: BaseFeeState{LearningRate: math.LegacyNewDec(-1)},
+			},
+			false,
+		},
+		{
+			"valid account fee denoms",
+			&GenesisState{
+				Params:           DefaultParams(),
+				AccountFeeDenoms: []AccountFeeDenom{{Address: addr, Denom: "uusdc"}},
+			},
+			true,
+		},
+		{
+			"invalid account fee denom address",
+			&GenesisState{
+				Params:           DefaultParams(),
+				AccountFeeDenoms: []AccountFeeDenom{{Address: "invalid", Denom: "uusdc"}},
+			},
+			false,
+		},
+		{
+			"invalid account fee denom",
+			&GenesisState{
+				Params:           DefaultParams(),
+				AccountFeeDenoms: []AccountFeeDenom{{Address: addr, Denom: ""}},
+			},
+			false,
+		},
+		{
+			"duplicate account fee denom",
+			&GenesisState{
+				Params: DefaultParams(),
+				AccountFeeDenoms: []AccountFeeDenom{
+					{Address: addr, Denom: "uusdc"},
+					{Address: addr, Denom: "uatom"},
+				},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
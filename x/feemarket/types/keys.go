@@ -20,18 +20,26 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBurnedBaseFee
+	prefixBaseFeeState
+	prefixAccountFeeDenom
 )
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientEVMGasUsed
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasWanted  = []byte{prefixBlockGasWanted}
+	KeyPrefixBurnedBaseFee   = []byte{prefixBurnedBaseFee}
+	KeyPrefixBaseFeeState    = []byte{prefixBaseFeeState}
+	KeyPrefixAccountFeeDenom = []byte{prefixAccountFeeDenom}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientEVMGasUsed     = []byte{prefixTransientEVMGasUsed}
 )
@@ -6,7 +6,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetFeeDenom{}
+)
 
 // ValidateBasic does a sanity check of the provided data
 func (m *MsgUpdateParams) ValidateBasic() error {
@@ -21,3 +24,28 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// NewMsgSetFeeDenom creates a new MsgSetFeeDenom instance
+func NewMsgSetFeeDenom(sender sdk.AccAddress, feeDenom string) *MsgSetFeeDenom {
+	return &MsgSetFeeDenom{
+		Sender:   sender.String(),
+		FeeDenom: feeDenom,
+	}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetFeeDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if m.FeeDenom == "" {
+		return nil
+	}
+	return sdk.ValidateDenom(m.FeeDenom)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetFeeDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -52,3 +52,45 @@ func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgSetFeeDenomValidateBasic() {
+	sender := authtypes.NewModuleAddress("sender")
+
+	testCases := []struct {
+		name    string
+		msg     *MsgSetFeeDenom
+		expPass bool
+	}{
+		{
+			"fail - invalid sender address",
+			&MsgSetFeeDenom{Sender: "invalid", FeeDenom: "uusdc"},
+			false,
+		},
+		{
+			"fail - invalid fee denom",
+			NewMsgSetFeeDenom(sender, "1uusdc"),
+			false,
+		},
+		{
+			"pass - fee denom",
+			NewMsgSetFeeDenom(sender, "uusdc"),
+			true,
+		},
+		{
+			"pass - empty fee denom",
+			NewMsgSetFeeDenom(sender, ""),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
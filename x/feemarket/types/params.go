@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -21,6 +22,24 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeSplit keeps the whole base fee in the fee collector
+	DefaultBaseFeeSplit = NewBaseFeeSplit(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyOneDec())
+	// DefaultBaseFeeAlgorithm is EIP-1559
+	DefaultBaseFeeAlgorithm = BaseFeeAlgorithmEIP1559
+	// DefaultAIMDParams uses a window of 8 blocks and a learning rate between
+	// 1% and 50%
+	DefaultAIMDParams = AIMDParams{
+		Window:          8,
+		Alpha:           math.LegacyNewDecWithPrec(25, 3),
+		Beta:            math.LegacyNewDecWithPrec(95, 2),
+		Gamma:           math.LegacyNewDecWithPrec(25, 2),
+		MinLearningRate: math.LegacyNewDecWithPrec(1, 2),
+		MaxLearningRate: math.LegacyNewDecWithPrec(50, 2),
+	}
+	// DefaultExponentialParams uses the default base fee as minimum base fee
+	DefaultExponentialParams = ExponentialParams{
+		MinBaseFee: DefaultBaseFee,
+	}
 )
 
 // Parameter keys
@@ -71,6 +90,10 @@ func NewParams(
 		EnableHeight:             enableHeight,
 		MinGasPrice:              minGasPrice,
 		MinGasMultiplier:         minGasPriceMultiplier,
+		BaseFeeSplit:             DefaultBaseFeeSplit,
+		BaseFeeAlgorithm:         DefaultBaseFeeAlgorithm,
+		AIMDParams:               DefaultAIMDParams,
+		ExponentialParams:        DefaultExponentialParams,
 	}
 }
 
@@ -84,6 +107,10 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BaseFeeSplit:             DefaultBaseFeeSplit,
+		BaseFeeAlgorithm:         DefaultBaseFeeAlgorithm,
+		AIMDParams:               DefaultAIMDParams,
+		ExponentialParams:        DefaultExponentialParams,
 	}
 }
 
@@ -105,9 +132,142 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateFeeDenoms(p.FeeDenoms); err != nil {
+		return err
+	}
+
+	if err := p.BaseFeeSplit.Validate(); err != nil {
+		return err
+	}
+
+	if _, ok := BaseFeeAlgorithm_name[int32(p.BaseFeeAlgorithm)]; !ok {
+		return fmt.Errorf("unknown base fee algorithm: %d", p.BaseFeeAlgorithm)
+	}
+
+	if err := p.AIMDParams.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.ExponentialParams.Validate(); err != nil {
+		return err
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
+// GetFeeDenom returns the accepted fee denom with the given bank denom.
+func (p Params) GetFeeDenom(denom string) (FeeDenom, bool) {
+	for _, feeDenom := range p.FeeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom, true
+		}
+	}
+	return FeeDenom{}, false
+}
+
+// NewFeeDenom creates a new FeeDenom instance
+func NewFeeDenom(denom string, conversionRate math.LegacyDec) FeeDenom {
+	return FeeDenom{
+		Denom:          denom,
+		ConversionRate: conversionRate,
+	}
+}
+
+// Validate performs a basic validation of the fee denom.
+func (fd FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(fd.Denom); err != nil {
+		return err
+	}
+
+	if fd.ConversionRate.IsNil() || !fd.ConversionRate.IsPositive() {
+		return fmt.Errorf("conversion rate of fee denom %s must be positive: %s", fd.Denom, fd.ConversionRate)
+	}
+
+	return nil
+}
+
+// NewBaseFeeSplit creates a new BaseFeeSplit instance
+func NewBaseFeeSplit(burn, communityPool, feeCollector math.LegacyDec) BaseFeeSplit {
+	return BaseFeeSplit{
+		Burn:          burn,
+		CommunityPool: communityPool,
+		FeeCollector:  feeCollector,
+	}
+}
+
+// Validate checks that the base fee split ratios are not negative and add up
+// to one.
+func (s BaseFeeSplit) Validate() error {
+	ratios := []struct {
+		name  string
+		ratio math.LegacyDec
+	}{
+		{"burn", s.Burn},
+		{"community pool", s.CommunityPool},
+		{"fee collector", s.FeeCollector},
+	}
+	for _, r := range ratios {
+		if r.ratio.IsNil() {
+			return fmt.Errorf("base fee split %s ratio cannot be nil", r.name)
+		}
+		if r.ratio.IsNegative() {
+			return fmt.Errorf("base fee split %s ratio cannot be negative: %s", r.name, r.ratio)
+		}
+	}
+
+	if total := s.Burn.Add(s.CommunityPool).Add(s.FeeCollector); !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("base fee split ratios must add up to 1: %s", total)
+	}
+
+	return nil
+}
+
+// IsSet returns true if the split has been set. Params stored before the
+// split was introduced have nil ratios.
+func (s BaseFeeSplit) IsSet() bool {
+	return !s.Burn.IsNil() && !s.CommunityPool.IsNil() && !s.FeeCollector.IsNil()
+}
+
+// Validate performs a basic validation of the AIMD base fee algorithm
+// parameters.
+func (p AIMDParams) Validate() error {
+	if p.Window == 0 {
+		return fmt.Errorf("AIMD window cannot be 0")
+	}
+
+	if p.Window > MaxAIMDWindow {
+		return fmt.Errorf("AIMD window cannot be greater than %d: %d", MaxAIMDWindow, p.Window)
+	}
+
+	for _, v := range []math.LegacyDec{p.Alpha, p.Beta, p.Gamma, p.MinLearningRate, p.MaxLearningRate} {
+		if v.IsNil() || v.IsNegative() || v.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("AIMD alpha, beta, gamma and learning rates must be between 0 and 1: %s", v)
+		}
+	}
+
+	if p.Gamma.GT(math.LegacyNewDecWithPrec(5, 1)) {
+		return fmt.Errorf("AIMD gamma cannot be greater than 0.5: %s", p.Gamma)
+	}
+
+	if p.MinLearningRate.GT(p.MaxLearningRate) {
+		return fmt.Errorf("AIMD min learning rate %s cannot be greater than max learning rate %s", p.MinLearningRate, p.MaxLearningRate)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the exponential base fee algorithm
+// parameters.
+func (p ExponentialParams) Validate() error {
+	if p.MinBaseFee.IsNil() || !p.MinBaseFee.IsPositive() {
+		return fmt.Errorf("exponential min base fee must be positive: %s", p.MinBaseFee)
+	}
+	if p.MinBaseFee.GT(MaxExponentialMinBaseFee) {
+		return fmt.Errorf("exponential min base fee cannot be greater than %s: %s", MaxExponentialMinBaseFee, p.MinBaseFee)
+	}
+	return nil
+}
+
 func validateBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -185,6 +345,22 @@ func validateEnableHeight(i interface{}) error {
 	return nil
 }
 
+func validateFeeDenoms(feeDenoms []FeeDenom) error {
+	seenDenoms := make(map[string]struct{}, len(feeDenoms))
+	for _, feeDenom := range feeDenoms {
+		if err := feeDenom.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := seenDenoms[feeDenom.Denom]; found {
+			return fmt.Errorf("duplicate fee denom %s", feeDenom.Denom)
+		}
+		seenDenoms[feeDenom.Denom] = struct{}{}
+	}
+
+	return nil
+}
+
 func validateMinGasMultiplier(i interface{}) error {
 	v, ok := i.(math.LegacyDec)
 
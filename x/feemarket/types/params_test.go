@@ -116,3 +116,114 @@ func (suite *ParamsTestSuite) TestParamsValidateMinGasPrice() {
 		}
 	}
 }
+
+func (suite *ParamsTestSuite) TestParamsValidateFeeDenoms() {
+	testCases := []struct {
+		name      string
+		feeDenoms []FeeDenom
+		expError  bool
+	}{
+		{"empty", nil, false},
+		{"valid", []FeeDenom{NewFeeDenom("uusdc", math.LegacyNewDecWithPrec(5, 1)), NewFeeDenom("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", math.LegacyNewDec(3))}, false},
+		{"invalid - denom", []FeeDenom{NewFeeDenom("1usdc", math.LegacyOneDec())}, true},
+		{"invalid - nil conversion rate", []FeeDenom{{Denom: "uusdc"}}, true},
+		{"invalid - zero conversion rate", []FeeDenom{NewFeeDenom("uusdc", math.LegacyZeroDec())}, true},
+		{"invalid - negative conversion rate", []FeeDenom{NewFeeDenom("uusdc", math.LegacyNewDec(-1))}, true},
+		{"invalid - duplicate denom", []FeeDenom{NewFeeDenom("uusdc", math.LegacyOneDec()), NewFeeDenom("uusdc", math.LegacyNewDec(2))}, true},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		params.FeeDenoms = tc.feeDenoms
+		err := params.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsValidateBaseFeeSplit() {
+	testCases := []struct {
+		name     string
+		split    BaseFeeSplit
+		expError bool
+	}{
+		{"default", DefaultBaseFeeSplit, false},
+		{"valid", NewBaseFeeSplit(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(3, 1)), false},
+		{"valid - burn all", NewBaseFeeSplit(math.LegacyOneDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), false},
+		{"invalid - nil ratios", BaseFeeSplit{}, true},
+		{"invalid - negative ratio", NewBaseFeeSplit(math.LegacyNewDec(-1), math.LegacyOneDec(), math.LegacyOneDec()), true},
+		{"invalid - ratios below 1", NewBaseFeeSplit(math.LegacyNewDecWithPrec(5, 1), math.LegacyZeroDec(), math.LegacyZeroDec()), true},
+		{"invalid - ratios above 1", NewBaseFeeSplit(math.LegacyOneDec(), math.LegacyNewDecWithPrec(1, 1), math.LegacyZeroDec()), true},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		params.BaseFeeSplit = tc.split
+		err := params.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsValidateBaseFeeAlgorithm() {
+	updateParams := func(update func(p *Params)) Params {
+		params := DefaultParams()
+		update(&params)
+		return params
+	}
+
+	testCases := []struct {
+		name     string
+		params   Params
+		expError bool
+	}{
+		{"default", DefaultParams(), false},
+		{"valid - AIMD", updateParams(func(p *Params) { p.BaseFeeAlgorithm = BaseFeeAlgorithmAIMD }), false},
+		{"valid - exponential", updateParams(func(p *Params) { p.BaseFeeAlgorithm = BaseFeeAlgorithmExponential }), false},
+		{"invalid - unknown algorithm", updateParams(func(p *Params) { p.BaseFeeAlgorithm = BaseFeeAlgorithm(100) }), true},
+		{"valid - AIMD max window", updateParams(func(p *Params) { p.AIMDParams.Window = MaxAIMDWindow }), false},
+		{"invalid - AIMD zero window", updateParams(func(p *Params) { p.AIMDParams.Window = 0 }), true},
+		{"invalid - AIMD window above max", updateParams(func(p *Params) { p.AIMDParams.Window = MaxAIMDWindow + 1 }), true},
+		{"invalid - AIMD nil alpha", updateParams(func(p *Params) { p.AIMDParams.Alpha = math.LegacyDec{} }), true},
+		{"invalid - AIMD beta above 1", updateParams(func(p *Params) { p.AIMDParams.Beta = math.LegacyNewDec(2) }), true},
+		{"invalid - AIMD gamma above 0.5", updateParams(func(p *Params) { p.AIMDParams.Gamma = math.LegacyNewDecWithPrec(6, 1) }), true},
+		{"invalid - AIMD negative min learning rate", updateParams(func(p *Params) { p.AIMDParams.MinLearningRate = math.LegacyNewDec(-1) }), true},
+		{"invalid - AIMD min learning rate above max", updateParams(func(p *Params) { p.AIMDParams.MinLearningRate = math.LegacyOneDec() }), true},
+		{"invalid - exponential nil min base fee", updateParams(func(p *Params) { p.ExponentialParams.MinBaseFee = math.LegacyDec{} }), true},
+		{"invalid - exponential zero min base fee", updateParams(func(p *Params) { p.ExponentialParams.MinBaseFee = math.LegacyZeroDec() }), true},
+		{"valid - exponential max min base fee", updateParams(func(p *Params) { p.ExponentialParams.MinBaseFee = MaxExponentialMinBaseFee }), false},
+		{"invalid - exponential min base fee above max", updateParams(func(p *Params) {
+			p.ExponentialParams.MinBaseFee = MaxExponentialMinBaseFee.Add(math.LegacySmallestDec())
+		}), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsGetFeeDenom() {
+	params := DefaultParams()
+	params.FeeDenoms = []FeeDenom{NewFeeDenom("uusdc", math.LegacyNewDecWithPrec(5, 1))}
+
+	feeDenom, found := params.GetFeeDenom("uusdc")
+	suite.Require().True(found)
+	suite.Require().Equal(params.FeeDenoms[0], feeDenom)
+
+	_, found = params.GetFeeDenom("uatom")
+	suite.Require().False(found)
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -275,6 +276,185 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryBurnedBaseFeeRequest defines the request type for querying the
+// cumulative burned base fee.
+type QueryBurnedBaseFeeRequest struct {
+}
+
+func (m *QueryBurnedBaseFeeRequest) Reset()         { *m = QueryBurnedBaseFeeRequest{} }
+func (m *QueryBurnedBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBurnedBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{6}
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBurnedBaseFeeResponse returns the cumulative burned base fee.
+type QueryBurnedBaseFeeResponse struct {
+	// burned_base_fee is the cumulative amount of the EVM denom burned from the
+	// base fee
+	BurnedBaseFee types.Coin `protobuf:"bytes,1,opt,name=burned_base_fee,json=burnedBaseFee,proto3" json:"burned_base_fee"`
+}
+
+func (m *QueryBurnedBaseFeeResponse) Reset()         { *m = QueryBurnedBaseFeeResponse{} }
+func (m *QueryBurnedBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBurnedBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{7}
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedBaseFeeResponse) GetBurnedBaseFee() types.Coin {
+	if m != nil {
+		return m.BurnedBaseFee
+	}
+	return types.Coin{}
+}
+
+// QueryAccountFeeDenomRequest defines the request type for querying the fee
+// denom of an account.
+type QueryAccountFeeDenomRequest struct {
+	// address is the bech32 address of the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountFeeDenomRequest) Reset()         { *m = QueryAccountFeeDenomRequest{} }
+func (m *QueryAccountFeeDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountFeeDenomRequest) ProtoMessage()    {}
+func (*QueryAccountFeeDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{8}
+}
+func (m *QueryAccountFeeDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountFeeDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountFeeDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountFeeDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountFeeDenomRequest.Merge(m, src)
+}
+func (m *QueryAccountFeeDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountFeeDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountFeeDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountFeeDenomRequest proto.InternalMessageInfo
+
+func (m *QueryAccountFeeDenomRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAccountFeeDenomResponse returns the fee denom of an account.
+type QueryAccountFeeDenomResponse struct {
+	// fee_denom is the fee denom used to pay the fees of the EVM transactions
+	// of the account. It is nil if the fees are paid in the EVM denom.
+	FeeDenom *FeeDenom `protobuf:"bytes,1,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
+}
+
+func (m *QueryAccountFeeDenomResponse) Reset()         { *m = QueryAccountFeeDenomResponse{} }
+func (m *QueryAccountFeeDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountFeeDenomResponse) ProtoMessage()    {}
+func (*QueryAccountFeeDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{9}
+}
+func (m *QueryAccountFeeDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountFeeDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountFeeDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountFeeDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountFeeDenomResponse.Merge(m, src)
+}
+func (m *QueryAccountFeeDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountFeeDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountFeeDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountFeeDenomResponse proto.InternalMessageInfo
+
+func (m *QueryAccountFeeDenomResponse) GetFeeDenom() *FeeDenom {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.feemarket.v1.QueryParamsResponse")
@@ -282,6 +462,10 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBurnedBaseFeeRequest)(nil), "cosmos.evm.feemarket.v1.QueryBurnedBaseFeeRequest")
+	proto.RegisterType((*QueryBurnedBaseFeeResponse)(nil), "cosmos.evm.feemarket.v1.QueryBurnedBaseFeeResponse")
+	proto.RegisterType((*QueryAccountFeeDenomRequest)(nil), "cosmos.evm.feemarket.v1.QueryAccountFeeDenomRequest")
+	proto.RegisterType((*QueryAccountFeeDenomResponse)(nil), "cosmos.evm.feemarket.v1.QueryAccountFeeDenomResponse")
 }
 
 func init() {
@@ -289,36 +473,48 @@ func init() {
 }
 
 var fileDescriptor_2c588b2369eb47d1 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x11, 0x91, 0xb6, 0xc7, 0x02, 0x47, 0x0a, 0xc8, 0x20, 0x9b, 0xba, 0x48, 0xa1, 0x05,
-	0xee, 0xd4, 0xb2, 0xb1, 0x61, 0x21, 0x58, 0x18, 0xc0, 0x1b, 0x2c, 0xd5, 0xc5, 0xbc, 0x5e, 0xad,
-	0xf4, 0x7c, 0x6e, 0xee, 0x12, 0x91, 0x95, 0x99, 0x01, 0x84, 0xf8, 0x0f, 0x8c, 0xfc, 0x06, 0xa6,
-	0x8e, 0x95, 0x58, 0x10, 0x43, 0x85, 0x12, 0x24, 0xfe, 0x06, 0xca, 0xdd, 0xb9, 0xd4, 0x54, 0x56,
-	0xb3, 0x58, 0x4f, 0xcf, 0xdf, 0xfb, 0xbe, 0xef, 0x7d, 0x4f, 0x87, 0xd7, 0x33, 0xa5, 0xa5, 0xd2,
-	0x0c, 0xc6, 0x92, 0xed, 0x02, 0x48, 0x3e, 0x1c, 0x80, 0x61, 0xe3, 0x2d, 0x76, 0x30, 0x82, 0xe1,
-	0x84, 0x96, 0x43, 0x65, 0x14, 0xb9, 0xee, 0x40, 0x14, 0xc6, 0x92, 0x9e, 0x80, 0xe8, 0x78, 0x2b,
-	0xb8, 0xc2, 0x65, 0x5e, 0x28, 0x66, 0xbf, 0x0e, 0x1b, 0xf4, 0x9a, 0x08, 0xff, 0x0d, 0x3a, 0x60,
-	0x57, 0x28, 0xa1, 0x6c, 0xc9, 0xe6, 0x95, 0xef, 0xde, 0x12, 0x4a, 0x89, 0x7d, 0x60, 0xbc, 0xcc,
-	0x19, 0x2f, 0x0a, 0x65, 0xb8, 0xc9, 0x55, 0xa1, 0xdd, 0xdf, 0xb8, 0x8b, 0xc9, 0xcb, 0xb9, 0xaf,
-	0x17, 0x7c, 0xc8, 0xa5, 0x4e, 0xe1, 0x60, 0x04, 0xda, 0xc4, 0xaf, 0xf0, 0xd5, 0x5a, 0x57, 0x97,
-	0xaa, 0xd0, 0x40, 0x12, 0xdc, 0x29, 0x6d, 0xe7, 0x06, 0xba, 0x8d, 0xee, 0x5e, 0xda, 0x8e, 0x68,
-	0xc3, 0x1a, 0xd4, 0x0d, 0x26, 0x2b, 0x87, 0xc7, 0x51, 0xeb, 0xcb, 0x9f, 0xaf, 0x9b, 0x28, 0xf5,
-	0x93, 0xf1, 0xaa, 0xa7, 0x4e, 0xb8, 0x86, 0xa7, 0x00, 0x95, 0x62, 0x8a, 0xbb, 0xf5, 0xb6, 0x97,
-	0x7c, 0x84, 0x97, 0xfb, 0x5c, 0xc3, 0xce, 0x2e, 0x80, 0x15, 0x5d, 0x49, 0xa2, 0x9f, 0xc7, 0xd1,
-	0x4d, 0xa7, 0xab, 0xdf, 0x0c, 0x68, 0xae, 0x98, 0xe4, 0x66, 0x8f, 0x3e, 0x07, 0xc1, 0xb3, 0xc9,
-	0x13, 0xc8, 0xd2, 0xa5, 0xbe, 0xe3, 0x88, 0xaf, 0x55, 0x9c, 0xfb, 0x2a, 0x1b, 0x3c, 0xe3, 0x27,
-	0xdb, 0x6d, 0xe0, 0xd5, 0xff, 0xfa, 0x5e, 0xec, 0x32, 0x6e, 0x0b, 0xee, 0x96, 0x6b, 0xa7, 0xf3,
-	0x72, 0xfb, 0x5b, 0x1b, 0x5f, 0xb4, 0x58, 0xf2, 0x1e, 0xe1, 0x8e, 0xdb, 0x8a, 0xdc, 0x6b, 0x5c,
-	0xfb, 0x6c, 0x94, 0xc1, 0xfd, 0xc5, 0xc0, 0xce, 0x41, 0xdc, 0x7b, 0xf7, 0xfd, 0xf7, 0xa7, 0x0b,
-	0x6b, 0x24, 0x62, 0x4d, 0x47, 0x77, 0x31, 0x92, 0x8f, 0x08, 0x2f, 0xf9, 0xac, 0xc8, 0x39, 0x12,
-	0xf5, 0xa4, 0x83, 0x07, 0x0b, 0xa2, 0xbd, 0xa3, 0x0d, 0xeb, 0x68, 0x9d, 0xac, 0x35, 0x3a, 0xaa,
-	0xee, 0x43, 0x3e, 0x23, 0xbc, 0x5c, 0x65, 0x4a, 0xce, 0x93, 0xa9, 0xdf, 0x24, 0xa0, 0x8b, 0xc2,
-	0xbd, 0xad, 0x4d, 0x6b, 0xeb, 0x0e, 0x89, 0x9b, 0x6d, 0xcd, 0x47, 0x76, 0x04, 0xd7, 0xc9, 0xe3,
-	0xc3, 0x69, 0x88, 0x8e, 0xa6, 0x21, 0xfa, 0x35, 0x0d, 0xd1, 0x87, 0x59, 0xd8, 0x3a, 0x9a, 0x85,
-	0xad, 0x1f, 0xb3, 0xb0, 0xf5, 0xba, 0x27, 0x72, 0xb3, 0x37, 0xea, 0xd3, 0x4c, 0xc9, 0xd3, 0x3c,
-	0x6f, 0x4f, 0x31, 0x99, 0x49, 0x09, 0xba, 0xdf, 0xb1, 0xaf, 0xe5, 0xe1, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x7d, 0x9a, 0xf3, 0x49, 0xdd, 0x03, 0x00, 0x00,
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0xbb, 0x3f, 0xf2, 0x2b, 0x74, 0x08, 0x41, 0x47, 0x50, 0x58, 0xc8, 0x56, 0x16, 0x13,
+	0xfe, 0xa8, 0x33, 0xb6, 0xa0, 0x26, 0xc6, 0x98, 0x50, 0x09, 0x5e, 0x38, 0x68, 0x6f, 0x7a, 0xb0,
+	0x99, 0xdd, 0x3e, 0x5d, 0x56, 0xd8, 0x9d, 0xd2, 0xd9, 0x36, 0x12, 0xe3, 0xc5, 0xb3, 0x07, 0x8d,
+	0xf1, 0x3d, 0x18, 0x0f, 0xc6, 0x17, 0xe0, 0x0b, 0xe0, 0x48, 0xe2, 0xc5, 0x78, 0x20, 0x06, 0x4c,
+	0x7c, 0x1b, 0x66, 0x67, 0x66, 0x6b, 0x17, 0xbb, 0x52, 0x2e, 0xcd, 0xf4, 0x99, 0xe7, 0xf9, 0x7e,
+	0x3f, 0x33, 0xf3, 0x3c, 0x8b, 0xe6, 0x5d, 0x2e, 0x02, 0x2e, 0x28, 0x74, 0x02, 0xda, 0x00, 0x08,
+	0x58, 0x6b, 0x1b, 0x22, 0xda, 0x29, 0xd1, 0xdd, 0x36, 0xb4, 0xf6, 0x48, 0xb3, 0xc5, 0x23, 0x8e,
+	0x2f, 0xa9, 0x24, 0x02, 0x9d, 0x80, 0x74, 0x93, 0x48, 0xa7, 0x64, 0x9e, 0x67, 0x81, 0x1f, 0x72,
+	0x2a, 0x7f, 0x55, 0xae, 0x69, 0x69, 0x41, 0x87, 0x09, 0xa0, 0x9d, 0x92, 0x03, 0x11, 0x2b, 0x51,
+	0x97, 0xfb, 0xa1, 0xde, 0x5f, 0xc8, 0x32, 0xfc, 0x23, 0xac, 0x12, 0x27, 0x3c, 0xee, 0x71, 0xb9,
+	0xa4, 0xf1, 0x4a, 0x47, 0x67, 0x3d, 0xce, 0xbd, 0x1d, 0xa0, 0xac, 0xe9, 0x53, 0x16, 0x86, 0x3c,
+	0x62, 0x91, 0xcf, 0x43, 0xa1, 0x76, 0xed, 0x09, 0x84, 0x1f, 0xc5, 0xdc, 0x0f, 0x59, 0x8b, 0x05,
+	0xa2, 0x0a, 0xbb, 0x6d, 0x10, 0x91, 0xfd, 0x18, 0x5d, 0x48, 0x45, 0x45, 0x93, 0x87, 0x02, 0x70,
+	0x05, 0xe5, 0x9b, 0x32, 0x32, 0x65, 0x5c, 0x36, 0x16, 0x47, 0xcb, 0x45, 0x92, 0x71, 0x4c, 0xa2,
+	0x0a, 0x2b, 0x85, 0xfd, 0xc3, 0x62, 0xee, 0xc3, 0xaf, 0xcf, 0xcb, 0x46, 0x55, 0x57, 0xda, 0x93,
+	0x5a, 0xba, 0xc2, 0x04, 0x6c, 0x00, 0x24, 0x8e, 0x55, 0x34, 0x91, 0x0e, 0x6b, 0xcb, 0x3b, 0x68,
+	0x24, 0xbe, 0x97, 0x5a, 0x03, 0x40, 0x9a, 0x16, 0x2a, 0xc5, 0xef, 0x87, 0xc5, 0x19, 0xe5, 0x2b,
+	0xea, 0xdb, 0xc4, 0xe7, 0x34, 0x60, 0xd1, 0x16, 0xd9, 0x04, 0x8f, 0xb9, 0x7b, 0xeb, 0xe0, 0x56,
+	0x87, 0x1d, 0xa5, 0x61, 0x5f, 0x4c, 0x34, 0x77, 0xb8, 0xbb, 0xfd, 0x80, 0x75, 0x4f, 0xb7, 0x84,
+	0x26, 0x4f, 0xc4, 0xb5, 0xd9, 0x39, 0x34, 0xe4, 0x31, 0x75, 0xb8, 0xa1, 0x6a, 0xbc, 0xb4, 0x67,
+	0xd0, 0xb4, 0x4a, 0x6d, 0xb7, 0x42, 0xa8, 0x9f, 0x60, 0x7e, 0x86, 0xcc, 0x7e, 0x9b, 0x5a, 0x6c,
+	0x13, 0x8d, 0x3b, 0x72, 0xa3, 0x96, 0x3a, 0xc0, 0x68, 0x79, 0x3a, 0xb9, 0xb5, 0x38, 0x4e, 0xf4,
+	0x83, 0x93, 0xfb, 0xdc, 0x0f, 0x7b, 0xef, 0x6b, 0xcc, 0xe9, 0x55, 0xb5, 0x6f, 0xa3, 0x19, 0xe9,
+	0xb5, 0xe6, 0xba, 0xbc, 0x1d, 0x46, 0x1b, 0x00, 0xeb, 0x10, 0xf2, 0x40, 0xa3, 0xe0, 0x29, 0x34,
+	0xcc, 0xea, 0xf5, 0x16, 0x08, 0x45, 0x5f, 0xa8, 0x26, 0x7f, 0xed, 0xa7, 0x68, 0xb6, 0x7f, 0xa1,
+	0xc6, 0xbc, 0x87, 0x0a, 0x0d, 0x80, 0x5a, 0x3d, 0x0e, 0x6a, 0xc0, 0xb9, 0xcc, 0x67, 0xed, 0x56,
+	0x8f, 0x34, 0xf4, 0xaa, 0xfc, 0x29, 0x8f, 0xfe, 0x97, 0x06, 0xf8, 0xb5, 0x81, 0xf2, 0xea, 0xdd,
+	0xf1, 0xd5, 0x4c, 0x85, 0xbf, 0x9b, 0xcd, 0xbc, 0x36, 0x58, 0xb2, 0xe2, 0xb5, 0x17, 0x5e, 0x7d,
+	0xfd, 0xf9, 0xee, 0xbf, 0x39, 0x5c, 0xa4, 0x59, 0x63, 0xa1, 0x1a, 0x0d, 0xbf, 0x35, 0xd0, 0xb0,
+	0xbe, 0x3d, 0x7c, 0x8a, 0x45, 0xfa, 0x5d, 0xcd, 0xeb, 0x03, 0x66, 0x6b, 0xa2, 0x25, 0x49, 0x34,
+	0x8f, 0xe7, 0x32, 0x89, 0x92, 0x06, 0xc0, 0xef, 0x0d, 0x34, 0x92, 0x74, 0x1d, 0x3e, 0xcd, 0x26,
+	0xdd, 0xb5, 0x26, 0x19, 0x34, 0x5d, 0x63, 0x2d, 0x4b, 0xac, 0x2b, 0xd8, 0xce, 0xc6, 0x8a, 0x4b,
+	0x6a, 0x1e, 0x13, 0xf8, 0xa3, 0x81, 0xc6, 0x52, 0x5d, 0x8c, 0xcb, 0xa7, 0xb8, 0xf5, 0x99, 0x07,
+	0x73, 0xe5, 0x4c, 0x35, 0x1a, 0xf3, 0x86, 0xc4, 0x5c, 0xc6, 0x8b, 0xd9, 0x98, 0xe9, 0x29, 0xc2,
+	0x5f, 0x0c, 0x34, 0x7e, 0xa2, 0x9b, 0xf1, 0xea, 0xbf, 0xad, 0xfb, 0x4f, 0x8d, 0x79, 0xf3, 0x8c,
+	0x55, 0x1a, 0xf9, 0xae, 0x44, 0xbe, 0x85, 0x57, 0x33, 0x91, 0x99, 0xaa, 0xac, 0x75, 0x27, 0x8b,
+	0xbe, 0xd0, 0xf3, 0xf8, 0xb2, 0xb2, 0xb6, 0x7f, 0x64, 0x19, 0x07, 0x47, 0x96, 0xf1, 0xe3, 0xc8,
+	0x32, 0xde, 0x1c, 0x5b, 0xb9, 0x83, 0x63, 0x2b, 0xf7, 0xed, 0xd8, 0xca, 0x3d, 0x59, 0xf0, 0xfc,
+	0x68, 0xab, 0xed, 0x10, 0x97, 0x07, 0xbd, 0xca, 0xcf, 0x7b, 0xb4, 0xa3, 0xbd, 0x26, 0x08, 0x27,
+	0x2f, 0xbf, 0xdd, 0x2b, 0xbf, 0x07, 0x00, 0x25, 0x05, 0xc6, 0xe4, 0x8b, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -339,6 +535,12 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BurnedBaseFee queries the cumulative amount of the EVM denom burned from
+	// the base fee.
+	BurnedBaseFee(ctx context.Context, in *QueryBurnedBaseFeeRequest, opts ...grpc.CallOption) (*QueryBurnedBaseFeeResponse, error)
+	// AccountFeeDenom queries the fee denom used to pay the fees of the EVM
+	// transactions of an account.
+	AccountFeeDenom(ctx context.Context, in *QueryAccountFeeDenomRequest, opts ...grpc.CallOption) (*QueryAccountFeeDenomResponse, error)
 }
 
 type queryClient struct {
@@ -376,6 +578,24 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BurnedBaseFee(ctx context.Context, in *QueryBurnedBaseFeeRequest, opts ...grpc.CallOption) (*QueryBurnedBaseFeeResponse, error) {
+	out := new(QueryBurnedBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/BurnedBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountFeeDenom(ctx context.Context, in *QueryAccountFeeDenomRequest, opts ...grpc.CallOption) (*QueryAccountFeeDenomResponse, error) {
+	out := new(QueryAccountFeeDenomResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/AccountFeeDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -384,6 +604,12 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BurnedBaseFee queries the cumulative amount of the EVM denom burned from
+	// the base fee.
+	BurnedBaseFee(context.Context, *QueryBurnedBaseFeeRequest) (*QueryBurnedBaseFeeResponse, error)
+	// AccountFeeDenom queries the fee denom used to pay the fees of the EVM
+	// transactions of an account.
+	AccountFeeDenom(context.Context, *QueryAccountFeeDenomRequest) (*QueryAccountFeeDenomResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -399,6 +625,12 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BurnedBaseFee(ctx context.Context, req *QueryBurnedBaseFeeRequest) (*QueryBurnedBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedBaseFee not implemented")
+}
+func (*UnimplementedQueryServer) AccountFeeDenom(ctx context.Context, req *QueryAccountFeeDenomRequest) (*QueryAccountFeeDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountFeeDenom not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -458,6 +690,42 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/BurnedBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedBaseFee(ctx, req.(*QueryBurnedBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountFeeDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountFeeDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountFeeDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/AccountFeeDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountFeeDenom(ctx, req.(*QueryAccountFeeDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -474,6 +742,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BurnedBaseFee",
+			Handler:    _Query_BurnedBaseFee_Handler,
+		},
+		{
+			MethodName: "AccountFeeDenom",
+			Handler:    _Query_AccountFeeDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/query.proto",
@@ -644,6 +920,127 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBurnedBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BurnedBaseFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountFeeDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountFeeDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountFeeDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountFeeDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountFeeDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountFeeDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeDenom != nil {
+		{
+			size, err := m.FeeDenom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -718,14 +1115,60 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryBurnedBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *QueryBurnedBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BurnedBaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountFeeDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountFeeDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeDenom != nil {
+		l = m.FeeDenom.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -1112,6 +1555,307 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBurnedBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedBaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountFeeDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountFeeDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountFeeDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountFeeDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountFeeDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountFeeDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeDenom == nil {
+				m.FeeDenom = &FeeDenom{}
+			}
+			if err := m.FeeDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
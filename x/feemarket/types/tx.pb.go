@@ -125,37 +125,140 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetFeeDenom defines a Msg for an account to select the denom used to pay
+// the fees of its EVM transactions.
+type MsgSetFeeDenom struct {
+	// sender is the address of the account paying the fees.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// fee_denom is one of the fee denoms accepted by the fee market module, or
+	// empty to pay the fees in the EVM denom.
+	FeeDenom string `protobuf:"bytes,2,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
+}
+
+func (m *MsgSetFeeDenom) Reset()         { *m = MsgSetFeeDenom{} }
+func (m *MsgSetFeeDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeDenom) ProtoMessage()    {}
+func (*MsgSetFeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e58e525ba547f0, []int{2}
+}
+func (m *MsgSetFeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeDenom.Merge(m, src)
+}
+func (m *MsgSetFeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeDenom proto.InternalMessageInfo
+
+func (m *MsgSetFeeDenom) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetFeeDenom) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+// MsgSetFeeDenomResponse defines the response structure for executing a
+// MsgSetFeeDenom message.
+type MsgSetFeeDenomResponse struct {
+}
+
+func (m *MsgSetFeeDenomResponse) Reset()         { *m = MsgSetFeeDenomResponse{} }
+func (m *MsgSetFeeDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeDenomResponse) ProtoMessage()    {}
+func (*MsgSetFeeDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e58e525ba547f0, []int{3}
+}
+func (m *MsgSetFeeDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeDenomResponse.Merge(m, src)
+}
+func (m *MsgSetFeeDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeDenomResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.evm.feemarket.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.evm.feemarket.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetFeeDenom)(nil), "cosmos.evm.feemarket.v1.MsgSetFeeDenom")
+	proto.RegisterType((*MsgSetFeeDenomResponse)(nil), "cosmos.evm.feemarket.v1.MsgSetFeeDenomResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/feemarket/v1/tx.proto", fileDescriptor_e1e58e525ba547f0) }
 
 var fileDescriptor_e1e58e525ba547f0 = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d,
-	0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x4b, 0x2d, 0xcb, 0xd5, 0x83, 0xab, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc,
-	0xcb, 0xd7, 0x07, 0x93, 0x10, 0xb5, 0x52, 0xea, 0xb8, 0x4c, 0x43, 0x68, 0x84, 0x28, 0x84, 0x1a,
-	0xaa, 0x9f, 0x5b, 0x9c, 0x0e, 0x92, 0xce, 0x2d, 0x4e, 0x87, 0x4a, 0x48, 0x42, 0x24, 0xe2, 0xc1,
-	0x3c, 0x7d, 0xa8, 0xd5, 0x10, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x88, 0x38, 0x88, 0x05, 0x11,
-	0x55, 0x3a, 0xc1, 0xc8, 0xc5, 0xef, 0x5b, 0x9c, 0x1e, 0x5a, 0x90, 0x92, 0x58, 0x92, 0x1a, 0x90,
-	0x58, 0x94, 0x98, 0x5b, 0x2c, 0x64, 0xc6, 0xc5, 0x99, 0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59,
-	0x52, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0x71, 0x69, 0x8b, 0xae, 0x08, 0xd4, 0x38,
-	0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x84, 0x52,
-	0x21, 0x27, 0x2e, 0xb6, 0x02, 0xb0, 0x09, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xf2, 0x7a,
-	0x38, 0xfc, 0xae, 0x07, 0xb1, 0xc8, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68,
-	0x31, 0x06, 0x41, 0x75, 0x5a, 0x59, 0x36, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xb3, 0xeb, 0xf9, 0x06,
-	0x2d, 0x35, 0xa4, 0x50, 0xa9, 0x40, 0x0a, 0x17, 0x34, 0x67, 0x2b, 0x49, 0x72, 0x89, 0xa3, 0x09,
-	0x05, 0xa5, 0x16, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x1a, 0x55, 0x70, 0x31, 0xfb, 0x16, 0xa7, 0x0b,
-	0x65, 0x71, 0xf1, 0xa0, 0x78, 0x54, 0x03, 0xa7, 0x03, 0xd1, 0x0c, 0x92, 0x32, 0x20, 0x56, 0x25,
-	0xcc, 0x4a, 0x29, 0xd6, 0x06, 0x90, 0xbf, 0x9c, 0x1c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x4a, 0x3d, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f,
-	0x87, 0x0f, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x31, 0x65, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x35, 0xe5, 0xf9, 0x17, 0x6c, 0x02, 0x00, 0x00,
+	// 439 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x33, 0x8a, 0xc5, 0xcc, 0x8a, 0x62, 0x58, 0xdc, 0x6c, 0x84, 0x6c, 0x09, 0x68, 0x4b,
+	0xc1, 0xcc, 0xee, 0x8a, 0x82, 0x7b, 0xdb, 0x20, 0xde, 0x0a, 0x92, 0xc5, 0x8b, 0x97, 0x25, 0xbb,
+	0x79, 0x9d, 0x8d, 0x32, 0x99, 0x90, 0x99, 0x0d, 0xed, 0x4d, 0x3c, 0x7a, 0xf2, 0xe4, 0x67, 0xf0,
+	0xd8, 0x83, 0x1f, 0xa2, 0xc7, 0xe2, 0xc9, 0x93, 0x68, 0x7b, 0xe8, 0xd7, 0x90, 0x64, 0xa6, 0x36,
+	0x2d, 0x44, 0x7b, 0x09, 0xc9, 0x7b, 0xbf, 0xf9, 0xbf, 0xff, 0xfb, 0x4f, 0x70, 0xfb, 0x92, 0x0b,
+	0xc6, 0x05, 0x81, 0x82, 0x91, 0x01, 0x00, 0x8b, 0xf2, 0xf7, 0x20, 0x49, 0x71, 0x44, 0xe4, 0xd0,
+	0xcf, 0x72, 0x2e, 0xb9, 0xb5, 0xa7, 0x08, 0x1f, 0x0a, 0xe6, 0xff, 0x25, 0xfc, 0xe2, 0xc8, 0xb9,
+	0x1f, 0xb1, 0x24, 0xe5, 0xa4, 0x7a, 0x2a, 0xd6, 0xe9, 0x34, 0xa9, 0xad, 0x0e, 0x2a, 0x50, 0x8b,
+	0x12, 0x26, 0x68, 0xd9, 0x66, 0x82, 0xea, 0xc6, 0xbe, 0x6a, 0x9c, 0x57, 0x5f, 0x44, 0x8f, 0x56,
+	0xad, 0x5d, 0xca, 0x29, 0x57, 0xf5, 0xf2, 0x4d, 0x55, 0xbd, 0x09, 0xc2, 0xf7, 0xfa, 0x82, 0xbe,
+	0xc9, 0xe2, 0x48, 0xc2, 0xeb, 0x28, 0x8f, 0x98, 0xb0, 0x9e, 0x63, 0x33, 0xba, 0x96, 0x57, 0x3c,
+	0x4f, 0xe4, 0xc8, 0x46, 0x6d, 0xd4, 0x35, 0x03, 0xfb, 0xfb, 0xb7, 0x27, 0xbb, 0x5a, 0xee, 0x34,
+	0x8e, 0x73, 0x10, 0xe2, 0x4c, 0xe6, 0x49, 0x4a, 0xc3, 0x15, 0x6a, 0x05, 0xb8, 0x95, 0x55, 0x0a,
+	0xf6, 0x8d, 0x36, 0xea, 0xee, 0x1c, 0x1f, 0xf8, 0x0d, 0xbb, 0xfb, 0x6a, 0x50, 0x60, 0x4e, 0x7e,
+	0x1e, 0x18, 0x5f, 0x17, 0xe3, 0x1e, 0x0a, 0xf5, 0xc9, 0x93, 0x17, 0x1f, 0x17, 0xe3, 0xde, 0x4a,
+	0xf3, 0xd3, 0x62, 0xdc, 0x7b, 0x5c, 0x4b, 0x65, 0x58, 0xcb, 0x65, 0xc3, 0xb6, 0xb7, 0x8f, 0xf7,
+	0x36, 0x4a, 0x21, 0x88, 0x8c, 0xa7, 0x02, 0xbc, 0x2f, 0x08, 0xdf, 0xed, 0x0b, 0x7a, 0x06, 0xf2,
+	0x15, 0xc0, 0x4b, 0x48, 0x39, 0xb3, 0x0e, 0x71, 0x4b, 0x40, 0x1a, 0x43, 0xfe, 0xdf, 0x0d, 0x35,
+	0x67, 0x3d, 0xc4, 0xe6, 0x00, 0xe0, 0x3c, 0x2e, 0x8f, 0x57, 0x1b, 0x9a, 0xe1, 0xed, 0x81, 0x96,
+	0x3b, 0x79, 0x56, 0xfa, 0xd6, 0x64, 0x69, 0xfa, 0x51, 0xb3, 0xe9, 0x9a, 0x0b, 0xcf, 0xc6, 0x0f,
+	0xd6, 0x2b, 0x4b, 0xcb, 0xc7, 0xbf, 0x11, 0xbe, 0xd9, 0x17, 0xd4, 0x7a, 0x87, 0xef, 0xac, 0x5d,
+	0x4e, 0xb7, 0x31, 0xd4, 0x8d, 0xe5, 0x9d, 0xc3, 0x6d, 0xc9, 0xe5, 0x4c, 0x8b, 0xe2, 0x9d, 0x7a,
+	0x44, 0x9d, 0x7f, 0x09, 0xd4, 0x40, 0x87, 0x6c, 0x09, 0x2e, 0x07, 0x39, 0xb7, 0x3e, 0x94, 0x97,
+	0x1e, 0x9c, 0x4e, 0x66, 0x2e, 0x9a, 0xce, 0x5c, 0xf4, 0x6b, 0xe6, 0xa2, 0xcf, 0x73, 0xd7, 0x98,
+	0xce, 0x5d, 0xe3, 0xc7, 0xdc, 0x35, 0xde, 0x76, 0x68, 0x22, 0xaf, 0xae, 0x2f, 0xfc, 0x4b, 0xce,
+	0x48, 0x43, 0x92, 0x72, 0x94, 0x81, 0xb8, 0x68, 0x55, 0xbf, 0xf1, 0xd3, 0x3f, 0x03, 0x00, 0x3e,
+	0xc6, 0xfd, 0xf2, 0x89, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -174,6 +277,9 @@ type MsgClient interface {
 	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetFeeDenom defines a method for an account to select the fee denom used
+	// to pay the fees of its EVM transactions.
+	SetFeeDenom(ctx context.Context, in *MsgSetFeeDenom, opts ...grpc.CallOption) (*MsgSetFeeDenomResponse, error)
 }
 
 type msgClient struct {
@@ -193,12 +299,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetFeeDenom(ctx context.Context, in *MsgSetFeeDenom, opts ...grpc.CallOption) (*MsgSetFeeDenomResponse, error) {
+	out := new(MsgSetFeeDenomResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Msg/SetFeeDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/feemarket
 	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetFeeDenom defines a method for an account to select the fee denom used
+	// to pay the fees of its EVM transactions.
+	SetFeeDenom(context.Context, *MsgSetFeeDenom) (*MsgSetFeeDenomResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -208,6 +326,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetFeeDenom(ctx context.Context, req *MsgSetFeeDenom) (*MsgSetFeeDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeDenom not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -231,6 +352,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetFeeDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Msg/SetFeeDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeDenom(ctx, req.(*MsgSetFeeDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.feemarket.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -239,6 +378,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetFeeDenom",
+			Handler:    _Msg_SetFeeDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/tx.proto",
@@ -307,6 +450,66 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetFeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -342,6 +545,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetFeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFeeDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -513,6 +742,170 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetFeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/ibc/callbacks/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+var _ types.PacketAdapter = ICAControllerAdapter{}
+
+// ICAControllerAdapter is the packet adapter of the ICS-27 interchain accounts
+// controller. The controller only sends packets, so only the source callbacks
+// are supported.
+type ICAControllerAdapter struct{}
+
+// NewICAControllerAdapter creates a new ICS-27 ICAControllerAdapter instance.
+func NewICAControllerAdapter() ICAControllerAdapter {
+	return ICAControllerAdapter{}
+}
+
+// UnmarshalPacketData unmarshals the ICS-27 interchain account packet data.
+func (ICAControllerAdapter) UnmarshalPacketData(data []byte, _ string) (interface{}, error) {
+	var packetData icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
+		return nil, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data: %s", err)
+	}
+	return packetData, nil
+}
@@ -1,23 +1,24 @@
 package keeper
 
 import (
+	"fmt"
 	"math/big"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 
-	"github.com/cosmos/evm/contracts"
-	"github.com/cosmos/evm/ibc"
 	callbacksabi "github.com/cosmos/evm/precompiles/callbacks"
 	types2 "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/utils"
-	erc20types "github.com/cosmos/evm/x/erc20/types"
 	"github.com/cosmos/evm/x/ibc/callbacks/types"
 	evmante "github.com/cosmos/evm/x/vm/ante"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	callbacktypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
-	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 
 	errorsmod "cosmossdk.io/errors"
@@ -29,33 +30,99 @@ import (
 // ContractKeeper implements callbacktypes.ContractKeeper
 var _ callbacktypes.ContractKeeper = (*ContractKeeper)(nil)
 
+// erc165GasLimit is the maximum gas of the ERC-165 supportsInterface query, as
+// specified by the standard.
+const erc165GasLimit uint64 = 30_000
+
+// erc165Selector is the selector of the ERC-165 supportsInterface(bytes4) function.
+var erc165Selector = common.FromHex("0x01ffc9a7")
+
 type ContractKeeper struct {
-	authKeeper            types.AccountKeeper
-	evmKeeper             types.EVMKeeper
-	erc20Keeper           types.ERC20Keeper
-	packetDataUnmarshaler porttypes.PacketDataUnmarshaler
+	authKeeper     types.AccountKeeper
+	evmKeeper      types.EVMKeeper
+	erc20Keeper    types.ERC20Keeper
+	channelKeeper  types.ChannelKeeper
+	packetAdapters map[string]types.PacketAdapter
 }
 
 // NewKeeper creates and initializes a new ContractKeeper instance.
 //
 // The ContractKeeper manages cross-chain contract execution and handles IBC packet
-// callbacks for smart contract interactions.
-func NewKeeper(authKeeper types.AccountKeeper, evmKeeper types.EVMKeeper, erc20Keeper types.ERC20Keeper) ContractKeeper {
+// callbacks for smart contract interactions. The adapters of the ICS-20 transfer
+// and ICS-27 controller applications are registered by default, the adapters of
+// other applications can be registered with WithPacketAdapter.
+func NewKeeper(
+	authKeeper types.AccountKeeper,
+	evmKeeper types.EVMKeeper,
+	erc20Keeper types.ERC20Keeper,
+	channelKeeper types.ChannelKeeper,
+) ContractKeeper {
 	ck := ContractKeeper{
-		authKeeper:  authKeeper,
-		evmKeeper:   evmKeeper,
-		erc20Keeper: erc20Keeper,
-	}
-	ck.packetDataUnmarshaler = types.Unmarshaler{}
+		authKeeper:     authKeeper,
+		evmKeeper:      evmKeeper,
+		erc20Keeper:    erc20Keeper,
+		channelKeeper:  channelKeeper,
+		packetAdapters: make(map[string]types.PacketAdapter),
+	}
+	ck.WithPacketAdapter(transfertypes.PortID, NewTransferAdapter(evmKeeper, erc20Keeper))
+	ck.WithPacketAdapter(icatypes.ControllerPortPrefix, NewICAControllerAdapter())
 	return ck
 }
 
-// IBCSendPacketCallback handles IBC packet send callbacks for cross-chain operations.
+// WithPacketAdapter registers the packet adapter of the IBC application bound to
+// the given port. A port ID ending with "-", like the ICS-27 controller port
+// prefix, registers the adapter for all the ports with that prefix.
+// It panics if an adapter is already registered for the port.
+func (k ContractKeeper) WithPacketAdapter(portID string, adapter types.PacketAdapter) {
+	if _, found := k.packetAdapters[portID]; found {
+		panic(fmt.Errorf("packet adapter already registered for port %s", portID))
+	}
+	k.packetAdapters[portID] = adapter
+}
+
+// packetAdapter returns the packet adapter of the application bound to the
+// given port. The adapters registered for the exact port take precedence over
+// the ones registered for the port prefix.
+func (k ContractKeeper) packetAdapter(portID string) (types.PacketAdapter, error) {
+	if adapter, found := k.packetAdapters[portID]; found {
+		return adapter, nil
+	}
+	if i := strings.Index(portID, "-"); i >= 0 {
+		if adapter, found := k.packetAdapters[portID[:i+1]]; found {
+			return adapter, nil
+		}
+	}
+	return nil, errorsmod.Wrapf(types.ErrUnsupportedApplication, "no packet adapter registered for port %s", portID)
+}
+
+// IBCSendPacketCallback handles IBC packet send callbacks for cross-chain contract execution.
+// This function is triggered when a packet that sets a source callback is sent,
+// allowing contracts to approve, reject or record the outgoing packets.
+//
+// The function performs the following operations:
+// 1. Retrieves the sequence of the packet, which was committed before the callback
+// 2. Validates the source callback data, which must not contain calldata
+// 3. Verifies the target contract exists and contains code
+// 4. Calls the contract's onPacketSend function with packet details
+// 5. Manages gas consumption within the callback gas limit
+//
+// Returns:
+//   - error: Returns nil on success, or an error if any step fails including:
+//   - Missing send sequence of the channel
+//   - Invalid callback data or non-empty calldata
+//   - Address parsing failures
+//   - Contract validation failures (non-existent or no code)
+//   - ABI loading errors
+//   - EVM execution errors, including failures of the ERC-165 query other than a revert
 //
-// IMPORTANT: This callback is currently not supported and always returns nil.
-// The rationale is that contracts can implement custom logic before the send packet
-// operation is called, making this callback redundant or potentially conflicting
-// with contract-defined behavior.
+// A returned error aborts the packet send, so the whole transaction that sent the
+// packet fails.
+//
+// Contract Requirements:
+//   - May implement onPacketSend(string calldata sourceChannel, string calldata sourcePort,
+//     uint64 sequence, bytes calldata data) function. It is only called if the contract
+//     returns true from the ERC-165 supportsInterface for the ICallbacks interface ID.
+//   - Should revert to reject the packet
 func (k ContractKeeper) IBCSendPacketCallback(
 	cachedCtx sdk.Context,
 	sourcePort string,
@@ -67,25 +134,143 @@ func (k ContractKeeper) IBCSendPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
+	// The callbacks middleware runs the callback once the packet is committed,
+	// so the packet used the last send sequence of the channel.
+	nextSequence, found := k.channelKeeper.GetNextSequenceSend(cachedCtx, sourcePort, sourceChannel)
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrSequenceSendNotFound, "source port: %s, source channel: %s", sourcePort, sourceChannel)
+	}
+	sequence := nextSequence - 1
+
+	packet := channeltypes.Packet{
+		Sequence:         sequence,
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Data:             packetData,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+	data, err := k.unmarshalSourcePacketData(packet, version)
+	if err != nil {
+		return err
+	}
+
+	cbData, isCbPacket, err := callbacktypes.GetCallbackData(data, version, sourcePort, cachedCtx.GasMeter().GasRemaining(), cachedCtx.GasMeter().GasRemaining(), callbacktypes.SourceCallbackKey)
+	if err != nil {
+		return err
+	}
+	if !isCbPacket {
+		return nil
+	}
+
+	// The same source callback runs on acknowledgement and timeout, which reject calldata,
+	// so reject it before the packet is sent.
+	if len(cbData.Calldata) != 0 {
+		return errorsmod.Wrap(types.ErrInvalidCalldata, "send callback data should not contain calldata")
+	}
+
+	sender, err := utils.HexAddressFromBech32String(packetSenderAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to parse packet sender address %s", packetSenderAddress)
+	}
+
+	contractAddr := common.HexToAddress(contractAddress)
+	contractAccount := k.evmKeeper.GetAccountOrEmpty(cachedCtx, contractAddr)
+
+	// Check if the contract address contains code.
+	// This check is required because if there is no code, the call will still pass on the EVM side
+	// and the packet would be sent without the contract approving it.
+	if !contractAccount.IsContract() {
+		return errorsmod.Wrapf(types.ErrCallbackFailed, "provided contract address is not a contract: %s", contractAddr)
+	}
+
+	abi, err := callbacksabi.LoadABI()
+	if err != nil {
+		return err
+	}
+
+	// `ProcessCallback` in IBC-Go runs the callback on a basic gas meter limited to the
+	// callback gas limit, so we need to generate a new infinite gas meter with the same
+	// limit to run the EVM execution on.
+	// The state changes are already written by `ProcessCallback` only if the callback succeeds.
+	gasLimit := cachedCtx.GasMeter().GasRemaining()
+	evmCtx := evmante.BuildEvmExecutionCtx(cachedCtx).
+		WithGasMeter(types2.NewInfiniteGasMeterWithLimit(gasLimit))
+
+	// onPacketSend is optional, so it is only called on the contracts that declare
+	// the ICallbacks interface through ERC-165.
+	supported, gasUsed, err := k.supportsCallbacksInterface(evmCtx, *abi, sender, contractAddr, min(gasLimit, erc165GasLimit))
+	cachedCtx.GasMeter().ConsumeGas(gasUsed, "callback supportsInterface")
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrCallbackFailed, "ERC-165 query failed: %s", err.Error())
+	}
+	if !supported {
+		return nil
+	}
+
+	gasLimit = cachedCtx.GasMeter().GasRemaining()
+	res, err := k.evmKeeper.CallEVM(evmCtx, *abi, sender, contractAddr, true, math.NewIntFromUint64(gasLimit).BigInt(), "onPacketSend",
+		sourceChannel, sourcePort, sequence, packetData)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrCallbackFailed, "EVM returned error: %s", err.Error())
+	}
+
+	// Consume the actual gas used on the original callback context.
+	cachedCtx.GasMeter().ConsumeGas(res.GasUsed, "callback onPacketSend")
+
 	return nil
 }
 
+// supportsCallbacksInterface returns true if the contract implements the ERC-165
+// supportsInterface query and returns true for the ICallbacks interface ID, along
+// with the gas used by the query. A query that reverts or doesn't return true counts
+// as not supported, while any other failure, such as running out of gas, is returned
+// so that a low callback gas limit cannot skip the callback.
+func (k ContractKeeper) supportsCallbacksInterface(
+	ctx sdk.Context,
+	callbacksABI abi.ABI,
+	from, contract common.Address,
+	gasLimit uint64,
+) (bool, uint64, error) {
+	// the interface ID is the XOR of the selectors of the interface functions
+	var interfaceID [4]byte
+	for _, method := range callbacksABI.Methods {
+		for i := range interfaceID {
+			interfaceID[i] ^= method.ID[i]
+		}
+	}
+	data := append(common.CopyBytes(erc165Selector), common.RightPadBytes(interfaceID[:], 32)...)
+
+	res, err := k.evmKeeper.CallEVMWithData(ctx, from, &contract, data, false, new(big.Int).SetUint64(gasLimit))
+	if res == nil {
+		return false, 0, err
+	}
+	if res.VmError == vm.ErrExecutionReverted.Error() {
+		return false, res.GasUsed, nil
+	}
+	if err != nil {
+		return false, res.GasUsed, err
+	}
+	return len(res.Ret) == 32 && common.BytesToHash(res.Ret) == common.BigToHash(common.Big1), res.GasUsed, nil
+}
+
 // IBCReceivePacketCallback handles IBC packet callbacks for cross-chain contract execution.
 // This function processes incoming IBC packets that contain callback data and executes
-// the specified contract with the transferred tokens.
+// the specified contract with the received funds.
 //
 // The function performs the following operations:
-// 1. Unmarshals and validates the IBC packet data
+// 1. Unmarshals and validates the IBC packet data with the adapter of the destination port
 // 2. Extracts callback data from the packet
 // 3. Generates an isolated address for security
 // 4. Validates the receiver address matches the isolated address
 // 5. Verifies the target contract exists and contains code
-// 6. Sets up ERC20 token allowance for the contract
+// 6. Approves the contract to pull the received funds through the adapter
 // 7. Executes the callback function on the target contract
-// 8. Validates that all tokens were successfully transferred to the contract
+// 8. Validates through the adapter that all funds were successfully transferred to the contract
 //
 // Returns:
 //   - error: Returns nil on success, or an error if any step fails including:
+//   - Unsupported application or destination callbacks
 //   - Packet data unmarshaling errors
 //   - Invalid callback data
 //   - Address validation failures
@@ -93,14 +278,14 @@ func (k ContractKeeper) IBCSendPacketCallback(
 //   - Token pair registration errors
 //   - EVM execution errors
 //   - Gas limit exceeded errors
-//   - Token transfer validation failures
+//   - Funds transfer validation failures
 //
 // Security Notes:
 //   - Uses isolated addresses to prevent unauthorized access
 //   - Validates contract existence to prevent fund loss
 //   - Enforces gas limits to prevent DoS attacks
-//   - Requires contracts to implement proper token transfer logic
-//   - Validates final token balances to ensure successful transfers
+//   - Requires contracts to implement proper funds transfer logic
+//   - Validates final balances to ensure successful transfers
 func (k ContractKeeper) IBCReceivePacketCallback(
 	ctx sdk.Context,
 	packet ibcexported.PacketI,
@@ -108,7 +293,12 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 	contractAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	adapter, err := k.packetAdapter(packet.GetDestPort())
+	if err != nil {
+		return err
+	}
+
+	data, err := adapter.UnmarshalPacketData(packet.GetData(), version)
 	if err != nil {
 		return err
 	}
@@ -121,6 +311,11 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 		return nil
 	}
 
+	destAdapter, ok := adapter.(types.DestinationPacketAdapter)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrUnsupportedCallback, "destination callbacks are not supported on port %s", packet.GetDestPort())
+	}
+
 	// `ProcessCallback` in IBC-Go overrides the infinite gas meter with a basic gas meter,
 	// so we need to generate a new infinite gas meter to run the EVM executions on.
 	// Skipping this causes the EVM gas estimation function to deplete all Cosmos gas.
@@ -130,11 +325,19 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 	cachedCtx = evmante.BuildEvmExecutionCtx(cachedCtx).
 		WithGasMeter(types2.NewInfiniteGasMeterWithLimit(cbData.CommitGasLimit))
 
-	// receiver := sdk.MustAccAddressFromBech32(data.Receiver)
-	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	packetSender, err := destAdapter.GetPacketSender(data)
+	if err != nil {
+		return err
+	}
+	packetReceiver, err := destAdapter.GetPacketReceiver(data)
+	if err != nil {
+		return err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(packetReceiver)
 	if err != nil {
 		return errorsmod.Wrapf(types.ErrInvalidReceiverAddress,
-			"acc addr from bech32 conversion failed for receiver address: %s", data.Receiver)
+			"acc addr from bech32 conversion failed for receiver address: %s", packetReceiver)
 	}
 	receiverHex, err := utils.HexAddressFromBech32String(receiver.String())
 	if err != nil {
@@ -143,7 +346,7 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 	}
 
 	// Generate secure isolated address from sender.
-	isolatedAddr := types.GenerateIsolatedAddress(packet.GetDestChannel(), data.Sender)
+	isolatedAddr := types.GenerateIsolatedAddress(packet.GetDestChannel(), packetSender)
 	isolatedAddrHex := common.BytesToAddress(isolatedAddr.Bytes())
 
 	acc := k.authKeeper.NewAccountWithAddress(ctx, receiver)
@@ -164,56 +367,25 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 		return errorsmod.Wrapf(types.ErrContractHasNoCode, "provided contract address is not a contract: %s", contractAddr)
 	}
 
-	// Check if the token pair exists and get the ERC20 contract address
-	// for the native ERC20 or the precompile.
-	// This call fails if the token does not exist or is not registered.
-	token := transfertypes.Token{
-		Denom:  data.Token.Denom,
-		Amount: data.Token.Amount,
-	}
-	coin := ibc.GetReceivedCoin(packet.(channeltypes.Packet), token)
-
-	tokenPairID := k.erc20Keeper.GetTokenPairID(ctx, coin.Denom)
-	tokenPair, found := k.erc20Keeper.GetTokenPair(ctx, tokenPairID)
-	if !found {
-		return errorsmod.Wrapf(types.ErrTokenPairNotFound, "token pair for denom %s not found", data.Token.Denom.IBCDenom())
-	}
-	amountInt, ok := math.NewIntFromString(data.Token.Amount)
-	if !ok {
-		return errorsmod.Wrapf(types.ErrNumberOverflow, "amount overflow")
-	}
-
-	erc20 := contracts.ERC20MinterBurnerDecimalsContract
-
 	remainingGas := math.NewIntFromUint64(cachedCtx.GasMeter().GasRemaining()).BigInt()
 
-	// Call the EVM with the remaining gas as the maximum gas limit.
+	// Approve the contract to pull the received funds with the remaining gas as the maximum gas limit.
 	// Up to now, the remaining gas is equal to the callback gas limit set by the user.
 	// NOTE: use the cached ctx for the EVM calls.
-	res, err := k.evmKeeper.CallEVM(cachedCtx, erc20.ABI, receiverHex, tokenPair.GetERC20Contract(), true, remainingGas, "approve", contractAddr, amountInt.BigInt())
+	gasUsed, err := destAdapter.ApproveReceivedFunds(cachedCtx, packet.(channeltypes.Packet), data, receiverHex, contractAddr, remainingGas)
 	if err != nil {
-		return errorsmod.Wrapf(types.ErrAllowanceFailed, "failed to set allowance: %v", err)
+		return err
 	}
 
 	// Consume the actual used gas on the original callback context.
-	ctx.GasMeter().ConsumeGas(res.GasUsed, "callback allowance")
-	remainingGas = remainingGas.Sub(remainingGas, math.NewIntFromUint64(res.GasUsed).BigInt())
+	ctx.GasMeter().ConsumeGas(gasUsed, "callback allowance")
+	remainingGas = remainingGas.Sub(remainingGas, math.NewIntFromUint64(gasUsed).BigInt())
 	if ctx.GasMeter().IsOutOfGas() || remainingGas.Cmp(big.NewInt(0)) < 0 {
 		return errorsmod.Wrapf(types.ErrOutOfGas, "out of gas")
 	}
 
-	var approveSuccess bool
-	err = erc20.ABI.UnpackIntoInterface(&approveSuccess, "approve", res.Ret)
-	if err != nil {
-		return errorsmod.Wrapf(types.ErrAllowanceFailed, "failed to unpack approve return: %v", err)
-	}
-
-	if !approveSuccess {
-		return errorsmod.Wrapf(types.ErrAllowanceFailed, "failed to set allowance")
-	}
-
 	// NOTE: use the cached ctx for the EVM calls.
-	res, err = k.evmKeeper.CallEVMWithData(cachedCtx, receiverHex, &contractAddr, cbData.Calldata, true, remainingGas)
+	res, err := k.evmKeeper.CallEVMWithData(cachedCtx, receiverHex, &contractAddr, cbData.Calldata, true, remainingGas)
 	if err != nil {
 		return errorsmod.Wrapf(types.ErrEVMCallFailed, "EVM returned error: %s", err.Error())
 	}
@@ -227,19 +399,11 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 	// Write cachedCtx events back to ctx.
 	writeFn()
 
-	// Check that the sender no longer has tokens after the callback.
-	// NOTE: contracts must implement an IERC20(token).transferFrom(msg.sender, address(this), amount)
-	// for the total amount, or the callback will fail.
+	// Check that the receiver no longer holds the funds after the callback.
 	// This check is here to prevent funds from getting stuck in the isolated address,
 	// since they would become irretrievable.
-	receiverTokenBalance := k.erc20Keeper.BalanceOf(ctx, erc20.ABI, tokenPair.GetERC20Contract(), receiverHex) // here,
-	// we can use the original ctx and skip manually adding the gas
-	if receiverTokenBalance.Cmp(big.NewInt(0)) != 0 {
-		return errorsmod.Wrapf(erc20types.ErrEVMCall,
-			"receiver has %d unrecoverable tokens after callback", receiverTokenBalance)
-	}
-
-	return nil
+	// Here, we can use the original ctx and skip manually adding the gas.
+	return destAdapter.ValidateReceivedFundsMoved(ctx, packet.(channeltypes.Packet), data, receiverHex)
 }
 
 // IBCOnAcknowledgementPacketCallback handles IBC packet acknowledgement callbacks for cross-chain contract execution.
@@ -277,7 +441,7 @@ func (k ContractKeeper) IBCOnAcknowledgementPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := k.unmarshalSourcePacketData(packet, version)
 	if err != nil {
 		return err
 	}
@@ -378,7 +542,7 @@ func (k ContractKeeper) IBCOnTimeoutPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := k.unmarshalSourcePacketData(packet, version)
 	if err != nil {
 		return err
 	}
@@ -439,3 +603,13 @@ func (k ContractKeeper) IBCOnTimeoutPacketCallback(
 	writeFn()
 	return nil
 }
+
+// unmarshalSourcePacketData unmarshals the data of a packet sent from this chain
+// with the adapter of the application bound to the source port.
+func (k ContractKeeper) unmarshalSourcePacketData(packet channeltypes.Packet, version string) (interface{}, error) {
+	adapter, err := k.packetAdapter(packet.GetSourcePort())
+	if err != nil {
+		return nil, err
+	}
+	return adapter.UnmarshalPacketData(packet.GetData(), version)
+}
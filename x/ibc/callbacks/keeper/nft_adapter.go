@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	erc721precompile "github.com/cosmos/evm/precompiles/erc721"
+	erc721types "github.com/cosmos/evm/x/erc721/types"
+	"github.com/cosmos/evm/x/ibc/callbacks/types"
+	nfttransfertypes "github.com/cosmos/evm/x/ibc/nfttransfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.DestinationPacketAdapter = NFTTransferAdapter{}
+
+// NFTTransferAdapter is the packet adapter of the ICS-721 nft-transfer
+// application. The received NFTs are handed over to the destination callback
+// contract through their ERC721 representation, which is either the ERC-721
+// precompile of the voucher class or the ERC721 contract the class belongs to.
+//
+// CONTRACT: the ERC-721 middleware must be below the callbacks middleware in
+// the nft-transfer stack, so the NFTs have an ERC721 representation when the
+// destination callback is executed.
+type NFTTransferAdapter struct {
+	evmKeeper    types.EVMKeeper
+	erc721Keeper types.ERC721Keeper
+}
+
+// NewNFTTransferAdapter creates a new ICS-721 NFTTransferAdapter instance.
+func NewNFTTransferAdapter(evmKeeper types.EVMKeeper, erc721Keeper types.ERC721Keeper) NFTTransferAdapter {
+	return NFTTransferAdapter{
+		evmKeeper:    evmKeeper,
+		erc721Keeper: erc721Keeper,
+	}
+}
+
+// UnmarshalPacketData unmarshals the ICS-721 nft-transfer packet data.
+func (a NFTTransferAdapter) UnmarshalPacketData(data []byte, _ string) (interface{}, error) {
+	return nfttransfertypes.UnmarshalPacketData(data)
+}
+
+// GetPacketSender returns the sender of the ICS-721 nft-transfer packet data.
+func (a NFTTransferAdapter) GetPacketSender(data interface{}) (string, error) {
+	nftData, err := castNFTTransferData(data)
+	if err != nil {
+		return "", err
+	}
+	return nftData.Sender, nil
+}
+
+// GetPacketReceiver returns the receiver of the ICS-721 nft-transfer packet data.
+func (a NFTTransferAdapter) GetPacketReceiver(data interface{}) (string, error) {
+	nftData, err := castNFTTransferData(data)
+	if err != nil {
+		return "", err
+	}
+	return nftData.Receiver, nil
+}
+
+// ApproveReceivedFunds approves the contract to transfer each of the NFTs
+// received by the receiver.
+func (a NFTTransferAdapter) ApproveReceivedFunds(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data interface{},
+	receiver, contract common.Address,
+	gasCap *big.Int,
+) (uint64, error) {
+	erc721, tokenIDs, err := a.receivedTokens(ctx, packet, data)
+	if err != nil {
+		return 0, err
+	}
+
+	erc721ABI, err := erc721precompile.LoadABI()
+	if err != nil {
+		return 0, err
+	}
+
+	var gasUsed uint64
+	remainingGas := new(big.Int).Set(gasCap)
+	for _, tokenID := range tokenIDs {
+		// NOTE: use the cached ctx for the EVM calls.
+		res, err := a.evmKeeper.CallEVM(ctx, erc721ABI, receiver, erc721, true, remainingGas, erc721precompile.ApproveMethod, contract, tokenID)
+		if err != nil {
+			return gasUsed, errorsmod.Wrapf(types.ErrAllowanceFailed, "failed to approve token %s: %v", tokenID, err)
+		}
+
+		gasUsed += res.GasUsed
+		remainingGas.Sub(remainingGas, new(big.Int).SetUint64(res.GasUsed))
+		if remainingGas.Sign() < 0 {
+			return gasUsed, errorsmod.Wrapf(types.ErrOutOfGas, "out of gas")
+		}
+	}
+
+	return gasUsed, nil
+}
+
+// ValidateReceivedFundsMoved checks that the receiver no longer owns any of the
+// received NFTs.
+// NOTE: contracts must implement an IERC721(token).transferFrom(msg.sender, address(this), tokenId)
+// for all the token ids, or the callback will fail.
+func (a NFTTransferAdapter) ValidateReceivedFundsMoved(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data interface{},
+	receiver common.Address,
+) error {
+	erc721, tokenIDs, err := a.receivedTokens(ctx, packet, data)
+	if err != nil {
+		return err
+	}
+
+	for _, tokenID := range tokenIDs {
+		owner, err := a.erc721Keeper.OwnerOf(ctx, erc721, tokenID)
+		if err != nil {
+			return err
+		}
+		if owner == receiver {
+			return errorsmod.Wrapf(erc721types.ErrEVMCall,
+				"receiver still owns token %s of %s after callback", tokenID, erc721)
+		}
+	}
+
+	return nil
+}
+
+// receivedTokens returns the ERC721 address of the class received with the
+// packet and the ids of the received tokens.
+// This call fails if the class has no registered token pair.
+func (a NFTTransferAdapter) receivedTokens(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data interface{},
+) (common.Address, []*big.Int, error) {
+	nftData, err := castNFTTransferData(data)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	classID := nfttransfertypes.GetReceivedClassID(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		nftData.ClassId,
+	)
+	tokenPair, found := a.erc721Keeper.GetTokenPair(ctx, a.erc721Keeper.GetClassMap(ctx, classID))
+	if !found {
+		return common.Address{}, nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token pair for class %s not found", classID)
+	}
+
+	tokenIDs := make([]*big.Int, 0, len(nftData.TokenIds))
+	for _, id := range nftData.TokenIds {
+		tokenID, err := erc721types.ParseTokenID(id)
+		if err != nil {
+			return common.Address{}, nil, err
+		}
+		tokenIDs = append(tokenIDs, tokenID)
+	}
+
+	return tokenPair.GetERC721Contract(), tokenIDs, nil
+}
+
+// castNFTTransferData casts the unmarshaled packet data to the ICS-721
+// nft-transfer packet data.
+func castNFTTransferData(data interface{}) (nfttransfertypes.NonFungibleTokenPacketData, error) {
+	nftData, ok := data.(nfttransfertypes.NonFungibleTokenPacketData)
+	if !ok {
+		return nfttransfertypes.NonFungibleTokenPacketData{}, errorsmod.Wrapf(types.ErrInvalidPacketData, "expected ICS-721 nft-transfer packet data, got %T", data)
+	}
+	return nftData, nil
+}
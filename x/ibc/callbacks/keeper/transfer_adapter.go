@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/ibc"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/ibc/callbacks/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.DestinationPacketAdapter = TransferAdapter{}
+
+// TransferAdapter is the packet adapter of the ICS-20 transfer application.
+// The received tokens are handed over to the destination callback contract
+// through their ERC20 representation.
+type TransferAdapter struct {
+	evmKeeper   types.EVMKeeper
+	erc20Keeper types.ERC20Keeper
+}
+
+// NewTransferAdapter creates a new ICS-20 TransferAdapter instance.
+func NewTransferAdapter(evmKeeper types.EVMKeeper, erc20Keeper types.ERC20Keeper) TransferAdapter {
+	return TransferAdapter{
+		evmKeeper:   evmKeeper,
+		erc20Keeper: erc20Keeper,
+	}
+}
+
+// UnmarshalPacketData unmarshals the ICS-20 transfer packet data.
+func (a TransferAdapter) UnmarshalPacketData(data []byte, version string) (interface{}, error) {
+	return transfertypes.UnmarshalPacketData(data, version, "")
+}
+
+// GetPacketSender returns the sender of the ICS-20 transfer packet data.
+func (a TransferAdapter) GetPacketSender(data interface{}) (string, error) {
+	transferData, err := castTransferData(data)
+	if err != nil {
+		return "", err
+	}
+	return transferData.Sender, nil
+}
+
+// GetPacketReceiver returns the receiver of the ICS-20 transfer packet data.
+func (a TransferAdapter) GetPacketReceiver(data interface{}) (string, error) {
+	transferData, err := castTransferData(data)
+	if err != nil {
+		return "", err
+	}
+	return transferData.Receiver, nil
+}
+
+// ApproveReceivedFunds sets the ERC20 allowance of the contract over the tokens
+// received by the receiver.
+// The token pair of the received coin must be registered, either for a native
+// ERC20 or a precompile, otherwise the contract can't pull the tokens.
+func (a TransferAdapter) ApproveReceivedFunds(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data interface{},
+	receiver, contract common.Address,
+	gasCap *big.Int,
+) (uint64, error) {
+	tokenPair, amount, err := a.receivedTokenPair(ctx, packet, data)
+	if err != nil {
+		return 0, err
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract
+
+	// NOTE: use the cached ctx for the EVM calls.
+	res, err := a.evmKeeper.CallEVM(ctx, erc20.ABI, receiver, tokenPair.GetERC20Contract(), true, gasCap, "approve", contract, amount)
+	if err != nil {
+		return 0, errorsmod.Wrapf(types.ErrAllowanceFailed, "failed to set allowance: %v", err)
+	}
+
+	var approveSuccess bool
+	err = erc20.ABI.UnpackIntoInterface(&approveSuccess, "approve", res.Ret)
+	if err != nil {
+		return res.GasUsed, errorsmod.Wrapf(types.ErrAllowanceFailed, "failed to unpack approve return: %v", err)
+	}
+
+	if !approveSuccess {
+		return res.GasUsed, errorsmod.Wrapf(types.ErrAllowanceFailed, "failed to set allowance")
+	}
+
+	return res.GasUsed, nil
+}
+
+// ValidateReceivedFundsMoved checks that the receiver no longer holds the ERC20
+// representation of the received tokens.
+// NOTE: contracts must implement an IERC20(token).transferFrom(msg.sender, address(this), amount)
+// for the total amount, or the callback will fail.
+func (a TransferAdapter) ValidateReceivedFundsMoved(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data interface{},
+	receiver common.Address,
+) error {
+	tokenPair, _, err := a.receivedTokenPair(ctx, packet, data)
+	if err != nil {
+		return err
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract
+	receiverTokenBalance := a.erc20Keeper.BalanceOf(ctx, erc20.ABI, tokenPair.GetERC20Contract(), receiver)
+	if receiverTokenBalance.Cmp(big.NewInt(0)) != 0 {
+		return errorsmod.Wrapf(erc20types.ErrEVMCall,
+			"receiver has %d unrecoverable tokens after callback", receiverTokenBalance)
+	}
+
+	return nil
+}
+
+// receivedTokenPair returns the token pair and the amount of the coin received
+// with the packet.
+// This call fails if the token does not exist or is not registered.
+func (a TransferAdapter) receivedTokenPair(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data interface{},
+) (erc20types.TokenPair, *big.Int, error) {
+	transferData, err := castTransferData(data)
+	if err != nil {
+		return erc20types.TokenPair{}, nil, err
+	}
+
+	token := transfertypes.Token{
+		Denom:  transferData.Token.Denom,
+		Amount: transferData.Token.Amount,
+	}
+	coin := ibc.GetReceivedCoin(packet, token)
+
+	tokenPairID := a.erc20Keeper.GetTokenPairID(ctx, coin.Denom)
+	tokenPair, found := a.erc20Keeper.GetTokenPair(ctx, tokenPairID)
+	if !found {
+		return erc20types.TokenPair{}, nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token pair for denom %s not found", transferData.Token.Denom.IBCDenom())
+	}
+	amountInt, ok := math.NewIntFromString(transferData.Token.Amount)
+	if !ok {
+		return erc20types.TokenPair{}, nil, errorsmod.Wrapf(types.ErrNumberOverflow, "amount overflow")
+	}
+
+	return tokenPair, amountInt.BigInt(), nil
+}
+
+// castTransferData casts the unmarshaled packet data to the ICS-20 transfer
+// packet data.
+func castTransferData(data interface{}) (transfertypes.InternalTransferRepresentation, error) {
+	transferData, ok := data.(transfertypes.InternalTransferRepresentation)
+	if !ok {
+		return transfertypes.InternalTransferRepresentation{}, errorsmod.Wrapf(types.ErrInvalidPacketData, "expected ICS-20 transfer packet data, got %T", data)
+	}
+	return transferData, nil
+}
@@ -72,6 +72,27 @@ contract CounterWithCallbacks is ICallbacks {
         return userTokenBalances[user][token];
     }
 
+    /**
+     * @dev ERC-165 interface detection, so that onPacketSend is called
+     * when a packet is sent
+     */
+    function supportsInterface(bytes4 interfaceId) external pure returns (bool) {
+        return interfaceId == type(ICallbacks).interfaceId || interfaceId == 0x01ffc9a7;
+    }
+
+    /**
+     * @dev Implementation of ICallbacks interface
+     * Called when a packet is sent
+     */
+    function onPacketSend(
+        string memory,
+        string memory,
+        uint64,
+        bytes memory
+    ) external override {
+        counter += 1; // Increment counter on send
+    }
+
     /**
      * @dev Implementation of ICallbacks interface
      * Called when a packet acknowledgement is received
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PacketAdapter adapts the packets of a callbacks compatible IBC application to
+// the EVM callbacks. The ContractKeeper routes the packets to the adapter
+// registered for their port, so the packet data of any application can be
+// parsed for the source callbacks (send, acknowledgement and timeout).
+type PacketAdapter interface {
+	// UnmarshalPacketData unmarshals the packet data of the application. The
+	// returned value must implement the ibc-go PacketDataProvider interface.
+	UnmarshalPacketData(data []byte, version string) (interface{}, error)
+}
+
+// DestinationPacketAdapter is implemented by the adapters of the applications
+// that support destination callbacks.
+//
+// The funds of a packet with a destination callback are received on an isolated
+// address, generated from the destination channel and the packet sender. Before
+// the callback contract is executed, the adapter approves the contract to pull
+// the funds from the isolated address, and after the execution it checks that
+// no funds are left behind, since they would become irretrievable.
+type DestinationPacketAdapter interface {
+	PacketAdapter
+
+	// GetPacketSender returns the sender of the packet data on the source chain.
+	GetPacketSender(data interface{}) (string, error)
+	// GetPacketReceiver returns the receiver of the packet data on this chain.
+	GetPacketReceiver(data interface{}) (string, error)
+	// ApproveReceivedFunds approves the contract to transfer the funds received by
+	// the receiver and returns the EVM gas used, which can't exceed the gas cap.
+	ApproveReceivedFunds(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		data interface{},
+		receiver, contract common.Address,
+		gasCap *big.Int,
+	) (uint64, error)
+	// ValidateReceivedFundsMoved checks that the receiver no longer holds any of
+	// the funds of the packet.
+	ValidateReceivedFundsMoved(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		data interface{},
+		receiver common.Address,
+	) error
+}
@@ -15,4 +15,7 @@ var (
 	ErrAllowanceFailed        = errorsmod.Register(ModuleName, 7, "allowance failed")
 	ErrEVMCallFailed          = errorsmod.Register(ModuleName, 8, "evm call failed")
 	ErrOutOfGas               = errorsmod.Register(ModuleName, 9, "out of gas")
+	ErrUnsupportedApplication = errorsmod.Register(ModuleName, 10, "unsupported IBC application")
+	ErrUnsupportedCallback    = errorsmod.Register(ModuleName, 11, "callback not supported by IBC application")
+	ErrInvalidPacketData      = errorsmod.Register(ModuleName, 12, "invalid packet data")
 )
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/cosmos/evm/x/erc20/types"
+	erc721types "github.com/cosmos/evm/x/erc721/types"
 	"github.com/cosmos/evm/x/vm/statedb"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -34,3 +35,16 @@ type ERC20Keeper interface {
 	SetAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address, value *big.Int) error
 	BalanceOf(ctx sdk.Context, abi abi.ABI, contract, account common.Address) *big.Int
 }
+
+// ERC721Keeper defines the expected x/erc721 keeper used to hand over the NFTs
+// received with a destination callback.
+type ERC721Keeper interface {
+	GetTokenPair(ctx sdk.Context, id []byte) (erc721types.TokenPair, bool)
+	GetClassMap(ctx sdk.Context, classID string) []byte
+	OwnerOf(ctx sdk.Context, contract common.Address, tokenID *big.Int) (common.Address, error)
+}
+
+// ChannelKeeper defines the expected IBC channel keeper.
+type ChannelKeeper interface {
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+}
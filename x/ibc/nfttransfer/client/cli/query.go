@@ -0,0 +1,183 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/x/ibc/nfttransfer/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// GetQueryCmd returns the parent command for all nft-transfer CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "nft-transfer",
+		Short:                      "IBC non-fungible token transfer query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetParamsCmd(),
+		GetClassTraceCmd(),
+		GetClassTracesCmd(),
+		GetClassHashCmd(),
+		GetEscrowAddressCmd(),
+	)
+	return cmd
+}
+
+// GetParamsCmd queries the module parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets nft-transfer params",
+		Long:  "Gets nft-transfer params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetClassTraceCmd queries the trace of a voucher class
+func GetClassTraceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-trace HASH_OR_CLASS_ID",
+		Short: "Gets the class trace of a voucher class",
+		Long:  "Gets the class trace of a voucher class from its hash or its ibc/{hash} class id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClassTrace(context.Background(), &types.QueryClassTraceRequest{Hash: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetClassTracesCmd queries the traces of all the voucher classes
+func GetClassTracesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-traces",
+		Short: "Gets the class traces of all the voucher classes",
+		Long:  "Gets the class traces of all the voucher classes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClassTraces(context.Background(), &types.QueryClassTracesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "class-traces")
+	return cmd
+}
+
+// GetClassHashCmd queries the hash of a class trace
+func GetClassHashCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-hash TRACE",
+		Short: "Gets the hash of a class trace",
+		Long:  "Gets the hash of a [port_id]/[channel_id]/[class_id] class trace",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClassHash(context.Background(), &types.QueryClassHashRequest{Trace: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetEscrowAddressCmd queries the escrow address of a channel
+func GetEscrowAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow-address PORT CHANNEL",
+		Short: "Gets the escrow address of a channel",
+		Long:  "Gets the address of the account escrowing the NFTs sent through a channel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEscrowAddressRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.EscrowAddress(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/x/ibc/nfttransfer/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+const (
+	flagPacketTimeout = "packet-timeout"
+	flagMemo          = "memo"
+)
+
+// defaultPacketTimeout is the relative timeout added to the block time of the
+// latest block when no timeout is provided.
+const defaultPacketTimeout = 10 * time.Minute
+
+// NewTxCmd returns a root CLI command handler for nft-transfer transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "nft-transfer",
+		Short:                      "IBC non-fungible token transfer transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewTransferTxCmd(),
+	)
+	return txCmd
+}
+
+// NewTransferTxCmd returns the command to create a MsgTransfer transaction
+func NewTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer SRC_PORT SRC_CHANNEL RECEIVER CLASS_ID TOKEN_IDS",
+		Short: "Transfer non-fungible tokens through IBC",
+		Long: `Transfer non-fungible tokens through IBC. The token ids are separated by commas.
+The packet timeout is relative to the current time.`,
+		Example: "tx nft-transfer transfer nfttransfer channel-0 cosmos1... my-class 1,2,3",
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeout, err := cmd.Flags().GetDuration(flagPacketTimeout)
+			if err != nil {
+				return err
+			}
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp := uint64(time.Now().Add(timeout).UnixNano()) //#nosec G115 -- time is always positive
+			msg := types.NewMsgTransfer(
+				args[0], args[1], args[3], strings.Split(args[4], ","),
+				cliCtx.GetFromAddress().String(), args[2], timeoutTimestamp, memo,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(flagPacketTimeout, defaultPacketTimeout, "Packet timeout relative to the current time")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,26 @@
+package nfttransfer
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/x/ibc/nfttransfer/keeper"
+	"github.com/cosmos/evm/x/ibc/nfttransfer/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the ibc nft-transfer state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(fmt.Errorf("error setting params %s", err))
+	}
+
+	for _, trace := range data.ClassTraces {
+		k.SetClassTrace(ctx, trace)
+	}
+}
+
+// ExportGenesis exports the ibc nft-transfer state.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetPort(ctx), k.GetAllClassTraces(ctx), k.GetParams(ctx))
+}
@@ -0,0 +1,256 @@
+package nfttransfer
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/evm/x/ibc/nfttransfer/keeper"
+	"github.com/cosmos/evm/x/ibc/nfttransfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ porttypes.IBCModule             = IBCModule{}
+	_ porttypes.PacketDataUnmarshaler = IBCModule{}
+)
+
+// IBCModule implements the ICS26 interface for the ICS-721 nft-transfer
+// application given the nft-transfer keeper.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper: k,
+	}
+}
+
+// validateChannelParams does validation of a newly created nft-transfer channel.
+// An nft-transfer channel must be UNORDERED and bound to the nft-transfer port.
+func validateChannelParams(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	order channeltypes.Order,
+	portID string,
+) error {
+	if order != channeltypes.UNORDERED {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.UNORDERED, order)
+	}
+
+	if boundPort := k.GetPort(ctx); boundPort != portID {
+		return errorsmod.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
+	}
+
+	return nil
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	_ []string,
+	portID string,
+	_ string,
+	_ channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if err := validateChannelParams(ctx, im.keeper, order, portID); err != nil {
+		return "", err
+	}
+
+	if strings.TrimSpace(version) == "" {
+		version = types.Version
+	}
+
+	if version != types.Version {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, version)
+	}
+
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	_ []string,
+	portID,
+	_ string,
+	_ channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := validateChannelParams(ctx, im.keeper, order, portID); err != nil {
+		return "", err
+	}
+
+	if counterpartyVersion != types.Version {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	}
+
+	return types.Version, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (IBCModule) OnChanOpenAck(
+	_ sdk.Context,
+	_,
+	_ string,
+	_ string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != types.Version {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (IBCModule) OnChanOpenConfirm(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (IBCModule) OnChanCloseInit(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	// Disallow user-initiated channel closing for nft-transfer channels
+	return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (IBCModule) OnChanCloseConfirm(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. A successful acknowledgement
+// is returned if the packet data is successfully decoded and the receive
+// application logic returns without error.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	_ string,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	data, err := types.UnmarshalPacketData(packet.GetData())
+	if err == nil {
+		err = im.keeper.OnRecvPacket(ctx, packet, data)
+	}
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	if err != nil {
+		ack = channeltypes.NewErrorAcknowledgement(err)
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", err.Error(), packet.Sequence))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacket,
+			sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, data.ClassId),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(data.TokenIds, ",")),
+			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", err == nil)),
+		),
+	)
+
+	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	_ string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-721 nft-transfer packet acknowledgement: %v", err)
+	}
+
+	bz := types.ModuleCdc.MustMarshalJSON(&ack)
+	if !bytes.Equal(bz, acknowledgement) {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "acknowledgement did not marshal to expected bytes: %X ≠ %X", bz, acknowledgement)
+	}
+
+	data, err := types.UnmarshalPacketData(packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	if err := im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack); err != nil {
+		return err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+		sdk.NewAttribute(types.AttributeKeyClassID, data.ClassId),
+	}
+	if errResp, ok := ack.Response.(*channeltypes.Acknowledgement_Error); ok {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyAckError, errResp.Error))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeAcknowledged, attributes...))
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	_ string,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) error {
+	data, err := types.UnmarshalPacketData(packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	// refund tokens
+	if err := im.keeper.OnTimeoutPacket(ctx, packet, data); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTimeout,
+			sdk.NewAttribute(types.AttributeKeyRefundSender, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyClassID, data.ClassId),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(data.TokenIds, ",")),
+		),
+	)
+
+	return nil
+}
+
+// UnmarshalPacketData attempts to unmarshal the provided packet data bytes
+// into a NonFungibleTokenPacketData. This function implements the optional
+// PacketDataUnmarshaler interface required for ADR 008 support.
+func (im IBCModule) UnmarshalPacketData(_ sdk.Context, _, _ string, bz []byte) (any, string, error) {
+	data, err := types.UnmarshalPacketData(bz)
+	return data, types.Version, err
+}
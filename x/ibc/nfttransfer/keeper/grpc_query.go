@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/evm/x/ibc/nfttransfer/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// ClassTrace implements the Query/ClassTrace gRPC method
+func (k Keeper) ClassTrace(c context.Context, req *types.QueryClassTraceRequest) (*types.QueryClassTraceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := types.ParseHexHash(strings.TrimPrefix(req.Hash, types.ClassPrefix+"/"))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, errorsmod.Wrapf(types.ErrInvalidClassID, "invalid class trace hash: %s, error: %s", req.Hash, err).Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	classTrace, found := k.GetClassTrace(ctx, hash)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrTraceNotFound, req.Hash).Error())
+	}
+
+	return &types.QueryClassTraceResponse{ClassTrace: &classTrace}, nil
+}
+
+// ClassTraces implements the Query/ClassTraces gRPC method
+func (k Keeper) ClassTraces(c context.Context, req *types.QueryClassTracesRequest) (*types.QueryClassTracesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	traces := types.Traces{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassTrace)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var trace types.ClassTrace
+		if err := k.cdc.Unmarshal(value, &trace); err != nil {
+			return err
+		}
+
+		traces = append(traces, trace)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassTracesResponse{
+		ClassTraces: traces.Sort(),
+		Pagination:  pageRes,
+	}, nil
+}
+
+// ClassHash implements the Query/ClassHash gRPC method
+func (k Keeper) ClassHash(c context.Context, req *types.QueryClassHashRequest) (*types.QueryClassHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	classTrace := types.ParseClassTrace(req.Trace)
+	if err := classTrace.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	classHash := classTrace.Hash()
+	if !k.HasClassTrace(ctx, classHash) {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrTraceNotFound, req.Trace).Error())
+	}
+
+	return &types.QueryClassHashResponse{Hash: classHash.String()}, nil
+}
+
+// EscrowAddress implements the Query/EscrowAddress gRPC method
+func (k Keeper) EscrowAddress(_ context.Context, req *types.QueryEscrowAddressRequest) (*types.QueryEscrowAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addr := types.GetEscrowAddress(req.PortId, req.ChannelId)
+	return &types.QueryEscrowAddressResponse{EscrowAddress: addr.String()}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
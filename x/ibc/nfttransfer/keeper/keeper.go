@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/x/ibc/nfttransfer/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper defines the ICS-721 nft-transfer keeper. The transferred tokens are
+// stored on x/nft: native tokens are escrowed on the channel escrow account and
+// received tokens are minted as vouchers under an ibc/{hash} class.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	ics4Wrapper   porttypes.ICS4Wrapper
+	channelKeeper types.ChannelKeeper
+	nftKeeper     types.NFTKeeper
+}
+
+// NewKeeper creates a new ICS-721 nft-transfer Keeper instance
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	channelKeeper types.ChannelKeeper,
+	nftKeeper types.NFTKeeper,
+) Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		authority:     authority,
+		ics4Wrapper:   ics4Wrapper,
+		channelKeeper: channelKeeper,
+		nftKeeper:     nftKeeper,
+	}
+}
+
+// WithICS4Wrapper sets the ICS4Wrapper. This function may be used after the
+// keeper's creation to set the middleware which is above this module in the
+// IBC application stack.
+func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
+	k.ics4Wrapper = wrapper
+}
+
+// GetICS4Wrapper returns the ICS4Wrapper.
+func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
+// GetPort returns the portID for the nft-transfer module.
+func (k Keeper) GetPort(_ sdk.Context) string {
+	return types.PortID
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/evm/x/ibc/nfttransfer/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// Transfer defines a gRPC msg server method for MsgTransfer.
+func (k *Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := k.SendTransfer(
+		ctx, msg.SourcePort, msg.SourceChannel,
+		msg.ClassId, msg.TokenIds, sender, msg.Receiver,
+		msg.TimeoutTimestamp, msg.Memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("IBC non-fungible token transfer", "class_id", msg.ClassId, "token_ids", msg.TokenIds, "sender", msg.Sender, "receiver", msg.Receiver)
+
+	return &types.MsgTransferResponse{Sequence: sequence}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
+// proposal passes, it updates the module parameters. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/evm/x/ibc/nfttransfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendTransfer handles nft-transfer sending logic. There are 2 possible cases:
+//
+// 1. Sender chain is acting as the source zone. The NFTs are transferred
+// to an escrow address (i.e locked) on the sender chain and then transferred
+// to the receiving chain through IBC TAO logic. It is expected that the
+// receiving chain will mint vouchers to the receiving address.
+//
+// 2. Sender chain is acting as the sink zone. The NFT vouchers are burned
+// on the sender chain and then transferred to the receiving chain though IBC
+// TAO logic. It is expected that the receiving chain, which had previously
+// sent the original NFTs, will unescrow the NFTs and send them to the
+// receiving address.
+func (k Keeper) SendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel,
+	classID string,
+	tokenIDs []string,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	if !k.GetParams(ctx).SendEnabled {
+		return 0, types.ErrSendDisabled
+	}
+
+	if _, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel); !found {
+		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	class, found := k.nftKeeper.GetClass(ctx, classID)
+	if !found {
+		return 0, errorsmod.Wrap(types.ErrClassNotFound, classID)
+	}
+
+	// the full class path is sent so that the counterparty chain can trace the
+	// tokens back to their originating chain
+	fullClassPath := classID
+	classData := ""
+	if strings.HasPrefix(classID, types.ClassPrefix+"/") {
+		classTrace, err := k.ClassPathFromHash(ctx, classID)
+		if err != nil {
+			return 0, err
+		}
+		fullClassPath = classTrace.GetFullClassPath()
+		classData = k.GetClassData(ctx, classID)
+	}
+
+	isSource := types.SenderChainIsSource(sourcePort, sourceChannel, fullClassPath)
+	escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+
+	tokenURIs := make([]string, len(tokenIDs))
+	tokenData := make([]string, len(tokenIDs))
+	for i, tokenID := range tokenIDs {
+		token, found := k.nftKeeper.GetNFT(ctx, classID, tokenID)
+		if !found {
+			return 0, errorsmod.Wrapf(nft.ErrNFTNotExists, "class %s token %s", classID, tokenID)
+		}
+		if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !owner.Equals(sender) {
+			return 0, errorsmod.Wrapf(types.ErrUnauthorized, "class %s token %s is owned by %s", classID, tokenID, owner)
+		}
+
+		tokenURIs[i] = token.Uri
+		tokenData[i] = k.GetTokenData(ctx, classID, tokenID)
+
+		if isSource {
+			// escrow the NFT on the channel escrow account
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, escrowAddress); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		// the NFT is a voucher returning to its source chain
+		if err := k.nftKeeper.Burn(ctx, classID, tokenID); err != nil {
+			return 0, err
+		}
+		k.deleteTokenData(ctx, classID, tokenID)
+	}
+
+	packetData := types.NewNonFungibleTokenPacketData(
+		fullClassPath, class.Uri, classData,
+		tokenIDs, tokenURIs, tokenData,
+		sender.String(), receiver, memo,
+	)
+	if err := packetData.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, clienttypes.ZeroHeight(), timeoutTimestamp, packetData.GetBytes())
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, fullClassPath),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(tokenIDs, ",")),
+			sdk.NewAttribute(types.AttributeKeyMemo, memo),
+		),
+	)
+
+	return sequence, nil
+}
+
+// OnRecvPacket processes an ICS-721 packet. If the receiving chain is the
+// source of the class, the escrowed NFTs are unlocked and sent to the receiver.
+// Otherwise, NFT vouchers are minted to the receiver under the ibc/{hash}
+// class of the prefixed class path.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	if !k.GetParams(ctx).ReceiveEnabled {
+		return types.ErrReceiveDisabled
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to decode receiver address %s", data.Receiver)
+	}
+
+	if types.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, data.ClassId) {
+		// remove the prefix added by the sender chain
+		voucherPrefix := types.GetClassPrefix(packet.SourcePort, packet.SourceChannel)
+		unprefixedClassID := data.ClassId[len(voucherPrefix):]
+		classID := types.ParseClassTrace(unprefixedClassID).IBCClassID()
+
+		escrowAddress := types.GetEscrowAddress(packet.DestinationPort, packet.DestinationChannel)
+		for _, tokenID := range data.TokenIds {
+			if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !owner.Equals(escrowAddress) {
+				return errorsmod.Wrapf(types.ErrUnauthorized, "class %s token %s is not escrowed", classID, tokenID)
+			}
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, receiver); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// the sender chain is the source, prefix the class path with the
+	// destination port and channel
+	classTrace := types.ParseClassTrace(types.GetClassPrefix(packet.DestinationPort, packet.DestinationChannel) + data.ClassId)
+	if !k.HasClassTrace(ctx, classTrace.Hash()) {
+		k.SetClassTrace(ctx, classTrace)
+	}
+
+	voucherClassID := classTrace.IBCClassID()
+	if !k.nftKeeper.HasClass(ctx, voucherClassID) {
+		if err := k.nftKeeper.SaveClass(ctx, nft.Class{Id: voucherClassID, Uri: data.ClassUri}); err != nil {
+			return err
+		}
+		k.SetClassData(ctx, voucherClassID, data.ClassData)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClassTrace,
+			sdk.NewAttribute(types.AttributeKeyTraceHash, classTrace.Hash().String()),
+			sdk.NewAttribute(types.AttributeKeyClassID, voucherClassID),
+		),
+	)
+
+	for i, tokenID := range data.TokenIds {
+		token := nft.NFT{ClassId: voucherClassID, Id: tokenID, Uri: data.GetTokenURI(i)}
+		if err := k.nftKeeper.Mint(ctx, token, receiver); err != nil {
+			return err
+		}
+		k.SetTokenData(ctx, voucherClassID, tokenID, data.GetTokenData(i))
+	}
+
+	return nil
+}
+
+// OnAcknowledgementPacket responds to the success or failure of a packet
+// acknowledgement written on the receiving chain. If the acknowledgement
+// was a success then nothing occurs. If the acknowledgement failed, then
+// the sender is refunded their NFTs.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundPacketTokens(ctx, packet, data)
+	default:
+		// the acknowledgement succeeded on the receiving chain so nothing
+		// needs to be executed and no error needs to be returned
+		return nil
+	}
+}
+
+// OnTimeoutPacket refunds the sender since the original packet sent was
+// never received and has been timed out.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	return k.refundPacketTokens(ctx, packet, data)
+}
+
+// refundPacketTokens will unescrow and send back the NFTs back to sender if
+// the sending chain was the source chain. Otherwise, the sent NFT vouchers
+// are minted back to the sender.
+func (k Keeper) refundPacketTokens(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	classID := types.ParseClassTrace(data.ClassId).IBCClassID()
+
+	if types.SenderChainIsSource(packet.SourcePort, packet.SourceChannel, data.ClassId) {
+		for _, tokenID := range data.TokenIds {
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, sender); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for i, tokenID := range data.TokenIds {
+		token := nft.NFT{ClassId: classID, Id: tokenID, Uri: data.GetTokenURI(i)}
+		if err := k.nftKeeper.Mint(ctx, token, sender); err != nil {
+			return err
+		}
+		k.SetTokenData(ctx, classID, tokenID, data.GetTokenData(i))
+	}
+	return nil
+}
+
+// ClassPathFromHash returns the class trace of the given ibc/{hash} class id.
+func (k Keeper) ClassPathFromHash(ctx sdk.Context, classID string) (types.ClassTrace, error) {
+	hash, err := types.ParseHexHash(strings.TrimPrefix(classID, types.ClassPrefix+"/"))
+	if err != nil {
+		return types.ClassTrace{}, errorsmod.Wrap(types.ErrInvalidClassID, err.Error())
+	}
+
+	classTrace, found := k.GetClassTrace(ctx, hash)
+	if !found {
+		return types.ClassTrace{}, errorsmod.Wrap(types.ErrTraceNotFound, hash.String())
+	}
+	return classTrace, nil
+}
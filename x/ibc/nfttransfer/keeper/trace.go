@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	"github.com/cosmos/evm/x/ibc/nfttransfer/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetClassTrace retrieves the full identifiers trace and base class id from
+// the store.
+func (k Keeper) GetClassTrace(ctx sdk.Context, classTraceHash cmtbytes.HexBytes) (types.ClassTrace, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassTrace)
+	bz := store.Get(classTraceHash)
+	if len(bz) == 0 {
+		return types.ClassTrace{}, false
+	}
+
+	var classTrace types.ClassTrace
+	k.cdc.MustUnmarshal(bz, &classTrace)
+	return classTrace, true
+}
+
+// HasClassTrace checks if the class trace with the given hash exists on the
+// store.
+func (k Keeper) HasClassTrace(ctx sdk.Context, classTraceHash cmtbytes.HexBytes) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassTrace)
+	return store.Has(classTraceHash)
+}
+
+// SetClassTrace sets a new {trace hash -> class trace} pair to the store.
+func (k Keeper) SetClassTrace(ctx sdk.Context, classTrace types.ClassTrace) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassTrace)
+	bz := k.cdc.MustMarshal(&classTrace)
+	store.Set(classTrace.Hash(), bz)
+}
+
+// GetAllClassTraces returns the trace information for all the classes.
+func (k Keeper) GetAllClassTraces(ctx sdk.Context) types.Traces {
+	traces := types.Traces{}
+	k.IterateClassTraces(ctx, func(classTrace types.ClassTrace) bool {
+		traces = append(traces, classTrace)
+		return false
+	})
+
+	return traces.Sort()
+}
+
+// IterateClassTraces iterates over the class traces in the store and
+// performs a callback function.
+func (k Keeper) IterateClassTraces(ctx sdk.Context, cb func(classTrace types.ClassTrace) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixClassTrace)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var classTrace types.ClassTrace
+		k.cdc.MustUnmarshal(iterator.Value(), &classTrace)
+
+		if cb(classTrace) {
+			break
+		}
+	}
+}
+
+// GetClassData returns the ICS-721 data of a voucher class.
+func (k Keeper) GetClassData(ctx sdk.Context, classID string) string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassData)
+	return string(store.Get([]byte(classID)))
+}
+
+// SetClassData stores the ICS-721 data of a voucher class.
+func (k Keeper) SetClassData(ctx sdk.Context, classID, data string) {
+	if data == "" {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClassData)
+	store.Set([]byte(classID), []byte(data))
+}
+
+// GetTokenData returns the ICS-721 data of a voucher token.
+func (k Keeper) GetTokenData(ctx sdk.Context, classID, tokenID string) string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenData)
+	return string(store.Get(types.TokenDataKey(classID, tokenID)))
+}
+
+// SetTokenData stores the ICS-721 data of a voucher token.
+func (k Keeper) SetTokenData(ctx sdk.Context, classID, tokenID, data string) {
+	if data == "" {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenData)
+	store.Set(types.TokenDataKey(classID, tokenID), []byte(data))
+}
+
+// deleteTokenData removes the ICS-721 data of a burned voucher token.
+func (k Keeper) deleteTokenData(ctx sdk.Context, classID, tokenID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenData)
+	store.Delete(types.TokenDataKey(classID, tokenID))
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInvalidPacketTimeout = errorsmod.Register(ModuleName, 2, "invalid packet timeout")
+	ErrInvalidClassID       = errorsmod.Register(ModuleName, 3, "invalid class id")
+	ErrInvalidTokenID       = errorsmod.Register(ModuleName, 4, "invalid token id")
+	ErrTraceNotFound        = errorsmod.Register(ModuleName, 5, "class trace not found")
+	ErrSendDisabled         = errorsmod.Register(ModuleName, 6, "nft transfers from this chain are disabled")
+	ErrReceiveDisabled      = errorsmod.Register(ModuleName, 7, "nft transfers to this chain are disabled")
+	ErrInvalidVersion       = errorsmod.Register(ModuleName, 8, "invalid ICS721 version")
+	ErrClassNotFound        = errorsmod.Register(ModuleName, 9, "class not found")
+	ErrUnauthorized         = errorsmod.Register(ModuleName, 10, "not the owner of the token")
+)
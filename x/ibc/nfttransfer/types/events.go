@@ -0,0 +1,20 @@
+package types
+
+// nft-transfer events
+const (
+	EventTypeTransfer     = "ibc_nft_transfer"
+	EventTypePacket       = "non_fungible_token_packet"
+	EventTypeTimeout      = "timeout"
+	EventTypeClassTrace   = "class_trace"
+	EventTypeAcknowledged = "acknowledge"
+
+	AttributeKeyClassID      = "class_id"
+	AttributeKeyTokenIDs     = "token_ids"
+	AttributeKeySender       = "sender"
+	AttributeKeyReceiver     = "receiver"
+	AttributeKeyMemo         = "memo"
+	AttributeKeyAckSuccess   = "success"
+	AttributeKeyAckError     = "error"
+	AttributeKeyTraceHash    = "trace_hash"
+	AttributeKeyRefundSender = "refund_receiver"
+)
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"context"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+}
+
+// NFTKeeper defines the expected x/nft keeper used to escrow, mint and burn
+// the transferred tokens.
+type NFTKeeper interface {
+	SaveClass(ctx context.Context, class nft.Class) error
+	GetClass(ctx context.Context, classID string) (nft.Class, bool)
+	HasClass(ctx context.Context, classID string) bool
+	Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error
+	Burn(ctx context.Context, classID, nftID string) error
+	Transfer(ctx context.Context, classID, nftID string, receiver sdk.AccAddress) error
+	GetNFT(ctx context.Context, classID, nftID string) (nft.NFT, bool)
+	GetOwner(ctx context.Context, classID, nftID string) sdk.AccAddress
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the ICS-721 nft-transfer module name
+	ModuleName = "nonfungibletokentransfer"
+
+	// PortID is the default port id that the nft-transfer module binds to
+	PortID = "nfttransfer"
+
+	// StoreKey is the store key string for the nft-transfer module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the nft-transfer module
+	RouterKey = ModuleName
+
+	// Version defines the current version the ICS-721 module supports
+	Version = "ics721-1"
+
+	// ClassPrefix is the prefix used for the class ids of the NFT vouchers
+	ClassPrefix = "ibc"
+)
+
+// prefix bytes for the nft-transfer persistent store
+const (
+	prefixClassTrace = iota + 1
+	prefixClassData
+	prefixTokenData
+	prefixParams
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixClassTrace = []byte{prefixClassTrace}
+	KeyPrefixClassData  = []byte{prefixClassData}
+	KeyPrefixTokenData  = []byte{prefixTokenData}
+	KeyPrefixParams     = []byte{prefixParams}
+)
+
+// TokenDataKey returns the key of the ICS-721 data of the given voucher token.
+func TokenDataKey(classID, tokenID string) []byte {
+	return []byte(classID + "/" + tokenID)
+}
+
+// GetEscrowAddress returns the escrow address for the specified channel.
+// The escrow address follows the format as outlined in ADR 028:
+// https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
+func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
+	// a slash is used to create domain separation between port and channel identifiers to
+	// prevent address collisions between escrow addresses created for different channels
+	contents := fmt.Sprintf("%s/%s", portID, channelID)
+
+	// ADR 028 AddressHash construction
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"strings"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg              = &MsgTransfer{}
+	_ sdk.Msg              = &MsgUpdateParams{}
+	_ sdk.HasValidateBasic = &MsgTransfer{}
+	_ sdk.HasValidateBasic = &MsgUpdateParams{}
+)
+
+// NewMsgTransfer creates a new MsgTransfer instance
+func NewMsgTransfer(
+	sourcePort, sourceChannel, classID string,
+	tokenIDs []string,
+	sender, receiver string,
+	timeoutTimestamp uint64,
+	memo string,
+) *MsgTransfer {
+	return &MsgTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		ClassId:          classID,
+		TokenIds:         tokenIDs,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgTransfer fields.
+// NOTE: The receiver address format is not validated as it can have a
+// different format on the destination chain.
+func (msg MsgTransfer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return errorsmod.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return errorsmod.Wrap(err, "invalid source channel ID")
+	}
+	if strings.TrimSpace(msg.ClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidClassID, "class id cannot be blank")
+	}
+	if len(msg.TokenIds) == 0 {
+		return errorsmod.Wrap(ErrInvalidTokenID, "token ids cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(msg.TokenIds))
+	for _, id := range msg.TokenIds {
+		if strings.TrimSpace(id) == "" {
+			return errorsmod.Wrap(ErrInvalidTokenID, "token id cannot be blank")
+		}
+		if seen[id] {
+			return errorsmod.Wrapf(ErrInvalidTokenID, "duplicated token id %s", id)
+		}
+		seen[id] = true
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, "missing recipient address")
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketTimeout, "timeout timestamp cannot be 0")
+	}
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ ibcexported.PacketData         = (*NonFungibleTokenPacketData)(nil)
+	_ ibcexported.PacketDataProvider = (*NonFungibleTokenPacketData)(nil)
+)
+
+// NonFungibleTokenPacketData defines a struct for the ICS-721 packet payload.
+// The JSON field names follow the ICS-721 specification.
+type NonFungibleTokenPacketData struct {
+	// ClassId is the class id of the tokens, prefixed with their trace
+	ClassId string `json:"classId"` //nolint:revive,stylecheck // follows the ICS-721 naming
+	// ClassUri is the optional uri of the class
+	ClassUri string `json:"classUri,omitempty"` //nolint:revive,stylecheck // follows the ICS-721 naming
+	// ClassData is the optional opaque data of the class
+	ClassData string `json:"classData,omitempty"`
+	// TokenIds are the ids of the transferred tokens
+	TokenIds []string `json:"tokenIds"` //nolint:revive,stylecheck // follows the ICS-721 naming
+	// TokenUris are the optional uris of the transferred tokens
+	TokenUris []string `json:"tokenUris,omitempty"` //nolint:revive,stylecheck // follows the ICS-721 naming
+	// TokenData is the optional opaque data of the transferred tokens
+	TokenData []string `json:"tokenData,omitempty"`
+	// Sender is the sender address on the source chain
+	Sender string `json:"sender"`
+	// Receiver is the receiver address on the destination chain
+	Receiver string `json:"receiver"`
+	// Memo is an optional memo
+	Memo string `json:"memo,omitempty"`
+}
+
+// NewNonFungibleTokenPacketData constructs a new NonFungibleTokenPacketData
+// instance.
+func NewNonFungibleTokenPacketData(
+	classID, classURI, classData string,
+	tokenIDs, tokenURIs, tokenData []string,
+	sender, receiver, memo string,
+) NonFungibleTokenPacketData {
+	return NonFungibleTokenPacketData{
+		ClassId:   classID,
+		ClassUri:  classURI,
+		ClassData: classData,
+		TokenIds:  tokenIDs,
+		TokenUris: tokenURIs,
+		TokenData: tokenData,
+		Sender:    sender,
+		Receiver:  receiver,
+		Memo:      memo,
+	}
+}
+
+// ValidateBasic is used for validating the nft transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient
+// can have different formats defined by their corresponding chains that are
+// not known to IBC.
+func (nftpd NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(nftpd.ClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidClassID, "class id cannot be blank")
+	}
+	if err := ParseClassTrace(nftpd.ClassId).Validate(); err != nil {
+		return err
+	}
+	if len(nftpd.TokenIds) == 0 {
+		return errorsmod.Wrap(ErrInvalidTokenID, "token ids cannot be empty")
+	}
+	for _, id := range nftpd.TokenIds {
+		if strings.TrimSpace(id) == "" {
+			return errorsmod.Wrap(ErrInvalidTokenID, "token id cannot be blank")
+		}
+	}
+	if len(nftpd.TokenUris) != 0 && len(nftpd.TokenUris) != len(nftpd.TokenIds) {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "the length of token uris must be 0 or equal to the length of token ids")
+	}
+	if len(nftpd.TokenData) != 0 && len(nftpd.TokenData) != len(nftpd.TokenIds) {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "the length of token data must be 0 or equal to the length of token ids")
+	}
+	if strings.TrimSpace(nftpd.Sender) == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(nftpd.Receiver) == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	return nil
+}
+
+// GetTokenURI returns the uri of the token at the given index, if any.
+func (nftpd NonFungibleTokenPacketData) GetTokenURI(i int) string {
+	if i < len(nftpd.TokenUris) {
+		return nftpd.TokenUris[i]
+	}
+	return ""
+}
+
+// GetTokenData returns the data of the token at the given index, if any.
+func (nftpd NonFungibleTokenPacketData) GetTokenData(i int) string {
+	if i < len(nftpd.TokenData) {
+		return nftpd.TokenData[i]
+	}
+	return ""
+}
+
+// GetPacketSender returns the sender address of the packet data, which is used
+// by the IBC callbacks middleware to authorize the source callbacks.
+func (nftpd NonFungibleTokenPacketData) GetPacketSender(_ string) string {
+	return nftpd.Sender
+}
+
+// GetCustomPacketData interprets the memo field of the packet data as a JSON object
+// and returns the value associated with the given key.
+// If the key is missing or the memo is not properly formatted, then nil is returned.
+func (nftpd NonFungibleTokenPacketData) GetCustomPacketData(key string) interface{} {
+	if len(nftpd.Memo) == 0 {
+		return nil
+	}
+
+	jsonObject := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(nftpd.Memo), &jsonObject); err != nil {
+		return nil
+	}
+
+	memoData, found := jsonObject[key]
+	if !found {
+		return nil
+	}
+
+	return memoData
+}
+
+// GetBytes is a helper for serialising
+func (nftpd NonFungibleTokenPacketData) GetBytes() []byte {
+	bz, err := json.Marshal(nftpd)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// UnmarshalPacketData unmarshals the JSON encoded ICS-721 packet data and
+// performs its stateless validation.
+func UnmarshalPacketData(bz []byte) (NonFungibleTokenPacketData, error) {
+	var data NonFungibleTokenPacketData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return NonFungibleTokenPacketData{}, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-721 nft-transfer packet data: %s", err.Error())
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return NonFungibleTokenPacketData{}, err
+	}
+	return data, nil
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/ibc/nfttransfer/types"
+)
+
+func TestNonFungibleTokenPacketDataValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData types.NonFungibleTokenPacketData
+		expPass    bool
+	}{
+		{"valid packet", types.NewNonFungibleTokenPacketData("class", "", "", []string{"1", "2"}, nil, nil, "sender", "receiver", ""), true},
+		{"valid packet with uris", types.NewNonFungibleTokenPacketData("nft-transfer/channel-0/class", "uri", "", []string{"1"}, []string{"uri1"}, []string{"data1"}, "sender", "receiver", "memo"), true},
+		{"blank class id", types.NewNonFungibleTokenPacketData(" ", "", "", []string{"1"}, nil, nil, "sender", "receiver", ""), false},
+		{"invalid class trace", types.NewNonFungibleTokenPacketData("nft-transfer/channel-0/", "", "", []string{"1"}, nil, nil, "sender", "receiver", ""), false},
+		{"no token ids", types.NewNonFungibleTokenPacketData("class", "", "", nil, nil, nil, "sender", "receiver", ""), false},
+		{"blank token id", types.NewNonFungibleTokenPacketData("class", "", "", []string{""}, nil, nil, "sender", "receiver", ""), false},
+		{"token uris length mismatch", types.NewNonFungibleTokenPacketData("class", "", "", []string{"1", "2"}, []string{"uri1"}, nil, "sender", "receiver", ""), false},
+		{"token data length mismatch", types.NewNonFungibleTokenPacketData("class", "", "", []string{"1"}, nil, []string{"a", "b"}, "sender", "receiver", ""), false},
+		{"blank sender", types.NewNonFungibleTokenPacketData("class", "", "", []string{"1"}, nil, nil, "", "receiver", ""), false},
+		{"blank receiver", types.NewNonFungibleTokenPacketData("class", "", "", []string{"1"}, nil, nil, "sender", "", ""), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.packetData.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestUnmarshalPacketData(t *testing.T) {
+	data := types.NewNonFungibleTokenPacketData("class", "uri", "", []string{"1"}, []string{"uri1"}, nil, "sender", "receiver", "")
+
+	got, err := types.UnmarshalPacketData(data.GetBytes())
+	require.NoError(t, err)
+	require.Equal(t, data, got)
+
+	// the fields follow the ICS-721 naming
+	require.Contains(t, string(data.GetBytes()), `"classId":"class"`)
+	require.Contains(t, string(data.GetBytes()), `"tokenIds":["1"]`)
+
+	_, err = types.UnmarshalPacketData([]byte("invalid"))
+	require.Error(t, err)
+
+	_, err = types.UnmarshalPacketData([]byte(`{"classId":"class"}`))
+	require.Error(t, err)
+}
+
+func TestGetCustomPacketData(t *testing.T) {
+	testCases := []struct {
+		name    string
+		memo    string
+		expData interface{}
+	}{
+		{"empty memo", "", nil},
+		{"memo is not json", "memo", nil},
+		{"key not found", `{"dest_callback": {"address": "0x1"}}`, nil},
+		{"key found", `{"src_callback": {"address": "0x1"}}`, map[string]interface{}{"address": "0x1"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			packetData := types.NewNonFungibleTokenPacketData("class", "", "", []string{"1"}, nil, nil, "sender", "receiver", tc.memo)
+			require.Equal(t, tc.expData, packetData.GetCustomPacketData("src_callback"))
+			require.Equal(t, "sender", packetData.GetPacketSender(types.PortID))
+		})
+	}
+}
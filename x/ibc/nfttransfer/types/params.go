@@ -0,0 +1,19 @@
+package types
+
+// NewParams creates a new Params instance
+func NewParams(enableSend, enableReceive bool) Params {
+	return Params{
+		SendEnabled:    enableSend,
+		ReceiveEnabled: enableReceive,
+	}
+}
+
+// DefaultParams returns the default nft-transfer module parameters
+func DefaultParams() Params {
+	return NewParams(true, true)
+}
+
+// Validate performs a stateless validation of the params
+func (p Params) Validate() error {
+	return nil
+}
@@ -0,0 +1,178 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ParseClassTrace parses a string with the ibc prefix (class trace) and the
+// base class id into a ClassTrace type.
+//
+// Examples:
+//
+//   - "nft-transfer/channel-0/class" => ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "class"}
+//   - "nft-transfer/channel-0/erc721/0xAbC..." => ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "erc721/0xAbC..."}
+//   - "class" => ClassTrace{Path: "", BaseClassId: "class"}
+func ParseClassTrace(rawClassID string) ClassTrace {
+	parts := strings.Split(rawClassID, "/")
+
+	// the path is made of port/channel pairs, the remaining identifiers are
+	// part of the base class id
+	i := 0
+	for ; i+2 < len(parts); i += 2 {
+		if !channeltypes.IsValidChannelID(parts[i+1]) {
+			break
+		}
+	}
+
+	return ClassTrace{
+		Path:        strings.Join(parts[:i], "/"),
+		BaseClassId: strings.Join(parts[i:], "/"),
+	}
+}
+
+// Hash returns the hex bytes of the SHA256 hash of the ClassTrace fields
+// using the following formula:
+//
+// hash = sha256(tracePath + "/" + baseClassId)
+func (ct ClassTrace) Hash() cmtbytes.HexBytes {
+	hash := sha256.Sum256([]byte(ct.GetFullClassPath()))
+	return hash[:]
+}
+
+// GetPrefix returns the receiving class prefix composed by the trace info and
+// a separator.
+func (ct ClassTrace) GetPrefix() string {
+	return ct.Path + "/"
+}
+
+// IBCClassID a class id for an ICS721 non-fungible token in the format
+// 'ibc/{hash(tracePath + baseClassId)}'. If the trace comes from the
+// originating chain, it simply returns the base class id.
+func (ct ClassTrace) IBCClassID() string {
+	if ct.Path != "" {
+		return fmt.Sprintf("%s/%s", ClassPrefix, ct.Hash())
+	}
+	return ct.BaseClassId
+}
+
+// GetFullClassPath returns the full class path according to the ICS721
+// specification: tracePath + "/" + baseClassId. If there exists no trace
+// then the base class id is returned.
+func (ct ClassTrace) GetFullClassPath() string {
+	if ct.Path == "" {
+		return ct.BaseClassId
+	}
+	return ct.GetPrefix() + ct.BaseClassId
+}
+
+// IsNativeClass returns true if the class is native to this chain.
+func (ct ClassTrace) IsNativeClass() bool {
+	return ct.Path == ""
+}
+
+// Validate performs a basic validation of the ClassTrace fields.
+func (ct ClassTrace) Validate() error {
+	if strings.TrimSpace(ct.BaseClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidClassID, "base class id cannot be blank")
+	}
+
+	if ct.Path == "" {
+		return nil
+	}
+
+	identifiers := strings.Split(ct.Path, "/")
+	if len(identifiers)%2 != 0 {
+		return errorsmod.Wrapf(ErrInvalidClassID, "trace info must come in pairs of port and channel identifiers '{portID}/{channelID}', got the identifiers: %s", identifiers)
+	}
+	for i := 0; i < len(identifiers); i += 2 {
+		if err := host.PortIdentifierValidator(identifiers[i]); err != nil {
+			return errorsmod.Wrapf(err, "invalid port ID at position %d", i)
+		}
+		if err := host.ChannelIdentifierValidator(identifiers[i+1]); err != nil {
+			return errorsmod.Wrapf(err, "invalid channel ID at position %d", i)
+		}
+	}
+	return nil
+}
+
+// Traces defines a wrapper type for a slice of ClassTrace.
+type Traces []ClassTrace
+
+// Validate performs a basic validation of each class trace info.
+func (t Traces) Validate() error {
+	seenTraces := make(map[string]bool)
+	for i, trace := range t {
+		hash := trace.Hash().String()
+		if seenTraces[hash] {
+			return fmt.Errorf("duplicated class trace with hash %s", hash)
+		}
+
+		if err := trace.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "failed class trace %d validation", i)
+		}
+		seenTraces[hash] = true
+	}
+	return nil
+}
+
+// Sort returns the class traces sorted by their full class path.
+func (t Traces) Sort() Traces {
+	sort.Slice(t, func(i, j int) bool {
+		return t[i].GetFullClassPath() < t[j].GetFullClassPath()
+	})
+	return t
+}
+
+// GetClassPrefix returns the receiving class prefix
+func GetClassPrefix(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/", portID, channelID)
+}
+
+// SenderChainIsSource returns false if the class originally came from the
+// receiving chain and true otherwise.
+func SenderChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	// This is the prefix that would have been prefixed to the class
+	// on sender chain IF and only if the NFTs originally came from the
+	// receiving chain.
+	return !ReceiverChainIsSource(sourcePort, sourceChannel, classID)
+}
+
+// ReceiverChainIsSource returns true if the class originally came from the
+// receiving chain and false otherwise.
+func ReceiverChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	// The prefix passed in should contain the SourcePort and SourceChannel.
+	// If  the receiver chain originally sent the NFTs to the sender chain
+	// the class id will have the sender's SourcePort and SourceChannel as the
+	// prefix.
+	return strings.HasPrefix(classID, GetClassPrefix(sourcePort, sourceChannel))
+}
+
+// GetReceivedClassID returns the id of the x/nft class that holds the NFTs
+// received on a packet with the given ports, channels and class path: either
+// the unprefixed class if the NFTs are returning to this chain, or the
+// ibc/{hash} voucher class of the prefixed class path otherwise.
+func GetReceivedClassID(sourcePort, sourceChannel, destPort, destChannel, classID string) string {
+	if ReceiverChainIsSource(sourcePort, sourceChannel, classID) {
+		unprefixedClassID := classID[len(GetClassPrefix(sourcePort, sourceChannel)):]
+		return ParseClassTrace(unprefixedClassID).IBCClassID()
+	}
+
+	return ParseClassTrace(GetClassPrefix(destPort, destChannel) + classID).IBCClassID()
+}
+
+// ParseHexHash parses a hex hash in string format to bytes and validates its
+// correctness.
+func ParseHexHash(hexHash string) (cmtbytes.HexBytes, error) {
+	return transfertypes.ParseHexHash(hexHash)
+}
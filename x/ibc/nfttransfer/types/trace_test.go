@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/ibc/nfttransfer/types"
+)
+
+func TestParseClassTrace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		classID  string
+		expTrace types.ClassTrace
+	}{
+		{"native class", "class", types.ClassTrace{BaseClassId: "class"}},
+		{"native class with slashes", "erc721/0xAbC", types.ClassTrace{BaseClassId: "erc721/0xAbC"}},
+		{"transferred class", "nft-transfer/channel-0/class", types.ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "class"}},
+		{"transferred class with slashes", "nft-transfer/channel-0/erc721/0xAbC", types.ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "erc721/0xAbC"}},
+		{"multiple hops", "nft-transfer/channel-0/nft-transfer/channel-1/class", types.ClassTrace{Path: "nft-transfer/channel-0/nft-transfer/channel-1", BaseClassId: "class"}},
+		{"invalid channel", "nft-transfer/channel/class", types.ClassTrace{BaseClassId: "nft-transfer/channel/class"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trace := types.ParseClassTrace(tc.classID)
+			require.Equal(t, tc.expTrace, trace)
+			require.Equal(t, tc.classID, trace.GetFullClassPath())
+		})
+	}
+}
+
+func TestClassTraceIBCClassID(t *testing.T) {
+	trace := types.ParseClassTrace("class")
+	require.Equal(t, "class", trace.IBCClassID())
+	require.True(t, trace.IsNativeClass())
+
+	trace = types.ParseClassTrace("nft-transfer/channel-0/class")
+	require.Equal(t, "ibc/"+trace.Hash().String(), trace.IBCClassID())
+	require.False(t, trace.IsNativeClass())
+}
+
+func TestClassTraceValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		trace   types.ClassTrace
+		expPass bool
+	}{
+		{"native class", types.ClassTrace{BaseClassId: "class"}, true},
+		{"transferred class", types.ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "class"}, true},
+		{"blank base class", types.ClassTrace{Path: "nft-transfer/channel-0"}, false},
+		{"odd identifiers", types.ClassTrace{Path: "nft-transfer", BaseClassId: "class"}, false},
+		{"invalid channel", types.ClassTrace{Path: "nft-transfer/channel", BaseClassId: "class"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.trace.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGetReceivedClassID(t *testing.T) {
+	// the NFTs return to the chain where the class is native
+	classID := types.GetReceivedClassID("nft-transfer", "channel-0", "nft-transfer", "channel-1", "nft-transfer/channel-0/class")
+	require.Equal(t, "class", classID)
+
+	// the NFTs return through one of their hops
+	classID = types.GetReceivedClassID("nft-transfer", "channel-0", "nft-transfer", "channel-1", "nft-transfer/channel-0/nft-transfer/channel-5/class")
+	require.Equal(t, types.ParseClassTrace("nft-transfer/channel-5/class").IBCClassID(), classID)
+
+	// the NFTs are received as vouchers
+	classID = types.GetReceivedClassID("nft-transfer", "channel-0", "nft-transfer", "channel-1", "class")
+	require.Equal(t, types.ParseClassTrace("nft-transfer/channel-1/class").IBCClassID(), classID)
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// GetQueryCmd returns the parent command for all IBC rate limit CLI query
+// commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "IBC rate limit query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetRateLimitsCmd(),
+		GetRateLimitCmd(),
+	)
+	return cmd
+}
+
+// GetRateLimitsCmd queries all the rate limits
+func GetRateLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits",
+		Short: "Gets all the IBC rate limits",
+		Long:  "Gets all the IBC rate limits",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RateLimits(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate limits")
+	return cmd
+}
+
+// GetRateLimitCmd queries the rate limit of a denomination over a channel
+func GetRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limit CHANNEL_ID DENOM",
+		Short:   "Gets the IBC rate limit of a denomination over a channel",
+		Long:    "Gets the IBC rate limit of a denomination over a channel and the amounts transferred during the current window",
+		Example: "evmd query ratelimit rate-limit channel-0 erc20/0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitRequest{
+				ChannelId: args[0],
+				Denom:     args[1],
+			}
+
+			res, err := queryClient.RateLimit(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
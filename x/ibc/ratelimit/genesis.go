@@ -0,0 +1,21 @@
+package ratelimit
+
+import (
+	"github.com/cosmos/evm/x/ibc/ratelimit/keeper"
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the IBC rate limit state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	for _, rateLimit := range data.RateLimits {
+		k.StoreRateLimit(ctx, rateLimit)
+	}
+}
+
+// ExportGenesis exports the IBC rate limit state. The amounts transferred
+// during the current windows are not exported.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllRateLimits(ctx))
+}
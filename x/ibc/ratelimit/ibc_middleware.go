@@ -0,0 +1,183 @@
+package ratelimit
+
+import (
+	"errors"
+
+	"github.com/cosmos/evm/ibc"
+	"github.com/cosmos/evm/x/ibc/ratelimit/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ porttypes.Middleware            = &IBCMiddleware{}
+	_ porttypes.PacketDataUnmarshaler = &IBCMiddleware{}
+)
+
+// IBCMiddleware implements the ICS26 callbacks and the ICS4 wrapper of the
+// transfer rate limit middleware. Outgoing packets are checked when they are
+// sent, so transfers initiated by the ICS20 precompile are rate limited too,
+// and incoming packets are checked once they are received successfully.
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper      keeper.Keeper
+	ics4Wrapper porttypes.ICS4Wrapper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper, the underlying
+// application and the ICS4 wrapper that sends the packets to core IBC.
+func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper) IBCMiddleware {
+	if app == nil {
+		panic(errors.New("underlying application cannot be nil"))
+	}
+	if ics4Wrapper == nil {
+		panic(errors.New("ICS4 wrapper cannot be nil"))
+	}
+
+	return IBCMiddleware{
+		Module:      ibc.NewModule(app),
+		keeper:      k,
+		ics4Wrapper: ics4Wrapper,
+	}
+}
+
+// SendPacket implements the ICS4Wrapper interface. It sends the packet and
+// records its outflow, which fails the transfer if it exceeds the quota of the
+// rate limit of the sent coin.
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	sequence, err := im.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
+		return 0, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	coin := ibc.GetSentCoin(packetData.Denom, packetData.Amount)
+	if err := im.keeper.UpdateOutflow(ctx, sourceChannel, sequence, coin); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return im.ics4Wrapper.WriteAcknowledgement(ctx, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface.
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// It receives the tokens through the underlying application and records their
+// inflow. If the inflow exceeds the quota of the rate limit of the received
+// coin, it returns an error acknowledgement, so the state changes are
+// discarded and the tokens are refunded on the source chain.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	ack := im.Module.OnRecvPacket(ctx, channelVersion, packet, relayer)
+
+	// return if the acknowledgement is an error ACK
+	if !ack.Success() {
+		return ack
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	token := transfertypes.Token{
+		Denom:  transfertypes.ExtractDenomFromPath(data.Denom),
+		Amount: data.Amount,
+	}
+	coin := ibc.GetReceivedCoin(packet, token)
+
+	if err := im.keeper.UpdateInflow(ctx, packet.DestinationChannel, packet.Sequence, coin); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// It reverts the outflow of the packet if it failed on the destination chain,
+// as the tokens are refunded to the sender.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	if ack.Success() {
+		im.keeper.RemovePendingSend(ctx, packet.SourceChannel, packet.Sequence)
+	} else {
+		im.keeper.RevertOutflow(ctx, packet.SourceChannel, packet.Sequence)
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// It reverts the outflow of the packet, as the tokens are refunded to the
+// sender.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnTimeoutPacket(ctx, channelVersion, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.RevertOutflow(ctx, packet.SourceChannel, packet.Sequence)
+	return nil
+}
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface.
+func (im IBCMiddleware) UnmarshalPacketData(
+	ctx sdk.Context,
+	portID, channelID string,
+	data []byte,
+) (any, string, error) {
+	return im.Module.UnmarshalPacketData(ctx, portID, channelID, data)
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpdateInflow checks that the coin received on the given channel doesn't
+// exceed the inflow quota of its rate limit, if any, and records it.
+func (k Keeper) UpdateInflow(ctx sdk.Context, channelID string, sequence uint64, coin sdk.Coin) error {
+	return k.updateFlow(ctx, channelID, sequence, coin, false)
+}
+
+// UpdateOutflow checks that the coin sent on the given channel doesn't exceed
+// the outflow quota of its rate limit, if any, and records it. The record is
+// kept as pending until the packet is acknowledged, so it can be reverted if
+// the packet fails.
+func (k Keeper) UpdateOutflow(ctx sdk.Context, channelID string, sequence uint64, coin sdk.Coin) error {
+	return k.updateFlow(ctx, channelID, sequence, coin, true)
+}
+
+// RevertOutflow removes the outflow recorded for a sent packet that timed out
+// or failed on the destination chain, as the coins were refunded to the
+// sender.
+func (k Keeper) RevertOutflow(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	pendingStore := prefix.NewStore(store, types.KeyPrefixPendingSend)
+	pendingKey := types.PendingSendKey(channelID, sequence)
+
+	recordKey := pendingStore.Get(pendingKey)
+	if len(recordKey) == 0 {
+		return
+	}
+	pendingStore.Delete(pendingKey)
+
+	// the record was already removed if it expired or the rate limit was removed
+	recordStore := prefix.NewStore(store, types.KeyPrefixFlowRecord)
+	bz := recordStore.Get(recordKey)
+	if len(bz) == 0 {
+		return
+	}
+	recordStore.Delete(recordKey)
+
+	_, denom, err := types.ParseFlowRecordKey(recordKey)
+	if err != nil {
+		panic(err)
+	}
+
+	flow := k.GetFlow(ctx, channelID, denom)
+	k.setFlow(ctx, channelID, denom, flow.Sub(mustUnmarshalAmount(bz), true))
+}
+
+// RemovePendingSend removes the pending record of a sent packet that was
+// successfully acknowledged. Its outflow is kept until it expires.
+func (k Keeper) RemovePendingSend(ctx sdk.Context, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSend)
+	store.Delete(types.PendingSendKey(channelID, sequence))
+}
+
+// GetFlow returns the amounts of a denomination transferred over a channel
+// during the current window, as of the last recorded transfer.
+func (k Keeper) GetFlow(ctx sdk.Context, channelID, denom string) types.Flow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFlow)
+	bz := store.Get(types.RateLimitKey(channelID, denom))
+	if len(bz) == 0 {
+		return types.NewFlow()
+	}
+
+	var flow types.Flow
+	k.cdc.MustUnmarshal(bz, &flow)
+	return flow
+}
+
+func (k Keeper) setFlow(ctx sdk.Context, channelID, denom string, flow types.Flow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFlow)
+	store.Set(types.RateLimitKey(channelID, denom), k.cdc.MustMarshal(&flow))
+}
+
+// updateFlow checks the quota of the given direction and records the
+// transferred coin.
+func (k Keeper) updateFlow(ctx sdk.Context, channelID string, sequence uint64, coin sdk.Coin, outflow bool) error {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, coin.Denom)
+	if !found {
+		return nil
+	}
+
+	flow := k.expireFlow(ctx, rateLimit)
+
+	quota := rateLimit.Quota(outflow)
+	if quota.IsPositive() && flow.Amount(outflow).Add(coin.Amount).GT(quota) {
+		direction := types.AttributeValueInflow
+		if outflow {
+			direction = types.AttributeValueOutflow
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeQuotaExceeded,
+				sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
+				sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+				sdk.NewAttribute(types.AttributeKeyAmount, coin.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyDirection, direction),
+			),
+		)
+
+		return errorsmod.Wrapf(
+			types.ErrQuotaExceeded,
+			"%s %s of %s on %s exceeds quota %s with %s already transferred in the last %s",
+			direction, coin.Amount, coin.Denom, channelID, quota, flow.Amount(outflow), rateLimit.Window,
+		)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	recordKey := types.FlowRecordKey(channelID, coin.Denom, outflow, ctx.BlockTime(), sequence)
+	bz, err := coin.Amount.Marshal()
+	if err != nil {
+		return err
+	}
+	prefix.NewStore(store, types.KeyPrefixFlowRecord).Set(recordKey, bz)
+
+	if outflow {
+		prefix.NewStore(store, types.KeyPrefixPendingSend).Set(types.PendingSendKey(channelID, sequence), recordKey)
+	}
+
+	k.setFlow(ctx, channelID, coin.Denom, flow.Add(coin.Amount, outflow))
+	return nil
+}
+
+// expireFlow removes the flow records that are older than the window of the
+// rate limit and returns the updated flow.
+func (k Keeper) expireFlow(ctx sdk.Context, rateLimit types.RateLimit) types.Flow {
+	flow := k.GetFlow(ctx, rateLimit.ChannelId, rateLimit.Denom)
+
+	cutoff := ctx.BlockTime().Add(-rateLimit.Window)
+	if cutoff.UnixNano() < 0 {
+		return flow
+	}
+
+	recordStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFlowRecord)
+
+	for _, outflow := range []bool{false, true} {
+		start := types.FlowRecordPrefix(rateLimit.ChannelId, rateLimit.Denom, outflow)
+		// records at the cutoff time are expired, so the end of the range is the
+		// first key of the next nanosecond
+		end := append(start[:len(start):len(start)], sdk.Uint64ToBigEndian(uint64(cutoff.UnixNano())+1)...) //#nosec G115 -- checked above
+		iterator := recordStore.Iterator(start, end)
+
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+			flow = flow.Sub(mustUnmarshalAmount(iterator.Value()), outflow)
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			recordStore.Delete(key)
+		}
+	}
+
+	k.setFlow(ctx, rateLimit.ChannelId, rateLimit.Denom, flow)
+	return flow
+}
+
+func mustUnmarshalAmount(bz []byte) math.Int {
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
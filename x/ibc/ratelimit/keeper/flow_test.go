@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/ibc/ratelimit/keeper"
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const channelID = "channel-0"
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(storeKey, cdc, authtypes.NewModuleAddress(govtypes.ModuleName))
+
+	k.StoreRateLimit(ctx, types.NewRateLimit("aatom", channelID, math.NewInt(100), math.NewInt(50), time.Hour))
+	return ctx, k
+}
+
+func TestUpdateFlow(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	// denominations without rate limit are not tracked
+	require.NoError(t, k.UpdateOutflow(ctx, channelID, 1, sdk.NewInt64Coin("abtc", 1_000)))
+	require.Equal(t, types.NewFlow(), k.GetFlow(ctx, channelID, "abtc"))
+
+	require.NoError(t, k.UpdateOutflow(ctx, channelID, 2, sdk.NewInt64Coin("aatom", 30)))
+	require.NoError(t, k.UpdateInflow(ctx, channelID, 1, sdk.NewInt64Coin("aatom", 100)))
+
+	err := k.UpdateOutflow(ctx, channelID, 3, sdk.NewInt64Coin("aatom", 21))
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+	err = k.UpdateInflow(ctx, channelID, 2, sdk.NewInt64Coin("aatom", 1))
+	require.ErrorIs(t, err, types.ErrQuotaExceeded)
+
+	flow := k.GetFlow(ctx, channelID, "aatom")
+	require.Equal(t, "100", flow.Inflow.String())
+	require.Equal(t, "30", flow.Outflow.String())
+
+	// the transfers expire once the window has elapsed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, k.UpdateOutflow(ctx, channelID, 3, sdk.NewInt64Coin("aatom", 50)))
+	require.NoError(t, k.UpdateInflow(ctx, channelID, 2, sdk.NewInt64Coin("aatom", 100)))
+
+	flow = k.GetFlow(ctx, channelID, "aatom")
+	require.Equal(t, "100", flow.Inflow.String())
+	require.Equal(t, "50", flow.Outflow.String())
+}
+
+func TestRevertOutflow(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	require.NoError(t, k.UpdateOutflow(ctx, channelID, 1, sdk.NewInt64Coin("aatom", 30)))
+	require.NoError(t, k.UpdateOutflow(ctx, channelID, 2, sdk.NewInt64Coin("aatom", 20)))
+
+	// acknowledged packets keep their outflow
+	k.RemovePendingSend(ctx, channelID, 1)
+	k.RevertOutflow(ctx, channelID, 1)
+	require.Equal(t, "50", k.GetFlow(ctx, channelID, "aatom").Outflow.String())
+
+	// failed packets release their outflow
+	k.RevertOutflow(ctx, channelID, 2)
+	require.Equal(t, "30", k.GetFlow(ctx, channelID, "aatom").Outflow.String())
+	require.NoError(t, k.UpdateOutflow(ctx, channelID, 3, sdk.NewInt64Coin("aatom", 20)))
+
+	// packets that fail after their record expired are a no-op
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	require.NoError(t, k.UpdateOutflow(ctx, channelID, 4, sdk.NewInt64Coin("aatom", 10)))
+	k.RevertOutflow(ctx, channelID, 3)
+	require.Equal(t, "10", k.GetFlow(ctx, channelID, "aatom").Outflow.String())
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// RateLimits implements the Query/RateLimits gRPC method
+func (k Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimits := []types.RateLimit{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RateLimit implements the Query/RateLimit gRPC method
+func (k Keeper) RateLimit(c context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rateLimit, found := k.GetRateLimit(ctx, req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrRateLimitNotFound, "%s on %s", req.Denom, req.ChannelId).Error(),
+		)
+	}
+
+	// leave out the transfers of the expired records without persisting it
+	cacheCtx, _ := ctx.CacheContext()
+	flow := k.expireFlow(cacheCtx, rateLimit)
+
+	return &types.QueryRateLimitResponse{
+		RateLimit: rateLimit,
+		Flow:      flow,
+	}, nil
+}
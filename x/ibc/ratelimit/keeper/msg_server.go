@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// SetRateLimit implements the gRPC MsgServer interface. After a successful
+// governance vote it adds or updates the rate limit of a denomination over an
+// IBC channel.
+func (k *Keeper) SetRateLimit(goCtx context.Context, req *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	if err := req.RateLimit.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.StoreRateLimit(ctx, req.RateLimit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetRateLimit,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.RateLimit.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, req.RateLimit.ChannelId),
+		),
+	)
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit implements the gRPC MsgServer interface. After a successful
+// governance vote it removes the rate limit of a denomination over an IBC
+// channel.
+func (k *Keeper) RemoveRateLimit(goCtx context.Context, req *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetRateLimit(ctx, req.ChannelId, req.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "%s on %s", req.Denom, req.ChannelId)
+	}
+
+	k.DeleteRateLimit(ctx, req.ChannelId, req.Denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveRateLimit,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, req.ChannelId),
+		),
+	)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
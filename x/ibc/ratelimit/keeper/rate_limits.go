@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRateLimit returns the rate limit of a denomination over a channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	bz := store.Get(types.RateLimitKey(channelID, denom))
+	if len(bz) == 0 {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// StoreRateLimit stores a rate limit. The amounts already transferred during the
+// current window are kept.
+func (k Keeper) StoreRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	store.Set(types.RateLimitKey(rateLimit.ChannelId, rateLimit.Denom), k.cdc.MustMarshal(&rateLimit))
+}
+
+// DeleteRateLimit removes a rate limit together with its flow and flow records.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.RateLimitKey(channelID, denom)
+
+	prefix.NewStore(store, types.KeyPrefixRateLimit).Delete(key)
+	prefix.NewStore(store, types.KeyPrefixFlow).Delete(key)
+
+	for _, outflow := range []bool{false, true} {
+		recordStore := prefix.NewStore(store, types.KeyPrefixFlowRecord)
+		iterator := storetypes.KVStorePrefixIterator(recordStore, types.FlowRecordPrefix(channelID, denom, outflow))
+
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			recordStore.Delete(key)
+		}
+	}
+}
+
+// GetAllRateLimits returns all the rate limits.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) (stop bool) {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// IterateRateLimits iterates over all the rate limits.
+func (k Keeper) IterateRateLimits(ctx sdk.Context, cb func(rateLimit types.RateLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixRateLimit)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInvalidRateLimit  = errorsmod.Register(ModuleName, 2, "invalid rate limit")
+	ErrRateLimitNotFound = errorsmod.Register(ModuleName, 3, "rate limit not found")
+	ErrQuotaExceeded     = errorsmod.Register(ModuleName, 4, "rate limit quota exceeded")
+)
@@ -0,0 +1,16 @@
+package types
+
+// IBC rate limit events
+const (
+	EventTypeSetRateLimit    = "set_rate_limit"
+	EventTypeRemoveRateLimit = "remove_rate_limit"
+	EventTypeQuotaExceeded   = "rate_limit_quota_exceeded"
+
+	AttributeKeyDenom     = "denom"
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyDirection = "direction"
+
+	AttributeValueInflow  = "inflow"
+	AttributeValueOutflow = "outflow"
+)
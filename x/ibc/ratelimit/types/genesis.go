@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new IBC rate limit GenesisState instance.
+func NewGenesisState(rateLimits []RateLimit) *GenesisState {
+	return &GenesisState{
+		RateLimits: rateLimits,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState without rate limits.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits: []RateLimit{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+
+	for _, rl := range gs.RateLimits {
+		if err := rl.Validate(); err != nil {
+			return err
+		}
+
+		key := string(RateLimitKey(rl.ChannelId, rl.Denom))
+		if seen[key] {
+			return fmt.Errorf("duplicated rate limit for %s on %s", rl.Denom, rl.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the IBC rate limit module name
+	ModuleName = "ratelimit"
+
+	// StoreKey is the store key string for the IBC rate limit module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the IBC rate limit module
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the IBC rate limit persistent store
+const (
+	prefixRateLimit = iota + 1
+	prefixFlow
+	prefixFlowRecord
+	prefixPendingSend
+)
+
+// flow directions of the flow records
+const (
+	directionInflow byte = iota + 1
+	directionOutflow
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixRateLimit   = []byte{prefixRateLimit}
+	KeyPrefixFlow        = []byte{prefixFlow}
+	KeyPrefixFlowRecord  = []byte{prefixFlowRecord}
+	KeyPrefixPendingSend = []byte{prefixPendingSend}
+)
+
+// RateLimitKey returns the key of the rate limit and the flow of a denomination
+// over a channel. Channel identifiers can't contain slashes, so the key is
+// unambiguous even for denominations with slashes.
+func RateLimitKey(channelID, denom string) []byte {
+	return []byte(channelID + "/" + denom)
+}
+
+// ParseRateLimitKey returns the channel identifier and the denomination of the
+// given rate limit key.
+func ParseRateLimitKey(key []byte) (channelID, denom string, err error) {
+	channelID, denom, found := strings.Cut(string(key), "/")
+	if !found {
+		return "", "", errors.New("invalid rate limit key")
+	}
+	return channelID, denom, nil
+}
+
+// FlowRecordPrefix returns the prefix of the flow records of a denomination
+// over a channel in the given direction. The records are sorted by time, so the
+// expired ones can be iterated first.
+func FlowRecordPrefix(channelID, denom string, outflow bool) []byte {
+	direction := directionInflow
+	if outflow {
+		direction = directionOutflow
+	}
+
+	key := address.MustLengthPrefix(RateLimitKey(channelID, denom))
+	return append(key, direction)
+}
+
+// FlowRecordKey returns the key of the flow record of the packet with the given
+// sequence, transferred at the given time.
+func FlowRecordKey(channelID, denom string, outflow bool, t time.Time, sequence uint64) []byte {
+	key := FlowRecordPrefix(channelID, denom, outflow)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(t.UnixNano()))...) //#nosec G115 -- block times are after the unix epoch
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// ParseFlowRecordKey returns the channel identifier and the denomination of the
+// given flow record key.
+func ParseFlowRecordKey(key []byte) (channelID, denom string, err error) {
+	if len(key) == 0 || len(key) < int(key[0])+1 {
+		return "", "", errors.New("invalid flow record key")
+	}
+	return ParseRateLimitKey(key[1 : int(key[0])+1])
+}
+
+// PendingSendKey returns the key of the flow record of a sent packet that is
+// waiting for its acknowledgement.
+func PendingSendKey(channelID string, sequence uint64) []byte {
+	return append([]byte(channelID+"/"), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+)
+
+func TestRateLimitKey(t *testing.T) {
+	denom := "transfer/channel-1/uatom"
+	channelID, parsedDenom, err := types.ParseRateLimitKey(types.RateLimitKey("channel-0", denom))
+	require.NoError(t, err)
+	require.Equal(t, "channel-0", channelID)
+	require.Equal(t, denom, parsedDenom)
+
+	_, _, err = types.ParseRateLimitKey([]byte("channel-0"))
+	require.Error(t, err)
+}
+
+func TestFlowRecordKey(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+
+	key := types.FlowRecordKey("channel-0", "aatom", true, now, 1)
+	require.True(t, bytes.HasPrefix(key, types.FlowRecordPrefix("channel-0", "aatom", true)))
+	require.False(t, bytes.HasPrefix(key, types.FlowRecordPrefix("channel-0", "aatom", false)))
+
+	channelID, denom, err := types.ParseFlowRecordKey(key)
+	require.NoError(t, err)
+	require.Equal(t, "channel-0", channelID)
+	require.Equal(t, "aatom", denom)
+
+	// records are sorted by time first, then by sequence
+	later := types.FlowRecordKey("channel-0", "aatom", true, now.Add(time.Nanosecond), 0)
+	require.Equal(t, -1, bytes.Compare(key, later))
+	require.Equal(t, -1, bytes.Compare(key, types.FlowRecordKey("channel-0", "aatom", true, now, 2)))
+
+	_, _, err = types.ParseFlowRecordKey([]byte{10, 'a'})
+	require.Error(t, err)
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg              = &MsgSetRateLimit{}
+	_ sdk.Msg              = &MsgRemoveRateLimit{}
+	_ sdk.HasValidateBasic = &MsgSetRateLimit{}
+	_ sdk.HasValidateBasic = &MsgRemoveRateLimit{}
+)
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.RateLimit.Validate()
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRemoveRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	return host.ChannelIdentifierValidator(m.ChannelId)
+}
@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/ibc/ratelimit/types"
+
+	"cosmossdk.io/math"
+)
+
+func TestRateLimitValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		rateLimit types.RateLimit
+		expPass   bool
+	}{
+		{"valid rate limit", types.NewRateLimit("aatom", "channel-0", math.NewInt(100), math.NewInt(50), time.Hour), true},
+		{"valid rate limit on ibc denom", types.NewRateLimit("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "channel-0", math.NewInt(100), math.ZeroInt(), time.Hour), true},
+		{"valid rate limit on client", types.NewRateLimit("aatom", "07-tendermint-0", math.ZeroInt(), math.NewInt(50), time.Hour), true},
+		{"invalid denom", types.NewRateLimit("1atom", "channel-0", math.NewInt(100), math.NewInt(50), time.Hour), false},
+		{"invalid channel", types.NewRateLimit("aatom", "channel/0", math.NewInt(100), math.NewInt(50), time.Hour), false},
+		{"nil max inflow", types.NewRateLimit("aatom", "channel-0", math.Int{}, math.NewInt(50), time.Hour), false},
+		{"negative max outflow", types.NewRateLimit("aatom", "channel-0", math.NewInt(100), math.NewInt(-1), time.Hour), false},
+		{"no quota", types.NewRateLimit("aatom", "channel-0", math.ZeroInt(), math.ZeroInt(), time.Hour), false},
+		{"zero window", types.NewRateLimit("aatom", "channel-0", math.NewInt(100), math.NewInt(50), 0), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rateLimit.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidRateLimit)
+			}
+		})
+	}
+}
+
+func TestFlow(t *testing.T) {
+	flow := types.NewFlow().Add(math.NewInt(10), false).Add(math.NewInt(20), true)
+	require.Equal(t, math.NewInt(10), flow.Amount(false))
+	require.Equal(t, math.NewInt(20), flow.Amount(true))
+
+	flow = flow.Sub(math.NewInt(15), false).Sub(math.NewInt(5), true)
+	require.True(t, flow.Inflow.IsZero(), "inflow must not go below zero")
+	require.Equal(t, math.NewInt(15), flow.Outflow)
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	rateLimit := types.NewRateLimit("aatom", "channel-0", math.NewInt(100), math.NewInt(50), time.Hour)
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{"default genesis", types.DefaultGenesisState(), true},
+		{"valid genesis", types.NewGenesisState([]types.RateLimit{rateLimit, types.NewRateLimit("aatom", "channel-1", math.NewInt(100), math.NewInt(50), time.Hour)}), true},
+		{"invalid rate limit", types.NewGenesisState([]types.RateLimit{types.NewRateLimit("aatom", "channel-0", math.ZeroInt(), math.ZeroInt(), time.Hour)}), false},
+		{"duplicated rate limit", types.NewGenesisState([]types.RateLimit{rateLimit, rateLimit}), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package v2
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/cosmos/evm/ibc"
+	"github.com/cosmos/evm/x/ibc/ratelimit/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ ibcapi.IBCModule = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the rate limit middleware
+// given the rate limit keeper and the underlying application.
+// The logics are same as the IBCMiddleware, but this is a v2 version of the
+// middleware, where the client identifiers take the place of the channels.
+type IBCMiddleware struct {
+	app    ibcapi.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application
+func NewIBCMiddleware(
+	app ibcapi.IBCModule,
+	k keeper.Keeper,
+) IBCMiddleware {
+	if app == nil {
+		panic(errors.New("underlying application cannot be nil"))
+	}
+
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface.
+// It records the outflow of the sent tokens, which fails the transfer if it
+// exceeds the quota of the rate limit of the sent coin.
+func (im IBCMiddleware) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	if err := im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer); err != nil {
+		return err
+	}
+
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	coin := ibc.GetSentCoin(data.Token.Denom.Path(), data.Token.Amount)
+	return im.keeper.UpdateOutflow(ctx, sourceClient, sequence, coin)
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// It receives the tokens through the underlying application and records their
+// inflow. If the inflow exceeds the quota of the rate limit of the received
+// coin, the packet fails and the tokens are refunded on the source chain.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	recvResult := im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	if recvResult.Status == channeltypesv2.PacketStatus_Failure {
+		return recvResult
+	}
+
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	packet := channeltypes.Packet{
+		Sequence:           sequence,
+		SourcePort:         payload.SourcePort,
+		SourceChannel:      sourceClient,
+		DestinationPort:    payload.DestinationPort,
+		DestinationChannel: destinationClient,
+	}
+	coin := ibc.GetReceivedCoin(packet, data.Token)
+
+	if err := im.keeper.UpdateInflow(ctx, destinationClient, sequence, coin); err != nil {
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	return recvResult
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// It reverts the outflow of the packet if it failed on the destination chain,
+// as the tokens are refunded to the sender.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer); err != nil {
+		return err
+	}
+
+	if bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:]) {
+		im.keeper.RevertOutflow(ctx, sourceClient, sequence)
+	} else {
+		im.keeper.RemovePendingSend(ctx, sourceClient, sequence)
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// It reverts the outflow of the packet, as the tokens are refunded to the
+// sender.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.RevertOutflow(ctx, sourceClient, sequence)
+	return nil
+}
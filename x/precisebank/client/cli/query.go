@@ -9,6 +9,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 )
 
+// FlagDenom is the flag of the extended denom to query.
+const FlagDenom = "denom"
+
 // GetQueryCmd returns the parent command for all x/precisebank CLI query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -20,18 +23,48 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		GetParamsCmd(),
 		GetRemainderCmd(),
 		GetFractionalBalanceCmd(),
 	)
 	return cmd
 }
 
+// GetParamsCmd queries the module params
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Get the precise bank module params",
+		Long:  "Get the precise bank module params, which list the extended denoms in addition to the EVM denom",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ctx := cmd.Context()
+			res, err := queryClient.Params(ctx, &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetRemainderCmd queries the remainder amount
 func GetRemainderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "remainder",
 		Short: "Get the remainder amount",
-		Long:  "Get the remainder amount in the precise bank module",
+		Long:  "Get the remainder amount of an extended denom in the precise bank module, the EVM denom by default",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -42,7 +75,14 @@ func GetRemainderCmd() *cobra.Command {
 			queryClient := types.NewQueryClient(clientCtx)
 
 			ctx := cmd.Context()
-			res, err := queryClient.Remainder(ctx, &types.QueryRemainderRequest{})
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Remainder(ctx, &types.QueryRemainderRequest{
+				Denom: denom,
+			})
 			if err != nil {
 				return err
 			}
@@ -51,6 +91,7 @@ func GetRemainderCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagDenom, "", "extended denom, defaults to the EVM denom")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -60,7 +101,7 @@ func GetFractionalBalanceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "fractional-balance [address]",
 		Short: "Get the fractional balance of an account",
-		Long:  "Get the fractional balance of an account at the specified address",
+		Long:  "Get the fractional balance of an extended denom, the EVM denom by default, of an account at the specified address",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -71,8 +112,14 @@ func GetFractionalBalanceCmd() *cobra.Command {
 			queryClient := types.NewQueryClient(clientCtx)
 
 			ctx := cmd.Context()
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.FractionalBalance(ctx, &types.QueryFractionalBalanceRequest{
 				Address: args[0],
+				Denom:   denom,
 			})
 			if err != nil {
 				return err
@@ -82,6 +129,7 @@ func GetFractionalBalanceCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagDenom, "", "extended denom, defaults to the EVM denom")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
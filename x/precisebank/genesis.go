@@ -29,46 +29,68 @@ func InitGenesis(
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
-	// Check module balance matches sum of fractional balances + remainder
-	// This is always a whole integer amount, as previously verified in
-	// GenesisState.Validate()
-	totalAmt := gs.TotalAmountWithRemainder()
-
-	moduleAddr := ak.GetModuleAddress(types.ModuleName)
-	moduleBal := bk.GetBalance(ctx, moduleAddr, types.IntegerCoinDenom())
-	moduleBalExtended := moduleBal.Amount.Mul(types.ConversionFactor())
-
-	// Compare balances in full precise extended amounts
-	if !totalAmt.Equal(moduleBalExtended) {
-		panic(fmt.Sprintf(
-			"module account balance does not match sum of fractional balances and remainder, balance is %s but expected %v%s (%v%s)",
-			moduleBal,
-			totalAmt, types.ExtendedCoinDenom(),
-			totalAmt.Quo(types.ConversionFactor()), types.IntegerCoinDenom(),
-		))
+	if err := keeper.SetParams(ctx, gs.Params); err != nil {
+		panic(fmt.Errorf("failed to set %s params: %w", types.ModuleName, err))
 	}
 
-	// Set FractionalBalances in state
-	for _, bal := range gs.Balances {
-		addr := sdk.MustAccAddressFromBech32(bal.Address)
-
-		keeper.SetFractionalBalance(ctx, addr, bal.Amount)
+	moduleAddr := ak.GetModuleAddress(types.ModuleName)
+	for _, extendedDenom := range keeper.GetExtendedDenoms(ctx) {
+		db := gs.GetExtendedDenomBalances(extendedDenom.ExtendedDenom)
+
+		// Check module balance matches sum of fractional balances + remainder
+		// This is always a whole integer amount, as previously verified in
+		// GenesisState.Validate()
+		totalAmt := db.TotalAmountWithRemainder()
+
+		moduleBal := bk.GetBalance(ctx, moduleAddr, extendedDenom.Denom)
+		moduleBalExtended := moduleBal.Amount.Mul(extendedDenom.ConversionFactor())
+
+		// Compare balances in full precise extended amounts
+		if !totalAmt.Equal(moduleBalExtended) {
+			panic(fmt.Sprintf(
+				"module account balance does not match sum of fractional balances and remainder, balance is %s but expected %v%s (%v%s)",
+				moduleBal,
+				totalAmt, extendedDenom.ExtendedDenom,
+				totalAmt.Quo(extendedDenom.ConversionFactor()), extendedDenom.Denom,
+			))
+		}
+
+		// Set FractionalBalances in state
+		for _, bal := range db.Balances {
+			addr := sdk.MustAccAddressFromBech32(bal.Address)
+
+			keeper.SetFractionalBalance(ctx, addr, extendedDenom.ExtendedDenom, bal.Amount)
+		}
+
+		// Set remainder amount in state
+		keeper.SetRemainderAmount(ctx, extendedDenom.ExtendedDenom, db.Remainder)
 	}
-
-	// Set remainder amount in state
-	keeper.SetRemainderAmount(ctx, gs.Remainder)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
-	balances := types.FractionalBalances{}
-	keeper.IterateFractionalBalances(ctx, func(addr sdk.AccAddress, amount sdkmath.Int) bool {
-		balances = append(balances, types.NewFractionalBalance(addr.String(), amount))
+	params := keeper.GetParams(ctx)
+
+	exportDenomBalances := func(extendedDenom string) types.DenomBalances {
+		balances := types.FractionalBalances{}
+		keeper.IterateFractionalBalances(ctx, extendedDenom, func(addr sdk.AccAddress, amount sdkmath.Int) bool {
+			balances = append(balances, types.NewFractionalBalance(addr.String(), amount))
 
-		return false
-	})
+			return false
+		})
 
-	remainder := keeper.GetRemainderAmount(ctx)
+		remainder := keeper.GetRemainderAmount(ctx, extendedDenom)
+
+		return types.NewDenomBalances(extendedDenom, balances, remainder)
+	}
+
+	evmBalances := exportDenomBalances(types.ExtendedCoinDenom())
+	gs := types.NewGenesisState(evmBalances.Balances, evmBalances.Remainder)
+	gs.Params = params
+
+	for _, d := range params.ExtendedDenoms {
+		gs.DenomBalances = append(gs.DenomBalances, exportDenomBalances(d.ExtendedDenom))
+	}
 
-	return types.NewGenesisState(balances, remainder)
+	return gs
 }
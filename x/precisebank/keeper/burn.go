@@ -41,15 +41,9 @@ func (k Keeper) BurnCoins(goCtx context.Context, moduleName string, amt sdk.Coin
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	// Get non-ExtendedCoinDenom coins
-	passthroughCoins := amt
-
-	extendedAmount := amt.AmountOf(types.ExtendedCoinDenom())
-	if extendedAmount.IsPositive() {
-		// Remove ExtendedCoinDenom from the coins as it is managed by x/precisebank
-		removeCoin := sdk.NewCoin(types.ExtendedCoinDenom(), extendedAmount)
-		passthroughCoins = amt.Sub(removeCoin)
-	}
+	// Get non-extended denom coins
+	extendedDenoms := k.extendedDenomsOf(ctx, amt)
+	passthroughCoins := removeExtendedCoins(amt, extendedDenoms)
 
 	// Coins unmanaged by x/precisebank are passed through to x/bank
 	if !passthroughCoins.Empty() {
@@ -58,14 +52,16 @@ func (k Keeper) BurnCoins(goCtx context.Context, moduleName string, amt sdk.Coin
 		}
 	}
 
-	// Only burn extended coin if the amount is positive
-	if extendedAmount.IsPositive() {
-		if err := k.burnExtendedCoin(ctx, moduleName, extendedAmount); err != nil {
-			return err
+	for _, d := range extendedDenoms {
+		// Only burn extended coin if the amount is positive
+		if extendedAmount := amt.AmountOf(d.ExtendedDenom); extendedAmount.IsPositive() {
+			if err := k.burnExtendedCoin(ctx, moduleName, d, extendedAmount); err != nil {
+				return err
+			}
 		}
 	}
 
-	fullEmissionCoins := sdk.NewCoins(types.SumExtendedCoin(amt))
+	fullEmissionCoins := types.SumExtendedCoins(amt, extendedDenoms)
 	if fullEmissionCoins.IsZero() {
 		return nil
 	}
@@ -78,27 +74,30 @@ func (k Keeper) BurnCoins(goCtx context.Context, moduleName string, amt sdk.Coin
 	return nil
 }
 
-// burnExtendedCoin burns the fractional amount of the ExtendedCoinDenom from the module account.
+// burnExtendedCoin burns the fractional amount of an extended denom from the
+// module account.
 func (k Keeper) burnExtendedCoin(
 	ctx sdk.Context,
 	moduleName string,
+	extendedDenom types.ExtendedDenom,
 	amt sdkmath.Int,
 ) error {
 	// Get the module address
 	moduleAddr := k.ak.GetModuleAddress(moduleName)
+	conversionFactor := extendedDenom.ConversionFactor()
 
 	// We only need the fractional balance to burn coins, as integer burns will
 	// return errors on insufficient funds.
-	prevFractionalBalance := k.GetFractionalBalance(ctx, moduleAddr)
+	prevFractionalBalance := k.GetFractionalBalance(ctx, moduleAddr, extendedDenom.ExtendedDenom)
 
 	// Get remainder amount first to optimize direct burn.
-	prevRemainder := k.GetRemainderAmount(ctx)
+	prevRemainder := k.GetRemainderAmount(ctx, extendedDenom.ExtendedDenom)
 
 	// -------------------------------------------------------------------------
 	// Pure stateless calculations
 
-	integerBurnAmount := amt.Quo(types.ConversionFactor())
-	fractionalBurnAmount := amt.Mod(types.ConversionFactor())
+	integerBurnAmount := amt.Quo(conversionFactor)
+	fractionalBurnAmount := amt.Mod(conversionFactor)
 
 	// newFractionalBalance can be negative if fractional balance is insufficient.
 	newFractionalBalance := prevFractionalBalance.Sub(fractionalBurnAmount)
@@ -112,7 +111,7 @@ func (k Keeper) burnExtendedCoin(
 
 	// If true, remainder has accumulated enough fractional amounts to burn 1
 	// integer coin.
-	overflowingRemainder := newRemainder.GTE(types.ConversionFactor())
+	overflowingRemainder := newRemainder.GTE(conversionFactor)
 
 	// -------------------------------------------------------------------------
 	// Stateful operations for burn
@@ -128,8 +127,8 @@ func (k Keeper) burnExtendedCoin(
 	// Case #1: (optimization) direct burn instead of borrow (reserve transfer)
 	// & reserve burn. No additional reserve burn would be necessary after this.
 	if requiresBorrow && overflowingRemainder {
-		newFractionalBalance = newFractionalBalance.Add(types.ConversionFactor())
-		newRemainder = newRemainder.Sub(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Add(conversionFactor)
+		newRemainder = newRemainder.Sub(conversionFactor)
 
 		integerBurnAmount = integerBurnAmount.AddRaw(1)
 	}
@@ -137,32 +136,32 @@ func (k Keeper) burnExtendedCoin(
 	// Case #2: Transfer 1 integer coin to reserve for integer borrow to ensure
 	// reserve fully backs the fractional amount.
 	if requiresBorrow && !overflowingRemainder {
-		newFractionalBalance = newFractionalBalance.Add(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Add(conversionFactor)
 
 		// Transfer 1 integer coin to reserve to cover the borrowed fractional
 		// amount. SendCoinsFromModuleToModule will return an error if the
 		// module account has insufficient funds and an error with the full
 		// extended balance will be returned.
-		borrowCoin := sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.OneInt())
+		borrowCoin := sdk.NewCoin(extendedDenom.Denom, sdkmath.OneInt())
 		if err := k.bk.SendCoinsFromModuleToModule(
 			ctx,
 			moduleName,
 			types.ModuleName, // borrowed integer is transferred to reserve
 			sdk.NewCoins(borrowCoin),
 		); err != nil {
-			return k.updateInsufficientFundsError(ctx, moduleAddr, amt, err)
+			return k.updateInsufficientFundsError(ctx, moduleAddr, extendedDenom, amt, err)
 		}
 	}
 
 	// Case #3: Does not require borrow, but remainder has accumulated enough
 	// fractional amounts to burn 1 integer coin.
 	if !requiresBorrow && overflowingRemainder {
-		reserveBurnCoins := sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.OneInt()))
+		reserveBurnCoins := sdk.NewCoins(sdk.NewCoin(extendedDenom.Denom, sdkmath.OneInt()))
 		if err := k.bk.BurnCoins(ctx, types.ModuleName, reserveBurnCoins); err != nil {
 			return fmt.Errorf("failed to burn %s for reserve: %w", reserveBurnCoins, err)
 		}
 
-		newRemainder = newRemainder.Sub(types.ConversionFactor())
+		newRemainder = newRemainder.Sub(conversionFactor)
 	}
 
 	// Case #4: No additional work required, no borrow needed and no additional
@@ -171,17 +170,17 @@ func (k Keeper) burnExtendedCoin(
 	// Burn the integer amount - this may include the extra optimization burn
 	// from case #1
 	if !integerBurnAmount.IsZero() {
-		coin := sdk.NewCoin(types.IntegerCoinDenom(), integerBurnAmount)
+		coin := sdk.NewCoin(extendedDenom.Denom, integerBurnAmount)
 		if err := k.bk.BurnCoins(ctx, moduleName, sdk.NewCoins(coin)); err != nil {
-			return k.updateInsufficientFundsError(ctx, moduleAddr, amt, err)
+			return k.updateInsufficientFundsError(ctx, moduleAddr, extendedDenom, amt, err)
 		}
 	}
 
 	// Assign new fractional balance in x/precisebank
-	k.SetFractionalBalance(ctx, moduleAddr, newFractionalBalance)
+	k.SetFractionalBalance(ctx, moduleAddr, extendedDenom.ExtendedDenom, newFractionalBalance)
 
 	// Update remainder for burned fractional coins
-	k.SetRemainderAmount(ctx, newRemainder)
+	k.SetRemainderAmount(ctx, extendedDenom.ExtendedDenom, newRemainder)
 
 	return nil
 }
@@ -12,9 +12,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// GetFractionalBalance returns the fractional balance for an address.
-func (k *Keeper) GetFractionalBalance(ctx sdk.Context, address sdk.AccAddress) sdkmath.Int {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+// GetFractionalBalance returns the fractional balance of an extended denom for
+// an address.
+func (k *Keeper) GetFractionalBalance(ctx sdk.Context, address sdk.AccAddress, denom string) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalanceDenomPrefix(denom))
 
 	bz := store.Get(types.FractionalBalanceKey(address))
 	if bz == nil {
@@ -29,10 +30,12 @@ func (k *Keeper) GetFractionalBalance(ctx sdk.Context, address sdk.AccAddress) s
 	return bal
 }
 
-// SetFractionalBalance sets the fractional balance for an address.
+// SetFractionalBalance sets the fractional balance of an extended denom for an
+// address.
 func (k *Keeper) SetFractionalBalance(
 	ctx sdk.Context,
 	address sdk.AccAddress,
+	denom string,
 	amount sdkmath.Int,
 ) {
 	if address.Empty() {
@@ -40,17 +43,17 @@ func (k *Keeper) SetFractionalBalance(
 	}
 
 	if amount.IsZero() {
-		k.DeleteFractionalBalance(ctx, address)
+		k.DeleteFractionalBalance(ctx, address, denom)
 		return
 	}
 
-	// Ensure the fractional balance is valid before setting it. Use the
-	// NewFractionalAmountFromInt wrapper to use its Validate() method.
-	if err := types.ValidateFractionalAmount(amount); err != nil {
+	// Ensure the fractional balance is valid before setting it, with the
+	// conversion factor of the extended denom.
+	if err := k.mustGetExtendedDenom(ctx, denom).ValidateFractionalAmount(amount); err != nil {
 		panic(fmt.Errorf("amount is invalid: %w", err))
 	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalanceDenomPrefix(denom))
 
 	amountBytes, err := amount.Marshal()
 	if err != nil {
@@ -60,22 +63,25 @@ func (k *Keeper) SetFractionalBalance(
 	store.Set(types.FractionalBalanceKey(address), amountBytes)
 }
 
-// DeleteFractionalBalance deletes the fractional balance for an address.
+// DeleteFractionalBalance deletes the fractional balance of an extended denom
+// for an address.
 func (k *Keeper) DeleteFractionalBalance(
 	ctx sdk.Context,
 	address sdk.AccAddress,
+	denom string,
 ) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalanceDenomPrefix(denom))
 	store.Delete(types.FractionalBalanceKey(address))
 }
 
-// IterateFractionalBalances iterates over all fractional balances in the store
-// and performs a callback function.
+// IterateFractionalBalances iterates over all fractional balances of an
+// extended denom in the store and performs a callback function.
 func (k *Keeper) IterateFractionalBalances(
 	ctx sdk.Context,
+	denom string,
 	cb func(address sdk.AccAddress, amount sdkmath.Int) (stop bool),
 ) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalanceDenomPrefix(denom))
 
 	iterator := store.Iterator(nil, nil)
 	defer iterator.Close()
@@ -94,11 +100,12 @@ func (k *Keeper) IterateFractionalBalances(
 	}
 }
 
-// GetTotalSumFractionalBalances returns the sum of all fractional balances.
-func (k *Keeper) GetTotalSumFractionalBalances(ctx sdk.Context) sdkmath.Int {
+// GetTotalSumFractionalBalances returns the sum of all fractional balances of
+// an extended denom.
+func (k *Keeper) GetTotalSumFractionalBalances(ctx sdk.Context, denom string) sdkmath.Int {
 	sum := sdkmath.ZeroInt()
 
-	k.IterateFractionalBalances(ctx, func(_ sdk.AccAddress, amount sdkmath.Int) bool {
+	k.IterateFractionalBalances(ctx, denom, func(_ sdk.AccAddress, amount sdkmath.Int) bool {
 		sum = sum.Add(amount)
 		return false
 	})
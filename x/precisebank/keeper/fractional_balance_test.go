@@ -67,32 +67,32 @@ func TestSetGetFractionalBalance(t *testing.T) {
 
 			if tt.setPanicMsg != "" {
 				require.PanicsWithError(t, tt.setPanicMsg, func() {
-					k.SetFractionalBalance(ctx, tt.address, tt.amount)
+					k.SetFractionalBalance(ctx, tt.address, types.ExtendedCoinDenom(), tt.amount)
 				})
 
 				return
 			}
 
 			require.NotPanics(t, func() {
-				k.SetFractionalBalance(ctx, tt.address, tt.amount)
+				k.SetFractionalBalance(ctx, tt.address, types.ExtendedCoinDenom(), tt.amount)
 			})
 
 			// If its zero balance, check it was deleted in store
 			if tt.amount.IsZero() {
-				store := prefix.NewStore(ctx.KVStore(td.storeKey), types.FractionalBalancePrefix)
+				store := prefix.NewStore(ctx.KVStore(td.storeKey), types.FractionalBalanceDenomPrefix(types.ExtendedCoinDenom()))
 				bz := store.Get(types.FractionalBalanceKey(tt.address))
 				require.Nil(t, bz)
 
 				return
 			}
 
-			gotAmount := k.GetFractionalBalance(ctx, tt.address)
+			gotAmount := k.GetFractionalBalance(ctx, tt.address, types.ExtendedCoinDenom())
 			require.Equal(t, tt.amount, gotAmount)
 
 			// Delete balance
-			k.DeleteFractionalBalance(ctx, tt.address)
+			k.DeleteFractionalBalance(ctx, tt.address, types.ExtendedCoinDenom())
 
-			store := prefix.NewStore(ctx.KVStore(td.storeKey), types.FractionalBalancePrefix)
+			store := prefix.NewStore(ctx.KVStore(td.storeKey), types.FractionalBalanceDenomPrefix(types.ExtendedCoinDenom()))
 			bz := store.Get(types.FractionalBalanceKey(tt.address))
 			require.Nil(t, bz)
 		})
@@ -107,7 +107,7 @@ func TestSetFractionalBalance_InvalidAddr(t *testing.T) {
 		t,
 		"address cannot be empty",
 		func() {
-			k.SetFractionalBalance(ctx, sdk.AccAddress{}, sdkmath.NewInt(100))
+			k.SetFractionalBalance(ctx, sdk.AccAddress{}, types.ExtendedCoinDenom(), sdkmath.NewInt(100))
 		},
 		"setting balance with empty address should panic",
 	)
@@ -120,16 +120,16 @@ func TestSetFractionalBalance_ZeroDeletes(t *testing.T) {
 	addr := sdk.AccAddress([]byte("test-address"))
 
 	// Set balance
-	k.SetFractionalBalance(ctx, addr, sdkmath.NewInt(100))
+	k.SetFractionalBalance(ctx, addr, types.ExtendedCoinDenom(), sdkmath.NewInt(100))
 
-	bal := k.GetFractionalBalance(ctx, addr)
+	bal := k.GetFractionalBalance(ctx, addr, types.ExtendedCoinDenom())
 	require.Equal(t, sdkmath.NewInt(100), bal)
 
 	// Set zero balance
-	k.SetFractionalBalance(ctx, addr, sdkmath.ZeroInt())
+	k.SetFractionalBalance(ctx, addr, types.ExtendedCoinDenom(), sdkmath.ZeroInt())
 
 	// Check balance was deleted
-	store := prefix.NewStore(ctx.KVStore(td.storeKey), types.FractionalBalancePrefix)
+	store := prefix.NewStore(ctx.KVStore(td.storeKey), types.FractionalBalanceDenomPrefix(types.ExtendedCoinDenom()))
 	bz := store.Get(types.FractionalBalanceKey(addr))
 	require.Nil(t, bz)
 
@@ -137,7 +137,7 @@ func TestSetFractionalBalance_ZeroDeletes(t *testing.T) {
 	require.NotPanics(
 		t,
 		func() {
-			k.SetFractionalBalance(ctx, addr, sdkmath.ZeroInt())
+			k.SetFractionalBalance(ctx, addr, types.ExtendedCoinDenom(), sdkmath.ZeroInt())
 		},
 		"deleting non-existent balance should not panic",
 	)
@@ -154,12 +154,12 @@ func TestIterateFractionalBalances(t *testing.T) {
 		addrs = append(addrs, addr)
 
 		// Set balance same as their address byte
-		k.SetFractionalBalance(ctx, addr, sdkmath.NewInt(int64(i)))
+		k.SetFractionalBalance(ctx, addr, types.ExtendedCoinDenom(), sdkmath.NewInt(int64(i)))
 	}
 
 	seenAddrs := []sdk.AccAddress{}
 
-	k.IterateFractionalBalances(ctx, func(addr sdk.AccAddress, bal sdkmath.Int) bool {
+	k.IterateFractionalBalances(ctx, types.ExtendedCoinDenom(), func(addr sdk.AccAddress, bal sdkmath.Int) bool {
 		seenAddrs = append(seenAddrs, addr)
 
 		// Balance is same as first address byte
@@ -184,10 +184,10 @@ func TestGetAggregateSumFractionalBalances(t *testing.T) {
 		sum = sum.Add(amt)
 
 		require.NotPanics(t, func() {
-			k.SetFractionalBalance(ctx, addr, amt)
+			k.SetFractionalBalance(ctx, addr, types.ExtendedCoinDenom(), amt)
 		})
 	}
 
-	gotSum := k.GetTotalSumFractionalBalances(ctx)
+	gotSum := k.GetTotalSumFractionalBalances(ctx, types.ExtendedCoinDenom())
 	require.Equal(t, sum, gotSum)
 }
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/evm/x/precisebank/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,22 +22,41 @@ func NewQueryServerImpl(k Keeper) types.QueryServer {
 
 var _ types.QueryServer = queryServer{}
 
-// Remainder returns the remainder amount in x/precisebank.
+// Params returns the params of the precisebank module.
+func (s queryServer) Params(
+	goCtx context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{
+		Params: s.keeper.GetParams(ctx),
+	}, nil
+}
+
+// Remainder returns the remainder amount of an extended denom in
+// x/precisebank.
 func (s queryServer) Remainder(
 	goCtx context.Context,
 	req *types.QueryRemainderRequest,
 ) (*types.QueryRemainderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	remainder := s.keeper.GetRemainderAmount(ctx)
-	remainderCoin := sdk.NewCoin(types.ExtendedCoinDenom(), remainder)
+	extendedDenom, err := s.queryExtendedDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	remainder := s.keeper.GetRemainderAmount(ctx, extendedDenom)
+	remainderCoin := sdk.NewCoin(extendedDenom, remainder)
 
 	return &types.QueryRemainderResponse{
 		Remainder: remainderCoin,
 	}, nil
 }
 
-// FractionalBalance returns the fractional balance of an account.
+// FractionalBalance returns the fractional balance of an account for an
+// extended denom.
 func (s queryServer) FractionalBalance(
 	goCtx context.Context,
 	req *types.QueryFractionalBalanceRequest,
@@ -46,10 +68,29 @@ func (s queryServer) FractionalBalance(
 		return nil, err
 	}
 
-	amt := s.keeper.GetFractionalBalance(ctx, address)
-	fractionalBalance := sdk.NewCoin(types.ExtendedCoinDenom(), amt)
+	extendedDenom, err := s.queryExtendedDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	amt := s.keeper.GetFractionalBalance(ctx, address, extendedDenom)
+	fractionalBalance := sdk.NewCoin(extendedDenom, amt)
 
 	return &types.QueryFractionalBalanceResponse{
 		FractionalBalance: fractionalBalance,
 	}, nil
 }
+
+// queryExtendedDenom returns the queried extended denom, which defaults to the
+// extended EVM denom, or an error if the denom is not extended.
+func (s queryServer) queryExtendedDenom(ctx sdk.Context, denom string) (string, error) {
+	if denom == "" {
+		return types.ExtendedCoinDenom(), nil
+	}
+
+	if _, found := s.keeper.GetExtendedDenom(ctx, denom); !found {
+		return "", status.Errorf(codes.InvalidArgument, "%s is not an extended denom", denom)
+	}
+
+	return denom, nil
+}
@@ -19,6 +19,8 @@ var _ evmtypes.BankKeeper = Keeper{}
 type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
 
 	bk types.BankKeeper
 	ak types.AccountKeeper
@@ -28,14 +30,21 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	authority sdk.AccAddress,
 	bk types.BankKeeper,
 	ak types.AccountKeeper,
 ) Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
 	return Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		bk:       bk,
-		ak:       ak,
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+		bk:        bk,
+		ak:        ak,
 	}
 }
 
@@ -15,6 +15,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // testData defines necessary fields for testing keeper store methods and mocks
@@ -43,7 +45,7 @@ func newMockedTestData(t *testing.T) testData {
 	chainID := testconstants.SixDecimalsChainID.EVMChainID
 	cfg := evmosencoding.MakeConfig(chainID)
 	cdc := cfg.Codec
-	k := keeper.NewKeeper(cdc, storeKey, bk, ak)
+	k := keeper.NewKeeper(cdc, storeKey, authtypes.NewModuleAddress(govtypes.ModuleName), bk, ak)
 	err := config.EvmAppOptions(chainID)
 	if err != nil {
 		return testData{}
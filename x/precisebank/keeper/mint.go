@@ -16,7 +16,7 @@ import (
 )
 
 // MintCoins creates new coins from thin air and adds it to the module account.
-// If ExtendedCoinDenom is provided, the corresponding fractional amount is
+// If an extended denom is provided, the corresponding fractional amount is
 // added to the module state.
 // It will panic if the module account does not exist or is unauthorized.
 func (k Keeper) MintCoins(goCtx context.Context, moduleName string, amt sdk.Coins) error {
@@ -43,15 +43,9 @@ func (k Keeper) MintCoins(goCtx context.Context, moduleName string, amt sdk.Coin
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	// Get non-ExtendedCoinDenom coins
-	passthroughCoins := amt
-
-	extendedAmount := amt.AmountOf(types.ExtendedCoinDenom())
-	if extendedAmount.IsPositive() {
-		// Remove ExtendedCoinDenom from the coins as it is managed by x/precisebank
-		removeCoin := sdk.NewCoin(types.ExtendedCoinDenom(), extendedAmount)
-		passthroughCoins = amt.Sub(removeCoin)
-	}
+	// Get non-extended denom coins
+	extendedDenoms := k.extendedDenomsOf(ctx, amt)
+	passthroughCoins := removeExtendedCoins(amt, extendedDenoms)
 
 	// Coins unmanaged by x/precisebank are passed through to x/bank
 	if !passthroughCoins.Empty() {
@@ -60,14 +54,16 @@ func (k Keeper) MintCoins(goCtx context.Context, moduleName string, amt sdk.Coin
 		}
 	}
 
-	// Only mint extended coin if the amount is positive
-	if extendedAmount.IsPositive() {
-		if err := k.mintExtendedCoin(ctx, moduleName, extendedAmount); err != nil {
-			return err
+	for _, d := range extendedDenoms {
+		// Only mint extended coin if the amount is positive
+		if extendedAmount := amt.AmountOf(d.ExtendedDenom); extendedAmount.IsPositive() {
+			if err := k.mintExtendedCoin(ctx, moduleName, d, extendedAmount); err != nil {
+				return err
+			}
 		}
 	}
 
-	fullEmissionCoins := sdk.NewCoins(types.SumExtendedCoin(amt))
+	fullEmissionCoins := types.SumExtendedCoins(amt, extendedDenoms)
 	if fullEmissionCoins.IsZero() {
 		return nil
 	}
@@ -80,7 +76,8 @@ func (k Keeper) MintCoins(goCtx context.Context, moduleName string, amt sdk.Coin
 	return nil
 }
 
-// mintExtendedCoin manages the minting of only extended coins. This also
+// mintExtendedCoin manages the minting of only extended coins of an extended
+// denom. This also
 // handles integer carry over from fractional balance to integer balance if
 // necessary depending on the fractional balance and minting amount. Ensures
 // that the reserve fully backs the additional minted amount, minting any extra
@@ -99,20 +96,22 @@ func (k Keeper) MintCoins(goCtx context.Context, moduleName string, amt sdk.Coin
 func (k Keeper) mintExtendedCoin(
 	ctx sdk.Context,
 	recipientModuleName string,
+	extendedDenom types.ExtendedDenom,
 	amt sdkmath.Int,
 ) error {
 	moduleAddr := k.ak.GetModuleAddress(recipientModuleName)
+	conversionFactor := extendedDenom.ConversionFactor()
 
 	// Get current module account fractional balance - 0 if not found
-	fractionalAmount := k.GetFractionalBalance(ctx, moduleAddr)
+	fractionalAmount := k.GetFractionalBalance(ctx, moduleAddr, extendedDenom.ExtendedDenom)
 
 	// Get separated mint amounts
-	integerMintAmount := amt.Quo(types.ConversionFactor())
-	fractionalMintAmount := amt.Mod(types.ConversionFactor())
+	integerMintAmount := amt.Quo(conversionFactor)
+	fractionalMintAmount := amt.Mod(conversionFactor)
 
 	// Get previous remainder amount, as we need to it before carry calculation
 	// for the optimization path.
-	prevRemainder := k.GetRemainderAmount(ctx)
+	prevRemainder := k.GetRemainderAmount(ctx, extendedDenom.ExtendedDenom)
 
 	// Deduct new remainder with minted fractional amount. This will result in
 	// two cases:
@@ -130,11 +129,11 @@ func (k Keeper) mintExtendedCoin(
 	newFractionalBalance := fractionalAmount.Add(fractionalMintAmount)
 
 	// Case #3 - Integer carry, remainder is sufficient (0 or positive)
-	if newFractionalBalance.GTE(types.ConversionFactor()) && newRemainder.GTE(sdkmath.ZeroInt()) {
+	if newFractionalBalance.GTE(conversionFactor) && newRemainder.GTE(sdkmath.ZeroInt()) {
 		// Carry should send from reserve -> account, instead of minting an
 		// extra integer coin. Otherwise doing an extra mint will require a burn
 		// from reserves to maintain exact backing.
-		carryCoin := sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.OneInt())
+		carryCoin := sdk.NewCoin(extendedDenom.Denom, sdkmath.OneInt())
 
 		// SendCoinsFromModuleToModule allows for sending coins even if the
 		// recipient module account is blocked.
@@ -151,7 +150,7 @@ func (k Keeper) mintExtendedCoin(
 	// Case #4 - Integer carry, remainder is insufficient
 	// This is the optimization path where the integer mint amount is increased
 	// by 1, instead of doing both a reserve -> account transfer and reserve mint.
-	if newFractionalBalance.GTE(types.ConversionFactor()) && newRemainder.IsNegative() {
+	if newFractionalBalance.GTE(conversionFactor) && newRemainder.IsNegative() {
 		integerMintAmount = integerMintAmount.AddRaw(1)
 	}
 
@@ -160,16 +159,16 @@ func (k Keeper) mintExtendedCoin(
 	// fractional amounts x and y where both x and y < ConversionFactor
 	// x + y < (2 * ConversionFactor) - 2
 	// x + y < 1 integer amount + fractional amount
-	if newFractionalBalance.GTE(types.ConversionFactor()) {
+	if newFractionalBalance.GTE(conversionFactor) {
 		// Subtract 1 integer equivalent amount of fractional balance. Same
 		// behavior as using .Mod() in this case.
-		newFractionalBalance = newFractionalBalance.Sub(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Sub(conversionFactor)
 	}
 
 	// Mint new integer amounts in x/bank - including carry over from fractional
 	// amount if any.
 	if integerMintAmount.IsPositive() {
-		integerMintCoin := sdk.NewCoin(types.IntegerCoinDenom(), integerMintAmount)
+		integerMintCoin := sdk.NewCoin(extendedDenom.Denom, integerMintAmount)
 
 		if err := k.bk.MintCoins(
 			ctx,
@@ -181,7 +180,7 @@ func (k Keeper) mintExtendedCoin(
 	}
 
 	// Assign new fractional balance in x/precisebank
-	k.SetFractionalBalance(ctx, moduleAddr, newFractionalBalance)
+	k.SetFractionalBalance(ctx, moduleAddr, extendedDenom.ExtendedDenom, newFractionalBalance)
 
 	// ----------------------------------------
 	// Update remainder & reserves to back minted fractional coins
@@ -194,10 +193,10 @@ func (k Keeper) mintExtendedCoin(
 	// Optimization: This is only done when the integer amount does NOT carry,
 	// as a direct account mint is done instead of integer carry transfer +
 	// insufficient remainder reserve mint.
-	wasCarried := fractionalAmount.Add(fractionalMintAmount).GTE(types.ConversionFactor())
+	wasCarried := fractionalAmount.Add(fractionalMintAmount).GTE(conversionFactor)
 	if prevRemainder.LT(fractionalMintAmount) && !wasCarried {
 		// Always only 1 integer coin, as fractionalMintAmount < ConversionFactor
-		reserveMintCoins := sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.OneInt()))
+		reserveMintCoins := sdk.NewCoins(sdk.NewCoin(extendedDenom.Denom, sdkmath.OneInt()))
 		if err := k.bk.MintCoins(ctx, types.ModuleName, reserveMintCoins); err != nil {
 			return fmt.Errorf("failed to mint %s for reserve: %w", reserveMintCoins, err)
 		}
@@ -207,10 +206,10 @@ func (k Keeper) mintExtendedCoin(
 	// This needs to be adjusted back to the corresponding positive value. The
 	// remainder will be always < conversionFactor after add if it is negative.
 	if newRemainder.IsNegative() {
-		newRemainder = newRemainder.Add(types.ConversionFactor())
+		newRemainder = newRemainder.Add(conversionFactor)
 	}
 
-	k.SetRemainderAmount(ctx, newRemainder)
+	k.SetRemainderAmount(ctx, extendedDenom.ExtendedDenom, newRemainder)
 
 	return nil
 }
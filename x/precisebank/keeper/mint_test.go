@@ -239,9 +239,10 @@ func TestMintCoins_ExpectedCalls(t *testing.T) {
 			td.keeper.SetFractionalBalance(
 				td.ctx,
 				moduleAddr,
+				types.ExtendedCoinDenom(),
 				tt.startFractionalBalance,
 			)
-			fBal := td.keeper.GetFractionalBalance(td.ctx, moduleAddr)
+			fBal := td.keeper.GetFractionalBalance(td.ctx, moduleAddr, types.ExtendedCoinDenom())
 			require.Equal(t, tt.startFractionalBalance, fBal)
 
 			// Always calls GetModuleAccount() to check if module exists &
@@ -296,7 +297,7 @@ func TestMintCoins_ExpectedCalls(t *testing.T) {
 			// Set expectations for reserve minting when fractional amounts
 			// are minted & remainder is insufficient
 			mintFractionalAmount := extCoins.Amount.Mod(types.ConversionFactor())
-			currentRemainder := td.keeper.GetRemainderAmount(td.ctx)
+			currentRemainder := td.keeper.GetRemainderAmount(td.ctx, types.ExtendedCoinDenom())
 
 			causesIntegerCarry := fBal.Add(mintFractionalAmount).GTE(types.ConversionFactor())
 			remainderEnough := currentRemainder.GTE(mintFractionalAmount)
@@ -362,7 +363,7 @@ func TestMintCoins_ExpectedCalls(t *testing.T) {
 			})
 
 			// Check final fractional balance
-			fBal = td.keeper.GetFractionalBalance(td.ctx, moduleAddr)
+			fBal = td.keeper.GetFractionalBalance(td.ctx, moduleAddr, types.ExtendedCoinDenom())
 			require.Equal(t, tt.wantPreciseBalance, fBal)
 		})
 	}
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/x/precisebank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the total set of precisebank parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the precisebank parameters in the store. The extended denoms
+// can't overlap with the EVM denom, and an extended denom can only be removed
+// once it has no fractional balances nor remainder left.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	evmDenom := types.EVMExtendedDenom()
+	for _, d := range params.ExtendedDenoms {
+		if d.Denom == evmDenom.Denom || d.Denom == evmDenom.ExtendedDenom ||
+			d.ExtendedDenom == evmDenom.Denom || d.ExtendedDenom == evmDenom.ExtendedDenom {
+			return fmt.Errorf("extended denom %s overlaps with the EVM denom %s", d.ExtendedDenom, evmDenom.ExtendedDenom)
+		}
+	}
+
+	for _, d := range k.GetParams(ctx).ExtendedDenoms {
+		newDenom, found := params.GetExtendedDenom(d.ExtendedDenom)
+		if found && newDenom == d {
+			continue
+		}
+
+		if !k.GetRemainderAmount(ctx, d.ExtendedDenom).IsZero() || !k.GetTotalSumFractionalBalances(ctx, d.ExtendedDenom).IsZero() {
+			return fmt.Errorf("extended denom %s with outstanding fractional balances can't be removed or modified", d.ExtendedDenom)
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
+
+// GetExtendedDenoms returns all the extended denoms managed by x/precisebank,
+// i.e. the EVM denom followed by the extended denoms of the params.
+func (k Keeper) GetExtendedDenoms(ctx sdk.Context) []types.ExtendedDenom {
+	return append([]types.ExtendedDenom{types.EVMExtendedDenom()}, k.GetParams(ctx).ExtendedDenoms...)
+}
+
+// GetExtendedDenom returns the extended denom managed by x/precisebank with
+// the given extended denomination. The params are not read for the EVM denom.
+func (k Keeper) GetExtendedDenom(ctx sdk.Context, extendedDenom string) (types.ExtendedDenom, bool) {
+	if evmDenom := types.EVMExtendedDenom(); extendedDenom == evmDenom.ExtendedDenom {
+		return evmDenom, true
+	}
+
+	return k.GetParams(ctx).GetExtendedDenom(extendedDenom)
+}
+
+// mustGetExtendedDenom returns the extended denom managed by x/precisebank
+// with the given extended denomination and panics if it is not found.
+func (k Keeper) mustGetExtendedDenom(ctx sdk.Context, extendedDenom string) types.ExtendedDenom {
+	d, found := k.GetExtendedDenom(ctx, extendedDenom)
+	if !found {
+		panic(fmt.Errorf("%s is not an extended denom", extendedDenom))
+	}
+	return d
+}
+
+// extendedDenomsOf returns the extended denoms managed by x/precisebank that
+// amt contains, either through their integer or extended denom. The params are
+// only read if amt contains coins other than the EVM coin.
+func (k Keeper) extendedDenomsOf(ctx sdk.Context, amt sdk.Coins) []types.ExtendedDenom {
+	evmDenom := types.EVMExtendedDenom()
+
+	var (
+		extendedDenoms []types.ExtendedDenom
+		params         *types.Params
+		seen           = make(map[string]struct{})
+	)
+	add := func(d types.ExtendedDenom) {
+		if _, found := seen[d.ExtendedDenom]; !found {
+			seen[d.ExtendedDenom] = struct{}{}
+			extendedDenoms = append(extendedDenoms, d)
+		}
+	}
+
+	for _, coin := range amt {
+		if coin.Denom == evmDenom.Denom || coin.Denom == evmDenom.ExtendedDenom {
+			add(evmDenom)
+			continue
+		}
+
+		if params == nil {
+			p := k.GetParams(ctx)
+			params = &p
+		}
+
+		for _, d := range params.ExtendedDenoms {
+			if coin.Denom == d.Denom || coin.Denom == d.ExtendedDenom {
+				add(d)
+			}
+		}
+	}
+
+	return extendedDenoms
+}
+
+// removeExtendedCoins returns amt without the coins of the given extended
+// denoms, which are managed by x/precisebank. The coins of their integer denoms
+// are kept, as they are passed through to x/bank.
+func removeExtendedCoins(amt sdk.Coins, extendedDenoms []types.ExtendedDenom) sdk.Coins {
+	passthroughCoins := amt
+
+	for _, d := range extendedDenoms {
+		extendedAmount := amt.AmountOf(d.ExtendedDenom)
+		if extendedAmount.IsPositive() {
+			// Remove the extended coin from the coins as it is managed by x/precisebank
+			removeCoin := sdk.NewCoin(d.ExtendedDenom, extendedAmount)
+			passthroughCoins = passthroughCoins.Sub(removeCoin)
+		}
+	}
+
+	return passthroughCoins
+}
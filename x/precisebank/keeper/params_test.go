@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/precisebank/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSetParams(t *testing.T) {
+	fooDenom := types.NewExtendedDenom("ufoo", "afoo", 6)
+	addr := sdk.AccAddress([]byte("test-address"))
+
+	tests := []struct {
+		name    string
+		setupFn func(td testData)
+		params  types.Params
+		wantErr string
+	}{
+		{
+			"valid - add extended denom",
+			func(testData) {},
+			types.NewParams([]types.ExtendedDenom{fooDenom}),
+			"",
+		},
+		{
+			"invalid - stateless validation",
+			func(testData) {},
+			types.NewParams([]types.ExtendedDenom{types.NewExtendedDenom("ufoo", "afoo", 18)}),
+			"invalid extended denom afoo: decimals of ufoo must be between 1 and 17, got 18",
+		},
+		{
+			"invalid - overlaps with EVM extended denom",
+			func(testData) {},
+			types.NewParams([]types.ExtendedDenom{types.NewExtendedDenom("ufoo", types.ExtendedCoinDenom(), 6)}),
+			"overlaps with the EVM denom",
+		},
+		{
+			"invalid - overlaps with EVM integer denom",
+			func(testData) {},
+			types.NewParams([]types.ExtendedDenom{types.NewExtendedDenom(types.IntegerCoinDenom(), "afoo", 6)}),
+			"overlaps with the EVM denom",
+		},
+		{
+			"valid - remove extended denom without balances",
+			func(td testData) {
+				require.NoError(t, td.keeper.SetParams(td.ctx, types.NewParams([]types.ExtendedDenom{fooDenom})))
+			},
+			types.DefaultParams(),
+			"",
+		},
+		{
+			"invalid - remove extended denom with fractional balances",
+			func(td testData) {
+				require.NoError(t, td.keeper.SetParams(td.ctx, types.NewParams([]types.ExtendedDenom{fooDenom})))
+				td.keeper.SetFractionalBalance(td.ctx, addr, "afoo", sdkmath.NewInt(100))
+			},
+			types.DefaultParams(),
+			"extended denom afoo with outstanding fractional balances can't be removed or modified",
+		},
+		{
+			"invalid - modify decimals of extended denom with remainder",
+			func(td testData) {
+				require.NoError(t, td.keeper.SetParams(td.ctx, types.NewParams([]types.ExtendedDenom{fooDenom})))
+				td.keeper.SetRemainderAmount(td.ctx, "afoo", sdkmath.NewInt(100))
+			},
+			types.NewParams([]types.ExtendedDenom{types.NewExtendedDenom("ufoo", "afoo", 8)}),
+			"extended denom afoo with outstanding fractional balances can't be removed or modified",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := newMockedTestData(t)
+			tt.setupFn(td)
+
+			err := td.keeper.SetParams(td.ctx, tt.params)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.params, td.keeper.GetParams(td.ctx))
+		})
+	}
+}
+
+func TestGetExtendedDenom(t *testing.T) {
+	td := newMockedTestData(t)
+	fooDenom := types.NewExtendedDenom("ufoo", "afoo", 6)
+
+	_, found := td.keeper.GetExtendedDenom(td.ctx, "afoo")
+	require.False(t, found, "afoo should not be extended before being added to the params")
+
+	require.NoError(t, td.keeper.SetParams(td.ctx, types.NewParams([]types.ExtendedDenom{fooDenom})))
+
+	extendedDenom, found := td.keeper.GetExtendedDenom(td.ctx, "afoo")
+	require.True(t, found)
+	require.Equal(t, fooDenom, extendedDenom)
+
+	// The EVM denom is always extended
+	extendedDenom, found = td.keeper.GetExtendedDenom(td.ctx, types.ExtendedCoinDenom())
+	require.True(t, found)
+	require.Equal(t, types.EVMExtendedDenom(), extendedDenom)
+
+	// Integer denoms are not extended denoms
+	_, found = td.keeper.GetExtendedDenom(td.ctx, "ufoo")
+	require.False(t, found)
+
+	require.Equal(t, []types.ExtendedDenom{types.EVMExtendedDenom(), fooDenom}, td.keeper.GetExtendedDenoms(td.ctx))
+}
+
+func TestFractionalBalance_PerDenom(t *testing.T) {
+	td := newMockedTestData(t)
+	addr := sdk.AccAddress([]byte("test-address"))
+
+	// Fractional balances can't be set for denoms that are not extended
+	require.PanicsWithError(t, "afoo is not an extended denom", func() {
+		td.keeper.SetFractionalBalance(td.ctx, addr, "afoo", sdkmath.NewInt(100))
+	})
+
+	fooDenom := types.NewExtendedDenom("ufoo", "afoo", 9)
+	require.NoError(t, td.keeper.SetParams(td.ctx, types.NewParams([]types.ExtendedDenom{fooDenom})))
+
+	td.keeper.SetFractionalBalance(td.ctx, addr, types.ExtendedCoinDenom(), sdkmath.NewInt(100))
+	td.keeper.SetFractionalBalance(td.ctx, addr, "afoo", sdkmath.NewInt(200))
+	td.keeper.SetRemainderAmount(td.ctx, "afoo", sdkmath.NewInt(300))
+
+	require.Equal(t, sdkmath.NewInt(100), td.keeper.GetFractionalBalance(td.ctx, addr, types.ExtendedCoinDenom()))
+	require.Equal(t, sdkmath.NewInt(200), td.keeper.GetFractionalBalance(td.ctx, addr, "afoo"))
+	require.Equal(t, sdkmath.ZeroInt(), td.keeper.GetRemainderAmount(td.ctx, types.ExtendedCoinDenom()))
+	require.Equal(t, sdkmath.NewInt(300), td.keeper.GetRemainderAmount(td.ctx, "afoo"))
+
+	// Amounts are validated with the conversion factor of the denom
+	require.PanicsWithError(t, "amount is invalid: amount 1000000000 exceeds max of 999999999", func() {
+		td.keeper.SetFractionalBalance(td.ctx, addr, "afoo", fooDenom.ConversionFactor())
+	})
+	require.NotPanics(t, func() {
+		td.keeper.SetFractionalBalance(td.ctx, addr, types.ExtendedCoinDenom(), fooDenom.ConversionFactor())
+	})
+
+	td.keeper.DeleteFractionalBalance(td.ctx, addr, "afoo")
+	require.Equal(t, sdkmath.ZeroInt(), td.keeper.GetTotalSumFractionalBalances(td.ctx, "afoo"))
+	require.Equal(t, fooDenom.ConversionFactor(), td.keeper.GetTotalSumFractionalBalances(td.ctx, types.ExtendedCoinDenom()))
+}
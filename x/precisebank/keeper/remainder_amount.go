@@ -10,13 +10,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// GetRemainderAmount returns the internal remainder amount.
+// GetRemainderAmount returns the internal remainder amount of an extended
+// denom.
 func (k *Keeper) GetRemainderAmount(
 	ctx sdk.Context,
+	denom string,
 ) sdkmath.Int {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(types.RemainderBalanceKey)
+	bz := store.Get(types.RemainderBalanceKey(denom))
 	if bz == nil {
 		return sdkmath.ZeroInt()
 	}
@@ -29,22 +31,23 @@ func (k *Keeper) GetRemainderAmount(
 	return bal
 }
 
-// SetRemainderAmount sets the internal remainder amount.
+// SetRemainderAmount sets the internal remainder amount of an extended denom.
 func (k *Keeper) SetRemainderAmount(
 	ctx sdk.Context,
+	denom string,
 	amount sdkmath.Int,
 ) {
 	// Prevent storing zero amounts. In practice, the remainder amount should
 	// only be non-zero during transactions as mint and burns should net zero
 	// due to only being used for EVM transfers.
 	if amount.IsZero() {
-		k.DeleteRemainderAmount(ctx)
+		k.DeleteRemainderAmount(ctx, denom)
 		return
 	}
 
 	// Ensure the remainder is valid before setting it. Follows the same
 	// validation as FractionalBalance with the same value range.
-	if err := types.ValidateFractionalAmount(amount); err != nil {
+	if err := k.mustGetExtendedDenom(ctx, denom).ValidateFractionalAmount(amount); err != nil {
 		panic(fmt.Errorf("remainder amount is invalid: %w", err))
 	}
 
@@ -55,13 +58,15 @@ func (k *Keeper) SetRemainderAmount(
 		panic(fmt.Errorf("failed to marshal remainder amount: %w", err))
 	}
 
-	store.Set(types.RemainderBalanceKey, amountBytes)
+	store.Set(types.RemainderBalanceKey(denom), amountBytes)
 }
 
-// DeleteRemainderAmount deletes the internal remainder amount.
+// DeleteRemainderAmount deletes the internal remainder amount of an extended
+// denom.
 func (k *Keeper) DeleteRemainderAmount(
 	ctx sdk.Context,
+	denom string,
 ) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.RemainderBalanceKey)
+	store.Delete(types.RemainderBalanceKey(denom))
 }
@@ -15,20 +15,20 @@ func TestGetSetRemainderAmount(t *testing.T) {
 	ctx, k, storeKey := tk.ctx, tk.keeper, tk.storeKey
 
 	// Set amount
-	k.SetRemainderAmount(ctx, sdkmath.NewInt(100))
+	k.SetRemainderAmount(ctx, types.ExtendedCoinDenom(), sdkmath.NewInt(100))
 
-	amt := k.GetRemainderAmount(ctx)
+	amt := k.GetRemainderAmount(ctx, types.ExtendedCoinDenom())
 	require.Equal(t, sdkmath.NewInt(100), amt)
 
 	// Set zero balance
-	k.SetRemainderAmount(ctx, sdkmath.ZeroInt())
+	k.SetRemainderAmount(ctx, types.ExtendedCoinDenom(), sdkmath.ZeroInt())
 
-	amt = k.GetRemainderAmount(ctx)
+	amt = k.GetRemainderAmount(ctx, types.ExtendedCoinDenom())
 	require.Equal(t, sdkmath.ZeroInt(), amt)
 
 	// Get directly from store to make sure it was actually deleted
 	store := ctx.KVStore(storeKey)
-	bz := store.Get(types.RemainderBalanceKey)
+	bz := store.Get(types.RemainderBalanceKey(types.ExtendedCoinDenom()))
 	require.Nil(t, bz)
 }
 
@@ -38,12 +38,12 @@ func TestInvalidRemainderAmount(t *testing.T) {
 
 	// Set negative amount
 	require.PanicsWithError(t, "remainder amount is invalid: non-positive amount -1", func() {
-		k.SetRemainderAmount(ctx, sdkmath.NewInt(-1))
+		k.SetRemainderAmount(ctx, types.ExtendedCoinDenom(), sdkmath.NewInt(-1))
 	})
 
 	// Set amount over max
 	require.PanicsWithError(t, "remainder amount is invalid: amount 1000000000000 exceeds max of 999999999999", func() {
-		k.SetRemainderAmount(ctx, types.ConversionFactor())
+		k.SetRemainderAmount(ctx, types.ExtendedCoinDenom(), types.ConversionFactor())
 	})
 }
 
@@ -52,22 +52,22 @@ func TestDeleteRemainderAmount(t *testing.T) {
 	ctx, k, storeKey := tk.ctx, tk.keeper, tk.storeKey
 
 	require.NotPanics(t, func() {
-		k.DeleteRemainderAmount(ctx)
+		k.DeleteRemainderAmount(ctx, types.ExtendedCoinDenom())
 	})
 
 	// Set amount
-	k.SetRemainderAmount(ctx, sdkmath.NewInt(100))
+	k.SetRemainderAmount(ctx, types.ExtendedCoinDenom(), sdkmath.NewInt(100))
 
-	amt := k.GetRemainderAmount(ctx)
+	amt := k.GetRemainderAmount(ctx, types.ExtendedCoinDenom())
 	require.Equal(t, sdkmath.NewInt(100), amt)
 
 	// Delete amount
-	k.DeleteRemainderAmount(ctx)
+	k.DeleteRemainderAmount(ctx, types.ExtendedCoinDenom())
 
-	amt = k.GetRemainderAmount(ctx)
+	amt = k.GetRemainderAmount(ctx, types.ExtendedCoinDenom())
 	require.Equal(t, sdkmath.ZeroInt(), amt)
 
 	store := ctx.KVStore(storeKey)
-	bz := store.Get(types.RemainderBalanceKey)
+	bz := store.Get(types.RemainderBalanceKey(types.ExtendedCoinDenom()))
 	require.Nil(t, bz)
 }
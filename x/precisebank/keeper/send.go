@@ -26,10 +26,16 @@ func (k Keeper) IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 	return k.bk.IsSendEnabledCoins(ctx, coins...)
 }
 
+// BlockedAddr uses the parent x/bank keeper to check if the given address is
+// blocked from receiving funds.
+func (k Keeper) BlockedAddr(addr sdk.AccAddress) bool {
+	// Simply pass through to x/bank
+	return k.bk.BlockedAddr(addr)
+}
+
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure. This handles transfers including
-// ExtendedCoinDenom and supports non-ExtendedCoinDenom transfers by passing
-// through to x/bank.
+// An error is returned upon failure. This handles transfers including extended
+// denoms and supports other transfers by passing through to x/bank.
 func (k Keeper) SendCoins(
 	goCtx context.Context,
 	from, to sdk.AccAddress,
@@ -44,14 +50,9 @@ func (k Keeper) SendCoins(
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	passthroughCoins := amt
-	extendedCoinAmount := amt.AmountOf(types.ExtendedCoinDenom())
-
-	// Remove the extended coin amount from the passthrough coins
-	if extendedCoinAmount.IsPositive() {
-		subCoin := sdk.NewCoin(types.ExtendedCoinDenom(), extendedCoinAmount)
-		passthroughCoins = amt.Sub(subCoin)
-	}
+	// Remove the extended coin amounts from the passthrough coins
+	extendedDenoms := k.extendedDenomsOf(ctx, amt)
+	passthroughCoins := removeExtendedCoins(amt, extendedDenoms)
 
 	// Send the passthrough coins through x/bank
 	if passthroughCoins.IsAllPositive() {
@@ -60,16 +61,18 @@ func (k Keeper) SendCoins(
 		}
 	}
 
-	// Send the extended coin amount through x/precisebank
-	if extendedCoinAmount.IsPositive() {
-		if err := k.sendExtendedCoins(ctx, from, to, extendedCoinAmount); err != nil {
-			return err
+	// Send the extended coin amounts through x/precisebank
+	for _, d := range extendedDenoms {
+		if extendedCoinAmount := amt.AmountOf(d.ExtendedDenom); extendedCoinAmount.IsPositive() {
+			if err := k.sendExtendedCoins(ctx, from, to, d, extendedCoinAmount); err != nil {
+				return err
+			}
 		}
 	}
 
-	// Get a full extended coin amount (passthrough integer + fractional) ONLY
-	// for event attributes.
-	fullEmissionCoins := sdk.NewCoins(types.SumExtendedCoin(amt))
+	// Get the full extended coin amounts (passthrough integer + fractional)
+	// ONLY for event attributes.
+	fullEmissionCoins := types.SumExtendedCoins(amt, extendedDenoms)
 
 	// If no passthrough integer nor fractional coins, then no event emission.
 	// We also want to emit the event with the whole equivalent extended coin
@@ -93,8 +96,8 @@ func (k Keeper) SendCoins(
 	return nil
 }
 
-// sendExtendedCoins transfers amt extended coins from a sending account to a
-// receiving account. An error is returned upon failure. This function is
+// sendExtendedCoins transfers amt coins of an extended denom from a sending
+// account to a receiving account. An error is returned upon failure. This function is
 // called by SendCoins() and should not be called directly.
 //
 // This method covers 4 cases between two accounts - sender and receiver.
@@ -125,6 +128,7 @@ func (k Keeper) SendCoins(
 func (k Keeper) sendExtendedCoins(
 	ctx sdk.Context,
 	from, to sdk.AccAddress,
+	extendedDenom types.ExtendedDenom,
 	amt sdkmath.Int,
 ) error {
 	// If we do not return early here, the following issue occurs:
@@ -146,17 +150,18 @@ func (k Keeper) sendExtendedCoins(
 	// sender does not have sufficient integer balance.
 
 	// Load required state: Account old balances
-	senderFracBal := k.GetFractionalBalance(ctx, from)
-	recipientFracBal := k.GetFractionalBalance(ctx, to)
+	senderFracBal := k.GetFractionalBalance(ctx, from, extendedDenom.ExtendedDenom)
+	recipientFracBal := k.GetFractionalBalance(ctx, to, extendedDenom.ExtendedDenom)
 
 	// -------------------------------------------------------------------------
 	// Pure stateless calculations
-	integerAmt := amt.Quo(types.ConversionFactor())
-	fractionalAmt := amt.Mod(types.ConversionFactor())
+	conversionFactor := extendedDenom.ConversionFactor()
+	integerAmt := amt.Quo(conversionFactor)
+	fractionalAmt := amt.Mod(conversionFactor)
 
 	// Account new fractional balances
-	senderNewFracBal, senderNeedsBorrow := subFromFractionalBalance(senderFracBal, fractionalAmt)
-	recipientNewFracBal, recipientNeedsCarry := addToFractionalBalance(recipientFracBal, fractionalAmt)
+	senderNewFracBal, senderNeedsBorrow := subFromFractionalBalance(senderFracBal, fractionalAmt, conversionFactor)
+	recipientNewFracBal, recipientNeedsCarry := addToFractionalBalance(recipientFracBal, fractionalAmt, conversionFactor)
 
 	// Case #1: Sender borrow, recipient carry
 	if senderNeedsBorrow && recipientNeedsCarry {
@@ -171,9 +176,9 @@ func (k Keeper) sendExtendedCoins(
 	// Full integer amount transfer, including direct transfer of borrow/carry
 	// if any.
 	if integerAmt.IsPositive() {
-		transferCoin := sdk.NewCoin(types.IntegerCoinDenom(), integerAmt)
+		transferCoin := sdk.NewCoin(extendedDenom.Denom, integerAmt)
 		if err := k.bk.SendCoins(ctx, from, to, sdk.NewCoins(transferCoin)); err != nil {
-			return k.updateInsufficientFundsError(ctx, from, amt, err)
+			return k.updateInsufficientFundsError(ctx, from, extendedDenom, amt, err)
 		}
 	}
 
@@ -181,14 +186,14 @@ func (k Keeper) sendExtendedCoins(
 	// Sender borrows by transferring 1 integer amount to reserve to account for
 	// lack of fractional balance.
 	if senderNeedsBorrow && !recipientNeedsCarry {
-		borrowCoin := sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1))
+		borrowCoin := sdk.NewCoin(extendedDenom.Denom, sdkmath.NewInt(1))
 		if err := k.bk.SendCoinsFromAccountToModule(
 			ctx,
 			from, // sender borrowing
 			types.ModuleName,
 			sdk.NewCoins(borrowCoin),
 		); err != nil {
-			return k.updateInsufficientFundsError(ctx, from, amt, err)
+			return k.updateInsufficientFundsError(ctx, from, extendedDenom, amt, err)
 		}
 	}
 
@@ -204,7 +209,7 @@ func (k Keeper) sendExtendedCoins(
 		// a SendCoins operation. Only SendCoinsFromModuleToAccount should check
 		// blocked addrs which is done by the parent SendCoinsFromModuleToAccount
 		// method.
-		carryCoin := sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1))
+		carryCoin := sdk.NewCoin(extendedDenom.Denom, sdkmath.NewInt(1))
 		if err := k.bk.SendCoins(
 			ctx,
 			reserveAddr,
@@ -224,8 +229,8 @@ func (k Keeper) sendExtendedCoins(
 	// already calculated and just need to be set.
 
 	// Persist new fractional balances to store.
-	k.SetFractionalBalance(ctx, from, senderNewFracBal)
-	k.SetFractionalBalance(ctx, to, recipientNewFracBal)
+	k.SetFractionalBalance(ctx, from, extendedDenom.ExtendedDenom, senderNewFracBal)
+	k.SetFractionalBalance(ctx, to, extendedDenom.ExtendedDenom, recipientNewFracBal)
 
 	return nil
 }
@@ -236,13 +241,14 @@ func (k Keeper) sendExtendedCoins(
 func subFromFractionalBalance(
 	currentFractionalBalance sdkmath.Int,
 	amountToSub sdkmath.Int,
+	conversionFactor sdkmath.Int,
 ) (sdkmath.Int, bool) {
 	// Enforce that currentFractionalBalance is not a full balance.
-	if currentFractionalBalance.GTE(types.ConversionFactor()) {
+	if currentFractionalBalance.GTE(conversionFactor) {
 		panic("currentFractionalBalance must be less than ConversionFactor")
 	}
 
-	if amountToSub.GTE(types.ConversionFactor()) {
+	if amountToSub.GTE(conversionFactor) {
 		panic("amountToSub must be less than ConversionFactor")
 	}
 
@@ -255,7 +261,7 @@ func subFromFractionalBalance(
 		// Borrowing 1 integer equivalent amount of fractional coins. We need to
 		// add 1 integer equivalent amount to the fractional balance otherwise
 		// the new fractional balance will be negative.
-		newFractionalBalance = newFractionalBalance.Add(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Add(conversionFactor)
 	}
 
 	return newFractionalBalance, borrowRequired
@@ -264,13 +270,17 @@ func subFromFractionalBalance(
 // addToFractionalBalance adds a fractional amount to the provided current
 // fractional balance, returning the new fractional balance and true if a carry
 // is required.
-func addToFractionalBalance(currentFractionalBalance sdkmath.Int, amountToAdd sdkmath.Int) (sdkmath.Int, bool) {
+func addToFractionalBalance(
+	currentFractionalBalance sdkmath.Int,
+	amountToAdd sdkmath.Int,
+	conversionFactor sdkmath.Int,
+) (sdkmath.Int, bool) {
 	// Enforce that currentFractionalBalance is not a full balance.
-	if currentFractionalBalance.GTE(types.ConversionFactor()) {
+	if currentFractionalBalance.GTE(conversionFactor) {
 		panic("currentFractionalBalance must be less than ConversionFactor")
 	}
 
-	if amountToAdd.GTE(types.ConversionFactor()) {
+	if amountToAdd.GTE(conversionFactor) {
 		panic("amountToAdd must be less than ConversionFactor")
 	}
 
@@ -278,11 +288,11 @@ func addToFractionalBalance(currentFractionalBalance sdkmath.Int, amountToAdd sd
 
 	// New balance exceeds max fractional balance, so we need to carry it over
 	// to the integer balance.
-	carryRequired := newFractionalBalance.GTE(types.ConversionFactor())
+	carryRequired := newFractionalBalance.GTE(conversionFactor)
 
 	if carryRequired {
 		// Carry over to integer amount
-		newFractionalBalance = newFractionalBalance.Sub(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Sub(conversionFactor)
 	}
 
 	return newFractionalBalance, carryRequired
@@ -384,6 +394,7 @@ func (k Keeper) SendCoinsFromModuleToModule(
 func (k Keeper) updateInsufficientFundsError(
 	ctx sdk.Context,
 	addr sdk.AccAddress,
+	extendedDenom types.ExtendedDenom,
 	amt sdkmath.Int,
 	err error,
 ) error {
@@ -392,8 +403,8 @@ func (k Keeper) updateInsufficientFundsError(
 	}
 
 	// Check balance is sufficient
-	bal := k.GetBalance(ctx, addr, types.ExtendedCoinDenom())
-	coin := sdk.NewCoin(types.ExtendedCoinDenom(), amt)
+	bal := k.GetBalance(ctx, addr, extendedDenom.ExtendedDenom)
+	coin := sdk.NewCoin(extendedDenom.ExtendedDenom, amt)
 
 	// TODO: This checks spendable coins and returns error with spendable
 	// coins, not full balance. If GetBalance() is modified to return the
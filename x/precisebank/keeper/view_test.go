@@ -94,7 +94,7 @@ func TestKeeper_GetBalance(t *testing.T) {
 			addr := sdk.AccAddress([]byte("test-address"))
 
 			// Set fractional balance in store before query
-			tk.keeper.SetFractionalBalance(tk.ctx, addr, tt.giveFractionalBal)
+			tk.keeper.SetFractionalBalance(tk.ctx, addr, types.ExtendedCoinDenom(), tt.giveFractionalBal)
 
 			// Checks address if its a reserve denom
 			if tt.giveDenom == types.ExtendedCoinDenom() {
@@ -209,7 +209,7 @@ func TestKeeper_SpendableCoin(t *testing.T) {
 			addr := sdk.AccAddress([]byte("test-address"))
 
 			// Set fractional balance in store before query
-			tk.keeper.SetFractionalBalance(tk.ctx, addr, tt.giveFractionalBal)
+			tk.keeper.SetFractionalBalance(tk.ctx, addr, types.ExtendedCoinDenom(), tt.giveFractionalBal)
 
 			// If its a reserve denom, module address is checked
 			if tt.giveDenom == types.ExtendedCoinDenom() {
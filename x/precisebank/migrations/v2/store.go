@@ -0,0 +1,57 @@
+package v2
+
+import (
+	"github.com/cosmos/evm/x/precisebank/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Store layout of v1, which tracks a single extended denom.
+var (
+	FractionalBalancePrefix = []byte{0x01} // address -> fractional balance
+	RemainderBalanceKey     = []byte{0x02} // fractional balance remainder
+)
+
+// MigrateStore migrates the x/precisebank store from the single denom layout
+// of v1 to the per denom layout of v2. The fractional balances and the
+// remainder of v1 are those of the extended EVM denom. The params are set to
+// their default value, so only the EVM denom is extended.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	extendedDenom := types.ExtendedCoinDenom()
+
+	legacyBalances := prefix.NewStore(store, FractionalBalancePrefix)
+	balances := prefix.NewStore(store, types.FractionalBalanceDenomPrefix(extendedDenom))
+
+	iterator := legacyBalances.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		balances.Set(iterator.Key(), iterator.Value())
+		keys = append(keys, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		legacyBalances.Delete(key)
+	}
+
+	if bz := store.Get(RemainderBalanceKey); bz != nil {
+		store.Set(types.RemainderBalanceKey(extendedDenom), bz)
+		store.Delete(RemainderBalanceKey)
+	}
+
+	params := types.DefaultParams()
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
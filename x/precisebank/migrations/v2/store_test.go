@@ -0,0 +1,66 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	evmosencoding "github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/testutil/config"
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	v2 "github.com/cosmos/evm/x/precisebank/migrations/v2"
+	"github.com/cosmos/evm/x/precisebank/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	chainID := testconstants.SixDecimalsChainID.EVMChainID
+	require.NoError(t, config.EvmAppOptions(chainID))
+	cdc := evmosencoding.MakeConfig(chainID).Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	// v1 layout
+	balances := map[string]sdkmath.Int{
+		string(sdk.AccAddress{1}): sdkmath.NewInt(100),
+		string(sdk.AccAddress{2}): sdkmath.NewInt(200),
+	}
+	legacyBalances := prefix.NewStore(store, v2.FractionalBalancePrefix)
+	for addr, amount := range balances {
+		bz, err := amount.Marshal()
+		require.NoError(t, err)
+		legacyBalances.Set(types.FractionalBalanceKey(sdk.AccAddress(addr)), bz)
+	}
+	remainderBz, err := sdkmath.NewInt(300).Marshal()
+	require.NoError(t, err)
+	store.Set(v2.RemainderBalanceKey, remainderBz)
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+
+	// v1 entries are removed
+	iterator := legacyBalances.Iterator(nil, nil)
+	require.False(t, iterator.Valid())
+	require.NoError(t, iterator.Close())
+	require.Nil(t, store.Get(v2.RemainderBalanceKey))
+
+	// v2 entries are those of the extended EVM denom
+	evmBalances := prefix.NewStore(store, types.FractionalBalanceDenomPrefix(types.ExtendedCoinDenom()))
+	for addr, amount := range balances {
+		var got sdkmath.Int
+		require.NoError(t, got.Unmarshal(evmBalances.Get(types.FractionalBalanceKey(sdk.AccAddress(addr)))))
+		require.Equal(t, amount, got)
+	}
+	require.Equal(t, remainderBz, store.Get(types.RemainderBalanceKey(types.ExtendedCoinDenom())))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, types.DefaultParams(), params)
+}
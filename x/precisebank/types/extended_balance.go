@@ -10,16 +10,16 @@ import (
 // amount in extended coins. This is intended to get the full value to emit in
 // events.
 func SumExtendedCoin(amt sdk.Coins) sdk.Coin {
-	// uatom converted to aatom
-	integerAmount := amt.AmountOf(IntegerCoinDenom()).Mul(ConversionFactor())
-	// aatom as is
-	extendedAmount := amt.AmountOf(ExtendedCoinDenom())
+	return EVMExtendedDenom().SumExtendedCoin(amt)
+}
 
-	// total of uatom and aatom amounts
-	fullEmissionAmount := integerAmount.Add(extendedAmount)
+// SumExtendedCoins returns the full extended amounts of amt for each of the
+// given extended denoms, see SumExtendedCoin. Zero amounts are omitted.
+func SumExtendedCoins(amt sdk.Coins, extendedDenoms []ExtendedDenom) sdk.Coins {
+	coins := make([]sdk.Coin, 0, len(extendedDenoms))
+	for _, d := range extendedDenoms {
+		coins = append(coins, d.SumExtendedCoin(amt))
+	}
 
-	return sdk.NewCoin(
-		ExtendedCoinDenom(),
-		fullEmissionAmount,
-	)
+	return sdk.NewCoins(coins...)
 }
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewExtendedDenom returns a new ExtendedDenom of the integer denom with the
+// given decimals.
+func NewExtendedDenom(denom, extendedDenom string, decimals uint32) ExtendedDenom {
+	return ExtendedDenom{
+		Denom:         denom,
+		ExtendedDenom: extendedDenom,
+		Decimals:      decimals,
+	}
+}
+
+// EVMExtendedDenom returns the ExtendedDenom of the EVM coin configured in the
+// EVM coin info. The EVM coin is always managed by x/precisebank, it is not
+// listed in the params.
+func EVMExtendedDenom() ExtendedDenom {
+	return NewExtendedDenom(
+		IntegerCoinDenom(),
+		ExtendedCoinDenom(),
+		uint32(evmtypes.GetEVMCoinDecimals()),
+	)
+}
+
+// ConversionFactor returns the conversion factor between the integer denom
+// and the extended denom. This is also 1 greater than the max valid fractional
+// amount of the extended denom.
+func (d ExtendedDenom) ConversionFactor() sdkmath.Int {
+	return evmtypes.Decimals(d.Decimals).ConversionFactor() //#nosec G115 // decimals are validated to be at most 18
+}
+
+// Validate returns an error if the ExtendedDenom has invalid denoms or
+// decimals that don't need an extended representation.
+func (d ExtendedDenom) Validate() error {
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(d.ExtendedDenom); err != nil {
+		return fmt.Errorf("invalid extended denom: %w", err)
+	}
+
+	if d.Denom == d.ExtendedDenom {
+		return fmt.Errorf("extended denom must be different from denom %s", d.Denom)
+	}
+
+	if d.Decimals == 0 || d.Decimals >= uint32(evmtypes.EighteenDecimals) {
+		return fmt.Errorf("decimals of %s must be between 1 and 17, got %d", d.Denom, d.Decimals)
+	}
+
+	return nil
+}
+
+// ValidateFractionalAmount checks if an sdkmath.Int is a valid fractional
+// amount of the extended denom, ensuring it is positive and less than the
+// conversion factor.
+func (d ExtendedDenom) ValidateFractionalAmount(amt sdkmath.Int) error {
+	return validateFractionalAmount(amt, d.ConversionFactor())
+}
+
+// SumExtendedCoin returns a sdk.Coin of the extended denom with all integer
+// and fractional amounts of amt combined.
+func (d ExtendedDenom) SumExtendedCoin(amt sdk.Coins) sdk.Coin {
+	// uatom converted to aatom
+	integerAmount := amt.AmountOf(d.Denom).Mul(d.ConversionFactor())
+	// aatom as is
+	extendedAmount := amt.AmountOf(d.ExtendedDenom)
+
+	return sdk.NewCoin(d.ExtendedDenom, integerAmount.Add(extendedAmount))
+}
@@ -12,8 +12,10 @@ func NewGenesisState(
 	remainder sdkmath.Int,
 ) *GenesisState {
 	return &GenesisState{
-		Balances:  balances,
-		Remainder: remainder,
+		Balances:      balances,
+		Remainder:     remainder,
+		Params:        DefaultParams(),
+		DenomBalances: []DenomBalances{},
 	}
 }
 
@@ -30,41 +32,122 @@ func (gs *GenesisState) Validate() error {
 		return fmt.Errorf("invalid balances: %w", err)
 	}
 
-	if gs.Remainder.IsNil() {
+	if err := validateRemainder(gs.Balances, gs.Remainder, ConversionFactor()); err != nil {
+		return err
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	seenDenoms := make(map[string]struct{})
+	for _, db := range gs.DenomBalances {
+		if _, found := seenDenoms[db.ExtendedDenom]; found {
+			return fmt.Errorf("duplicate denom balances for %s", db.ExtendedDenom)
+		}
+		seenDenoms[db.ExtendedDenom] = struct{}{}
+
+		extendedDenom, found := gs.Params.GetExtendedDenom(db.ExtendedDenom)
+		if !found {
+			return fmt.Errorf("denom balances for %s, which is not an extended denom of the params", db.ExtendedDenom)
+		}
+
+		if err := db.Validate(extendedDenom); err != nil {
+			return fmt.Errorf("invalid denom balances for %s: %w", db.ExtendedDenom, err)
+		}
+	}
+
+	return nil
+}
+
+// TotalAmountWithRemainder returns the total amount of all balances in the
+// genesis state, including both fractional balances and the remainder. A bit
+// more verbose WithRemainder to ensure its clearly different from SumAmount().
+func (gs *GenesisState) TotalAmountWithRemainder() sdkmath.Int {
+	return gs.Balances.SumAmount().Add(gs.Remainder)
+}
+
+// GetExtendedDenomBalances returns the fractional balances and the remainder
+// of the given extended denom, which are kept at the top level of the genesis
+// state for the EVM denom. Empty balances are returned if the genesis state has
+// no balances for the denom.
+func (gs *GenesisState) GetExtendedDenomBalances(extendedDenom string) DenomBalances {
+	if extendedDenom == ExtendedCoinDenom() {
+		return NewDenomBalances(extendedDenom, gs.Balances, gs.Remainder)
+	}
+
+	for _, db := range gs.DenomBalances {
+		if db.ExtendedDenom == extendedDenom {
+			return db
+		}
+	}
+	return NewDenomBalances(extendedDenom, FractionalBalances{}, sdkmath.ZeroInt())
+}
+
+// NewDenomBalances creates a new DenomBalances.
+func NewDenomBalances(
+	extendedDenom string,
+	balances FractionalBalances,
+	remainder sdkmath.Int,
+) DenomBalances {
+	return DenomBalances{
+		ExtendedDenom: extendedDenom,
+		Balances:      balances,
+		Remainder:     remainder,
+	}
+}
+
+// Validate returns an error if the fractional balances or the remainder are
+// invalid for the given extended denom.
+func (db DenomBalances) Validate(extendedDenom ExtendedDenom) error {
+	if err := db.Balances.validate(extendedDenom.ConversionFactor()); err != nil {
+		return fmt.Errorf("invalid balances: %w", err)
+	}
+
+	return validateRemainder(db.Balances, db.Remainder, extendedDenom.ConversionFactor())
+}
+
+// TotalAmountWithRemainder returns the total amount of the fractional balances
+// and the remainder.
+func (db DenomBalances) TotalAmountWithRemainder() sdkmath.Int {
+	return db.Balances.SumAmount().Add(db.Remainder)
+}
+
+// validateRemainder checks that the remainder is a valid amount and that the
+// fractional balances with the remainder add up to whole integer amounts.
+func validateRemainder(
+	balances FractionalBalances,
+	remainder sdkmath.Int,
+	conversionFactor sdkmath.Int,
+) error {
+	if remainder.IsNil() {
 		return fmt.Errorf("nil remainder amount")
 	}
 
 	// Validate remainder, 0 <= remainder <= maxFractionalAmount
-	if gs.Remainder.IsNegative() {
-		return fmt.Errorf("negative remainder amount %s", gs.Remainder)
+	if remainder.IsNegative() {
+		return fmt.Errorf("negative remainder amount %s", remainder)
 	}
 
-	if gs.Remainder.GTE(ConversionFactor()) {
-		return fmt.Errorf("remainder %v exceeds max of %v", gs.Remainder, ConversionFactor().SubRaw(1))
+	if remainder.GTE(conversionFactor) {
+		return fmt.Errorf("remainder %v exceeds max of %v", remainder, conversionFactor.SubRaw(1))
 	}
 
 	// Determine if sum(fractionalBalances) + remainder = whole integer value
 	// i.e total of all fractional balances + remainder == 0 fractional digits
-	sum := gs.Balances.SumAmount()
-	sumWithRemainder := sum.Add(gs.Remainder)
+	sum := balances.SumAmount()
+	sumWithRemainder := sum.Add(remainder)
 
-	offBy := sumWithRemainder.Mod(ConversionFactor())
+	offBy := sumWithRemainder.Mod(conversionFactor)
 
 	if !offBy.IsZero() {
 		return fmt.Errorf(
 			"sum of fractional balances %v + remainder %v is not a multiple of %v",
 			sum,
-			gs.Remainder,
-			ConversionFactor(),
+			remainder,
+			conversionFactor,
 		)
 	}
 
 	return nil
 }
-
-// TotalAmountWithRemainder returns the total amount of all balances in the
-// genesis state, including both fractional balances and the remainder. A bit
-// more verbose WithRemainder to ensure its clearly different from SumAmount().
-func (gs *GenesisState) TotalAmountWithRemainder() sdkmath.Int {
-	return gs.Balances.SumAmount().Add(gs.Remainder)
-}
@@ -124,6 +124,106 @@ func TestGenesisStateValidate_Basic(t *testing.T) {
 	}
 }
 
+func TestGenesisStateValidate_DenomBalances(t *testing.T) {
+	extendedDenom := types.NewExtendedDenom("ufoo", "afoo", 8)
+	params := types.NewParams([]types.ExtendedDenom{extendedDenom})
+
+	testCases := []struct {
+		name          string
+		params        types.Params
+		denomBalances []types.DenomBalances
+		wantErr       string
+	}{
+		{
+			"valid - no denom balances",
+			params,
+			nil,
+			"",
+		},
+		{
+			"valid - balances add up with remainder",
+			params,
+			[]types.DenomBalances{
+				types.NewDenomBalances(
+					"afoo",
+					types.FractionalBalances{
+						types.NewFractionalBalance(sdk.AccAddress{1}.String(), sdkmath.NewInt(1)),
+					},
+					extendedDenom.ConversionFactor().SubRaw(1),
+				),
+			},
+			"",
+		},
+		{
+			"invalid - params",
+			types.NewParams([]types.ExtendedDenom{types.NewExtendedDenom("ufoo", "ufoo", 8)}),
+			nil,
+			"invalid params: invalid extended denom ufoo: extended denom must be different from denom ufoo",
+		},
+		{
+			"invalid - denom not in params",
+			params,
+			[]types.DenomBalances{
+				types.NewDenomBalances("abar", types.FractionalBalances{}, sdkmath.ZeroInt()),
+			},
+			"denom balances for abar, which is not an extended denom of the params",
+		},
+		{
+			"invalid - duplicate denom balances",
+			params,
+			[]types.DenomBalances{
+				types.NewDenomBalances("afoo", types.FractionalBalances{}, sdkmath.ZeroInt()),
+				types.NewDenomBalances("afoo", types.FractionalBalances{}, sdkmath.ZeroInt()),
+			},
+			"duplicate denom balances for afoo",
+		},
+		{
+			"invalid - balance exceeds conversion factor of the denom",
+			params,
+			[]types.DenomBalances{
+				types.NewDenomBalances(
+					"afoo",
+					types.FractionalBalances{
+						types.NewFractionalBalance(sdk.AccAddress{1}.String(), extendedDenom.ConversionFactor()),
+					},
+					sdkmath.ZeroInt(),
+				),
+			},
+			"invalid denom balances for afoo: invalid balances: invalid fractional balance for cosmos1qyfkm2y3: amount 10000000000 exceeds max of 9999999999",
+		},
+		{
+			"invalid - balances don't add up",
+			params,
+			[]types.DenomBalances{
+				types.NewDenomBalances(
+					"afoo",
+					types.FractionalBalances{
+						types.NewFractionalBalance(sdk.AccAddress{1}.String(), sdkmath.NewInt(1)),
+					},
+					sdkmath.ZeroInt(),
+				),
+			},
+			"invalid denom balances for afoo: sum of fractional balances 1 + remainder 0 is not a multiple of 10000000000",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			gs := types.DefaultGenesisState()
+			gs.Params = tc.params
+			gs.DenomBalances = tc.denomBalances
+
+			err := gs.Validate()
+
+			if tc.wantErr == "" {
+				require.NoError(tt, err)
+			} else {
+				require.EqualError(tt, err, tc.wantErr)
+			}
+		})
+	}
+}
+
 func TestGenesisStateValidate_Total(t *testing.T) {
 	testCases := []struct {
 		name              string
@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName name that will be used throughout the module
@@ -14,15 +17,27 @@ const (
 
 // key prefixes for store
 var (
-	FractionalBalancePrefix = []byte{0x01} // address -> fractional balance
+	FractionalBalancePrefix = []byte{0x03} // denom -> address -> fractional balance
+	RemainderBalancePrefix  = []byte{0x04} // denom -> fractional balance remainder
 )
 
 // Keys for store that are not prefixed
 var (
-	RemainderBalanceKey = []byte{0x02} // fractional balance remainder
+	ParamsKey = []byte{0x05} // module params
 )
 
+// FractionalBalanceDenomPrefix returns the store prefix of the fractional
+// balances of an extended denom.
+func FractionalBalanceDenomPrefix(denom string) []byte {
+	return append(append([]byte{}, FractionalBalancePrefix...), address.MustLengthPrefix([]byte(denom))...)
+}
+
 // FractionalBalanceKey returns a key from an address
 func FractionalBalanceKey(address sdk.AccAddress) []byte {
 	return address.Bytes()
 }
+
+// RemainderBalanceKey returns the key of the remainder of an extended denom.
+func RemainderBalanceKey(denom string) []byte {
+	return append(append([]byte{}, RemainderBalancePrefix...), []byte(denom)...)
+}
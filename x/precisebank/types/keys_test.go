@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -17,3 +18,15 @@ func TestFractionalBalanceKey(t *testing.T) {
 	require.Equal(t, addr.Bytes(), key)
 	require.Equal(t, addr, sdk.AccAddress(key), "key should be able to be converted back to address")
 }
+
+func TestFractionalBalanceDenomPrefix(t *testing.T) {
+	prefix := types.FractionalBalanceDenomPrefix("afoo")
+	require.Equal(t, append([]byte{0x03, 4}, []byte("afoo")...), prefix)
+
+	// Denoms that are a prefix of another denom have distinct prefixes
+	require.False(t, bytes.HasPrefix(types.FractionalBalanceDenomPrefix("afoobar"), prefix))
+}
+
+func TestRemainderBalanceKey(t *testing.T) {
+	require.Equal(t, append([]byte{0x04}, []byte("afoo")...), types.RemainderBalanceKey("afoo"))
+}
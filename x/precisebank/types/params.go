@@ -0,0 +1,48 @@
+package types
+
+import "fmt"
+
+// NewParams creates a new Params object
+func NewParams(extendedDenoms []ExtendedDenom) Params {
+	return Params{
+		ExtendedDenoms: extendedDenoms,
+	}
+}
+
+// DefaultParams returns the default precisebank parameters. Only the EVM denom
+// is extended by default.
+func DefaultParams() Params {
+	return NewParams(nil)
+}
+
+// Validate performs a stateless validation of the precisebank parameters. The
+// integer and extended denoms must be unique across all the extended denoms.
+func (p Params) Validate() error {
+	seenDenoms := make(map[string]struct{})
+
+	for _, d := range p.ExtendedDenoms {
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("invalid extended denom %s: %w", d.ExtendedDenom, err)
+		}
+
+		for _, denom := range []string{d.Denom, d.ExtendedDenom} {
+			if _, found := seenDenoms[denom]; found {
+				return fmt.Errorf("duplicate denom %s", denom)
+			}
+			seenDenoms[denom] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// GetExtendedDenom returns the extended denom of the params with the given
+// extended denomination.
+func (p Params) GetExtendedDenom(extendedDenom string) (ExtendedDenom, bool) {
+	for _, d := range p.ExtendedDenoms {
+		if d.ExtendedDenom == extendedDenom {
+			return d, true
+		}
+	}
+	return ExtendedDenom{}, false
+}
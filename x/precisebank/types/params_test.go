@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/precisebank/types"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		wantErr string
+	}{
+		{
+			"valid - default params",
+			types.DefaultParams(),
+			"",
+		},
+		{
+			"valid - multiple extended denoms",
+			types.NewParams([]types.ExtendedDenom{
+				types.NewExtendedDenom("ufoo", "afoo", 6),
+				types.NewExtendedDenom("nbar", "abar", 9),
+			}),
+			"",
+		},
+		{
+			"invalid - denom",
+			types.NewParams([]types.ExtendedDenom{types.NewExtendedDenom("", "afoo", 6)}),
+			"invalid extended denom afoo: invalid denom: invalid denom: ",
+		},
+		{
+			"invalid - extended denom",
+			types.NewParams([]types.ExtendedDenom{types.NewExtendedDenom("ufoo", "1foo", 6)}),
+			"invalid extended denom 1foo: invalid extended denom: invalid denom: 1foo",
+		},
+		{
+			"invalid - zero decimals",
+			types.NewParams([]types.ExtendedDenom{types.NewExtendedDenom("ufoo", "afoo", 0)}),
+			"invalid extended denom afoo: decimals of ufoo must be between 1 and 17, got 0",
+		},
+		{
+			"invalid - 18 decimals",
+			types.NewParams([]types.ExtendedDenom{types.NewExtendedDenom("ufoo", "afoo", 18)}),
+			"invalid extended denom afoo: decimals of ufoo must be between 1 and 17, got 18",
+		},
+		{
+			"invalid - duplicate extended denom",
+			types.NewParams([]types.ExtendedDenom{
+				types.NewExtendedDenom("ufoo", "afoo", 6),
+				types.NewExtendedDenom("nfoo", "afoo", 9),
+			}),
+			"duplicate denom afoo",
+		},
+		{
+			"invalid - extended denom of another integer denom",
+			types.NewParams([]types.ExtendedDenom{
+				types.NewExtendedDenom("ufoo", "afoo", 6),
+				types.NewExtendedDenom("afoo", "abar", 9),
+			}),
+			"duplicate denom afoo",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestExtendedDenom_ConversionFactor(t *testing.T) {
+	require.Equal(t, sdkmath.NewInt(1e12), types.NewExtendedDenom("ufoo", "afoo", 6).ConversionFactor())
+	require.Equal(t, sdkmath.NewInt(1e9), types.NewExtendedDenom("nfoo", "afoo", 9).ConversionFactor())
+	require.Equal(t, sdkmath.NewInt(10), types.NewExtendedDenom("foo", "afoo", 17).ConversionFactor())
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,15 +31,95 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c5456889057ce50, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC
+// method.
+type QueryParamsResponse struct {
+	// params are the precisebank module parameters
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c5456889057ce50, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
 // QueryRemainderRequest defines the request type for Query/Remainder method.
 type QueryRemainderRequest struct {
+	// denom is the extended denom to query the remainder for. Defaults to the
+	// extended EVM denom if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryRemainderRequest) Reset()         { *m = QueryRemainderRequest{} }
 func (m *QueryRemainderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRemainderRequest) ProtoMessage()    {}
 func (*QueryRemainderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c5456889057ce50, []int{0}
+	return fileDescriptor_8c5456889057ce50, []int{2}
 }
 func (m *QueryRemainderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -78,7 +159,7 @@ func (m *QueryRemainderResponse) Reset()         { *m = QueryRemainderResponse{}
 func (m *QueryRemainderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRemainderResponse) ProtoMessage()    {}
 func (*QueryRemainderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c5456889057ce50, []int{1}
+	return fileDescriptor_8c5456889057ce50, []int{3}
 }
 func (m *QueryRemainderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -112,13 +193,16 @@ var xxx_messageInfo_QueryRemainderResponse proto.InternalMessageInfo
 type QueryFractionalBalanceRequest struct {
 	// address is the account address to query  fractional balance for.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the extended denom to query the fractional balance for. Defaults
+	// to the extended EVM denom if empty.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryFractionalBalanceRequest) Reset()         { *m = QueryFractionalBalanceRequest{} }
 func (m *QueryFractionalBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFractionalBalanceRequest) ProtoMessage()    {}
 func (*QueryFractionalBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c5456889057ce50, []int{2}
+	return fileDescriptor_8c5456889057ce50, []int{4}
 }
 func (m *QueryFractionalBalanceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,7 +242,7 @@ func (m *QueryFractionalBalanceResponse) Reset()         { *m = QueryFractionalB
 func (m *QueryFractionalBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFractionalBalanceResponse) ProtoMessage()    {}
 func (*QueryFractionalBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c5456889057ce50, []int{3}
+	return fileDescriptor_8c5456889057ce50, []int{5}
 }
 func (m *QueryFractionalBalanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,6 +272,8 @@ func (m *QueryFractionalBalanceResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryFractionalBalanceResponse proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.precisebank.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.precisebank.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryRemainderRequest)(nil), "cosmos.evm.precisebank.v1.QueryRemainderRequest")
 	proto.RegisterType((*QueryRemainderResponse)(nil), "cosmos.evm.precisebank.v1.QueryRemainderResponse")
 	proto.RegisterType((*QueryFractionalBalanceRequest)(nil), "cosmos.evm.precisebank.v1.QueryFractionalBalanceRequest")
@@ -199,34 +285,40 @@ func init() {
 }
 
 var fileDescriptor_8c5456889057ce50 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x2f, 0x28, 0x4a, 0x4d, 0xce, 0x2c, 0x4e, 0x4d, 0x4a,
-	0xcc, 0xcb, 0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x84, 0x28, 0xd3, 0x4b, 0x2d, 0xcb, 0xd5, 0x43, 0x52, 0xa6, 0x57, 0x66, 0x28,
-	0x25, 0x07, 0x35, 0x21, 0x29, 0xb1, 0x38, 0x55, 0xbf, 0xcc, 0x30, 0x29, 0xb5, 0x24, 0xd1, 0x50,
-	0x3f, 0x39, 0x3f, 0x33, 0x0f, 0xa2, 0x55, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07,
-	0xb1, 0xa0, 0xa2, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89,
-	0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x10, 0x59, 0x25, 0x71, 0x2e, 0xd1,
-	0x40, 0x90, 0xed, 0x41, 0xa9, 0xb9, 0x89, 0x99, 0x79, 0x29, 0xa9, 0x45, 0x41, 0xa9, 0x85, 0xa5,
-	0xa9, 0xc5, 0x25, 0x4a, 0xe1, 0x5c, 0x62, 0xe8, 0x12, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42,
-	0xb6, 0x5c, 0x9c, 0x45, 0x30, 0x41, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x49, 0x3d, 0xa8,
-	0xab, 0x41, 0x4e, 0xd3, 0x83, 0x3a, 0x4d, 0xcf, 0x39, 0x3f, 0x33, 0xcf, 0x89, 0xe5, 0xc4, 0x3d,
-	0x79, 0x86, 0x20, 0x84, 0x0e, 0x25, 0x4b, 0x2e, 0x59, 0xb0, 0xc1, 0x6e, 0x45, 0x89, 0xc9, 0x20,
-	0x97, 0x24, 0xe6, 0x38, 0x25, 0xe6, 0x24, 0xe6, 0x25, 0xa7, 0x42, 0x6d, 0x16, 0x92, 0xe0, 0x62,
-	0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x06, 0x9b, 0xce, 0x19, 0x04, 0xe3, 0x2a, 0x15, 0x70,
-	0xc9, 0xe1, 0xd2, 0x0a, 0x75, 0x9b, 0x1f, 0x97, 0x50, 0x1a, 0x5c, 0x32, 0x3e, 0x09, 0x22, 0x4b,
-	0xac, 0x23, 0x05, 0xd3, 0xd0, 0xcd, 0x35, 0xfa, 0xc2, 0xc4, 0xc5, 0x0a, 0xb6, 0x52, 0x68, 0x1e,
-	0x23, 0x17, 0x27, 0x3c, 0x2c, 0x84, 0x0c, 0xf4, 0x70, 0x46, 0x93, 0x1e, 0xd6, 0xf0, 0x94, 0x32,
-	0x24, 0x41, 0x07, 0xc4, 0x33, 0x4a, 0x3a, 0x4d, 0x97, 0x9f, 0x4c, 0x66, 0x52, 0x13, 0x52, 0xd1,
-	0xc7, 0x9d, 0x74, 0xe0, 0xe1, 0x2a, 0x74, 0x92, 0x91, 0x4b, 0x10, 0x23, 0x60, 0x84, 0x2c, 0x08,
-	0x59, 0x8b, 0x2b, 0x1a, 0xa4, 0x2c, 0xc9, 0xd0, 0x09, 0x75, 0xb8, 0x3d, 0xd8, 0xe1, 0x96, 0x42,
-	0xe6, 0x78, 0x1c, 0x8e, 0x19, 0x4d, 0xfa, 0xd5, 0xd0, 0x78, 0xae, 0x75, 0x72, 0x3f, 0xf1, 0x50,
-	0x8e, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x34, 0xd3, 0x33, 0x4b,
-	0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0x91, 0x2d, 0xa8, 0x40, 0xb1, 0xa2, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0x9c, 0xca, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x79, 0x05, 0x2f,
-	0x39, 0x7d, 0x03, 0x00, 0x00,
+	// 520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0xe3, 0x69, 0x2b, 0xaa, 0x39, 0xd5, 0x14, 0xb4, 0x45, 0x60, 0x58, 0x78, 0x11, 0x03,
+	0x66, 0x93, 0x71, 0x80, 0x1d, 0x10, 0x52, 0x41, 0x70, 0xe3, 0x25, 0x17, 0x24, 0x38, 0x20, 0x27,
+	0xf5, 0x42, 0x44, 0x63, 0x67, 0x71, 0x1a, 0x31, 0x21, 0x2e, 0xdc, 0xb8, 0x21, 0xb8, 0x73, 0xe6,
+	0xc8, 0xc7, 0x28, 0xb7, 0x49, 0x5c, 0x38, 0x21, 0x68, 0x91, 0xf8, 0x1a, 0x28, 0xb6, 0xdb, 0x75,
+	0xad, 0xda, 0x8d, 0x5d, 0x22, 0xfb, 0x79, 0xfb, 0xff, 0xfc, 0x3c, 0x8f, 0x02, 0x2f, 0x46, 0x52,
+	0xa5, 0x52, 0x51, 0x5e, 0xa6, 0x34, 0xcb, 0x79, 0x94, 0x28, 0x1e, 0x32, 0xf1, 0x8a, 0x96, 0x3e,
+	0xdd, 0xee, 0xf2, 0x7c, 0x87, 0x64, 0xb9, 0x2c, 0x24, 0x5a, 0x31, 0x61, 0x84, 0x97, 0x29, 0x19,
+	0x0b, 0x23, 0xa5, 0xef, 0x36, 0x58, 0x9a, 0x08, 0x49, 0xf5, 0xd7, 0x44, 0xbb, 0xd8, 0x16, 0x0d,
+	0x99, 0xe2, 0xb4, 0xf4, 0x43, 0x5e, 0x30, 0x9f, 0x46, 0x32, 0x11, 0xd6, 0x7f, 0x75, 0xb6, 0xe8,
+	0x78, 0x71, 0x13, 0xdc, 0x8c, 0x65, 0x2c, 0xf5, 0x91, 0x56, 0x27, 0x6b, 0x3d, 0x1d, 0x4b, 0x19,
+	0x77, 0x38, 0x65, 0x59, 0x42, 0x99, 0x10, 0xb2, 0x60, 0x45, 0x22, 0x85, 0x32, 0x5e, 0xaf, 0x09,
+	0xd1, 0x93, 0x8a, 0xfe, 0x31, 0xcb, 0x59, 0xaa, 0x02, 0xbe, 0xdd, 0xe5, 0xaa, 0xf0, 0x9e, 0xc3,
+	0x13, 0xfb, 0xac, 0x2a, 0x93, 0x42, 0x71, 0x74, 0x0f, 0xd6, 0x32, 0x6d, 0x59, 0x06, 0xe7, 0xc0,
+	0xe5, 0xe3, 0x1b, 0xab, 0x64, 0xe6, 0x63, 0x89, 0x49, 0x6d, 0xd5, 0x7b, 0x3f, 0xcf, 0x3a, 0x5f,
+	0xfe, 0x7e, 0xbd, 0x02, 0x02, 0x9b, 0xeb, 0xad, 0xc3, 0x93, 0xba, 0x78, 0xc0, 0x53, 0x96, 0x88,
+	0x36, 0xcf, 0xad, 0x2a, 0x6a, 0xc2, 0xa5, 0x36, 0x17, 0x32, 0xd5, 0xd5, 0xeb, 0x81, 0xb9, 0x78,
+	0x4f, 0xe1, 0xa9, 0xc9, 0x70, 0x8b, 0x73, 0x1b, 0xd6, 0xf3, 0xa1, 0xd1, 0x12, 0xad, 0x0c, 0x89,
+	0xaa, 0x86, 0x12, 0xdb, 0x50, 0x72, 0x57, 0x26, 0xa2, 0xb5, 0x58, 0x91, 0x04, 0x7b, 0x19, 0xde,
+	0x23, 0x78, 0x46, 0x17, 0xbe, 0x9f, 0xb3, 0xa8, 0x6a, 0x09, 0xeb, 0xb4, 0x58, 0x87, 0x89, 0x88,
+	0x0f, 0x79, 0x96, 0xe1, 0x31, 0xd6, 0x6e, 0xe7, 0x5c, 0x29, 0x4b, 0x34, 0xbc, 0xee, 0x91, 0x2e,
+	0x8c, 0x93, 0x66, 0x10, 0xcf, 0x2a, 0x68, 0x89, 0x1f, 0x42, 0xb4, 0x35, 0x72, 0xbe, 0x08, 0x8d,
+	0xf7, 0xb0, 0xe8, 0x8d, 0xad, 0xc9, 0xba, 0x1b, 0xef, 0x17, 0xe1, 0x92, 0x96, 0x44, 0x1f, 0x01,
+	0xac, 0x99, 0x96, 0xa3, 0xf5, 0x39, 0x53, 0x99, 0x9e, 0xb5, 0x4b, 0x0e, 0x1b, 0x6e, 0xde, 0xe0,
+	0xad, 0xbd, 0xfb, 0xfe, 0xe7, 0xd3, 0xc2, 0x79, 0xb4, 0x4a, 0xe7, 0xec, 0xa6, 0x21, 0xf9, 0x0c,
+	0x60, 0x7d, 0x34, 0x36, 0x74, 0xfd, 0x20, 0xa1, 0xc9, 0x85, 0x70, 0xfd, 0xff, 0xc8, 0xb0, 0x74,
+	0xd7, 0x34, 0xdd, 0x25, 0x74, 0x61, 0x0e, 0xdd, 0x68, 0x05, 0xd0, 0x37, 0x00, 0x1b, 0x53, 0xd3,
+	0x42, 0xb7, 0x0e, 0x92, 0x9d, 0xb5, 0x31, 0xee, 0xe6, 0x11, 0x32, 0x2d, 0xf8, 0x1d, 0x0d, 0xbe,
+	0x89, 0x6e, 0xce, 0x01, 0x9f, 0xde, 0x1d, 0xfa, 0xc6, 0xae, 0xe4, 0xdb, 0xd6, 0x83, 0xde, 0x6f,
+	0xec, 0xf4, 0xfa, 0x18, 0xec, 0xf6, 0x31, 0xf8, 0xd5, 0xc7, 0xe0, 0xc3, 0x00, 0x3b, 0xbb, 0x03,
+	0xec, 0xfc, 0x18, 0x60, 0xe7, 0xd9, 0x5a, 0x9c, 0x14, 0x2f, 0xbb, 0x21, 0x89, 0x64, 0x3a, 0x2e,
+	0xf0, 0x7a, 0x9f, 0x44, 0xb1, 0x93, 0x71, 0x15, 0xd6, 0xf4, 0x9f, 0xe1, 0xc6, 0xbf, 0x01, 0x00,
+	0xfe, 0xaa, 0xe6, 0x1d, 0xf1, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -241,6 +333,8 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params retrieves the precisebank module params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Remainder returns the amount backed by the reserve, but not yet owned by
 	// any account, i.e. not in circulation.
 	Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error)
@@ -257,6 +351,15 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.precisebank.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error) {
 	out := new(QueryRemainderResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.precisebank.v1.Query/Remainder", in, out, opts...)
@@ -277,6 +380,8 @@ func (c *queryClient) FractionalBalance(ctx context.Context, in *QueryFractional
 
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	// Params retrieves the precisebank module params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Remainder returns the amount backed by the reserve, but not yet owned by
 	// any account, i.e. not in circulation.
 	Remainder(context.Context, *QueryRemainderRequest) (*QueryRemainderResponse, error)
@@ -289,6 +394,9 @@ type QueryServer interface {
 type UnimplementedQueryServer struct {
 }
 
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (*UnimplementedQueryServer) Remainder(ctx context.Context, req *QueryRemainderRequest) (*QueryRemainderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Remainder not implemented")
 }
@@ -300,6 +408,24 @@ func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.precisebank.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Remainder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRemainderRequest)
 	if err := dec(in); err != nil {
@@ -340,6 +466,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.precisebank.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "Remainder",
 			Handler:    _Query_Remainder_Handler,
@@ -353,6 +483,62 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 	Metadata: "cosmos/evm/precisebank/v1/query.proto",
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryRemainderRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -373,6 +559,13 @@ func (m *QueryRemainderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -429,6 +622,13 @@ func (m *QueryFractionalBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -483,12 +683,36 @@ func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryRemainderRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -513,6 +737,10 @@ func (m *QueryFractionalBalanceRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -533,6 +761,139 @@ func sovQuery(x uint64) (n int) {
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryRemainderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -562,6 +923,38 @@ func (m *QueryRemainderRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryRemainderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -727,6 +1120,38 @@ func (m *QueryFractionalBalanceRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,45 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/x/tokenfactory/keeper"
+	"github.com/cosmos/evm/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accountKeeper authkeeper.AccountKeeper,
+	data types.GenesisState,
+) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(fmt.Errorf("error setting params %s", err))
+	}
+
+	// ensure tokenfactory module account is set on genesis
+	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
+		// NOTE: shouldn't occur
+		panic("the tokenfactory module account has not been set")
+	}
+
+	// NOTE: the bank metadata and the ERC20 token pairs of the denoms are
+	// part of the x/bank and x/erc20 genesis states.
+	for _, denom := range data.FactoryDenoms {
+		if err := k.SetFactoryDenom(ctx, denom.Denom, denom.AuthorityMetadata); err != nil {
+			panic(fmt.Errorf("error setting token factory denom %s", err))
+		}
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:        k.GetParams(ctx),
+		FactoryDenoms: k.GetAllFactoryDenoms(ctx),
+	}
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/tokenfactory/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateFactoryDenom creates the factory/{creator}/{subdenom} denom with the creator
+// as admin, charges the denom creation fee and registers the denom as an
+// ERC20 token pair backed by a dynamic ERC20 precompile.
+func (k Keeper) CreateFactoryDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, common.Address, error) {
+	denom, err := types.GetTokenDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", common.Address{}, err
+	}
+
+	if _, found := k.GetAuthorityMetadata(ctx, denom); found {
+		return "", common.Address{}, errorsmod.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found || k.bankKeeper.HasSupply(ctx, denom) {
+		return "", common.Address{}, errorsmod.Wrapf(types.ErrDenomExists, "denom %s already exists in the bank module", denom)
+	}
+
+	erc20Addr := types.GetERC20Address(denom)
+	if k.erc20Keeper.IsDenomRegistered(ctx, denom) || k.erc20Keeper.IsERC20Registered(ctx, erc20Addr) {
+		return "", common.Address{}, errorsmod.Wrapf(types.ErrTokenPairExists, "denom %s", denom)
+	}
+
+	params := k.GetParams(ctx)
+	if !params.DenomCreationFee.IsZero() {
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, params.DenomCreationFee, creator); err != nil {
+			return "", common.Address{}, errorsmod.Wrap(err, "failed to pay the denom creation fee")
+		}
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, types.NewDenomMetadata(denom, denom, subdenom, 0))
+
+	if err := k.SetFactoryDenom(ctx, denom, types.DenomAuthorityMetadata{Admin: creator.String()}); err != nil {
+		return "", common.Address{}, err
+	}
+
+	pair := erc20types.NewTokenPair(erc20Addr, denom, erc20types.OWNER_MODULE)
+	k.erc20Keeper.SetToken(ctx, pair)
+	if err := k.erc20Keeper.EnableDynamicPrecompiles(ctx, erc20Addr); err != nil {
+		return "", common.Address{}, err
+	}
+
+	return denom, erc20Addr, nil
+}
+
+// GetAuthorityMetadata returns the authority metadata of a token factory denom.
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomAuthorityMetadata)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.DenomAuthorityMetadata{}, false
+	}
+
+	var metadata types.DenomAuthorityMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, true
+}
+
+// SetAuthorityMetadata stores the authority metadata of a token factory denom.
+func (k Keeper) SetAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomAuthorityMetadata)
+	store.Set([]byte(denom), k.cdc.MustMarshal(&metadata))
+}
+
+// IterateAuthorityMetadata iterates over all the token factory denoms and
+// their authority metadata.
+func (k Keeper) IterateAuthorityMetadata(ctx sdk.Context, cb func(denom string, metadata types.DenomAuthorityMetadata) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixDenomAuthorityMetadata)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.DenomAuthorityMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+
+		denom := string(iterator.Key()[len(types.KeyPrefixDenomAuthorityMetadata):])
+		if cb(denom, metadata) {
+			break
+		}
+	}
+}
+
+// GetAllFactoryDenoms returns all the token factory denoms with their
+// authority metadata.
+func (k Keeper) GetAllFactoryDenoms(ctx sdk.Context) []types.GenesisDenom {
+	denoms := []types.GenesisDenom{}
+	k.IterateAuthorityMetadata(ctx, func(denom string, metadata types.DenomAuthorityMetadata) bool {
+		denoms = append(denoms, types.GenesisDenom{Denom: denom, AuthorityMetadata: metadata})
+		return false
+	})
+	return denoms
+}
+
+// setCreatorDenom adds the denom to the denoms created by the given creator.
+func (k Keeper) setCreatorDenom(ctx sdk.Context, creator sdk.AccAddress, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCreatorDenoms)
+	store.Set(types.CreatorDenomKey(creator, denom), []byte{1})
+}
+
+// validateAdmin checks that the sender is the admin of the given token factory
+// denom.
+func (k Keeper) validateAdmin(ctx sdk.Context, sender, denom string) error {
+	metadata, found := k.GetAuthorityMetadata(ctx, denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrDenomNotFound, "denom %s", denom)
+	}
+	if metadata.Admin == "" || metadata.Admin != sender {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "sender %s, admin %s", sender, metadata.Admin)
+	}
+	return nil
+}
+
+// SetFactoryDenom stores an existing token factory denom with its authority
+// metadata, indexing it by its creator.
+func (k Keeper) SetFactoryDenom(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) error {
+	creator, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return err
+	}
+
+	k.SetAuthorityMetadata(ctx, denom, metadata)
+	k.setCreatorDenom(ctx, creatorAddr, denom)
+	return nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/evm/x/tokenfactory/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the tokenfactory module params
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// DenomAuthorityMetadata returns the authority metadata of a token factory denom
+func (k Keeper) DenomAuthorityMetadata(c context.Context, req *types.QueryDenomAuthorityMetadataRequest) (*types.QueryDenomAuthorityMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	metadata, found := k.GetAuthorityMetadata(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token factory denom '%s'", req.Denom)
+	}
+
+	return &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: metadata}, nil
+}
+
+// DenomsFromCreator returns the denoms created by an address
+func (k Keeper) DenomsFromCreator(c context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	creator, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address %s: %s", req.Creator, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var denoms []string
+	store := prefix.NewStore(prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCreatorDenoms), types.CreatorDenomsPrefix(creator))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		denoms = append(denoms, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsFromCreatorResponse{
+		Denoms:     denoms,
+		Pagination: pageRes,
+	}, nil
+}
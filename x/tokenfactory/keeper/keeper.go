@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/x/tokenfactory/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the tokenfactory module, which maintains the authority metadata of
+// the denoms created through the token factory.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	bankKeeper          types.BankKeeper
+	communityPoolKeeper types.CommunityPoolKeeper
+	erc20Keeper         types.ERC20Keeper
+}
+
+// NewKeeper creates new instances of the tokenfactory Keeper
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
+	bk types.BankKeeper,
+	ck types.CommunityPoolKeeper,
+	erc20Keeper types.ERC20Keeper,
+) Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		storeKey:            storeKey,
+		cdc:                 cdc,
+		authority:           authority,
+		bankKeeper:          bk,
+		communityPoolKeeper: ck,
+		erc20Keeper:         erc20Keeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
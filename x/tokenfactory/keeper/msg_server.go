@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/evm/x/tokenfactory/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// CreateDenom implements the gRPC MsgServer interface. Any account can create
+// a denom under its own factory/{creator} namespace.
+func (k *Keeper) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	denom, erc20Addr, err := k.CreateFactoryDenom(ctx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateDenom,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, erc20Addr.Hex()),
+		),
+	)
+
+	return &types.MsgCreateDenomResponse{
+		NewTokenDenom: denom,
+		Erc20Address:  erc20Addr.Hex(),
+	}, nil
+}
+
+// Mint implements the gRPC MsgServer interface. Only the admin of the denom
+// can mint new coins.
+func (k *Keeper) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAdmin(ctx, msg.Sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	mintTo := msg.MintToAddress
+	if mintTo == "" {
+		mintTo = msg.Sender
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(mintTo)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid mint to address")
+	}
+
+	if k.bankKeeper.BlockedAddr(receiver) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not allowed to receive funds", mintTo)
+	}
+
+	coins := sdk.Coins{msg.Amount}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeKeyMintToAddress, mintTo),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgMintResponse{}, nil
+}
+
+// Burn implements the gRPC MsgServer interface. Only the admin of the denom
+// can burn coins, and only from its own balance.
+func (k *Keeper) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAdmin(ctx, msg.Sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	coins := sdk.Coins{msg.Amount}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			sdk.NewAttribute(types.AttributeKeyBurnFromAddress, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// ChangeAdmin implements the gRPC MsgServer interface. The current admin can
+// transfer the administration of the denom or renounce it by setting an empty
+// admin.
+func (k *Keeper) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAdmin(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	k.SetAuthorityMetadata(ctx, msg.Denom, types.DenomAuthorityMetadata{Admin: msg.NewAdmin})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyNewAdmin, msg.NewAdmin),
+		),
+	)
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+// SetDenomMetadata implements the gRPC MsgServer interface. Only the admin of
+// the denom can update its bank metadata.
+func (k *Keeper) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateAdmin(ctx, msg.Sender, msg.Metadata.Base); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMetadata, err.Error())
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Metadata.Base),
+		),
+	)
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
+// proposal passes, it updates the module parameters. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
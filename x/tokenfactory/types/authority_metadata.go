@@ -0,0 +1,19 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a stateless validation of the denom authority metadata.
+// An empty admin is valid and means that the denom is no longer administered.
+func (m DenomAuthorityMetadata) Validate() error {
+	if m.Admin == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "invalid admin address %s: %s", m.Admin, err)
+	}
+	return nil
+}
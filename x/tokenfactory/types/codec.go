@@ -0,0 +1,65 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global tokenfactory module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding.
+	//
+	// The actual codec used for serialization should be provided to modules/tokenfactory and
+	// defined at the application level.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino) //nolint:staticcheck
+)
+
+const (
+	// Amino names
+	createDenomName      = "cosmos/evm/x/tokenfactory/MsgCreateDenom"
+	mintName             = "cosmos/evm/x/tokenfactory/MsgMint"
+	burnName             = "cosmos/evm/x/tokenfactory/MsgBurn"
+	changeAdminName      = "cosmos/evm/x/tokenfactory/MsgChangeAdmin"
+	setDenomMetadataName = "cosmos/evm/x/tokenfactory/MsgSetDenomMetadata"
+	updateParamsName     = "cosmos/evm/x/tokenfactory/MsgUpdateParams"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces register implementations
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateDenom{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgChangeAdmin{},
+		&MsgSetDenomMetadata{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/tokenfactory interfaces
+// and concrete types on the provided LegacyAmino codec. These types are used
+// for Amino JSON serialization and EIP-712 compatibility.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateDenom{}, createDenomName, nil)
+	cdc.RegisterConcrete(&MsgMint{}, mintName, nil)
+	cdc.RegisterConcrete(&MsgBurn{}, burnName, nil)
+	cdc.RegisterConcrete(&MsgChangeAdmin{}, changeAdminName, nil)
+	cdc.RegisterConcrete(&MsgSetDenomMetadata{}, setDenomMetadataName, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+}
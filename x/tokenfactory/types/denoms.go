@@ -0,0 +1,107 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// ModuleDenomPrefix is the prefix of all the denoms created by the token factory.
+	ModuleDenomPrefix = "factory"
+	// MaxSubdenomLength is the maximum length of the creator-scoped part of a denom.
+	MaxSubdenomLength = 44
+	// MaxCreatorLength is the maximum length of the bech32 creator address of a denom.
+	MaxCreatorLength = 75
+)
+
+// GetTokenDenom returns the factory/{creator}/{subdenom} denom for the given
+// creator and subdenom.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) == 0 {
+		return "", errorsmod.Wrap(ErrInvalidDenom, "subdenom cannot be empty")
+	}
+	if len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidDenom, "subdenom too long, max length is %d", MaxSubdenomLength)
+	}
+	if len(creator) > MaxCreatorLength {
+		return "", errorsmod.Wrapf(ErrInvalidDenom, "creator too long, max length is %d", MaxCreatorLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", errorsmod.Wrapf(ErrInvalidDenom, "invalid creator %s", creator)
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+	return denom, nil
+}
+
+// DeconstructDenom splits a token factory denom into its creator and subdenom.
+func DeconstructDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) < 3 || parts[0] != ModuleDenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "denom %s does not have the format %s/{creator}/{subdenom}", denom, ModuleDenomPrefix)
+	}
+
+	creator = parts[1]
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "invalid creator address %s: %s", creator, err)
+	}
+
+	subdenom = parts[2]
+	if len(subdenom) == 0 || len(subdenom) > MaxSubdenomLength {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "invalid subdenom %s", subdenom)
+	}
+
+	return creator, subdenom, nil
+}
+
+// GetERC20Address returns the address of the ERC20 precompile of a token
+// factory denom. Similar to IBC vouchers, it is derived from the SHA256 hash
+// of the denom.
+func GetERC20Address(denom string) common.Address {
+	return common.BytesToAddress(tmhash.Sum([]byte(denom)))
+}
+
+// NewDenomMetadata returns the bank metadata of a token factory denom. If
+// decimals is greater than zero, a display unit named after the lowercase
+// symbol is added with the given exponent.
+func NewDenomMetadata(denom, name, symbol string, decimals uint32) banktypes.Metadata {
+	metadata := banktypes.Metadata{
+		Base: denom,
+		// NOTE: Denom units MUST be increasing
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    denom,
+				Exponent: 0,
+			},
+		},
+		Name:    name,
+		Symbol:  symbol,
+		Display: denom,
+	}
+
+	if decimals > 0 {
+		display := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(symbol), " ", ""))
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+			Denom:    display,
+			Exponent: decimals,
+		})
+		metadata.Display = display
+	}
+
+	return metadata
+}
@@ -0,0 +1,133 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/utils"
+	"github.com/cosmos/evm/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type DenomsTestSuite struct {
+	suite.Suite
+}
+
+func TestDenomsTestSuite(t *testing.T) {
+	suite.Run(t, new(DenomsTestSuite))
+}
+
+func (suite *DenomsTestSuite) TestGetTokenDenom() {
+	creator := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+
+	testCases := []struct {
+		name     string
+		creator  string
+		subdenom string
+		expDenom string
+		expPass  bool
+	}{
+		{"valid", creator, "bitcoin", "factory/" + creator + "/bitcoin", true},
+		{"valid - subdenom with slashes", creator, "bit/coin", "factory/" + creator + "/bit/coin", true},
+		{"empty subdenom", creator, "", "", false},
+		{"subdenom too long", creator, strings.Repeat("a", types.MaxSubdenomLength+1), "", false},
+		{"creator too long", strings.Repeat("a", types.MaxCreatorLength+1), "bitcoin", "", false},
+		{"creator with slash", "cosmos/1", "bitcoin", "", false},
+		{"invalid characters", creator, "bit coin", "", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			denom, err := types.GetTokenDenom(tc.creator, tc.subdenom)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expDenom, denom)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *DenomsTestSuite) TestDeconstructDenom() {
+	creator := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+
+	testCases := []struct {
+		name        string
+		denom       string
+		expCreator  string
+		expSubdenom string
+		expPass     bool
+	}{
+		{"valid", "factory/" + creator + "/bitcoin", creator, "bitcoin", true},
+		{"valid - subdenom with slashes", "factory/" + creator + "/bit/coin", creator, "bit/coin", true},
+		{"missing subdenom", "factory/" + creator, "", "", false},
+		{"empty subdenom", "factory/" + creator + "/", "", "", false},
+		{"invalid prefix", "ibc/" + creator + "/bitcoin", "", "", false},
+		{"invalid creator", "factory/creator/bitcoin", "", "", false},
+		{"native denom", "aatom", "", "", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			creator, subdenom, err := types.DeconstructDenom(tc.denom)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expCreator, creator)
+				suite.Require().Equal(tc.expSubdenom, subdenom)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *DenomsTestSuite) TestGetERC20Address() {
+	creator := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	denom := "factory/" + creator + "/bitcoin"
+
+	addr := types.GetERC20Address(denom)
+	suite.Require().Equal(addr, types.GetERC20Address(denom), "address should be deterministic")
+	suite.Require().NotEqual(addr, types.GetERC20Address(denom+"2"))
+
+	// the derivation must not collide with the IBC voucher derivation
+	ibcAddr, err := utils.GetIBCDenomAddress("ibc/" + strings.Repeat("A", 64))
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(ibcAddr, addr)
+}
+
+func (suite *DenomsTestSuite) TestNewDenomMetadata() {
+	creator := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	denom := "factory/" + creator + "/bitcoin"
+
+	testCases := []struct {
+		name       string
+		symbol     string
+		decimals   uint32
+		expDisplay string
+		expPass    bool
+	}{
+		{"no decimals", "BTC", 0, denom, true},
+		{"with decimals", "BTC", 8, "btc", true},
+		{"symbol too short for a display unit", "X", 6, "x", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			metadata := types.NewDenomMetadata(denom, "Bitcoin", tc.symbol, tc.decimals)
+			suite.Require().Equal(denom, metadata.Base)
+			suite.Require().Equal(tc.expDisplay, metadata.Display)
+
+			err := metadata.Validate()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
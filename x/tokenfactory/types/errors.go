@@ -0,0 +1,16 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrDenomExists              = errorsmod.Register(ModuleName, 2, "denom already exists")
+	ErrDenomNotFound            = errorsmod.Register(ModuleName, 3, "denom not found")
+	ErrInvalidDenom             = errorsmod.Register(ModuleName, 4, "invalid token factory denom")
+	ErrUnauthorized             = errorsmod.Register(ModuleName, 5, "sender is not the denom admin")
+	ErrInvalidAuthorityMetadata = errorsmod.Register(ModuleName, 6, "invalid denom authority metadata")
+	ErrInvalidMetadata          = errorsmod.Register(ModuleName, 7, "invalid denom metadata")
+	ErrTokenPairExists          = errorsmod.Register(ModuleName, 8, "denom already registered as an ERC20 token pair")
+)
@@ -0,0 +1,18 @@
+package types
+
+// tokenfactory events
+const (
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeMint             = "tf_mint"
+	EventTypeBurn             = "tf_burn"
+	EventTypeChangeAdmin      = "change_admin"
+	EventTypeSetDenomMetadata = "set_denom_metadata"
+
+	AttributeKeyCreator         = "creator"
+	AttributeKeyDenom           = "denom"
+	AttributeKeyERC20Token      = "erc20_token" // #nosec
+	AttributeKeyAmount          = "amount"
+	AttributeKeyMintToAddress   = "mint_to_address"
+	AttributeKeyBurnFromAddress = "burn_from_address"
+	AttributeKeyNewAdmin        = "new_admin"
+)
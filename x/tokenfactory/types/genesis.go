@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, denoms []GenesisDenom) GenesisState {
+	return GenesisState{
+		Params:        params,
+		FactoryDenoms: denoms,
+	}
+}
+
+// DefaultGenesisState sets default tokenfactory genesis state with default
+// params and no denoms.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:        DefaultParams(),
+		FactoryDenoms: []GenesisDenom{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params on genesis: %w", err)
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, d := range gs.FactoryDenoms {
+		if seenDenoms[d.Denom] {
+			return fmt.Errorf("token factory denom duplicated on genesis: '%s'", d.Denom)
+		}
+
+		if _, _, err := DeconstructDenom(d.Denom); err != nil {
+			return err
+		}
+
+		if err := d.AuthorityMetadata.Validate(); err != nil {
+			return err
+		}
+
+		seenDenoms[d.Denom] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	creator := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	denom := "factory/" + creator + "/bitcoin"
+
+	newGen := types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+		{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator}},
+	})
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			name:     "valid genesis constructor",
+			genState: &newGen,
+			expPass:  true,
+		},
+		{
+			name:     "default",
+			genState: types.DefaultGenesisState(),
+			expPass:  true,
+		},
+		{
+			name: "valid genesis - renounced admin",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{Denom: denom},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator}},
+					{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator}},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - not a factory denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{Denom: "aatom", AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator}},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid admin",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: "admin"}},
+				},
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
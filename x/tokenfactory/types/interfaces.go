@@ -0,0 +1,40 @@
+package types
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BankKeeper defines the expected interface needed to mint, burn and move
+// token factory coins and to manage their metadata.
+type BankKeeper interface {
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
+	HasSupply(ctx context.Context, denom string) bool
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// CommunityPoolKeeper defines the expected interface needed to fund the
+// community pool with the denom creation fee.
+type CommunityPoolKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// ERC20Keeper defines the expected interface needed to register token factory
+// denoms as ERC20 token pairs.
+type ERC20Keeper interface {
+	IsDenomRegistered(ctx sdk.Context, denom string) bool
+	IsERC20Registered(ctx sdk.Context, erc20 common.Address) bool
+	SetToken(ctx sdk.Context, pair erc20types.TokenPair)
+	EnableDynamicPrecompiles(ctx sdk.Context, addresses ...common.Address) error
+}
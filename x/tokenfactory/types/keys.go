@@ -0,0 +1,38 @@
+package types
+
+// constants
+const (
+	// module name
+	ModuleName = "tokenfactory"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the tokenfactory persistent store
+const (
+	prefixParams = iota + 1
+	prefixDenomAuthorityMetadata
+	prefixCreatorDenoms
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixParams                 = []byte{prefixParams}
+	KeyPrefixDenomAuthorityMetadata = []byte{prefixDenomAuthorityMetadata}
+	KeyPrefixCreatorDenoms          = []byte{prefixCreatorDenoms}
+)
+
+// CreatorDenomsPrefix returns the store prefix of the denoms created by the
+// given creator.
+func CreatorDenomsPrefix(creator []byte) []byte {
+	return append([]byte{byte(len(creator))}, creator...)
+}
+
+// CreatorDenomKey returns the key of a denom in the creator denoms index.
+func CreatorDenomKey(creator []byte, denom string) []byte {
+	return append(CreatorDenomsPrefix(creator), []byte(denom)...)
+}
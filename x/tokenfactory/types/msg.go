@@ -0,0 +1,144 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	_ sdk.Msg              = &MsgCreateDenom{}
+	_ sdk.Msg              = &MsgMint{}
+	_ sdk.Msg              = &MsgBurn{}
+	_ sdk.Msg              = &MsgChangeAdmin{}
+	_ sdk.Msg              = &MsgSetDenomMetadata{}
+	_ sdk.Msg              = &MsgUpdateParams{}
+	_ sdk.HasValidateBasic = &MsgCreateDenom{}
+	_ sdk.HasValidateBasic = &MsgMint{}
+	_ sdk.HasValidateBasic = &MsgBurn{}
+	_ sdk.HasValidateBasic = &MsgChangeAdmin{}
+	_ sdk.HasValidateBasic = &MsgSetDenomMetadata{}
+	_ sdk.HasValidateBasic = &MsgUpdateParams{}
+)
+
+// NewMsgCreateDenom creates a new instance of MsgCreateDenom
+func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{
+		Sender:   sender,
+		Subdenom: subdenom,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgCreateDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	_, err := GetTokenDenom(m.Sender, m.Subdenom)
+	return err
+}
+
+// NewMsgMint creates a new instance of MsgMint
+func NewMsgMint(sender string, amount sdk.Coin, mintToAddress string) *MsgMint {
+	return &MsgMint{
+		Sender:        sender,
+		Amount:        amount,
+		MintToAddress: mintToAddress,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if m.MintToAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.MintToAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid mint to address")
+		}
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid amount %s", m.Amount)
+	}
+
+	_, _, err := DeconstructDenom(m.Amount.Denom)
+	return err
+}
+
+// NewMsgBurn creates a new instance of MsgBurn
+func NewMsgBurn(sender string, amount sdk.Coin) *MsgBurn {
+	return &MsgBurn{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid amount %s", m.Amount)
+	}
+
+	_, _, err := DeconstructDenom(m.Amount.Denom)
+	return err
+}
+
+// NewMsgChangeAdmin creates a new instance of MsgChangeAdmin
+func NewMsgChangeAdmin(sender, denom, newAdmin string) *MsgChangeAdmin {
+	return &MsgChangeAdmin{
+		Sender:   sender,
+		Denom:    denom,
+		NewAdmin: newAdmin,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgChangeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if m.NewAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(m.NewAdmin); err != nil {
+			return errorsmod.Wrap(err, "invalid new admin address")
+		}
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// NewMsgSetDenomMetadata creates a new instance of MsgSetDenomMetadata
+func NewMsgSetDenomMetadata(sender string, metadata banktypes.Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{
+		Sender:   sender,
+		Metadata: metadata,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgSetDenomMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if err := m.Metadata.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidMetadata, err.Error())
+	}
+
+	_, _, err := DeconstructDenom(m.Metadata.Base)
+	return err
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
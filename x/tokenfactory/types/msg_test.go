@@ -0,0 +1,224 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/tokenfactory/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+
+	sender string
+	denom  string
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) SetupTest() {
+	suite.sender = sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	suite.denom = "factory/" + suite.sender + "/bitcoin"
+}
+
+func (suite *MsgsTestSuite) TestMsgCreateDenom() {
+	testCases := []struct {
+		msg        string
+		sender     string
+		subdenom   string
+		expectPass bool
+	}{
+		{"valid", suite.sender, "bitcoin", true},
+		{"invalid sender", "sender", "bitcoin", false},
+		{"empty subdenom", suite.sender, "", false},
+		{"invalid subdenom", suite.sender, "bit coin", false},
+	}
+
+	for i, tc := range testCases {
+		err := types.NewMsgCreateDenom(tc.sender, tc.subdenom).ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgMint() {
+	testCases := []struct {
+		msg        string
+		sender     string
+		amount     sdk.Coin
+		mintTo     string
+		expectPass bool
+	}{
+		{"valid", suite.sender, sdk.NewCoin(suite.denom, math.NewInt(100)), "", true},
+		{"valid - mint to address", suite.sender, sdk.NewCoin(suite.denom, math.NewInt(100)), sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(), true},
+		{"invalid sender", "sender", sdk.NewCoin(suite.denom, math.NewInt(100)), "", false},
+		{"invalid mint to address", suite.sender, sdk.NewCoin(suite.denom, math.NewInt(100)), "receiver", false},
+		{"zero amount", suite.sender, sdk.NewCoin(suite.denom, math.ZeroInt()), "", false},
+		{"not a factory denom", suite.sender, sdk.NewCoin("aatom", math.NewInt(100)), "", false},
+	}
+
+	for i, tc := range testCases {
+		err := types.NewMsgMint(tc.sender, tc.amount, tc.mintTo).ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgBurn() {
+	testCases := []struct {
+		msg        string
+		sender     string
+		amount     sdk.Coin
+		expectPass bool
+	}{
+		{"valid", suite.sender, sdk.NewCoin(suite.denom, math.NewInt(100)), true},
+		{"invalid sender", "sender", sdk.NewCoin(suite.denom, math.NewInt(100)), false},
+		{"zero amount", suite.sender, sdk.NewCoin(suite.denom, math.ZeroInt()), false},
+		{"not a factory denom", suite.sender, sdk.NewCoin("aatom", math.NewInt(100)), false},
+	}
+
+	for i, tc := range testCases {
+		err := types.NewMsgBurn(tc.sender, tc.amount).ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgChangeAdmin() {
+	testCases := []struct {
+		msg        string
+		sender     string
+		denom      string
+		newAdmin   string
+		expectPass bool
+	}{
+		{"valid", suite.sender, suite.denom, sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(), true},
+		{"valid - renounce", suite.sender, suite.denom, "", true},
+		{"invalid sender", "sender", suite.denom, "", false},
+		{"invalid new admin", suite.sender, suite.denom, "admin", false},
+		{"not a factory denom", suite.sender, "aatom", "", false},
+	}
+
+	for i, tc := range testCases {
+		err := types.NewMsgChangeAdmin(tc.sender, tc.denom, tc.newAdmin).ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSetDenomMetadata() {
+	invalidMetadata := types.NewDenomMetadata(suite.denom, "", "BTC", 8)
+	nativeMetadata := types.NewDenomMetadata("aatom", "Atom", "ATOM", 18)
+
+	testCases := []struct {
+		msg        string
+		sender     string
+		expectPass bool
+		malleate   func() *types.MsgSetDenomMetadata
+	}{
+		{
+			"valid",
+			suite.sender,
+			true,
+			func() *types.MsgSetDenomMetadata {
+				return types.NewMsgSetDenomMetadata(suite.sender, types.NewDenomMetadata(suite.denom, "Bitcoin", "BTC", 8))
+			},
+		},
+		{
+			"invalid sender",
+			"sender",
+			false,
+			func() *types.MsgSetDenomMetadata {
+				return types.NewMsgSetDenomMetadata("sender", types.NewDenomMetadata(suite.denom, "Bitcoin", "BTC", 8))
+			},
+		},
+		{
+			"invalid metadata",
+			suite.sender,
+			false,
+			func() *types.MsgSetDenomMetadata {
+				return types.NewMsgSetDenomMetadata(suite.sender, invalidMetadata)
+			},
+		},
+		{
+			"not a factory denom",
+			suite.sender,
+			false,
+			func() *types.MsgSetDenomMetadata {
+				return types.NewMsgSetDenomMetadata(suite.sender, nativeMetadata)
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.malleate().ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateParams() {
+	testCases := []struct {
+		name      string
+		msgUpdate *types.MsgUpdateParams
+		expPass   bool
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgUpdateParams{Authority: "invalid"},
+			false,
+		},
+		{
+			"fail - invalid fee",
+			&types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.NewParams(sdk.Coins{{Denom: "aatom", Amount: math.NewInt(-1)}}),
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.NewParams(sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(100)))),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msgUpdate.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params object
+func NewParams(denomCreationFee sdk.Coins) Params {
+	return Params{
+		DenomCreationFee: denomCreationFee,
+	}
+}
+
+// DefaultParams returns the default tokenfactory parameters. Denom creation is
+// free by default.
+func DefaultParams() Params {
+	return Params{
+		DenomCreationFee: sdk.Coins{},
+	}
+}
+
+// Validate performs a stateless validation of the tokenfactory parameters.
+func (p Params) Validate() error {
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+	return nil
+}
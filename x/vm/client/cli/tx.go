@@ -13,6 +13,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/evm/utils"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	"github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/core/address"
@@ -76,7 +77,19 @@ func NewRawTxCmd() *cobra.Command {
 
 			baseDenom := types.GetEVMCoinDenom()
 
-			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), baseDenom)
+			// the fee amount is paid in the fee denom selected by the sender
+			var feeDenom *feemarkettypes.FeeDenom
+			if !clientCtx.Offline {
+				res, err := feemarkettypes.NewQueryClient(clientCtx).AccountFeeDenom(cmd.Context(), &feemarkettypes.QueryAccountFeeDenomRequest{
+					Address: sdk.AccAddress(msg.From).String(),
+				})
+				if err != nil {
+					return err
+				}
+				feeDenom = res.FeeDenom
+			}
+
+			tx, err := msg.BuildTxWithFeeDenom(clientCtx.TxConfig.NewTxBuilder(), baseDenom, feeDenom)
 			if err != nil {
 				return err
 			}
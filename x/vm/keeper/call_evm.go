@@ -79,6 +79,12 @@ func (k Keeper) CallEVMWithData(
 		if err != nil {
 			return nil, err
 		}
+		// the estimation returns the revert instead of failing when the call reverts
+		// at the gas cap
+		if gasRes.VmError != "" {
+			res := &types.MsgEthereumTxResponse{Ret: gasRes.Ret, VmError: gasRes.VmError}
+			return res, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
+		}
 		gasCap = math.NewIntFromUint64(gasRes.Gas).BigInt()
 	}
 
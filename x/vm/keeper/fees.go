@@ -23,8 +23,24 @@ func CheckSenderBalance(
 	balance sdkmath.Int,
 	txData types.TxData,
 ) error {
-	cost := txData.Cost()
+	return checkSenderCost(balance, txData.Cost())
+}
+
+// CheckSenderValueBalance validates that the tx value is positive and that the
+// sender has enough funds to pay for the value of the transaction. It is used
+// when the fees are paid in an alternative fee denom.
+func CheckSenderValueBalance(
+	balance sdkmath.Int,
+	txData types.TxData,
+) error {
+	value := txData.GetValue()
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	return checkSenderCost(balance, value)
+}
 
+func checkSenderCost(balance sdkmath.Int, cost *big.Int) error {
 	if cost.Sign() < 0 {
 		return errorsmod.Wrapf(
 			errortypes.ErrInvalidCoins,
@@ -32,6 +32,9 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 // consumed in the transaction. Additionally, the function sets the total gas consumed to the value
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
 // AnteHandler.
+//
+// If the denom is not the EVM denom, it must be one of the fee denoms accepted by the fee market
+// module, and the refund is converted at its conversion rate, rounded down.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice)
@@ -42,7 +45,15 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		return errorsmod.Wrapf(types.ErrInvalidRefund, "refunded amount value cannot be negative %d", remaining.Int64())
 	case 1:
 		// positive amount refund
-		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
+		refundedCoin := sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))
+		if denom != types.GetEVMCoinDenom() {
+			feeDenom, found := k.feeMarketWrapper.GetParams(ctx).GetFeeDenom(denom)
+			if !found {
+				return errorsmod.Wrapf(types.ErrInvalidRefund, "%s is not an accepted fee denom", denom)
+			}
+			refundedCoin = types.ConvertAmountFrom18DecimalsToFeeDenom(remaining, feeDenom, false)
+		}
+		refundedCoins := sdk.Coins{refundedCoin}
 
 		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
 		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From.Bytes(), refundedCoins)
@@ -59,6 +59,8 @@ type Keeper struct {
 	feeMarketWrapper *wrappers.FeeMarketWrapper
 	// erc20Keeper interface needed to instantiate erc20 precompiles
 	erc20Keeper types.Erc20Keeper
+	// erc721Keeper interface needed to instantiate erc721 precompiles
+	erc721Keeper types.Erc721Keeper
 
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
@@ -70,6 +72,10 @@ type Keeper struct {
 	// Some of these precompiled contracts might not be active depending on the EVM
 	// parameters.
 	precompiles map[common.Address]vm.PrecompiledContract
+
+	// isImpersonated checks if the sender of an ethereum tx is impersonated by
+	// a local dev node, in which case it is not recovered from the signature.
+	isImpersonated func(common.Address) bool
 }
 
 // NewKeeper generates new evm module keeper
@@ -175,6 +181,58 @@ func (k Keeper) GetTxIndexTransient(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixTransientTxIndex))
 }
 
+// SetTxMsgCountTransient sets the number of EVM messages contained in the
+// cosmos tx that is currently processed. It is set in the ante handler.
+func (k Keeper) SetTxMsgCountTransient(ctx sdk.Context, count uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientTxMsgCount, sdk.Uint64ToBigEndian(count))
+}
+
+// GetTxMsgCountTransient returns the number of EVM messages contained in the
+// cosmos tx that is currently processed.
+func (k Keeper) GetTxMsgCountTransient(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixTransientTxMsgCount))
+}
+
+// SetTxFeeDenomTransient sets the alternative denom used to pay the fees of
+// the cosmos tx that is currently processed. It is set in the ante handler and
+// deleted if the fees are paid in the EVM denom.
+func (k Keeper) SetTxFeeDenomTransient(ctx sdk.Context, feeDenom string) {
+	store := ctx.TransientStore(k.transientKey)
+	if feeDenom == "" {
+		store.Delete(types.KeyPrefixTransientTxFeeDenom)
+		return
+	}
+	store.Set(types.KeyPrefixTransientTxFeeDenom, []byte(feeDenom))
+}
+
+// GetTxFeeDenomTransient returns the alternative denom used to pay the fees of
+// the cosmos tx that is currently processed, or an empty string if the fees
+// are paid in the EVM denom.
+func (k Keeper) GetTxFeeDenomTransient(ctx sdk.Context) string {
+	store := ctx.TransientStore(k.transientKey)
+	return string(store.Get(types.KeyPrefixTransientTxFeeDenom))
+}
+
+// SetImpersonationChecker sets the function used to check if the sender of an
+// ethereum tx is impersonated. The sender of impersonated txs is the `From`
+// field of the message instead of the address recovered from the signature.
+//
+// NOTE: it must only be used by local dev nodes.
+func (k *Keeper) SetImpersonationChecker(isImpersonated func(common.Address) bool) *Keeper {
+	k.isImpersonated = isImpersonated
+	return k
+}
+
+// SetErc721Keeper sets the keeper used to instantiate the ERC721 precompiles
+// of the x/nft classes. It is optional since the erc721 keeper depends on the
+// EVM keeper and therefore can only be set after construction.
+func (k *Keeper) SetErc721Keeper(erc721Keeper types.Erc721Keeper) *Keeper {
+	k.erc721Keeper = erc721Keeper
+	return k
+}
+
 // ----------------------------------------------------------------------------
 // Hooks
 // ----------------------------------------------------------------------------
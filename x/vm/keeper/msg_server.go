@@ -47,6 +47,12 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
 	}
 
+	// When the cosmos tx contains a batch of ethereum txs, the batch is
+	// atomic: a failed message reverts the state changes of all of them.
+	if response.Failed() && k.GetTxMsgCountTransient(ctx) > 1 {
+		return nil, errorsmod.Wrapf(types.ErrBatchReverted, "ethereum tx %s failed: %s", response.Hash, response.VmError)
+	}
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{"tx", "msg", "ethereum_tx", "total"},
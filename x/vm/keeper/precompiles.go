@@ -35,9 +35,18 @@ func (k *Keeper) GetPrecompileInstance(
 
 	// Get the precompile from the dynamic precompiles
 	precompile, found, err := k.erc20Keeper.GetERC20PrecompileInstance(ctx, address)
-	if err != nil || !found {
+	if err != nil {
 		return nil, false, err
 	}
+	if !found && k.erc721Keeper != nil {
+		precompile, found, err = k.erc721Keeper.GetERC721PrecompileInstance(ctx, address)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	if !found {
+		return nil, false, nil
+	}
 	addressMap := make(map[common.Address]vm.PrecompiledContract)
 	addressMap[address] = precompile
 	return &Precompiles{
@@ -166,6 +166,9 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
 	}
+	if k.isImpersonated != nil && k.isImpersonated(msgEth.GetSender()) {
+		msg.From = msgEth.GetSender()
+	}
 
 	// create a cache context to revert state. The cache context is only committed when both tx and hooks executed successfully.
 	// Didn't use `Snapshot` because the context stack has exponential complexity on certain operations,
@@ -241,14 +244,18 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		}
 	}
 
-	evmDenom := types.GetEVMCoinDenom()
+	// refund in the denom used to pay the fees in the ante handler
+	feeDenom := types.GetEVMCoinDenom()
+	if txFeeDenom := k.GetTxFeeDenomTransient(ctx); txFeeDenom != "" {
+		feeDenom = txFeeDenom
+	}
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
 	remainingGas := uint64(0)
 	if msg.GasLimit > res.GasUsed {
 		remainingGas = msg.GasLimit - res.GasUsed
 	}
-	if err = k.RefundGas(ctx, *msg, remainingGas, evmDenom); err != nil {
+	if err = k.RefundGas(ctx, *msg, remainingGas, feeDenom); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From)
 	}
 
@@ -265,6 +272,11 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, msgEth *types.MsgEthereumTx)
 		return nil, errorsmod.Wrap(err, "failed to add transient gas used")
 	}
 
+	// only the base fee paid in the EVM denom is split by the fee market module
+	if feeDenom == types.GetEVMCoinDenom() {
+		k.feeMarketWrapper.AddTransientEVMGasUsed(ctx, res.GasUsed)
+	}
+
 	// reset the gas meter for current cosmos transaction
 	k.ResetGasMeterAndConsumeGas(ctx, totalGasUsed)
 	return res, nil
@@ -32,6 +32,7 @@ const (
 	codeErrABIPack
 	codeErrABIUnpack
 	codeErrInvalidPreinstall
+	codeErrBatchReverted
 )
 
 var (
@@ -92,6 +93,10 @@ var (
 	// ErrInvalidPreinstall returns an error if a preinstall is invalid
 	ErrInvalidPreinstall = errorsmod.Register(ModuleName, codeErrInvalidPreinstall, "invalid preinstall")
 
+	// ErrBatchReverted returns an error if a message of a cosmos tx containing multiple
+	// ethereum txs fails, which reverts the execution of the whole batch
+	ErrBatchReverted = errorsmod.Register(ModuleName, codeErrBatchReverted, "ethereum tx batch reverted")
+
 	// RevertSelector is selector of ErrExecutionReverted
 	RevertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
 )
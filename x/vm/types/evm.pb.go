@@ -74,6 +74,9 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the
 	// precompiled contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,9,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// allowed_dispatch_msgs defines the type URLs of the Cosmos messages that
+	// can be executed through the dispatch precompile
+	AllowedDispatchMsgs []string `protobuf:"bytes,10,rep,name=allowed_dispatch_msgs,json=allowedDispatchMsgs,proto3" json:"allowed_dispatch_msgs,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -151,6 +154,13 @@ func (m *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetAllowedDispatchMsgs() []string {
+	if m != nil {
+		return m.AllowedDispatchMsgs
+	}
+	return nil
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -942,132 +952,129 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/evm.proto", fileDescriptor_d1129b8db63d55c7) }
 
 var fileDescriptor_d1129b8db63d55c7 = []byte{
-	// 1988 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x5d, 0x6f, 0x1b, 0xc7,
-	0xd5, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd4, 0x7a, 0x44, 0xc9, 0x34, 0xed, 0x68, 0xf9, 0xee,
-	0xdb, 0x0b, 0xd5, 0x48, 0x25, 0x4b, 0x8e, 0x5a, 0xc3, 0xe9, 0x07, 0x44, 0x99, 0x69, 0xa5, 0xda,
-	0x0e, 0x31, 0x54, 0x12, 0xa4, 0x68, 0xb1, 0x18, 0xee, 0x8e, 0x97, 0x1b, 0xee, 0xee, 0x10, 0x3b,
-	0x4b, 0x86, 0xea, 0x2f, 0x08, 0x7c, 0x95, 0xfe, 0x00, 0x03, 0x01, 0x7a, 0x93, 0x4b, 0xff, 0x84,
-	0x5e, 0x06, 0xbd, 0xca, 0x65, 0x51, 0xa0, 0x8b, 0x82, 0xbe, 0x08, 0xa0, 0x4b, 0xfd, 0x82, 0x62,
-	0x3e, 0xf8, 0x29, 0x85, 0x55, 0x01, 0xc1, 0x9e, 0xe7, 0xcc, 0x39, 0xcf, 0x73, 0x66, 0xe6, 0xec,
-	0xec, 0x59, 0x82, 0x8a, 0x43, 0x59, 0x48, 0xd9, 0x3e, 0xe9, 0x87, 0xfb, 0xfc, 0xef, 0x80, 0x8f,
-	0xf6, 0xba, 0x31, 0x4d, 0x28, 0x34, 0xe4, 0xdc, 0x1e, 0xb7, 0xf0, 0xbf, 0x83, 0xca, 0x1d, 0x1c,
-	0xfa, 0x11, 0xdd, 0x17, 0xff, 0x4a, 0xa7, 0x4a, 0xc9, 0xa3, 0x1e, 0x15, 0xc3, 0x7d, 0x3e, 0x92,
-	0x56, 0xeb, 0x6d, 0x16, 0xac, 0x36, 0x70, 0x8c, 0x43, 0x06, 0x0f, 0x40, 0x8e, 0xf4, 0x43, 0xdb,
-	0x25, 0x11, 0x0d, 0xcb, 0x99, 0x6a, 0x66, 0x37, 0x57, 0x2b, 0x5d, 0xa5, 0xa6, 0x71, 0x81, 0xc3,
-	0xe0, 0xa9, 0x35, 0x9e, 0xb2, 0x90, 0x4e, 0xfa, 0xe1, 0x33, 0x3e, 0x84, 0xc7, 0x00, 0x90, 0x41,
-	0x12, 0x63, 0x9b, 0xf8, 0x5d, 0x56, 0xd6, 0xaa, 0xd9, 0xdd, 0x6c, 0xcd, 0x1a, 0xa6, 0x66, 0xae,
-	0xce, 0xad, 0xf5, 0xd3, 0x06, 0xbb, 0x4a, 0xcd, 0x3b, 0x8a, 0x60, 0xec, 0x68, 0xa1, 0x9c, 0x00,
-	0x75, 0xbf, 0xcb, 0xe0, 0x21, 0xd8, 0xc2, 0x41, 0x40, 0xbf, 0xb4, 0x7b, 0x11, 0xcf, 0x88, 0x38,
-	0x09, 0x71, 0xed, 0x64, 0xc0, 0xca, 0x2b, 0xd5, 0xcc, 0xae, 0x8e, 0x36, 0xc5, 0xe4, 0x27, 0x93,
-	0xb9, 0xf3, 0x01, 0x8f, 0x29, 0xf0, 0x74, 0x9c, 0x36, 0x8e, 0x22, 0x12, 0xb0, 0xf2, 0x5a, 0x35,
-	0xbb, 0x9b, 0xab, 0x6d, 0x0c, 0x53, 0x33, 0x5f, 0xff, 0xf4, 0xc5, 0x89, 0x32, 0xa3, 0x3c, 0xe9,
-	0x87, 0x23, 0x00, 0xff, 0x04, 0x8a, 0xd8, 0x71, 0x08, 0x63, 0xb6, 0x43, 0xa3, 0x24, 0xa6, 0x41,
-	0x59, 0xaf, 0x66, 0x76, 0xf3, 0x87, 0xe6, 0xde, 0xfc, 0xe6, 0xed, 0x1d, 0x0b, 0xbf, 0x13, 0xe9,
-	0x56, 0xdb, 0xfa, 0x2e, 0x35, 0x97, 0x86, 0xa9, 0xb9, 0x3e, 0x63, 0x46, 0xeb, 0x78, 0x1a, 0xc2,
-	0xa7, 0xe0, 0x1e, 0x76, 0x12, 0xbf, 0x4f, 0x6c, 0x96, 0xe0, 0xc4, 0x77, 0xec, 0x6e, 0x4c, 0x1c,
-	0x1a, 0x76, 0xfd, 0x80, 0xb0, 0x72, 0x8e, 0xe7, 0x87, 0xee, 0x4a, 0x87, 0xa6, 0x98, 0x6f, 0x4c,
-	0xa6, 0x9f, 0xde, 0x7f, 0xfd, 0xc3, 0xdb, 0x87, 0xdb, 0x53, 0xe7, 0x3b, 0xe0, 0x27, 0x2c, 0x4f,
-	0xe5, 0x4c, 0xd3, 0x97, 0x8d, 0xec, 0x99, 0xa6, 0x67, 0x0d, 0xed, 0x4c, 0xd3, 0x57, 0x8d, 0x35,
-	0xeb, 0x2f, 0x19, 0x30, 0x9b, 0x0b, 0x3c, 0x06, 0xab, 0x4e, 0x4c, 0x70, 0x42, 0xc4, 0xb1, 0xe5,
-	0x0f, 0xff, 0xff, 0xbf, 0xac, 0xe9, 0xfc, 0xa2, 0x4b, 0x6a, 0x1a, 0x5f, 0x17, 0x52, 0x81, 0xf0,
-	0x57, 0x40, 0x73, 0x70, 0x10, 0x94, 0x97, 0xff, 0x57, 0x02, 0x11, 0x66, 0xfd, 0x2b, 0x03, 0xee,
-	0x5c, 0xf3, 0x80, 0x0e, 0xc8, 0xab, 0x3d, 0x4f, 0x2e, 0xba, 0x32, 0xb9, 0xe2, 0xe1, 0x83, 0x1f,
-	0xe3, 0x16, 0xa4, 0x3f, 0x19, 0xa6, 0x26, 0x98, 0xe0, 0xab, 0xd4, 0x84, 0xb2, 0x7c, 0xa6, 0x88,
-	0x2c, 0x04, 0xf0, 0xd8, 0x03, 0x3a, 0x60, 0x73, 0xf6, 0x60, 0xed, 0xc0, 0x67, 0x49, 0x79, 0x59,
-	0xd4, 0xc4, 0xe3, 0x61, 0x6a, 0xce, 0x26, 0xf6, 0xdc, 0x67, 0xc9, 0x55, 0x6a, 0x56, 0x66, 0x58,
-	0xa7, 0x23, 0x2d, 0x74, 0x07, 0xcf, 0x07, 0x58, 0xdf, 0x1a, 0x20, 0x7f, 0xd2, 0xc6, 0x7e, 0x74,
-	0x42, 0xa3, 0x57, 0xbe, 0x07, 0xff, 0x08, 0x36, 0xda, 0x34, 0x24, 0x2c, 0x21, 0xd8, 0xb5, 0x5b,
-	0x01, 0x75, 0x3a, 0xea, 0x89, 0x79, 0xfc, 0xcf, 0xd4, 0xdc, 0x92, 0x0b, 0x64, 0x6e, 0x67, 0xcf,
-	0xa7, 0xfb, 0x21, 0x4e, 0xda, 0x7b, 0xa7, 0x11, 0x17, 0xdd, 0x96, 0xa2, 0x73, 0x91, 0x16, 0x2a,
-	0x8e, 0x2d, 0x35, 0x6e, 0x80, 0x6d, 0x50, 0x74, 0x31, 0xb5, 0x5f, 0xd1, 0xb8, 0xa3, 0xc8, 0x97,
-	0x05, 0x79, 0xed, 0x47, 0xc9, 0x87, 0xa9, 0x59, 0x78, 0x76, 0xfc, 0xf1, 0x47, 0x34, 0xee, 0x08,
-	0x8a, 0xab, 0xd4, 0xdc, 0x92, 0x62, 0xb3, 0x44, 0x16, 0x2a, 0xb8, 0x98, 0x8e, 0xdd, 0xe0, 0x67,
-	0xc0, 0x18, 0x3b, 0xb0, 0x5e, 0xb7, 0x4b, 0xe3, 0xa4, 0x9c, 0xe5, 0x0f, 0x5e, 0xed, 0x67, 0xc3,
-	0xd4, 0x2c, 0x2a, 0xca, 0xa6, 0x9c, 0xb9, 0x4a, 0xcd, 0xbb, 0x73, 0xa4, 0x2a, 0xc6, 0x42, 0x45,
-	0x45, 0xab, 0x5c, 0x61, 0x0b, 0x14, 0x88, 0xdf, 0x3d, 0x38, 0x7a, 0xa4, 0x16, 0xa0, 0x89, 0x05,
-	0xfc, 0x66, 0xd1, 0x02, 0xf2, 0xf5, 0xd3, 0xc6, 0xc1, 0xd1, 0xa3, 0x51, 0xfe, 0x9b, 0xea, 0xda,
-	0x98, 0x62, 0xb1, 0x50, 0x5e, 0x42, 0x99, 0xfc, 0x48, 0xe3, 0x48, 0x69, 0xac, 0xde, 0x56, 0xe3,
-	0xe8, 0x26, 0x8d, 0xa3, 0x59, 0x8d, 0xa3, 0x59, 0x8d, 0x27, 0x4a, 0x63, 0xed, 0xb6, 0x1a, 0x4f,
-	0x6e, 0xd2, 0x78, 0x32, 0xab, 0x21, 0x7d, 0x78, 0x31, 0xb5, 0x2e, 0xfe, 0x8c, 0xa3, 0xc4, 0xef,
-	0x85, 0x4a, 0x46, 0xbf, 0x75, 0x31, 0xcd, 0x45, 0x5a, 0xa8, 0x38, 0xb6, 0x48, 0xf6, 0x0e, 0x28,
-	0x39, 0x34, 0x62, 0x09, 0xb7, 0x45, 0xb4, 0x1b, 0x10, 0x25, 0x91, 0x13, 0x12, 0x4f, 0x16, 0x49,
-	0xdc, 0x97, 0x12, 0x37, 0x85, 0x5b, 0x68, 0x73, 0xd6, 0x2c, 0xc5, 0x6c, 0x60, 0x74, 0x49, 0x42,
-	0x62, 0xd6, 0xea, 0xc5, 0x9e, 0x12, 0x02, 0x42, 0xe8, 0x83, 0x45, 0x42, 0xaa, 0xac, 0xe6, 0x43,
-	0x2d, 0xb4, 0x31, 0x31, 0x49, 0x81, 0xcf, 0x41, 0xd1, 0xe7, 0xaa, 0xad, 0x5e, 0xa0, 0xe8, 0xf3,
-	0x82, 0xfe, 0x70, 0x11, 0xbd, 0x7a, 0x14, 0x66, 0x03, 0x2d, 0xb4, 0x3e, 0x32, 0x48, 0x6a, 0x17,
-	0xc0, 0xb0, 0xe7, 0xc7, 0xb6, 0x17, 0x60, 0xc7, 0x27, 0xb1, 0xa2, 0x2f, 0x08, 0xfa, 0x9f, 0x2f,
-	0xa2, 0xbf, 0x27, 0xe9, 0xaf, 0x07, 0x5b, 0xc8, 0xe0, 0xc6, 0xdf, 0x4a, 0x9b, 0x54, 0x69, 0x82,
-	0x42, 0x8b, 0xc4, 0x81, 0x1f, 0x29, 0xfe, 0x75, 0xc1, 0xff, 0x68, 0x11, 0xbf, 0xaa, 0xa0, 0xe9,
-	0x30, 0x0b, 0xe5, 0x25, 0x1c, 0x93, 0x06, 0x34, 0x72, 0xe9, 0x88, 0xf4, 0xce, 0xad, 0x49, 0xa7,
-	0xc3, 0x2c, 0x94, 0x97, 0x50, 0x92, 0x7a, 0x60, 0x13, 0xc7, 0x31, 0xfd, 0x72, 0x6e, 0x43, 0xa0,
-	0xe0, 0xfe, 0xc5, 0x22, 0xee, 0xd1, 0xe5, 0x7a, 0x3d, 0x9a, 0x5f, 0xae, 0xdc, 0x3a, 0xb3, 0x25,
-	0x2e, 0x80, 0x5e, 0x8c, 0x2f, 0xe6, 0x74, 0x4a, 0xb7, 0xde, 0xf8, 0xeb, 0xc1, 0x16, 0x32, 0xb8,
-	0x71, 0x46, 0xe5, 0x0b, 0x50, 0x0a, 0x49, 0xec, 0x11, 0x3b, 0x22, 0x09, 0xeb, 0x06, 0x7e, 0xa2,
-	0x74, 0xb6, 0x6e, 0xfd, 0x1c, 0xdc, 0x14, 0x6e, 0x21, 0x28, 0xcc, 0x2f, 0x95, 0x55, 0x6a, 0xdd,
-	0x03, 0xba, 0xc3, 0xdf, 0x16, 0xb6, 0xef, 0x96, 0xcb, 0xd5, 0xcc, 0xae, 0x86, 0xd6, 0x04, 0x3e,
-	0x75, 0x61, 0x09, 0xac, 0xc8, 0x0e, 0xeb, 0x1e, 0xd7, 0x45, 0x12, 0xc0, 0x0a, 0xd0, 0x5d, 0xe2,
-	0xf8, 0x21, 0x0e, 0x58, 0xb9, 0x22, 0x02, 0xc6, 0x18, 0x7e, 0x0a, 0xd6, 0x59, 0x1b, 0x47, 0x5e,
-	0x1b, 0xfb, 0x76, 0xe2, 0x87, 0xa4, 0x7c, 0x5f, 0x64, 0x7c, 0xb0, 0x28, 0xe3, 0x92, 0xcc, 0x78,
-	0x26, 0xce, 0x42, 0x85, 0x11, 0x3e, 0xf7, 0x43, 0x02, 0x1b, 0x20, 0xef, 0xe0, 0xc8, 0xe9, 0x45,
-	0x92, 0xf5, 0x81, 0x60, 0xdd, 0x5f, 0xc4, 0xaa, 0x5e, 0xc5, 0x53, 0x51, 0x16, 0x02, 0x12, 0x8d,
-	0x18, 0xbb, 0x31, 0xf6, 0x7a, 0x44, 0x32, 0xbe, 0x77, 0x6b, 0xc6, 0xa9, 0x28, 0x0b, 0x01, 0x89,
-	0x46, 0x8c, 0x7d, 0x12, 0x77, 0x02, 0xc5, 0xb8, 0x73, 0x6b, 0xc6, 0xa9, 0x28, 0x0b, 0x01, 0x89,
-	0x04, 0xe3, 0x0b, 0x00, 0x28, 0xc3, 0x1d, 0x2c, 0x09, 0x4d, 0x41, 0xb8, 0xb7, 0x88, 0x50, 0xb5,
-	0xaf, 0x93, 0x20, 0x0b, 0xe5, 0x04, 0xe0, 0x74, 0x67, 0x9a, 0xbe, 0x62, 0xac, 0x9e, 0x69, 0xfa,
-	0xb6, 0x71, 0xf7, 0x4c, 0xd3, 0xef, 0x1a, 0x65, 0x6b, 0x1f, 0xac, 0xf0, 0x16, 0x8f, 0x40, 0x03,
-	0x64, 0x3b, 0xe4, 0x42, 0xf6, 0x05, 0x88, 0x0f, 0xf9, 0xd9, 0xf7, 0x71, 0xd0, 0x23, 0xf2, 0x75,
-	0x8e, 0x24, 0xb0, 0x1a, 0x60, 0xe3, 0x3c, 0xc6, 0x11, 0xe3, 0xed, 0x21, 0x8d, 0x9e, 0x53, 0x8f,
-	0x41, 0x08, 0xb4, 0x36, 0x66, 0x6d, 0x15, 0x2b, 0xc6, 0xf0, 0xa7, 0x40, 0x0b, 0xa8, 0xc7, 0x44,
-	0x63, 0x93, 0x3f, 0xdc, 0xba, 0xde, 0x45, 0x3d, 0xa7, 0x1e, 0x12, 0x2e, 0xd6, 0xdf, 0x97, 0x41,
-	0xf6, 0x39, 0xf5, 0x60, 0x19, 0xac, 0x61, 0xd7, 0x8d, 0x09, 0x63, 0x8a, 0x69, 0x04, 0xe1, 0x36,
-	0x58, 0x4d, 0x68, 0xd7, 0x77, 0x24, 0x5d, 0x0e, 0x29, 0xc4, 0x85, 0x5d, 0x9c, 0x60, 0xd1, 0x03,
-	0x14, 0x90, 0x18, 0xf3, 0x6e, 0x5b, 0x94, 0xba, 0x1d, 0xf5, 0xc2, 0x16, 0x89, 0xc5, 0xab, 0x5c,
-	0xab, 0x6d, 0x5c, 0xa6, 0x66, 0x5e, 0xd8, 0x5f, 0x0a, 0x33, 0x9a, 0x06, 0xf0, 0x7d, 0xb0, 0x96,
-	0x0c, 0x6c, 0xb1, 0x86, 0x15, 0xb1, 0xc5, 0x9b, 0x97, 0xa9, 0xb9, 0x91, 0x4c, 0x96, 0xf9, 0x3b,
-	0xcc, 0xda, 0x68, 0x35, 0x19, 0xf0, 0xff, 0xe1, 0x3e, 0xd0, 0x93, 0x81, 0xed, 0x47, 0x2e, 0x19,
-	0x88, 0x97, 0xb8, 0x56, 0x2b, 0x5d, 0xa6, 0xa6, 0x31, 0xe5, 0x7e, 0xca, 0xe7, 0xd0, 0x5a, 0x32,
-	0x10, 0x03, 0xf8, 0x3e, 0x00, 0x32, 0x25, 0xa1, 0x20, 0xdf, 0xc9, 0xeb, 0x97, 0xa9, 0x99, 0x13,
-	0x56, 0xc1, 0x3d, 0x19, 0x42, 0x0b, 0xac, 0x48, 0x6e, 0x5d, 0x70, 0x17, 0x2e, 0x53, 0x53, 0x0f,
-	0xa8, 0x27, 0x39, 0xe5, 0x14, 0xdf, 0xaa, 0x98, 0x84, 0xb4, 0x4f, 0x5c, 0xf1, 0x62, 0xd4, 0xd1,
-	0x08, 0x5a, 0x5f, 0x2f, 0x03, 0xfd, 0x7c, 0x80, 0x08, 0xeb, 0x05, 0x09, 0xfc, 0x08, 0x18, 0xa2,
-	0x57, 0xc4, 0x4e, 0x62, 0xcf, 0x6c, 0x6d, 0xed, 0xfe, 0xe4, 0x35, 0x36, 0xef, 0x61, 0xa1, 0x8d,
-	0x91, 0xe9, 0x58, 0xed, 0x7f, 0x09, 0xac, 0xb4, 0x02, 0x4a, 0x43, 0x51, 0x09, 0x05, 0x24, 0x01,
-	0xfc, 0x4c, 0xec, 0x9a, 0x38, 0xe5, 0xac, 0xe8, 0xc3, 0xff, 0xef, 0xfa, 0x29, 0xcf, 0x95, 0x4a,
-	0xed, 0x3e, 0xef, 0xc2, 0xaf, 0x52, 0xb3, 0x28, 0xb5, 0x55, 0xbc, 0xf5, 0xed, 0x0f, 0x6f, 0x1f,
-	0x66, 0xf8, 0x06, 0x8b, 0x7a, 0x32, 0x40, 0x36, 0x26, 0x89, 0x38, 0xb9, 0x02, 0xe2, 0x43, 0x7e,
-	0xe1, 0xc4, 0xa4, 0x4f, 0xe2, 0x84, 0xb8, 0xea, 0x4b, 0x6b, 0x8c, 0xf9, 0xed, 0xe5, 0x61, 0x66,
-	0xf7, 0x18, 0x71, 0xe5, 0x71, 0xa0, 0x35, 0x0f, 0xb3, 0x4f, 0x18, 0x71, 0x9f, 0x6a, 0x5f, 0x7d,
-	0x63, 0x2e, 0x59, 0x18, 0xe4, 0x55, 0x8b, 0xde, 0xeb, 0x06, 0x64, 0x41, 0x99, 0x1d, 0x82, 0x02,
-	0x4b, 0x68, 0x8c, 0x3d, 0x62, 0x77, 0xc8, 0x85, 0x2a, 0x36, 0x59, 0x3a, 0xca, 0xfe, 0x7b, 0x72,
-	0xc1, 0xd0, 0x34, 0x50, 0x12, 0xdf, 0x68, 0x20, 0x7f, 0x1e, 0x63, 0x87, 0xa8, 0x86, 0x9b, 0x17,
-	0x2c, 0x87, 0xb1, 0x92, 0x50, 0x88, 0x6b, 0xf3, 0x67, 0x92, 0xf6, 0x12, 0xf5, 0x50, 0x8d, 0x20,
-	0x8f, 0x88, 0x09, 0x19, 0x10, 0x47, 0xec, 0xa5, 0x86, 0x14, 0x82, 0x47, 0x60, 0xdd, 0xf5, 0x19,
-	0x6e, 0x05, 0xe2, 0x53, 0xcd, 0xe9, 0xc8, 0xe5, 0xd7, 0x8c, 0xcb, 0xd4, 0x2c, 0xa8, 0x89, 0x26,
-	0xb7, 0xa3, 0x19, 0x04, 0x3f, 0x04, 0x1b, 0x93, 0x30, 0x91, 0xad, 0xd8, 0x1b, 0xbd, 0x06, 0x2f,
-	0x53, 0xb3, 0x38, 0x76, 0x15, 0x33, 0x68, 0x0e, 0xcb, 0x4b, 0xbf, 0xd5, 0xf3, 0x44, 0x05, 0xea,
-	0x48, 0x02, 0x6e, 0x0d, 0xfc, 0xd0, 0x4f, 0x44, 0xc5, 0xad, 0x20, 0x09, 0xe0, 0x87, 0x20, 0x47,
-	0xfb, 0x24, 0x8e, 0x7d, 0x97, 0x30, 0xd1, 0x3b, 0xe5, 0x0f, 0xdf, 0xbb, 0x5e, 0x06, 0x53, 0x1f,
-	0x23, 0x68, 0xe2, 0xcf, 0x17, 0x47, 0x22, 0x91, 0x64, 0x48, 0x42, 0x1a, 0x5f, 0x88, 0xee, 0x48,
-	0x2d, 0x4e, 0x4e, 0xbc, 0x10, 0x76, 0x34, 0x83, 0x60, 0x0d, 0x40, 0x15, 0x16, 0x93, 0xa4, 0x17,
-	0x47, 0xb6, 0xb8, 0x04, 0x0a, 0x22, 0x56, 0x3c, 0x8a, 0x72, 0x16, 0x89, 0xc9, 0x67, 0x38, 0xc1,
-	0xe8, 0x9a, 0x05, 0xfe, 0x1a, 0x40, 0x79, 0x26, 0xf6, 0x17, 0x8c, 0x46, 0xfc, 0x93, 0xea, 0x95,
-	0xef, 0xa9, 0xf6, 0x46, 0xe8, 0xcb, 0x59, 0x95, 0xb3, 0x21, 0xd1, 0x19, 0xa3, 0x6a, 0x15, 0x67,
-	0x9a, 0xae, 0x19, 0x2b, 0x67, 0x9a, 0xbe, 0x66, 0xe8, 0xe3, 0xfd, 0x53, 0xab, 0x40, 0x9b, 0x23,
-	0x3c, 0x95, 0x9e, 0xf5, 0x12, 0x80, 0x46, 0x4c, 0x7c, 0xde, 0x84, 0x06, 0x01, 0xbf, 0xb9, 0x22,
-	0x1c, 0x92, 0xd1, 0x95, 0xc9, 0xc7, 0xd3, 0x85, 0xb9, 0x3c, 0x5b, 0x98, 0x10, 0x68, 0x0e, 0x75,
-	0x89, 0x28, 0x8d, 0x1c, 0x12, 0xe3, 0x87, 0x7f, 0xcb, 0x80, 0xa9, 0x2f, 0x4f, 0xf8, 0x4b, 0x50,
-	0x39, 0x3e, 0x39, 0xa9, 0x37, 0x9b, 0xf6, 0xf9, 0xe7, 0x8d, 0xba, 0xdd, 0xa8, 0xa3, 0x17, 0xa7,
-	0xcd, 0xe6, 0xe9, 0xc7, 0x2f, 0x9f, 0xd7, 0x9b, 0x4d, 0x63, 0xa9, 0xf2, 0xe0, 0xf5, 0x9b, 0x6a,
-	0x79, 0xe2, 0xdf, 0x20, 0x71, 0xe8, 0x33, 0xe6, 0xd3, 0x28, 0xe0, 0x02, 0x1f, 0x80, 0xed, 0xe9,
-	0x68, 0x54, 0x6f, 0x9e, 0xa3, 0xd3, 0x93, 0xf3, 0xfa, 0x33, 0x23, 0x53, 0x29, 0xbf, 0x7e, 0x53,
-	0x2d, 0x4d, 0x22, 0x11, 0x61, 0x49, 0xec, 0x3b, 0xfc, 0xc9, 0x7b, 0x02, 0xca, 0x37, 0x6b, 0xd6,
-	0x9f, 0x19, 0xcb, 0x95, 0xca, 0xeb, 0x37, 0xd5, 0xed, 0x9b, 0x14, 0x89, 0x5b, 0xd1, 0xbe, 0xfa,
-	0xeb, 0xce, 0x52, 0xed, 0xe9, 0x77, 0xc3, 0x9d, 0xcc, 0xf7, 0xc3, 0x9d, 0xcc, 0xbf, 0x87, 0x3b,
-	0x99, 0xaf, 0xdf, 0xed, 0x2c, 0x7d, 0xff, 0x6e, 0x67, 0xe9, 0x1f, 0xef, 0x76, 0x96, 0xfe, 0x50,
-	0xf5, 0xfc, 0xa4, 0xdd, 0x6b, 0xed, 0x39, 0x34, 0xdc, 0x9f, 0xff, 0xa5, 0x81, 0x7f, 0x53, 0xb3,
-	0xd6, 0xaa, 0xf8, 0x41, 0xe8, 0xf1, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x92, 0xb6, 0x78, 0x1a,
-	0x69, 0x12, 0x00, 0x00,
+	// 1945 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x95, 0x58, 0x5d, 0x4f, 0x2b, 0xc7,
+	0x19, 0x06, 0x6c, 0xc0, 0x1e, 0x1b, 0x58, 0x06, 0xc3, 0xd9, 0x63, 0x4e, 0x0e, 0xa7, 0xdb, 0x5e,
+	0xa4, 0x51, 0x8b, 0x03, 0x27, 0xb4, 0x88, 0x7e, 0x09, 0x83, 0xd3, 0x92, 0x1e, 0x4e, 0xd0, 0x98,
+	0xa4, 0x4a, 0xd5, 0x6a, 0x35, 0xde, 0x9d, 0x98, 0x0d, 0xfb, 0x61, 0xed, 0x07, 0x35, 0xfd, 0x05,
+	0x51, 0xae, 0xd2, 0x1f, 0x50, 0x29, 0x52, 0x6f, 0x72, 0xd9, 0x9f, 0xd0, 0xcb, 0xa8, 0x57, 0xb9,
+	0xac, 0x2a, 0xf5, 0xa8, 0x4a, 0x2b, 0x55, 0xea, 0x65, 0x7f, 0x41, 0xdf, 0x79, 0x67, 0x6c, 0xef,
+	0x1a, 0xe2, 0x52, 0x09, 0x9b, 0x79, 0xbf, 0x9e, 0xe7, 0x9d, 0x99, 0x77, 0x67, 0xde, 0x35, 0x69,
+	0x3a, 0x51, 0x12, 0x44, 0x49, 0x4b, 0xdc, 0x04, 0x2d, 0xf9, 0xb7, 0x27, 0x47, 0xbb, 0x83, 0x38,
+	0x4a, 0x23, 0x6a, 0x28, 0xdb, 0xae, 0xd4, 0xc8, 0xbf, 0xbd, 0xe6, 0x3a, 0x0f, 0xbc, 0x30, 0x6a,
+	0xe1, 0xb7, 0x72, 0x6a, 0x36, 0xfa, 0x51, 0x3f, 0xc2, 0x61, 0x4b, 0x8e, 0x94, 0xd6, 0xfa, 0x67,
+	0x89, 0x2c, 0x5d, 0xf0, 0x98, 0x07, 0x09, 0xdd, 0x23, 0x55, 0x00, 0xb0, 0x5d, 0x11, 0x46, 0x81,
+	0x39, 0xff, 0x6c, 0xfe, 0xf5, 0x6a, 0xbb, 0xf1, 0x9f, 0x57, 0x3b, 0xc6, 0x2d, 0x0f, 0xfc, 0x23,
+	0x6b, 0x6c, 0xb2, 0x58, 0x05, 0xc6, 0xa7, 0x72, 0x48, 0x8f, 0x09, 0x11, 0xc3, 0x34, 0xe6, 0xb6,
+	0xf0, 0x06, 0x89, 0x59, 0x7e, 0x56, 0x7a, 0xbd, 0xd4, 0xb6, 0xbe, 0x7a, 0xb5, 0x53, 0xed, 0x48,
+	0x6d, 0xe7, 0xec, 0x22, 0x01, 0x80, 0x75, 0x0d, 0x30, 0x76, 0xb4, 0x58, 0x15, 0x85, 0x0e, 0x8c,
+	0xe9, 0x3e, 0xd9, 0xe4, 0xbe, 0x1f, 0xfd, 0xc6, 0xce, 0x42, 0x99, 0x91, 0x70, 0x52, 0xe1, 0xda,
+	0xe9, 0x30, 0x31, 0x17, 0x21, 0x83, 0x0a, 0xdb, 0x40, 0xe3, 0x7b, 0x13, 0xdb, 0xe5, 0x50, 0xc6,
+	0xd4, 0x65, 0x3a, 0xce, 0x15, 0x0f, 0x43, 0xe1, 0x27, 0xe6, 0x32, 0x10, 0x57, 0xdb, 0x6b, 0x40,
+	0x5c, 0xeb, 0xbc, 0x7f, 0x7e, 0xa2, 0xd5, 0xac, 0x06, 0x4e, 0x23, 0x81, 0xfe, 0x9a, 0xac, 0x72,
+	0xc7, 0x11, 0x49, 0x62, 0x3b, 0x51, 0x98, 0xc6, 0x91, 0x6f, 0x56, 0x80, 0xa0, 0xb6, 0xbf, 0xb3,
+	0x3b, 0xbd, 0x78, 0xbb, 0xc7, 0xe8, 0x77, 0xa2, 0xdc, 0xda, 0x9b, 0x5f, 0xbc, 0xda, 0x99, 0x03,
+	0xe8, 0x95, 0x82, 0x9a, 0xad, 0xf0, 0xbc, 0x48, 0x8f, 0xc8, 0x63, 0xee, 0xa4, 0xde, 0x8d, 0xb0,
+	0x93, 0x94, 0xa7, 0x9e, 0x63, 0x0f, 0x62, 0xe1, 0x44, 0xc1, 0xc0, 0xf3, 0x45, 0x62, 0x56, 0x65,
+	0x7e, 0xec, 0x91, 0x72, 0xe8, 0xa2, 0xfd, 0x62, 0x62, 0x1e, 0x2f, 0x01, 0x4c, 0xdc, 0xf5, 0x92,
+	0x01, 0x4f, 0x9d, 0x2b, 0x3b, 0x48, 0xfa, 0x89, 0x49, 0x30, 0x6e, 0x43, 0x1b, 0x4f, 0xb5, 0xed,
+	0x1c, 0x4c, 0x47, 0xdb, 0x9f, 0xfc, 0xeb, 0x8f, 0x6f, 0x6c, 0xe5, 0x6a, 0x62, 0x28, 0xab, 0x42,
+	0xed, 0xe4, 0x3b, 0xe5, 0xca, 0x82, 0x51, 0x82, 0xef, 0x92, 0x51, 0x86, 0xef, 0x25, 0x63, 0xd9,
+	0xfa, 0xdd, 0x3c, 0x29, 0xe6, 0x0f, 0x5b, 0xb7, 0xe4, 0xc4, 0x82, 0xa7, 0x02, 0xb7, 0xba, 0xb6,
+	0xff, 0xcd, 0xff, 0xb1, 0x0e, 0x97, 0xb7, 0x03, 0xd1, 0x2e, 0xcb, 0xb5, 0x60, 0x3a, 0x90, 0xfe,
+	0x88, 0x94, 0x1d, 0xc8, 0xcd, 0x5c, 0xf8, 0x7f, 0x01, 0x30, 0xcc, 0xfa, 0xdb, 0x3c, 0x59, 0xbf,
+	0xe3, 0x41, 0x1d, 0x52, 0xd3, 0xfb, 0x94, 0x82, 0x88, 0xc9, 0xad, 0xee, 0x3f, 0xf9, 0x3a, 0x6c,
+	0x04, 0xfd, 0x16, 0xec, 0x0e, 0x99, 0xc8, 0x50, 0x72, 0x54, 0x95, 0x5c, 0x0e, 0xc8, 0x62, 0x84,
+	0x8f, 0x3d, 0x80, 0x64, 0xa3, 0x58, 0x0c, 0xb6, 0xef, 0x25, 0x29, 0x4c, 0x44, 0xd6, 0xd1, 0x73,
+	0x80, 0x2b, 0x26, 0xf6, 0x02, 0x8c, 0x80, 0xda, 0x2c, 0xa0, 0xe6, 0x23, 0x2d, 0xb6, 0xce, 0xa7,
+	0x03, 0xac, 0xcf, 0x0d, 0x52, 0x83, 0xf2, 0xf3, 0x42, 0x50, 0x7e, 0xe8, 0xf5, 0xe9, 0xaf, 0xc8,
+	0xda, 0x55, 0x14, 0x88, 0x24, 0x15, 0xdc, 0xb5, 0x7b, 0x7e, 0xe4, 0x5c, 0xeb, 0xa7, 0xec, 0xf9,
+	0x5f, 0x5f, 0xed, 0x6c, 0xaa, 0x09, 0x26, 0xee, 0xf5, 0xae, 0x17, 0xb5, 0x02, 0x9e, 0x5e, 0xed,
+	0x9e, 0x85, 0x92, 0x74, 0x4b, 0x91, 0x4e, 0x45, 0x5a, 0x6c, 0x75, 0xac, 0x69, 0x4b, 0x05, 0xbd,
+	0x22, 0xab, 0x2e, 0x8f, 0xec, 0x0f, 0xa3, 0xf8, 0x5a, 0x83, 0x2f, 0x20, 0x78, 0xfb, 0x6b, 0xc1,
+	0x61, 0x9a, 0xf5, 0xd3, 0xe3, 0x77, 0xdf, 0x86, 0x08, 0x84, 0x00, 0xb2, 0x4d, 0x45, 0x56, 0x04,
+	0xb2, 0x58, 0x1d, 0x14, 0x63, 0x37, 0xfa, 0x0b, 0x62, 0x8c, 0x1d, 0x92, 0x6c, 0x30, 0x88, 0xe2,
+	0xd4, 0x2c, 0xc9, 0x87, 0xb5, 0xfd, 0x5d, 0x80, 0x5c, 0xd5, 0x90, 0x5d, 0x65, 0x01, 0xd0, 0x47,
+	0x53, 0xa0, 0x3a, 0x06, 0xa6, 0xa0, 0x61, 0xb5, 0x2b, 0xed, 0xc1, 0x63, 0xed, 0x0d, 0xf6, 0x0e,
+	0xde, 0xd4, 0x13, 0x28, 0xe3, 0x04, 0x7e, 0x32, 0x6b, 0x02, 0x35, 0x38, 0x63, 0x20, 0x60, 0x94,
+	0xff, 0x86, 0x3e, 0x6a, 0x72, 0x28, 0x16, 0x1c, 0x03, 0x28, 0xaa, 0xe4, 0x47, 0x1c, 0x07, 0x9a,
+	0x63, 0xe9, 0xa1, 0x1c, 0x07, 0xf7, 0x71, 0x1c, 0x14, 0x39, 0x0e, 0x8a, 0x1c, 0x87, 0x9a, 0x63,
+	0xf9, 0xa1, 0x1c, 0x87, 0xf7, 0x71, 0x1c, 0x16, 0x39, 0x94, 0x8f, 0x2c, 0xa6, 0xde, 0xed, 0x6f,
+	0x79, 0x98, 0x7a, 0x59, 0xa0, 0x69, 0x2a, 0x0f, 0x2e, 0xa6, 0xa9, 0x48, 0xd8, 0x89, 0xb1, 0x46,
+	0xa1, 0x5f, 0x93, 0x06, 0x94, 0x37, 0x9c, 0x64, 0xa0, 0x0b, 0xa3, 0x81, 0x2f, 0x34, 0x45, 0x15,
+	0x29, 0x0e, 0x67, 0x51, 0x6c, 0x2b, 0x8a, 0xfb, 0xc2, 0x2d, 0xb6, 0x51, 0x54, 0x2b, 0x32, 0x9b,
+	0x18, 0x03, 0x91, 0x8a, 0x38, 0xe9, 0x65, 0x71, 0x5f, 0x13, 0x11, 0x24, 0x7a, 0x6b, 0x16, 0x91,
+	0x2e, 0xab, 0xe9, 0x50, 0x8b, 0xad, 0x4d, 0x54, 0x8a, 0xe0, 0x03, 0xb2, 0xea, 0x49, 0xd6, 0x5e,
+	0xe6, 0x6b, 0xf8, 0x1a, 0xc2, 0xef, 0xcf, 0x82, 0xd7, 0x8f, 0x42, 0x31, 0xd0, 0x62, 0x2b, 0x23,
+	0x85, 0x82, 0x76, 0x09, 0x0d, 0x32, 0x2f, 0xb6, 0xfb, 0x3e, 0x77, 0x3c, 0x11, 0x6b, 0xf8, 0x3a,
+	0xc2, 0x7f, 0x6f, 0x16, 0xfc, 0x63, 0x05, 0x7f, 0x37, 0xd8, 0x62, 0x86, 0x54, 0xfe, 0x54, 0xe9,
+	0x14, 0x4b, 0x97, 0xd4, 0x7b, 0x22, 0xf6, 0xbd, 0x50, 0xe3, 0xaf, 0x20, 0xfe, 0x9b, 0xb3, 0xf0,
+	0x75, 0x05, 0xe5, 0xc3, 0xa0, 0x82, 0x94, 0x38, 0x06, 0xf5, 0xa3, 0xd0, 0x8d, 0x46, 0xa0, 0xeb,
+	0x0f, 0x06, 0xcd, 0x87, 0x01, 0xa8, 0x12, 0x15, 0x68, 0x1f, 0x0e, 0xd6, 0x38, 0x86, 0xdb, 0xbc,
+	0xb8, 0x20, 0x14, 0xb1, 0xbf, 0x3f, 0x0b, 0x7b, 0x74, 0xb8, 0xde, 0x8d, 0x96, 0x87, 0xab, 0xd4,
+	0x16, 0x96, 0x04, 0x16, 0xbe, 0x1f, 0xf3, 0xdb, 0x29, 0x9e, 0xc6, 0x83, 0x17, 0xfe, 0x6e, 0x30,
+	0x2c, 0xbc, 0x54, 0x16, 0x58, 0x3e, 0x22, 0x8d, 0x40, 0xc4, 0x7d, 0x61, 0x87, 0x22, 0x4d, 0x06,
+	0xbe, 0x97, 0x6a, 0x9e, 0xcd, 0x07, 0x3f, 0x07, 0xf7, 0x85, 0x5b, 0x8c, 0xa2, 0xfa, 0xa5, 0xd6,
+	0x2a, 0xae, 0xc7, 0xa4, 0xe2, 0xc8, 0xdb, 0xc2, 0xf6, 0x5c, 0xd3, 0x04, 0xfc, 0x32, 0x5b, 0x46,
+	0xf9, 0xcc, 0xa5, 0x0d, 0xb2, 0xa8, 0xba, 0xb2, 0xc7, 0x92, 0x97, 0x29, 0x81, 0x36, 0x49, 0xc5,
+	0x15, 0x8e, 0x17, 0x70, 0xe8, 0x80, 0x9a, 0x18, 0x30, 0x96, 0xe9, 0xfb, 0x64, 0x25, 0x81, 0xce,
+	0xa7, 0x0f, 0x00, 0x76, 0xea, 0x05, 0xc2, 0xdc, 0xc6, 0x8c, 0xf7, 0x66, 0x65, 0xdc, 0x50, 0x19,
+	0x17, 0xe2, 0xe0, 0xec, 0x1f, 0xc9, 0x97, 0x20, 0xd2, 0x0b, 0x52, 0x73, 0x78, 0xe8, 0x64, 0xa1,
+	0x42, 0x7d, 0x82, 0xa8, 0xad, 0x59, 0xa8, 0xfa, 0x2a, 0xce, 0x45, 0xc1, 0x55, 0xac, 0xa4, 0x11,
+	0xe2, 0x20, 0xe6, 0xfd, 0x4c, 0x28, 0xc4, 0xd7, 0x1e, 0x8c, 0x98, 0x8b, 0x02, 0x44, 0x25, 0x8d,
+	0x10, 0x6f, 0x44, 0x7c, 0xed, 0x6b, 0xc4, 0xa7, 0x0f, 0x46, 0xcc, 0x45, 0x01, 0xa2, 0x92, 0x10,
+	0xf1, 0x9c, 0x90, 0x28, 0xe1, 0xd7, 0x5c, 0x01, 0xee, 0x20, 0xe0, 0xee, 0x2c, 0x40, 0xdd, 0xf2,
+	0x4e, 0x82, 0xa0, 0xe5, 0x45, 0x41, 0xc2, 0x41, 0x4b, 0xb6, 0x68, 0x2c, 0xc1, 0xf7, 0x96, 0xf1,
+	0x08, 0xbe, 0x1f, 0x19, 0xa6, 0xd5, 0x22, 0x8b, 0xb2, 0x2d, 0x14, 0xd4, 0x20, 0xa5, 0x6b, 0x71,
+	0xab, 0xfa, 0x02, 0x26, 0x87, 0x72, 0xef, 0x6f, 0xb8, 0x9f, 0x09, 0x75, 0x9d, 0x33, 0x25, 0x58,
+	0x17, 0x64, 0xed, 0x32, 0xe6, 0x61, 0x22, 0x5b, 0xca, 0x28, 0x7c, 0x11, 0xf5, 0x13, 0x4a, 0x49,
+	0xf9, 0x8a, 0x27, 0x57, 0x3a, 0x16, 0xc7, 0xf4, 0xdb, 0xa4, 0xec, 0x83, 0x0d, 0x1b, 0x9b, 0xda,
+	0xfe, 0xe6, 0xdd, 0x2e, 0x0a, 0x22, 0x19, 0xba, 0x58, 0x7f, 0x5e, 0x20, 0x25, 0x90, 0xa8, 0x49,
+	0x96, 0xb9, 0xeb, 0xc6, 0xd0, 0xcb, 0x68, 0xa4, 0x91, 0x48, 0xb7, 0xc8, 0x52, 0x1a, 0x0d, 0x3c,
+	0x47, 0xc1, 0x55, 0x99, 0x96, 0x24, 0xb1, 0xcb, 0x53, 0x8e, 0x3d, 0x40, 0x9d, 0xe1, 0x58, 0x76,
+	0xe8, 0x58, 0xea, 0x76, 0x98, 0x05, 0x70, 0xe8, 0xe0, 0x55, 0x5e, 0x6e, 0xaf, 0xfd, 0x1b, 0x6e,
+	0x3a, 0xd4, 0xbf, 0x44, 0x35, 0xcb, 0x0b, 0xf4, 0x3b, 0x64, 0x39, 0x1d, 0xda, 0x38, 0x87, 0x45,
+	0x5c, 0xe2, 0x0d, 0x70, 0x5f, 0x4b, 0x27, 0xd3, 0xfc, 0x19, 0x98, 0x80, 0x75, 0x28, 0xff, 0xd3,
+	0x16, 0xa9, 0x80, 0xb7, 0x17, 0xba, 0x62, 0x88, 0x97, 0x78, 0xb9, 0xdd, 0x00, 0x77, 0x23, 0xe7,
+	0x7e, 0x26, 0x6d, 0x0c, 0x30, 0x71, 0x00, 0xf0, 0x44, 0xa5, 0x84, 0x0c, 0xea, 0x4e, 0x5e, 0x81,
+	0x90, 0x2a, 0x6a, 0x11, 0x7b, 0x32, 0xa4, 0x16, 0x59, 0x54, 0xd8, 0x15, 0xc4, 0xae, 0x83, 0x63,
+	0x05, 0xd6, 0x49, 0x61, 0x2a, 0x93, 0x5c, 0xaa, 0x58, 0x04, 0xd1, 0x8d, 0x70, 0xf1, 0x62, 0xac,
+	0xb0, 0x91, 0x68, 0x7d, 0xba, 0x40, 0x2a, 0x97, 0x43, 0x26, 0x92, 0xcc, 0x4f, 0xe9, 0xdb, 0xc4,
+	0xc0, 0x5e, 0x11, 0xb2, 0xb2, 0x0b, 0x4b, 0xdb, 0xde, 0x9e, 0x5c, 0x63, 0xd3, 0x1e, 0x70, 0x8d,
+	0x8d, 0x54, 0xc7, 0x7a, 0xfd, 0xa1, 0x12, 0x20, 0x3f, 0x38, 0x05, 0x16, 0x70, 0xa1, 0x95, 0x00,
+	0xdd, 0x98, 0x5c, 0x35, 0xdc, 0xe5, 0x12, 0xf6, 0xe1, 0xdf, 0xb8, 0xbb, 0xcb, 0x53, 0xa5, 0xd2,
+	0xde, 0x96, 0x5d, 0x38, 0x70, 0xaf, 0x2a, 0x6e, 0x1d, 0x6f, 0x7d, 0x0e, 0xaf, 0x14, 0xf3, 0x72,
+	0x81, 0xb1, 0x9e, 0xa0, 0x14, 0x63, 0x91, 0xe2, 0xce, 0xd5, 0x99, 0x1c, 0xca, 0x03, 0x27, 0x16,
+	0xf0, 0x58, 0xc0, 0x5b, 0x98, 0x7e, 0x3b, 0x1b, 0xcb, 0xf2, 0xf4, 0xea, 0xf3, 0xc4, 0xce, 0x12,
+	0xb0, 0x2d, 0xa9, 0xd3, 0x0b, 0xe4, 0xf7, 0x40, 0x3c, 0x2a, 0x7f, 0xfc, 0xd9, 0xce, 0x9c, 0xc5,
+	0x49, 0x4d, 0xb7, 0xe8, 0x19, 0xdc, 0xfc, 0x33, 0xca, 0x0c, 0x4a, 0x27, 0x49, 0x23, 0x78, 0x9c,
+	0x85, 0x0d, 0xf5, 0xaf, 0x8b, 0x4d, 0x95, 0x8e, 0xd6, 0xff, 0x1c, 0xd4, 0x2c, 0x2f, 0x68, 0x8a,
+	0xcf, 0xca, 0xa4, 0x06, 0x53, 0x75, 0x84, 0x6e, 0xb8, 0x65, 0xc1, 0x4a, 0x31, 0xd6, 0x14, 0x5a,
+	0x92, 0xdc, 0xf2, 0x99, 0x8c, 0xb2, 0x54, 0x3f, 0x54, 0x23, 0x51, 0x46, 0xc4, 0x42, 0x0c, 0x85,
+	0x83, 0x6b, 0x59, 0x66, 0x5a, 0xa2, 0x07, 0x64, 0x05, 0xde, 0xcc, 0x78, 0xcf, 0xc7, 0xd7, 0x3b,
+	0xb8, 0x00, 0x70, 0xfa, 0x6d, 0x03, 0x92, 0xaa, 0x6b, 0x43, 0x57, 0xea, 0x59, 0x41, 0xa2, 0x3f,
+	0x20, 0x6b, 0x93, 0x30, 0xcc, 0x16, 0xd7, 0xa6, 0xd2, 0xa6, 0x10, 0xb8, 0x3a, 0x76, 0x45, 0x0b,
+	0x9b, 0x92, 0xd5, 0xa1, 0xdf, 0xcb, 0xfa, 0x58, 0x81, 0x15, 0xa6, 0x04, 0xa9, 0xf5, 0xbd, 0xc0,
+	0x4b, 0xb1, 0xe2, 0x16, 0x99, 0x12, 0x80, 0xa8, 0x0a, 0x75, 0x17, 0xc7, 0x9e, 0x2b, 0x12, 0xec,
+	0x9d, 0x6a, 0xfb, 0xaf, 0xdd, 0x2d, 0x83, 0xdc, 0xcb, 0x08, 0x9b, 0xf8, 0xcb, 0xc9, 0x89, 0x10,
+	0x93, 0x0c, 0xa0, 0x7c, 0xe3, 0x5b, 0xec, 0x8e, 0xf4, 0xe4, 0x94, 0xe1, 0x1c, 0xf5, 0xac, 0x20,
+	0xd1, 0x36, 0xa1, 0x3a, 0x0c, 0x6a, 0x23, 0x8b, 0x43, 0x1b, 0x0f, 0x81, 0x3a, 0xc6, 0xe2, 0xa3,
+	0xa8, 0xac, 0x0c, 0x8d, 0xa7, 0x60, 0x63, 0x77, 0x34, 0xf4, 0xc7, 0x84, 0xaa, 0x3d, 0xb1, 0x3f,
+	0x4a, 0xa0, 0xa3, 0x70, 0x30, 0x37, 0xdd, 0xde, 0x20, 0xbf, 0xb2, 0xea, 0x9c, 0x0d, 0x25, 0xbd,
+	0x03, 0xae, 0x4a, 0x03, 0xa7, 0x67, 0xd9, 0x58, 0x84, 0xef, 0x65, 0xa3, 0x32, 0x5e, 0x3f, 0x3d,
+	0x0b, 0xb6, 0x31, 0x92, 0x73, 0xe9, 0x59, 0x2f, 0x09, 0x81, 0x37, 0x6f, 0x4f, 0x36, 0xa1, 0xbe,
+	0x2f, 0x4f, 0xae, 0x90, 0x07, 0x62, 0x74, 0x64, 0xca, 0x71, 0xbe, 0x30, 0x17, 0x8a, 0x85, 0x09,
+	0xde, 0x4e, 0xe4, 0x0a, 0x2c, 0x0d, 0xf0, 0x96, 0xe3, 0x37, 0xfe, 0x34, 0x4f, 0x72, 0x6f, 0x9e,
+	0xf4, 0x87, 0xa4, 0x79, 0x7c, 0x72, 0xd2, 0xe9, 0x76, 0xed, 0xcb, 0x0f, 0x2e, 0x3a, 0xf6, 0x45,
+	0x87, 0x9d, 0x9f, 0x75, 0xbb, 0x67, 0xef, 0xbe, 0x7c, 0x01, 0x3a, 0x63, 0xae, 0xf9, 0xe4, 0x93,
+	0xdf, 0x3f, 0x33, 0x27, 0xfe, 0x17, 0x22, 0x0e, 0xbc, 0x24, 0x81, 0xc7, 0xd2, 0x97, 0x04, 0x6f,
+	0x91, 0xad, 0x7c, 0x34, 0xeb, 0x74, 0x2f, 0xd9, 0xd9, 0xc9, 0x65, 0xe7, 0xd4, 0x98, 0x6f, 0x9a,
+	0x10, 0xd9, 0x98, 0x44, 0xc2, 0xd1, 0x92, 0xc6, 0x9e, 0xfc, 0x3d, 0x84, 0x1e, 0x12, 0xf3, 0x7e,
+	0x4e, 0x88, 0x5b, 0x68, 0x36, 0x21, 0x6e, 0xeb, 0x3e, 0x46, 0xe1, 0x36, 0xcb, 0x1f, 0xff, 0xe1,
+	0xe9, 0x5c, 0xfb, 0xe8, 0x8b, 0xaf, 0x9e, 0xce, 0x7f, 0x09, 0x9f, 0xbf, 0xc3, 0xe7, 0xd3, 0x7f,
+	0x3c, 0x9d, 0xfb, 0x12, 0x3e, 0x7f, 0x81, 0xcf, 0x2f, 0x9f, 0xf5, 0xbd, 0xf4, 0x2a, 0xeb, 0x41,
+	0x21, 0x05, 0xad, 0xe9, 0x5f, 0x1a, 0xe4, 0x3b, 0x75, 0xd2, 0x5b, 0xc2, 0x1f, 0x91, 0x9e, 0xff,
+	0x17, 0x74, 0xbc, 0x1d, 0xd8, 0x9d, 0x12, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1090,6 +1097,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedDispatchMsgs) > 0 {
+		for iNdEx := len(m.AllowedDispatchMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDispatchMsgs[iNdEx])
+			copy(dAtA[i:], m.AllowedDispatchMsgs[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AllowedDispatchMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.ActiveStaticPrecompiles) > 0 {
 		for iNdEx := len(m.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ActiveStaticPrecompiles[iNdEx])
@@ -2030,6 +2046,12 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	if len(m.AllowedDispatchMsgs) > 0 {
+		for _, s := range m.AllowedDispatchMsgs {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2620,6 +2642,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDispatchMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDispatchMsgs = append(m.AllowedDispatchMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -57,6 +57,7 @@ type FeeMarketKeeper interface {
 	GetBaseFee(ctx sdk.Context) math.LegacyDec
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 	CalculateBaseFee(ctx sdk.Context) math.LegacyDec
+	AddTransientEVMGasUsed(ctx sdk.Context, gasUsed uint64) uint64
 }
 
 // Erc20Keeper defines the expected interface needed to instantiate ERC20 precompiles.
@@ -64,6 +65,11 @@ type Erc20Keeper interface {
 	GetERC20PrecompileInstance(ctx sdk.Context, address common.Address) (contract vm.PrecompiledContract, found bool, err error)
 }
 
+// Erc721Keeper defines the expected interface needed to instantiate ERC721 precompiles.
+type Erc721Keeper interface {
+	GetERC721PrecompileInstance(ctx sdk.Context, address common.Address) (contract vm.PrecompiledContract, found bool, err error)
+}
+
 // EvmHooks event hooks for evm tx processing
 type EvmHooks interface {
 	// Must be called after tx is processed successfully, if return an error, the whole transaction is reverted.
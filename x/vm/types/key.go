@@ -35,6 +35,8 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientTxMsgCount
+	prefixTransientTxFeeDenom
 )
 
 // KVStore key prefixes
@@ -51,6 +53,9 @@ var (
 	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
 	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
 	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+
+	KeyPrefixTransientTxMsgCount = []byte{prefixTransientTxMsgCount}
+	KeyPrefixTransientTxFeeDenom = []byte{prefixTransientTxFeeDenom}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -16,6 +16,24 @@ type FeeMarketKeeper struct {
 	mock.Mock
 }
 
+// AddTransientEVMGasUsed provides a mock function with given fields: ctx, gasUsed
+func (_m *FeeMarketKeeper) AddTransientEVMGasUsed(ctx types.Context, gasUsed uint64) uint64 {
+	ret := _m.Called(ctx, gasUsed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTransientEVMGasUsed")
+	}
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(types.Context, uint64) uint64); ok {
+		r0 = rf(ctx, gasUsed)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // CalculateBaseFee provides a mock function with given fields: ctx
 func (_m *FeeMarketKeeper) CalculateBaseFee(ctx types.Context) math.LegacyDec {
 	ret := _m.Called(ctx)
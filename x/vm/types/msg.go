@@ -12,6 +12,7 @@ import (
 	protov2 "google.golang.org/protobuf/proto"
 
 	evmapi "github.com/cosmos/evm/api/cosmos/evm/vm/v1"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -400,6 +401,14 @@ func (msg *MsgEthereumTx) UnmarshalBinary(b []byte, signer ethtypes.Signer) erro
 
 // BuildTx builds the canonical cosmos tx from ethereum msg
 func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.Tx, error) {
+	return msg.BuildTxWithFeeDenom(b, evmDenom, nil)
+}
+
+// BuildTxWithFeeDenom builds the canonical cosmos tx from ethereum msg. The fee
+// amount is converted into the given fee denom, rounded up, if the sender pays
+// the fees of its EVM transactions in an alternative fee denom. It must be nil
+// if the fees are paid in the EVM denom.
+func (msg *MsgEthereumTx) BuildTxWithFeeDenom(b client.TxBuilder, evmDenom string, feeDenom *feemarkettypes.FeeDenom) (signing.Tx, error) {
 	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
 	if !ok {
 		return nil, errors.New("unsupported builder")
@@ -417,8 +426,12 @@ func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.
 	fees := make(sdk.Coins, 0, 1)
 	feeAmt := sdkmath.NewIntFromBigInt(txData.Fee())
 	if feeAmt.Sign() > 0 {
-		fees = append(fees, sdk.NewCoin(evmDenom, feeAmt))
-		fees = ConvertCoinsDenomToExtendedDenom(fees)
+		if feeDenom != nil {
+			fees = append(fees, ConvertAmountFrom18DecimalsToFeeDenom(feeAmt.BigInt(), *feeDenom, true))
+		} else {
+			fees = append(fees, sdk.NewCoin(evmDenom, feeAmt))
+			fees = ConvertCoinsDenomToExtendedDenom(fees)
+		}
 	}
 
 	builder.SetExtensionOptions(option)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"slices"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -14,6 +15,8 @@ import (
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 
 	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -30,7 +33,10 @@ var (
 	// DefaultExtraEIPs defines the default extra EIPs to be included.
 	DefaultExtraEIPs []int64
 	// DefaultEVMChannels defines a list of IBC channels that connect to EVM chains like injective or cronos.
-	DefaultEVMChannels              []string
+	DefaultEVMChannels []string
+	// DefaultAllowedDispatchMsgs defines the default Cosmos messages that can be
+	// executed through the dispatch precompile.
+	DefaultAllowedDispatchMsgs      []string
 	DefaultCreateAllowlistAddresses []string
 	DefaultCallAllowlistAddresses   []string
 	DefaultAccessControl            = AccessControl{
@@ -71,6 +77,7 @@ func DefaultParams() Params {
 		ActiveStaticPrecompiles: DefaultStaticPrecompiles,
 		EVMChannels:             DefaultEVMChannels,
 		AccessControl:           DefaultAccessControl,
+		AllowedDispatchMsgs:     DefaultAllowedDispatchMsgs,
 	}
 }
 
@@ -110,6 +117,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidateDispatchMsgs(p.AllowedDispatchMsgs); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -132,6 +143,12 @@ func (p Params) GetActiveStaticPrecompilesAddrs() []common.Address {
 	return precompiles
 }
 
+// IsDispatchMsgAllowed returns true if the Cosmos message with the provided
+// type URL can be executed through the dispatch precompile
+func (p Params) IsDispatchMsgAllowed(typeURL string) bool {
+	return slices.Contains(p.AllowedDispatchMsgs, typeURL)
+}
+
 // IsEVMChannel returns true if the channel provided is in the list of
 // EVM channels
 func (p Params) IsEVMChannel(channel string) bool {
@@ -240,6 +257,34 @@ func ValidatePrecompiles(i interface{}) error {
 	return nil
 }
 
+// ValidateDispatchMsgs checks if the dispatch message type URLs are valid and unique.
+func ValidateDispatchMsgs(i interface{}) error {
+	typeURLs, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid dispatch message slice type: %T", i)
+	}
+
+	seenTypeURLs := make(map[string]struct{})
+	for _, typeURL := range typeURLs {
+		if _, ok := seenTypeURLs[typeURL]; ok {
+			return fmt.Errorf("duplicate dispatch message %s", typeURL)
+		}
+
+		if len(typeURL) < 2 || !strings.HasPrefix(typeURL, "/") {
+			return fmt.Errorf("invalid dispatch message type URL %q", typeURL)
+		}
+
+		// NOTE: Ethereum transactions must not be executed from within the EVM
+		if typeURL == sdk.MsgTypeURL(&MsgEthereumTx{}) {
+			return fmt.Errorf("dispatch message %s is not allowed", typeURL)
+		}
+
+		seenTypeURLs[typeURL] = struct{}{}
+	}
+
+	return nil
+}
+
 // IsLondon returns if london hardfork is enabled.
 func IsLondon(ethConfig *params.ChainConfig, height int64) bool {
 	return ethConfig.IsLondon(big.NewInt(height))
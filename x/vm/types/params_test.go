@@ -49,6 +49,40 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "valid dispatch messages",
+			params: Params{
+				AllowedDispatchMsgs: []string{
+					"/cosmos.bank.v1beta1.MsgSend",
+					"/cosmos.staking.v1beta1.MsgDelegate",
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid dispatch message type URL",
+			params: Params{
+				AllowedDispatchMsgs: []string{"cosmos.bank.v1beta1.MsgSend"},
+			},
+			errContains: "invalid dispatch message type URL",
+		},
+		{
+			name: "duplicate dispatch messages",
+			params: Params{
+				AllowedDispatchMsgs: []string{
+					"/cosmos.bank.v1beta1.MsgSend",
+					"/cosmos.bank.v1beta1.MsgSend",
+				},
+			},
+			errContains: "duplicate dispatch message",
+		},
+		{
+			name: "ethereum tx dispatch message",
+			params: Params{
+				AllowedDispatchMsgs: []string{"/cosmos.evm.vm.v1.MsgEthereumTx"},
+			},
+			errContains: "is not allowed",
+		},
 	}
 
 	for _, tc := range testCases {
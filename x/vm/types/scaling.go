@@ -6,6 +6,8 @@ import (
 
 	"github.com/holiman/uint256"
 
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -43,6 +45,19 @@ func ConvertBigIntFrom18DecimalsToLegacyDec(amt *big.Int) sdkmath.LegacyDec {
 	return decAmt.QuoInt(evmCoinDecimal.ConversionFactor())
 }
 
+// ConvertAmountFrom18DecimalsToFeeDenom converts the given amount, in the 18
+// decimals representation of the EVM denom, into a coin of the given fee denom
+// using its conversion rate. The converted amount is rounded up if roundUp is
+// true and truncated otherwise.
+func ConvertAmountFrom18DecimalsToFeeDenom(amt *big.Int, feeDenom feemarkettypes.FeeDenom, roundUp bool) sdk.Coin {
+	feeAmt := ConvertBigIntFrom18DecimalsToLegacyDec(amt).Mul(feeDenom.ConversionRate)
+	if roundUp {
+		return sdk.Coin{Denom: feeDenom.Denom, Amount: feeAmt.Ceil().TruncateInt()}
+	}
+
+	return sdk.Coin{Denom: feeDenom.Denom, Amount: feeAmt.TruncateInt()}
+}
+
 // ConvertEvmCoinDenomToExtendedDenom converts the coin's Denom to the extended denom.
 // Return an error if the coin denom is not the EVM.
 func ConvertEvmCoinDenomToExtendedDenom(coin sdk.Coin) (sdk.Coin, error) {
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	testconstants "github.com/cosmos/evm/testutil/constants"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/math"
@@ -239,3 +240,60 @@ func TestConvertAmountTo18DecimalsBigInt(t *testing.T) {
 		}
 	}
 }
+
+func TestConvertAmountFrom18DecimalsToFeeDenom(t *testing.T) {
+	feeDenom := feemarkettypes.NewFeeDenom("uusdc", math.LegacyNewDecWithPrec(5, 1))
+
+	testCases := []struct {
+		name     string
+		amt      *big.Int
+		roundUp  bool
+		exp6dec  math.Int
+		exp18dec math.Int
+	}{
+		{
+			name:     "zero",
+			amt:      big.NewInt(0),
+			exp6dec:  math.ZeroInt(),
+			exp18dec: math.ZeroInt(),
+		},
+		{
+			name:     "exact amount",
+			amt:      big.NewInt(4e12),
+			exp6dec:  math.NewInt(2),
+			exp18dec: math.NewInt(2e12),
+		},
+		{
+			name:     "fractional amount truncated",
+			amt:      big.NewInt(3e12 + 1),
+			exp6dec:  math.NewInt(1),
+			exp18dec: math.NewInt(15e11),
+		},
+		{
+			name:     "fractional amount rounded up",
+			amt:      big.NewInt(3e12 + 1),
+			roundUp:  true,
+			exp6dec:  math.NewInt(2),
+			exp18dec: math.NewInt(15e11 + 1),
+		},
+	}
+
+	for _, coinInfo := range []evmtypes.EvmCoinInfo{
+		testconstants.ExampleChainCoinInfo[testconstants.SixDecimalsChainID],
+		testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID],
+	} {
+		for _, tc := range testCases {
+			t.Run(fmt.Sprintf("%d dec - %s", coinInfo.Decimals, tc.name), func(t *testing.T) {
+				configurator := evmtypes.NewEVMConfigurator()
+				configurator.ResetTestConfig()
+				require.NoError(t, configurator.WithEVMCoinInfo(coinInfo).Configure())
+				res := evmtypes.ConvertAmountFrom18DecimalsToFeeDenom(tc.amt, feeDenom, tc.roundUp)
+				exp := tc.exp18dec
+				if coinInfo.Decimals == evmtypes.SixDecimals {
+					exp = tc.exp6dec
+				}
+				require.Equal(t, sdk.NewCoin(feeDenom.Denom, exp), res)
+			})
+		}
+	}
+}
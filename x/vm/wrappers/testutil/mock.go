@@ -44,6 +44,20 @@ func (m *MockFeeMarketKeeper) EXPECT() *MockFeeMarketKeeperMockRecorder {
 	return m.recorder
 }
 
+// AddTransientEVMGasUsed mocks base method.
+func (m *MockFeeMarketKeeper) AddTransientEVMGasUsed(ctx types.Context, gasUsed uint64) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTransientEVMGasUsed", ctx, gasUsed)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// AddTransientEVMGasUsed indicates an expected call of AddTransientEVMGasUsed.
+func (mr *MockFeeMarketKeeperMockRecorder) AddTransientEVMGasUsed(ctx, gasUsed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTransientEVMGasUsed", reflect.TypeOf((*MockFeeMarketKeeper)(nil).AddTransientEVMGasUsed), ctx, gasUsed)
+}
+
 // CalculateBaseFee mocks base method.
 func (m *MockFeeMarketKeeper) CalculateBaseFee(ctx types.Context) math.LegacyDec {
 	m.ctrl.T.Helper()